@@ -21,3 +21,49 @@ resource "cloudflare_access_application" "staging_app" {
     max_age           = 10
   }
 }
+
+# SaaS application using SAML with a custom attribute mapping
+resource "cloudflare_access_application" "saml_app" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "SAML application"
+  type       = "saas"
+
+  saas_app {
+    sp_entity_id         = "saas-app.example"
+    consumer_service_url = "https://saas-app.example/sso/saml/consume"
+    name_id_format       = "email"
+
+    custom_attribute {
+      name        = "email"
+      name_format = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+      source {
+        name = "user_email"
+      }
+    }
+  }
+}
+
+# SaaS application using OIDC with a custom claim mapping
+resource "cloudflare_access_application" "oidc_app" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "OIDC application"
+  type       = "saas"
+
+  saas_app {
+    auth_type     = "oidc"
+    redirect_uris = ["https://saas-app.example/sso/oauth2/callback"]
+    grant_types   = ["authorization_code"]
+    scopes        = ["openid", "email", "profile", "groups"]
+
+    custom_claim {
+      name  = "rank"
+      scope = "profile"
+      source {
+        name = "rank"
+        name_by_idp = {
+          "2e2b2a37-dbb1-4b5f-8c58-4e1b8d9a1d6c" = "employee_rank"
+        }
+      }
+    }
+  }
+}
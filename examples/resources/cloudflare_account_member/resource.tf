@@ -5,3 +5,15 @@ resource "cloudflare_account_member" "example_user" {
     "d784fa8b6d98d27699781bd9a7cf19f0"
   ]
 }
+
+# Grant permission groups on a resource group, which can cover zones added
+# to the account later without updating the member.
+resource "cloudflare_account_member" "example_policy_user" {
+  email_address = "policy-user@example.com"
+
+  policies {
+    access               = "allow"
+    permission_group_ids = ["c8fed203ed3043cba015a93ad1616f1f"]
+    resource_group_ids   = ["6d7f2f5f5b1d4a0e9081fdc98d432fd1"]
+  }
+}
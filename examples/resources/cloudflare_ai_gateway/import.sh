@@ -0,0 +1 @@
+$ terraform import cloudflare_ai_gateway.example <account_id>/<gateway_name>
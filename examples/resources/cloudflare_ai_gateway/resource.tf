@@ -0,0 +1,11 @@
+resource "cloudflare_ai_gateway" "example" {
+  account_id              = "f037e56e89293a057740de681ac9abbe"
+  name                    = "llm-proxy"
+  cache_ttl               = 3600
+  collect_logs            = true
+  rate_limiting_limit     = 100
+  rate_limiting_interval  = 60
+  rate_limiting_technique = "sliding"
+  log_management          = 100000
+  log_management_strategy = "DELETE_OLDEST"
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_api_shield_operation_schema_validation_settings.example <zone_id>/<operation_id>
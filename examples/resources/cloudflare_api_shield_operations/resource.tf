@@ -0,0 +1,4 @@
+resource "cloudflare_api_shield_operations" "example" {
+  zone_id      = "0da42c8d2132a9ddaf714f9e7c920711"
+  openapi_path = "${path.module}/openapi.yaml"
+}
@@ -0,0 +1,7 @@
+resource "cloudflare_api_shield_schema" "petstore" {
+  zone_id            = "0da42c8d2132a9ddaf714f9e7c920711"
+  name               = "petstore"
+  kind               = "openapi_v3"
+  validation_enabled = true
+  source             = file("./schemas/petstore.json")
+}
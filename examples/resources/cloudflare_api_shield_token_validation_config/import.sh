@@ -0,0 +1 @@
+$ terraform import cloudflare_api_shield_token_validation_config.example <zone_id>/<config_id>
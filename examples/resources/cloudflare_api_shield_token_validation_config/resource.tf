@@ -0,0 +1,17 @@
+resource "cloudflare_api_shield_token_validation_config" "example" {
+  zone_id       = "0da42c8d2132a9ddaf714f9e7c920711"
+  title         = "Auth0 access tokens"
+  description   = "Tokens issued by our identity provider"
+  token_sources = ["http.request.headers[\"authorization\"][0]"]
+
+  credentials {
+    key {
+      kid = "ec-key-1"
+      kty = "EC"
+      alg = "ES256"
+      crv = "P-256"
+      x   = "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU"
+      y   = "x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"
+    }
+  }
+}
@@ -0,0 +1,19 @@
+resource "cloudflare_custom_hostname_batch" "example" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  concurrency = 10
+  hostnames = [
+    "shop.customer-one.com",
+    "shop.customer-two.com",
+    "shop.customer-three.com",
+  ]
+
+  custom_origin_server = "origin.example.com"
+
+  ssl {
+    method = "txt"
+    settings {
+      min_tls_version = "1.2"
+      tls13           = "on"
+    }
+  }
+}
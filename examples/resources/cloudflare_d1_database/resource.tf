@@ -0,0 +1,12 @@
+# Seed a staging database from an export of production and keep a copy of
+# its contents in R2 when it is destroyed.
+resource "cloudflare_d1_database" "staging" {
+  account_id      = "f037e56e89293a057740de681ac9abbe"
+  name            = "app-staging"
+  import_sql_path = "${path.module}/production-export.sql"
+
+  export_on_destroy {
+    bucket_name = "d1-backups"
+    key_prefix  = "staging/"
+  }
+}
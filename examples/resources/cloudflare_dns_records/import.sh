@@ -0,0 +1 @@
+$ terraform import cloudflare_dns_records.example <zone_id>
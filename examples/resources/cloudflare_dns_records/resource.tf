@@ -0,0 +1,24 @@
+resource "cloudflare_dns_records" "example" {
+  zone_id    = "0da42c8d2132a9ddaf714f9e7c920711"
+  batch_size = 500
+
+  record {
+    name    = "www.example.com"
+    type    = "A"
+    value   = "192.0.2.1"
+    proxied = true
+  }
+
+  record {
+    name     = "example.com"
+    type     = "MX"
+    value    = "mx.example.com"
+    priority = 10
+  }
+
+  record {
+    name  = "example.com"
+    type  = "TXT"
+    value = "v=spf1 include:_spf.example.com -all"
+  }
+}
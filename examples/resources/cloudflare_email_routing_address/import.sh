@@ -0,0 +1 @@
+$ terraform import cloudflare_email_routing_address.example <account_id>/<address_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_email_routing_catch_all.example <zone_id>
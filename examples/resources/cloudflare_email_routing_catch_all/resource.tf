@@ -0,0 +1,10 @@
+resource "cloudflare_email_routing_catch_all" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  name    = "catch all"
+  enabled = true
+
+  action {
+    type  = "forward"
+    value = ["inbox@example.com"]
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_email_routing_rule.example <zone_id>/<rule_id>
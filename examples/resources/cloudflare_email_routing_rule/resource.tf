@@ -0,0 +1,16 @@
+resource "cloudflare_email_routing_rule" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  name    = "forward sales"
+  enabled = true
+
+  matcher {
+    type  = "literal"
+    field = "to"
+    value = "sales@example.com"
+  }
+
+  action {
+    type  = "forward"
+    value = ["destination@example.net"]
+  }
+}
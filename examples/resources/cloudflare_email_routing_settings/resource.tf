@@ -0,0 +1,4 @@
+resource "cloudflare_email_routing_settings" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  enabled = true
+}
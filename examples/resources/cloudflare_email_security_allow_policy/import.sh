@@ -0,0 +1 @@
+$ terraform import cloudflare_email_security_allow_policy.example <account_id>/<allow_policy_id>
@@ -0,0 +1,8 @@
+resource "cloudflare_email_security_allow_policy" "example" {
+  account_id        = "f037e56e89293a057740de681ac9abbe"
+  pattern_type      = "DOMAIN"
+  pattern           = "partner.example.com"
+  is_trusted_sender = true
+  verify_sender     = true
+  comments          = "Trusted partner"
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_email_security_block_sender.example <account_id>/<block_sender_id>
@@ -0,0 +1,6 @@
+resource "cloudflare_email_security_block_sender" "example" {
+  account_id   = "f037e56e89293a057740de681ac9abbe"
+  pattern_type = "DOMAIN"
+  pattern      = "phishing.example.com"
+  comments     = "Known phishing domain"
+}
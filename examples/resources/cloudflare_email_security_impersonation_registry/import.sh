@@ -0,0 +1 @@
+$ terraform import cloudflare_email_security_impersonation_registry.example <account_id>/<impersonation_registry_id>
@@ -0,0 +1,5 @@
+resource "cloudflare_email_security_impersonation_registry" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "Jane Doe"
+  email      = "jane.doe@example.com"
+}
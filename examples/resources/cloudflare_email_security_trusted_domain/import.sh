@@ -0,0 +1 @@
+$ terraform import cloudflare_email_security_trusted_domain.example <account_id>/<trusted_domain_id>
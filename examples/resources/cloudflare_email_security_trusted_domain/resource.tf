@@ -0,0 +1,7 @@
+resource "cloudflare_email_security_trusted_domain" "example" {
+  account_id    = "f037e56e89293a057740de681ac9abbe"
+  pattern       = "example-corp.com"
+  is_recent     = true
+  is_similarity = true
+  comments      = "Sister company domain"
+}
@@ -51,3 +51,20 @@ resource "cloudflare_healthcheck" "tcp_health_check" {
   consecutive_fails = 3
   consecutive_successes = 2
 }
+
+# Notify when the HTTPS Healthcheck becomes unhealthy
+resource "cloudflare_notification_policy" "http_health_check" {
+  account_id = var.cloudflare_account_id
+  name       = "http-health-check-unhealthy"
+  enabled    = true
+  alert_type = "health_check_status_notification"
+
+  email_integration {
+    id = "myemail@example.com"
+  }
+
+  filters {
+    health_check_id = [cloudflare_healthcheck.http_health_check.id]
+    status          = ["Unhealthy"]
+  }
+}
@@ -0,0 +1,13 @@
+resource "cloudflare_hostname_tls_setting" "min_tls_version" {
+  zone_id  = "0da42c8d2132a9ddaf714f9e7c920711"
+  hostname = "app.example.com"
+  setting  = "min_tls_version"
+  value    = "1.2"
+}
+
+resource "cloudflare_hostname_tls_setting" "ciphers" {
+  zone_id  = "0da42c8d2132a9ddaf714f9e7c920711"
+  hostname = "app.example.com"
+  setting  = "ciphers"
+  ciphers  = ["ECDHE-ECDSA-AES128-GCM-SHA256", "ECDHE-RSA-AES128-GCM-SHA256"]
+}
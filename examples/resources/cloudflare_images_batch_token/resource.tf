@@ -0,0 +1,9 @@
+# Issue a new token every day, or as soon as the current one has expired.
+resource "time_rotating" "daily" {
+  rotation_days = 1
+}
+
+resource "cloudflare_images_batch_token" "example" {
+  account_id       = "f037e56e89293a057740de681ac9abbe"
+  rotation_trigger = time_rotating.daily.id
+}
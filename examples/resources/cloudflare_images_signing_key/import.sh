@@ -0,0 +1 @@
+$ terraform import cloudflare_images_signing_key.example <account_id>/<key_name>
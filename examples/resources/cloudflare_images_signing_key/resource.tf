@@ -0,0 +1,21 @@
+# Rotate the value of the key every 30 days.
+resource "time_rotating" "monthly" {
+  rotation_days = 30
+}
+
+resource "cloudflare_images_signing_key" "example" {
+  account_id       = "f037e56e89293a057740de681ac9abbe"
+  name             = "delivery"
+  rotation_trigger = time_rotating.monthly.id
+}
+
+# Alternatively, rotate the key by changing its name; the previous key is
+# removed once the replacement has been created.
+resource "cloudflare_images_signing_key" "named" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "key-2022-09"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_images_variant.example <account_id>/<variant_name>
@@ -0,0 +1,9 @@
+resource "cloudflare_images_variant" "thumbnail" {
+  account_id                = "f037e56e89293a057740de681ac9abbe"
+  name                      = "thumbnail"
+  fit                       = "cover"
+  width                     = 200
+  height                    = 200
+  metadata                  = "none"
+  never_require_signed_urls = true
+}
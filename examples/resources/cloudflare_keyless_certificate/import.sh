@@ -0,0 +1 @@
+$ terraform import cloudflare_keyless_certificate.example <zone_id>/<keyless_certificate_id>
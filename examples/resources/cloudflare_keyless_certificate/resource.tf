@@ -0,0 +1,20 @@
+resource "cloudflare_keyless_certificate" "example" {
+  zone_id       = "0da42c8d2132a9ddaf714f9e7c920711"
+  name          = "example"
+  certificate   = file("example.com.pem")
+  bundle_method = "ubiquitous"
+  host          = "keyless.example.com"
+  port          = 24008
+}
+
+# Reach a key server on a private network through Cloudflare Tunnel
+resource "cloudflare_keyless_certificate" "tunnel" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  certificate = file("example.com.pem")
+  host        = "keyless.internal.example.com"
+
+  tunnel {
+    private_ip = "10.0.0.10"
+    vnet_id    = "7365377a-85a4-4390-9480-531ef7dc7a3c"
+  }
+}
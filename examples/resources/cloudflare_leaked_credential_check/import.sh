@@ -0,0 +1 @@
+$ terraform import cloudflare_leaked_credential_check.example <zone_id>
@@ -0,0 +1,10 @@
+resource "cloudflare_leaked_credential_check" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  enabled = true
+}
+
+resource "cloudflare_leaked_credential_check_rule" "login_form" {
+  zone_id  = cloudflare_leaked_credential_check.example.zone_id
+  username = "lookup_json_string(http.request.body.raw, \"user\")"
+  password = "lookup_json_string(http.request.body.raw, \"secret\")"
+}
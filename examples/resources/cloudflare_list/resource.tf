@@ -52,3 +52,35 @@ resource "cloudflare_list" "example" {
     comment = "two"
   }
 }
+
+# Hostname list
+resource "cloudflare_list" "example_hostname" {
+  account_id  = "919f297a62fdfb28844177128ed4d331"
+  name        = "example_hostnames"
+  description = "example hostnames for a list"
+  kind        = "hostname"
+
+  item {
+    value {
+      hostname {
+        url_hostname = "*.example.com"
+      }
+    }
+    comment = "all subdomains"
+  }
+}
+
+# ASN list
+resource "cloudflare_list" "example_asn" {
+  account_id  = "919f297a62fdfb28844177128ed4d331"
+  name        = "example_asns"
+  description = "example ASNs for a list"
+  kind        = "asn"
+
+  item {
+    value {
+      asn = 13335
+    }
+    comment = "cloudflare"
+  }
+}
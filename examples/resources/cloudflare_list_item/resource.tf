@@ -0,0 +1,45 @@
+resource "cloudflare_list" "example" {
+  account_id  = "919f297a62fdfb28844177128ed4d331"
+  name        = "example_list"
+  description = "example IPs for a list"
+  kind        = "ip"
+
+  ignore_items = true
+}
+
+# IP list item
+resource "cloudflare_list_item" "example_ip" {
+  account_id = "919f297a62fdfb28844177128ed4d331"
+  list_id    = cloudflare_list.example.id
+  ip         = "192.0.2.0"
+  comment    = "one"
+}
+
+# Redirect list item
+resource "cloudflare_list_item" "example_redirect" {
+  account_id = "919f297a62fdfb28844177128ed4d331"
+  list_id    = "2c0fc9fa937b11eaa1b71c4d701ab86e"
+
+  redirect {
+    source_url  = "example.com/blog"
+    target_url  = "https://blog.example.com"
+    status_code = 301
+  }
+}
+
+# Hostname list item
+resource "cloudflare_list_item" "example_hostname" {
+  account_id = "919f297a62fdfb28844177128ed4d331"
+  list_id    = "5c0fc9fa937b11eaa1b71c4d701ab86e"
+
+  hostname {
+    url_hostname = "*.example.com"
+  }
+}
+
+# ASN list item
+resource "cloudflare_list_item" "example_asn" {
+  account_id = "919f297a62fdfb28844177128ed4d331"
+  list_id    = "6c0fc9fa937b11eaa1b71c4d701ab86e"
+  asn        = 13335
+}
@@ -75,3 +75,55 @@ resource "cloudflare_logpush_job" "example_job" {
   dataset             = "http_requests"
   frequency           = "high"
 }
+
+# Example Usage (plan time destination validation)
+#
+# With `validate_destination` the destination, ownership challenge and
+# logpull options are checked against the Logpush validation API during
+# `terraform plan` instead of failing on apply.
+resource "cloudflare_logpush_job" "validated_job" {
+  enabled              = true
+  zone_id              = "d41d8cd98f00b204e9800998ecf8427e"
+  name                 = "My-validated-logpush-job"
+  logpull_options      = "fields=RayID,ClientIP,EdgeStartTimestamp&timestamps=rfc3339"
+  destination_conf     = "s3://my-bucket-path?region=us-west-2"
+  ownership_challenge  = "0000000000000"
+  dataset              = "http_requests"
+  validate_destination = true
+}
+
+# Example Usage (structured filter)
+resource "cloudflare_logpush_job" "filtered_job" {
+  enabled          = true
+  zone_id          = "d41d8cd98f00b204e9800998ecf8427e"
+  name             = "My-filtered-logpush-job"
+  logpull_options  = "fields=RayID,ClientIP,EdgeStartTimestamp&timestamps=rfc3339"
+  destination_conf = "r2://my-bucket-path/{DATE}?account-id=f037e56e89293a057740de681ac9abbe&access-key-id=ACCESS_KEY_ID&secret-access-key=SECRET_ACCESS_KEY"
+  dataset          = "http_requests"
+
+  filter_builder {
+    match = "all"
+
+    condition {
+      key      = "ClientRequestHost"
+      operator = "eq"
+      value    = "example.com"
+    }
+
+    group {
+      match = "any"
+
+      condition {
+        key      = "EdgeResponseStatus"
+        operator = "geq"
+        value    = "500"
+      }
+
+      condition {
+        key      = "ClientCountry"
+        operator = "in"
+        values   = ["us", "ca"]
+      }
+    }
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_magic_transit_connector.example <account_id>/<connector_id>
@@ -0,0 +1,9 @@
+resource "cloudflare_magic_transit_connector" "london" {
+  account_id                      = "f037e56e89293a057740de681ac9abbe"
+  serial_number                   = "ABC1234567"
+  activated                       = true
+  interrupt_window_hour_of_day    = 2
+  interrupt_window_duration_hours = 4
+  timezone                        = "Europe/London"
+  notes                           = "London office"
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_managed_headers.example <zone_id>
@@ -0,0 +1,6 @@
+resource "cloudflare_mtls_certificate" "example" {
+  account_id   = "f037e56e89293a057740de681ac9abbe"
+  name         = "client-ca-2022"
+  ca           = true
+  certificates = file("${path.module}/client-ca.pem")
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_mtls_certificate_association.example <zone_id>/<mtls_certificate_id>
@@ -0,0 +1,5 @@
+resource "cloudflare_mtls_certificate_association" "example" {
+  zone_id             = "0da42c8d2132a9ddaf714f9e7c920711"
+  mtls_certificate_id = cloudflare_mtls_certificate.example.id
+  hostnames           = ["api.example.com"]
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_observatory_schedule.example <zone_id>/<region>/<url>
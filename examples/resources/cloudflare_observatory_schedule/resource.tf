@@ -0,0 +1,16 @@
+resource "cloudflare_observatory_schedule" "example" {
+  zone_id   = "0da42c8d2132a9ddaf714f9e7c920711"
+  url       = "example.com/pricing"
+  region    = "europe-west1"
+  frequency = "DAILY"
+}
+
+# Fail the plan when the page drops below the performance budget.
+resource "terraform_data" "performance_budget" {
+  lifecycle {
+    precondition {
+      condition     = cloudflare_observatory_schedule.example.mobile_performance_score >= 80
+      error_message = "Mobile performance score is below the budget of 80."
+    }
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_page_shield_policy.example <zone_id>/<policy_id>
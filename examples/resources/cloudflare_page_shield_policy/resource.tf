@@ -0,0 +1,7 @@
+resource "cloudflare_page_shield_policy" "checkout" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  action      = "allow"
+  description = "Only allow first party and payment provider scripts on checkout"
+  expression  = "ends_with(http.request.uri.path, \"/checkout\")"
+  value       = "script-src 'self' https://js.stripe.com"
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_page_shield_settings.example <zone_id>
@@ -0,0 +1,70 @@
+# Direct upload project
+resource "cloudflare_pages_project" "basic_project" {
+  account_id        = "f037e56e89293a057740de681ac9abbe"
+  name              = "this-is-my-project-01"
+  production_branch = "main"
+}
+
+# Git integrated project with build configuration, environment variables and
+# bindings per deployment environment
+resource "cloudflare_pages_project" "build_config" {
+  account_id        = "f037e56e89293a057740de681ac9abbe"
+  name              = "this-is-my-project-02"
+  production_branch = "main"
+
+  build_config {
+    build_command   = "npm run build"
+    destination_dir = "build"
+    root_dir        = "/"
+  }
+
+  source {
+    type = "github"
+    config {
+      owner                   = "cloudflare"
+      repo_name               = "ninjakittens"
+      production_branch       = "main"
+      pr_comments_enabled           = true
+      deployments_enabled           = true
+      production_deployment_enabled = true
+      preview_deployment_setting    = "custom"
+      preview_branch_includes       = ["dev", "preview"]
+      preview_branch_excludes       = ["main", "prod"]
+    }
+  }
+
+  deployment_configs {
+    preview {
+      environment_variables = {
+        ENVIRONMENT = "preview"
+      }
+      kv_namespaces = {
+        KV_BINDING = "5eb63bbbe01eeed093cb22bb8f5acdc3"
+      }
+      compatibility_date = "2022-08-15"
+    }
+
+    production {
+      environment_variables = {
+        ENVIRONMENT = "production"
+      }
+      secrets = {
+        API_KEY = "super-secret-value"
+      }
+      kv_namespaces = {
+        KV_BINDING = "5eb63bbbe01eeed093cb22bb8f5acdc3"
+      }
+      durable_object_namespaces = {
+        DO_BINDING = "5eb63bbbe01eeed093cb22bb8f5acdc3"
+      }
+      d1_databases = {
+        D1_BINDING = "445e2955-951a-43f8-a35b-a4d0c8138f63"
+      }
+      r2_buckets = {
+        R2_BINDING = "some-bucket"
+      }
+      compatibility_date  = "2022-08-15"
+      compatibility_flags = ["nodejs_compat"]
+    }
+  }
+}
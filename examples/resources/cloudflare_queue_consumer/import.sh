@@ -0,0 +1 @@
+$ terraform import cloudflare_queue_consumer.example <account_id>/<queue_id>/<consumer_id>
@@ -0,0 +1,19 @@
+resource "cloudflare_queue" "orders" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "orders"
+}
+
+resource "cloudflare_queue" "orders_dlq" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "orders-dlq"
+}
+
+resource "cloudflare_queue_consumer" "example" {
+  account_id           = "f037e56e89293a057740de681ac9abbe"
+  queue_id             = cloudflare_queue.orders.id
+  script_name          = "order-processor"
+  dead_letter_queue_id = cloudflare_queue.orders_dlq.id
+  batch_size           = 25
+  max_retries          = 5
+  max_wait_time_ms     = 2000
+}
@@ -0,0 +1,13 @@
+resource "cloudflare_r2_bucket_cors" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  bucket     = "my-bucket"
+
+  rule {
+    id              = "allow-uploads"
+    allowed_methods = ["GET", "PUT"]
+    allowed_origins = ["https://example.com"]
+    allowed_headers = ["Content-Type"]
+    expose_headers  = ["ETag"]
+    max_age_seconds = 3600
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_r2_bucket_event_notification.example <account_id>/<bucket_name>/<queue_id>
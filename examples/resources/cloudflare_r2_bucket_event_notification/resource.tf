@@ -0,0 +1,12 @@
+resource "cloudflare_r2_bucket_event_notification" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  bucket     = "my-bucket"
+  queue_id   = "5e8d0ea1a8ee4cd4a4ed4b9fb04c0a47"
+
+  rule {
+    actions     = ["PutObject", "CompleteMultipartUpload"]
+    prefix      = "uploads/"
+    suffix      = ".jpg"
+    description = "Process new images"
+  }
+}
@@ -0,0 +1,23 @@
+resource "cloudflare_r2_bucket_lifecycle" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  bucket     = "my-bucket"
+
+  rule {
+    id     = "archive-logs"
+    prefix = "logs/"
+
+    transition {
+      max_age_days  = 30
+      storage_class = "InfrequentAccess"
+    }
+
+    expiration {
+      max_age_days = 365
+    }
+  }
+
+  rule {
+    id                                 = "abort-uploads"
+    abort_multipart_uploads_after_days = 7
+  }
+}
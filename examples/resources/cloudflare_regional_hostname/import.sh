@@ -0,0 +1 @@
+$ terraform import cloudflare_regional_hostname.example <zone_id>/<hostname>
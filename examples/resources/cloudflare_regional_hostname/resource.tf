@@ -0,0 +1,5 @@
+resource "cloudflare_regional_hostname" "example" {
+  zone_id    = "0da42c8d2132a9ddaf714f9e7c920711"
+  hostname   = "eu.example.com"
+  region_key = "eu"
+}
@@ -65,6 +65,40 @@ resource "cloudflare_ruleset" "zone_level_managed_waf_with_category_based_overri
   }
 }
 
+# Zone-level OWASP Core Ruleset logging requests with an anomaly score of 40 or more
+resource "cloudflare_ruleset" "zone_level_managed_owasp_log_only" {
+  zone_id     = "cb029e245cfdd66dc8d2e570d5dd3322"
+  name        = "managed OWASP ruleset in log mode"
+  description = "managed OWASP ruleset in log mode ruleset description"
+  kind        = "zone"
+  phase       = "http_request_firewall_managed"
+
+  rules {
+    action = "execute"
+    action_parameters {
+      id = "4814384a9e5d4991b9815dcfc25d2f1f"
+      overrides {
+        action = "log"
+
+        categories {
+          category = "paranoia-level-2"
+          status   = "disabled"
+        }
+
+        rules {
+          id              = "6179ae15870a4bb7b2d480d4843b323c"
+          action          = "log"
+          score_threshold = 40
+        }
+      }
+    }
+
+    expression  = "true"
+    description = "log OWASP anomaly scores of 40 and above"
+    enabled     = true
+  }
+}
+
 # Rewrite the URI path component to a static path
 resource "cloudflare_ruleset" "transform_uri_rule_path" {
   zone_id     = "cb029e245cfdd66dc8d2e570d5dd3322"
@@ -174,6 +208,35 @@ resource "cloudflare_ruleset" "rate_limiting_example" {
   }
 }
 
+# HTTP rate limit throttling clients by API key and TLS fingerprint
+resource "cloudflare_ruleset" "rate_limiting_throttle_example" {
+  zone_id     = "cb029e245cfdd66dc8d2e570d5dd3322"
+  name        = "throttle API clients"
+  description = "throttle API clients exceeding the request rate"
+  kind        = "zone"
+  phase       = "http_ratelimit"
+
+  rules {
+    action = "block"
+    ratelimit {
+      characteristics = [
+        "cf.colo.id",
+        "cf.bot_management.ja3_hash",
+        "http.request.headers[\"x-api-key\"]"
+      ]
+      period              = 10
+      requests_per_period = 50
+      mitigation_timeout  = 0
+      counting_expression = "(http.request.uri.path matches \"^/api/\") and (http.response.code eq 429)"
+      requests_to_origin  = true
+    }
+
+    expression  = "(http.request.uri.path matches \"^/api/\")"
+    description = "throttle API clients by API key"
+    enabled     = true
+  }
+}
+
 # Change origin for an API route
 resource "cloudflare_ruleset" "http_origin_example" {
   zone_id     = "cb029e245cfdd66dc8d2e570d5dd3322"
@@ -294,4 +357,81 @@ resource "cloudflare_ruleset" "cache_settings_example" {
     description = "set cache settings rule"
     enabled = true
   }
-}
\ No newline at end of file
+}
+# Change zone settings for requests matching an expression (Configuration Rules).
+resource "cloudflare_ruleset" "config_settings_example" {
+  zone_id     = "cb029e245cfdd66dc8d2e570d5dd3322"
+  name        = "set config rules"
+  description = "set config rules for the request"
+  kind        = "zone"
+  phase       = "http_config_settings"
+
+  rules {
+    action = "set_config"
+    action_parameters {
+      email_obfuscation = false
+      rocket_loader     = false
+      bic               = true
+      polish            = "lossless"
+      security_level    = "high"
+      ssl               = "strict"
+    }
+    expression  = "(http.request.uri.path matches \"^/api/\")"
+    description = "disable email obfuscation and rocket loader for the API"
+    enabled     = true
+  }
+}
+
+# Redirect requests to a URL built from the request (Single Redirects).
+resource "cloudflare_ruleset" "redirect_from_value_example" {
+  zone_id     = "cb029e245cfdd66dc8d2e570d5dd3322"
+  name        = "redirects"
+  description = "Redirect ruleset"
+  kind        = "zone"
+  phase       = "http_request_dynamic_redirect"
+
+  rules {
+    action = "redirect"
+    action_parameters {
+      from_value {
+        status_code = 301
+        target_url {
+          expression = "concat(\"https://example.com\", http.request.uri.path)"
+        }
+        preserve_query_string = true
+      }
+    }
+    expression  = "(http.host eq \"old.example.com\")"
+    description = "Redirect visitors to the new domain"
+    enabled     = true
+  }
+}
+
+# Tune the HTTP DDoS Attack Protection managed ruleset
+resource "cloudflare_ruleset" "http_ddos_override" {
+  zone_id     = "cb029e245cfdd66dc8d2e570d5dd3322"
+  name        = "HTTP DDoS overrides"
+  description = "HTTP DDoS overrides ruleset description"
+  kind        = "zone"
+  phase       = "ddos_l7"
+
+  rules {
+    action = "execute"
+    action_parameters {
+      id = "4d21379b4f9f4bb088e0729962c8b3cf"
+      overrides {
+        sensitivity_level = "medium"
+
+        rules {
+          id                = "fdfdac75430c4c47a959592f0aa5e68a"
+          action            = "managed_challenge"
+          sensitivity_level = "low"
+        }
+      }
+    }
+
+    expression  = "true"
+    description = "Challenge requests with odd HTTP headers at low sensitivity"
+    enabled     = true
+  }
+}
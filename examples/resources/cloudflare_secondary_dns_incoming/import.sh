@@ -0,0 +1 @@
+$ terraform import cloudflare_secondary_dns_incoming.example <zone_id>
@@ -0,0 +1,11 @@
+resource "cloudflare_secondary_dns_outgoing" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  name    = "example.com"
+  peers   = [cloudflare_secondary_dns_peer.example.id]
+  enabled = true
+
+  # Notify the peers as soon as the record changes.
+  force_notify_triggers = {
+    www = cloudflare_record.www.value
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_secondary_dns_peer.example <account_id>/<peer_id>
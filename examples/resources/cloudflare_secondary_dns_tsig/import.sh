@@ -0,0 +1 @@
+$ terraform import cloudflare_secondary_dns_tsig.example <account_id>/<tsig_id>
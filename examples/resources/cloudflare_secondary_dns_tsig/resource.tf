@@ -0,0 +1,6 @@
+resource "cloudflare_secondary_dns_tsig" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "tsig.customer.cf."
+  secret     = var.tsig_secret
+  algo       = "hmac-sha512."
+}
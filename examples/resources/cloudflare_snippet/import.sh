@@ -0,0 +1 @@
+$ terraform import cloudflare_snippet.example <zone_id>/<snippet_name>
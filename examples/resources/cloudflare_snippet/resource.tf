@@ -0,0 +1,10 @@
+resource "cloudflare_snippet" "example" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  name        = "add_header"
+  main_module = "main.js"
+
+  files {
+    name    = "main.js"
+    content = file("${path.module}/snippets/main.js")
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_snippet_rules.example <zone_id>
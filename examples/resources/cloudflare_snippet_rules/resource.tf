@@ -0,0 +1,9 @@
+resource "cloudflare_snippet_rules" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+
+  rules {
+    expression   = "http.request.uri.path wildcard \"/api/*\""
+    snippet_name = cloudflare_snippet.example.name
+    description  = "Add a header to API responses"
+  }
+}
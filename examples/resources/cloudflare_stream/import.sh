@@ -0,0 +1 @@
+$ terraform import cloudflare_stream.example <account_id>/<video_id>
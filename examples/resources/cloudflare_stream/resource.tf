@@ -0,0 +1,8 @@
+resource "cloudflare_stream" "example" {
+  account_id              = "f037e56e89293a057740de681ac9abbe"
+  url                     = "https://example.com/videos/intro.mp4"
+  name                    = "Product introduction"
+  allowed_origins         = ["example.com", "*.example.com"]
+  require_signed_urls     = true
+  thumbnail_timestamp_pct = 0.5
+}
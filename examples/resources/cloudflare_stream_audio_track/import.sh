@@ -0,0 +1 @@
+$ terraform import cloudflare_stream_audio_track.example <account_id>/<video_id>/<audio_track_id>
@@ -0,0 +1,6 @@
+resource "cloudflare_stream_audio_track" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  video_id   = cloudflare_stream.example.id
+  url        = "https://example.com/videos/intro.fr.mp3"
+  label      = "Français"
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_stream_caption.example <account_id>/<video_id>/<language>
@@ -0,0 +1,6 @@
+resource "cloudflare_stream_caption" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  video_id   = cloudflare_stream.example.id
+  language   = "fr"
+  content    = file("${path.module}/captions/fr.vtt")
+}
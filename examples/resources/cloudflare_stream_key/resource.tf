@@ -0,0 +1,3 @@
+resource "cloudflare_stream_key" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_stream_live_input.example <account_id>/<live_input_id>
@@ -0,0 +1,8 @@
+resource "cloudflare_stream_live_input" "example" {
+  account_id                  = "f037e56e89293a057740de681ac9abbe"
+  name                        = "Weekly town hall"
+  recording_mode              = "automatic"
+  recording_timeout_seconds   = 60
+  recording_allowed_origins   = ["example.com"]
+  delete_recording_after_days = 45
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_stream_webhook.example <account_id>
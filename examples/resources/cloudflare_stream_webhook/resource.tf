@@ -0,0 +1,4 @@
+resource "cloudflare_stream_webhook" "example" {
+  account_id       = "f037e56e89293a057740de681ac9abbe"
+  notification_url = "https://example.com/stream/webhook"
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_teams_device_enrollment.example <account_id>
@@ -0,0 +1,19 @@
+resource "cloudflare_teams_device_enrollment" "example" {
+  account_id                = "f037e56e89293a057740de681ac9abbe"
+  allowed_idps              = ["f174e90a-fafe-4643-bbbc-4a0ed4fc8415"]
+  auto_redirect_to_identity = true
+  session_duration          = "24h"
+}
+
+# Only employees may enroll devices.
+resource "cloudflare_access_policy" "device_enrollment" {
+  application_id = cloudflare_teams_device_enrollment.example.application_id
+  account_id     = "f037e56e89293a057740de681ac9abbe"
+  name           = "Employees"
+  precedence     = 1
+  decision       = "allow"
+
+  include {
+    email_domain = ["example.com"]
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_total_tls.example <zone_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_turnstile_widget.example <account_id>/<site_key>
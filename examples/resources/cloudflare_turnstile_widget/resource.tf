@@ -0,0 +1,21 @@
+resource "cloudflare_turnstile_widget" "example" {
+  account_id      = "f037e56e89293a057740de681ac9abbe"
+  name            = "example widget"
+  domains         = ["example.com", "www.example.com"]
+  mode            = "managed"
+  clearance_level = "managed"
+  ephemeral_id    = true
+}
+
+# Rotate the widget secret every 30 days
+resource "time_rotating" "turnstile_secret" {
+  rotation_days = 30
+}
+
+resource "cloudflare_turnstile_widget" "rotating" {
+  account_id              = "f037e56e89293a057740de681ac9abbe"
+  name                    = "rotating widget"
+  domains                 = ["example.com"]
+  mode                    = "invisible"
+  secret_rotation_trigger = time_rotating.turnstile_secret.id
+}
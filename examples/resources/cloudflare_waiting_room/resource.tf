@@ -6,4 +6,17 @@ resource "cloudflare_waiting_room" "example" {
   path                 = "/"
   new_users_per_minute = 200
   total_active_users   = 200
-}
\ No newline at end of file
+}
+# Waiting Room for a flash sale, admitting queued users in random order
+resource "cloudflare_waiting_room" "flash_sale" {
+  zone_id               = "ae36f999674d196762efcc5abb06b345"
+  name                  = "flash-sale"
+  host                  = "shop.example.com"
+  path                  = "/sale"
+  new_users_per_minute  = 500
+  total_active_users    = 1000
+  session_duration      = 10
+  queueing_method       = "random"
+  cookie_suffix         = "sale"
+  json_response_enabled = true
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_waiting_room_settings.example <zone_id>
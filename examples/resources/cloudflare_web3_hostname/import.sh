@@ -0,0 +1 @@
+$ terraform import cloudflare_web3_hostname.example <zone_id>/<hostname_id>
@@ -0,0 +1,7 @@
+resource "cloudflare_web3_hostname" "example" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  name        = "ipfs.example.com"
+  target      = "ipfs"
+  description = "IPFS gateway for the docs site"
+  dnslink     = "/ipns/onboarding.ipfs.cloudflare.com"
+}
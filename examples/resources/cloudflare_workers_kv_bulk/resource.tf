@@ -0,0 +1,22 @@
+resource "cloudflare_workers_kv_namespace" "example" {
+  title = "configuration"
+}
+
+resource "cloudflare_workers_kv_bulk" "example" {
+  namespace_id = cloudflare_workers_kv_namespace.example.id
+
+  values = {
+    "feature-flags" = jsonencode({ new_checkout = true })
+    "maintenance"   = "false"
+  }
+}
+
+resource "cloudflare_workers_kv_bulk" "assets" {
+  namespace_id   = cloudflare_workers_kv_namespace.example.id
+  base64         = true
+  expiration_ttl = 86400
+
+  values = {
+    "logo.png" = filebase64("${path.module}/logo.png")
+  }
+}
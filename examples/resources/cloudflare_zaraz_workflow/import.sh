@@ -0,0 +1 @@
+$ terraform import cloudflare_zaraz_workflow.example <zone_id>
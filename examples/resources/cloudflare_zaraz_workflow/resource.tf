@@ -0,0 +1,7 @@
+# Save Zaraz changes as a draft and publish them explicitly.
+resource "cloudflare_zaraz_workflow" "example" {
+  zone_id             = "0da42c8d2132a9ddaf714f9e7c920711"
+  workflow            = "preview"
+  publish_trigger     = "2022-06-01"
+  publish_description = "Add analytics tool for the summer campaign"
+}
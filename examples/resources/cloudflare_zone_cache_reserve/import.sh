@@ -0,0 +1 @@
+$ terraform import cloudflare_zone_cache_reserve.example <zone_id>
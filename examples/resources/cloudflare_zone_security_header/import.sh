@@ -0,0 +1 @@
+$ terraform import cloudflare_zone_security_header.example <zone_id>
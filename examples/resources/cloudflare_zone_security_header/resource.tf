@@ -0,0 +1,7 @@
+resource "cloudflare_zone_security_header" "example" {
+  zone_id            = "0da42c8d2132a9ddaf714f9e7c920711"
+  max_age            = 31536000
+  include_subdomains = true
+  preload            = true
+  nosniff            = true
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_zone_security_level_schedule.example <zone_id>
@@ -0,0 +1,19 @@
+# Enable under attack mode until the incident is expected to be over.
+resource "cloudflare_zone_security_level_schedule" "incident" {
+  zone_id               = "0da42c8d2132a9ddaf714f9e7c920711"
+  security_level        = "under_attack"
+  revert_security_level = "medium"
+  until                 = "2022-06-16T09:00:00Z"
+}
+
+# Raise the security level of another zone every weekend night.
+resource "cloudflare_zone_security_level_schedule" "weekends" {
+  zone_id        = "1d5fdc9e88c8a8c4518b068cd94331fe"
+  security_level = "high"
+
+  window {
+    days  = ["fri", "sat"]
+    start = "22:00"
+    end   = "06:00"
+  }
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -14,12 +15,12 @@ func TestMain(m *testing.M) {
 
 // sharedClient returns a common Cloudflare client setup needed for the
 // sweeper functions.
-func sharedClient() (*cloudflare.API, error) {
+func sharedClient() (*apiClient, error) {
 	client, err := cloudflare.New(os.Getenv("CLOUDFLARE_API_KEY"), os.Getenv("CLOUDFLARE_EMAIL"))
 
 	if err != nil {
-		return client, err
+		return nil, err
 	}
 
-	return client, nil
+	return &apiClient{API: client, httpClient: cleanhttp.DefaultClient()}, nil
 }
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessGroups() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareAccessGroupsSchema(),
+		ReadContext: dataSourceCloudflareAccessGroupsRead,
+		Description: "Use this data source to list Access groups and policies together with what references them, for example to find groups that are no longer used by any application.",
+	}
+}
+
+func dataSourceCloudflareAccessGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groups, err := listAccessGroups(ctx, client, identifier)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Access groups: %w", err))
+	}
+
+	applications, err := listAccessApplications(ctx, client, identifier)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Access applications: %w", err))
+	}
+
+	// referencedGroupIDs returns the sorted, unique IDs of the groups
+	// referenced by the include, exclude and require conditions.
+	referencedGroupIDs := func(include, exclude, require []interface{}) []string {
+		var conditions []interface{}
+		conditions = append(conditions, include...)
+		conditions = append(conditions, exclude...)
+		conditions = append(conditions, require...)
+
+		ids := make([]string, 0)
+		for _, id := range accessGroupConditionReferences(conditions) {
+			if !contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+
+		return ids
+	}
+
+	applicationIDs := make(map[string][]string)
+	policyIDs := make(map[string][]string)
+	groupIDs := make(map[string][]string)
+
+	policies := make([]map[string]interface{}, 0)
+	for _, app := range applications {
+		appPolicies, err := listAccessPolicies(ctx, client, identifier, app.ID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing Access policies of application %q: %w", app.ID, err))
+		}
+
+		for _, policy := range appPolicies {
+			referenced := referencedGroupIDs(policy.Include, policy.Exclude, policy.Require)
+			for _, id := range referenced {
+				policyIDs[id] = append(policyIDs[id], policy.ID)
+				if !contains(applicationIDs[id], app.ID) {
+					applicationIDs[id] = append(applicationIDs[id], app.ID)
+				}
+			}
+
+			policies = append(policies, map[string]interface{}{
+				"id":             policy.ID,
+				"name":           policy.Name,
+				"application_id": app.ID,
+				"decision":       policy.Decision,
+				"group_ids":      referenced,
+			})
+		}
+	}
+
+	for _, group := range groups {
+		for _, id := range referencedGroupIDs(group.Include, group.Exclude, group.Require) {
+			if id != group.ID {
+				groupIDs[id] = append(groupIDs[id], group.ID)
+			}
+		}
+	}
+
+	groupsData := make([]map[string]interface{}, 0, len(groups))
+	for _, group := range groups {
+		groupsData = append(groupsData, map[string]interface{}{
+			"id":              group.ID,
+			"name":            group.Name,
+			"application_ids": applicationIDs[group.ID],
+			"policy_ids":      policyIDs[group.ID],
+			"group_ids":       groupIDs[group.ID],
+			"in_use":          len(policyIDs[group.ID]) > 0 || len(groupIDs[group.ID]) > 0,
+		})
+	}
+
+	if err := d.Set("groups", groupsData); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting groups: %w", err))
+	}
+
+	if err := d.Set("policies", policies); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting policies: %w", err))
+	}
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s", identifier.Type, identifier.Value)))
+
+	return nil
+}
+
+func listAccessGroups(ctx context.Context, client *apiClient, identifier *AccessIdentifier) ([]cloudflare.AccessGroup, error) {
+	var groups []cloudflare.AccessGroup
+	pageOpts := cloudflare.PaginationOptions{PerPage: 50, Page: 1}
+
+	for {
+		var results []cloudflare.AccessGroup
+		var info cloudflare.ResultInfo
+		var err error
+		if identifier.Type == AccountType {
+			results, info, err = client.AccessGroups(ctx, identifier.Value, pageOpts)
+		} else {
+			results, info, err = client.ZoneLevelAccessGroups(ctx, identifier.Value, pageOpts)
+		}
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, results...)
+
+		if len(results) == 0 || pageOpts.Page >= info.TotalPages {
+			return groups, nil
+		}
+		pageOpts.Page++
+	}
+}
+
+func listAccessApplications(ctx context.Context, client *apiClient, identifier *AccessIdentifier) ([]cloudflare.AccessApplication, error) {
+	var applications []cloudflare.AccessApplication
+	pageOpts := cloudflare.PaginationOptions{PerPage: 50, Page: 1}
+
+	for {
+		var results []cloudflare.AccessApplication
+		var info cloudflare.ResultInfo
+		var err error
+		if identifier.Type == AccountType {
+			results, info, err = client.AccessApplications(ctx, identifier.Value, pageOpts)
+		} else {
+			results, info, err = client.ZoneLevelAccessApplications(ctx, identifier.Value, pageOpts)
+		}
+		if err != nil {
+			return nil, err
+		}
+		applications = append(applications, results...)
+
+		if len(results) == 0 || pageOpts.Page >= info.TotalPages {
+			return applications, nil
+		}
+		pageOpts.Page++
+	}
+}
+
+func listAccessPolicies(ctx context.Context, client *apiClient, identifier *AccessIdentifier, applicationID string) ([]cloudflare.AccessPolicy, error) {
+	var policies []cloudflare.AccessPolicy
+	pageOpts := cloudflare.PaginationOptions{PerPage: 50, Page: 1}
+
+	for {
+		var results []cloudflare.AccessPolicy
+		var info cloudflare.ResultInfo
+		var err error
+		if identifier.Type == AccountType {
+			results, info, err = client.AccessPolicies(ctx, identifier.Value, applicationID, pageOpts)
+		} else {
+			results, info, err = client.ZoneLevelAccessPolicies(ctx, identifier.Value, applicationID, pageOpts)
+		}
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, results...)
+
+		if len(results) == 0 || pageOpts.Page >= info.TotalPages {
+			return policies, nil
+		}
+		pageOpts.Page++
+	}
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccessGroupsDataSource(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_access_groups.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccessAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessGroupsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(name, "groups.*", map[string]string{
+						"name":   rnd + "-used",
+						"in_use": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "groups.*", map[string]string{
+						"name":   rnd + "-unused",
+						"in_use": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "policies.*", map[string]string{
+						"name":     rnd,
+						"decision": "allow",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessGroupsDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_group" "%[1]s_used" {
+  account_id = "%[2]s"
+  name       = "%[1]s-used"
+
+  include {
+    email = ["test@example.com"]
+  }
+}
+
+resource "cloudflare_access_group" "%[1]s_unused" {
+  account_id = "%[2]s"
+  name       = "%[1]s-unused"
+
+  include {
+    email = ["test@example.com"]
+  }
+}
+
+resource "cloudflare_access_application" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  type       = "self_hosted"
+  domain     = "%[1]s.example.com"
+}
+
+resource "cloudflare_access_policy" "%[1]s" {
+  application_id = cloudflare_access_application.%[1]s.id
+  account_id     = "%[2]s"
+  name           = "%[1]s"
+  precedence     = 1
+  decision       = "allow"
+
+  include {
+    group = [cloudflare_access_group.%[1]s_used.id]
+  }
+}
+
+data "cloudflare_access_groups" "%[1]s" {
+  account_id = "%[2]s"
+
+  depends_on = [
+    cloudflare_access_group.%[1]s_unused,
+    cloudflare_access_policy.%[1]s,
+  ]
+}`, rnd, accountID)
+}
@@ -17,7 +17,7 @@ func dataSourceCloudflareAccessIdentityProvider() *schema.Resource {
 }
 
 func dataSourceCloudflareAccessIdentityProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	identifier, err := initIdentifier(d)
 	name := d.Get("name").(string)
 	if err != nil {
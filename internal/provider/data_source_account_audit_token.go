@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccountAuditToken() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareAccountAuditTokenSchema(),
+		ReadContext: dataSourceCloudflareAccountAuditTokenRead,
+		Description: "Use this data source to verify the API token the provider is configured with, allowing configurations to fail early when the token is disabled or has expired.",
+	}
+}
+
+func dataSourceCloudflareAccountAuditTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	if client.APIToken == "" {
+		return diag.FromErr(fmt.Errorf("token verification requires the provider to be configured with an API token"))
+	}
+
+	tflog.Debug(ctx, "Verifying Cloudflare API token")
+
+	token, err := client.VerifyAPIToken(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error verifying API token: %w", err))
+	}
+
+	valid, reason := apiTokenValidity(token, time.Now())
+	if !valid && d.Get("fail_on_invalid").(bool) {
+		return diag.FromErr(fmt.Errorf("API token %q is not valid: %s", token.ID, reason))
+	}
+
+	d.SetId(token.ID)
+	d.Set("token_id", token.ID)
+	d.Set("status", token.Status)
+	d.Set("not_before", formatAPITokenTime(token.NotBefore))
+	d.Set("expires_on", formatAPITokenTime(token.ExpiresOn))
+	d.Set("valid", valid)
+
+	return nil
+}
+
+// apiTokenValidity reports whether the token is usable at the given time and,
+// when it is not, a reason suitable for an error message.
+func apiTokenValidity(token cloudflare.APITokenVerifyBody, now time.Time) (bool, string) {
+	if token.Status != "active" {
+		return false, fmt.Sprintf("token status is %q", token.Status)
+	}
+
+	if !token.NotBefore.IsZero() && now.Before(token.NotBefore) {
+		return false, fmt.Sprintf("token is not valid before %s", token.NotBefore.Format(time.RFC3339))
+	}
+
+	if !token.ExpiresOn.IsZero() && !now.Before(token.ExpiresOn) {
+		return false, fmt.Sprintf("token expired on %s", token.ExpiresOn.Format(time.RFC3339))
+	}
+
+	return true, ""
+}
+
+func formatAPITokenTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
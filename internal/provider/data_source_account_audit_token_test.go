@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccountAuditToken_Basic(t *testing.T) {
+	if os.Getenv("CLOUDFLARE_API_TOKEN") == "" {
+		t.Skip("token verification requires CLOUDFLARE_API_TOKEN to be set")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_account_audit_token.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccountAuditTokenConfig(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "token_id"),
+					resource.TestCheckResourceAttr(name, "status", "active"),
+					resource.TestCheckResourceAttr(name, "valid", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccountAuditTokenConfig(name string) string {
+	return fmt.Sprintf(`
+data "cloudflare_account_audit_token" "%[1]s" {}
+`, name)
+}
+
+func TestAPITokenValidity(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		token cloudflare.APITokenVerifyBody
+		valid bool
+	}{
+		"active without expiry": {
+			token: cloudflare.APITokenVerifyBody{Status: "active"},
+			valid: true,
+		},
+		"active before expiry": {
+			token: cloudflare.APITokenVerifyBody{Status: "active", ExpiresOn: now.Add(time.Hour)},
+			valid: true,
+		},
+		"active after expiry": {
+			token: cloudflare.APITokenVerifyBody{Status: "active", ExpiresOn: now.Add(-time.Hour)},
+			valid: false,
+		},
+		"active before not_before": {
+			token: cloudflare.APITokenVerifyBody{Status: "active", NotBefore: now.Add(time.Hour)},
+			valid: false,
+		},
+		"disabled": {
+			token: cloudflare.APITokenVerifyBody{Status: "disabled"},
+			valid: false,
+		},
+		"expired": {
+			token: cloudflare.APITokenVerifyBody{Status: "expired"},
+			valid: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			valid, reason := apiTokenValidity(tc.token, now)
+			if valid != tc.valid {
+				t.Errorf("expected valid to be %t, got %t (%s)", tc.valid, valid, reason)
+			}
+			if !valid && reason == "" {
+				t.Error("expected a reason for an invalid token")
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -46,7 +45,7 @@ func dataSourceCloudflareAccountRoles() *schema.Resource {
 }
 
 func dataSourceCloudflareAccountRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	tflog.Debug(ctx, fmt.Sprintf("Reading Account Roles"))
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAIGateway() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareAIGatewaySchema(),
+		ReadContext: dataSourceCloudflareAIGatewayRead,
+		Description: "Use this data source to look up the configuration of an existing AI Gateway gateway.",
+	}
+}
+
+func dataSourceCloudflareAIGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading AI Gateway %s", name))
+
+	gateway, err := fetchAIGateway(ctx, client, accountID, name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding AI Gateway %q: %w", name, err))
+	}
+
+	d.SetId(gateway.ID)
+	setAIGatewayData(d, gateway)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAIGatewayDataSource_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_ai_gateway.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAIGatewayConfig(rnd, accountID, 300, 50) + fmt.Sprintf(`
+data "cloudflare_ai_gateway" "%[1]s" {
+  account_id = "%[2]s"
+  name       = cloudflare_ai_gateway.%[1]s.name
+}
+`, rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "cache_ttl", "300"),
+					resource.TestCheckResourceAttr(name, "rate_limiting_limit", "50"),
+					resource.TestCheckResourceAttr(name, "collect_logs", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	botManagementJA4Field          = "cf.bot_management.ja4"
+	botManagementDetectionIDsField = "cf.bot_management.detection_ids"
+)
+
+type botManagementJA4Signal struct {
+	kind        string
+	description string
+}
+
+// botManagementJA4Signals holds the inter-request signals computed for each
+// JA4 fingerprint over the last hour of traffic across the Cloudflare
+// network.
+var botManagementJA4Signals = map[string]botManagementJA4Signal{
+	"h2h3_ratio_1h":      {"ratio", "Share of requests with the fingerprint made over HTTP/2 or HTTP/3."},
+	"heuristic_ratio_1h": {"ratio", "Share of requests with the fingerprint flagged by heuristic detections."},
+	"browser_ratio_1h":   {"ratio", "Share of requests with the fingerprint made by browser-like user agents."},
+	"cache_ratio_1h":     {"ratio", "Share of requests with the fingerprint served from cache."},
+	"uas_rank_1h":        {"rank", "Rank of the fingerprint by number of distinct user agents."},
+	"paths_rank_1h":      {"rank", "Rank of the fingerprint by number of distinct paths requested."},
+	"reqs_rank_1h":       {"rank", "Rank of the fingerprint by number of requests."},
+	"ips_rank_1h":        {"rank", "Rank of the fingerprint by number of distinct client IPs."},
+	"reqs_quantile_1h":   {"quantile", "Quantile of the fingerprint by number of requests."},
+	"ips_quantile_1h":    {"quantile", "Quantile of the fingerprint by number of distinct client IPs."},
+}
+
+func dataSourceCloudflareBotManagementSignals() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareBotManagementSignalsSchema(),
+		ReadContext: dataSourceCloudflareBotManagementSignalsRead,
+		Description: "Use this data source to look up the JA4 signals and bot management fields available to rule expressions, " +
+			"so bot exception rules can reference names that are checked at plan time.",
+	}
+}
+
+func dataSourceCloudflareBotManagementSignalsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	names := expandInterfaceToStringList(d.Get("names").(*schema.Set).List())
+	if len(names) == 0 {
+		for name := range botManagementJA4Signals {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	signals := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		signal, ok := botManagementJA4Signals[name]
+		if !ok {
+			return diag.FromErr(fmt.Errorf("unknown JA4 signal %q", name))
+		}
+
+		signals = append(signals, map[string]interface{}{
+			"name":        name,
+			"kind":        signal.kind,
+			"description": signal.description,
+		})
+	}
+
+	if err := d.Set("ja4_signals", signals); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting ja4_signals: %w", err))
+	}
+	d.Set("ja4_field", botManagementJA4Field)
+	d.Set("detection_ids_field", botManagementDetectionIDsField)
+
+	d.SetId(stringListChecksum(names))
+
+	return nil
+}
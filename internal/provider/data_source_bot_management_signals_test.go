@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareBotManagementSignals_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_bot_management_signals.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareBotManagementSignalsConfig(rnd, `["reqs_rank_1h", "h2h3_ratio_1h"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ja4_field", "cf.bot_management.ja4"),
+					resource.TestCheckResourceAttr(name, "detection_ids_field", "cf.bot_management.detection_ids"),
+					resource.TestCheckResourceAttr(name, "ja4_signals.#", "2"),
+					resource.TestCheckResourceAttr(name, "ja4_signals.0.name", "h2h3_ratio_1h"),
+					resource.TestCheckResourceAttr(name, "ja4_signals.0.kind", "ratio"),
+					resource.TestCheckResourceAttr(name, "ja4_signals.1.name", "reqs_rank_1h"),
+					resource.TestCheckResourceAttr(name, "ja4_signals.1.kind", "rank"),
+				),
+			},
+			{
+				Config:      testAccCloudflareBotManagementSignalsConfig(rnd, `["not_a_signal"]`),
+				ExpectError: regexp.MustCompile(`unknown JA4 signal "not_a_signal"`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareBotManagementSignalsConfig(name, names string) string {
+	return fmt.Sprintf(`
+data "cloudflare_bot_management_signals" "%[1]s" {
+  names = %[2]s
+}
+`, name, names)
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const urlColoLocations = "https://speed.cloudflare.com/locations"
+
+type coloLocation struct {
+	IATA      string  `json:"iata"`
+	City      string  `json:"city"`
+	Country   string  `json:"cca2"`
+	Region    string  `json:"region"`
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lon"`
+}
+
+func dataSourceCloudflareColos() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareColosSchema(),
+		ReadContext: dataSourceCloudflareColosRead,
+		Description: "Use this data source to look up the Cloudflare data centers (colos) by IATA code, with their location and region. " +
+			"The locations are fetched from the endpoint used by speed.cloudflare.com, which is not part of the " +
+			"documented Cloudflare API and may change or become unavailable without notice.",
+	}
+}
+
+func dataSourceCloudflareColosRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "Reading Cloudflare data center locations")
+
+	colos, err := fetchColoLocations(ctx, meta.(*apiClient).httpClient, urlColoLocations)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to fetch Cloudflare data center locations: %w", err))
+	}
+
+	region := d.Get("region").(string)
+	country := d.Get("country").(string)
+
+	var matching []coloLocation
+	for _, colo := range colos {
+		if region != "" && !strings.EqualFold(colo.Region, region) {
+			continue
+		}
+		if country != "" && !strings.EqualFold(colo.Country, country) {
+			continue
+		}
+		matching = append(matching, colo)
+	}
+
+	sort.Slice(matching, func(i, j int) bool {
+		return matching[i].IATA < matching[j].IATA
+	})
+
+	codes := make([]string, 0, len(matching))
+	result := make([]interface{}, 0, len(matching))
+	for _, colo := range matching {
+		codes = append(codes, colo.IATA)
+		result = append(result, map[string]interface{}{
+			"iata":      colo.IATA,
+			"city":      colo.City,
+			"country":   colo.Country,
+			"region":    colo.Region,
+			"latitude":  colo.Latitude,
+			"longitude": colo.Longitude,
+		})
+	}
+
+	if err := d.Set("iata_codes", codes); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting iata_codes: %w", err))
+	}
+
+	if err := d.Set("colos", result); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting colos: %w", err))
+	}
+
+	d.SetId(strconv.Itoa(hashCodeString(strings.Join(codes, "|"))))
+
+	return nil
+}
+
+// fetchColoLocations fetches the data center locations from url using the
+// HTTP client of the provider, so that requests are retried, rate limited and
+// logged like those to the API.
+func fetchColoLocations(ctx context.Context, client *http.Client, url string) ([]coloLocation, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status %d from %s", res.StatusCode, url)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var colos []coloLocation
+	if err := json.Unmarshal(body, &colos); err != nil {
+		return nil, fmt.Errorf("error unmarshalling data center locations: %w", err)
+	}
+
+	return colos, nil
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const testColoLocationsFixture = `[
+  {"iata":"MAN","lat":53.35,"lon":-2.27,"cca2":"GB","region":"Europe","city":"Manchester"},
+  {"iata":"CDG","lat":49.01,"lon":2.55,"cca2":"FR","region":"Europe","city":"Paris"},
+  {"iata":"LHR","lat":51.47,"lon":-0.45,"cca2":"GB","region":"Europe","city":"London"},
+  {"iata":"SJC","lat":37.36,"lon":-121.93,"cca2":"US","region":"North America","city":"San Jose"}
+]`
+
+// testColoLocationsTransport sends every request to the test server.
+type testColoLocationsTransport struct {
+	server *httptest.Server
+}
+
+func (t testColoLocationsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target, err := url.Parse(t.server.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.URL.Scheme, req.URL.Host = target.Scheme, target.Host
+
+	return t.server.Client().Transport.RoundTrip(req)
+}
+
+func TestFetchColoLocations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/locations", r.URL.Path)
+		w.Write([]byte(testColoLocationsFixture)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	colos, err := fetchColoLocations(context.Background(), server.Client(), server.URL+"/locations")
+	assert.NoError(t, err)
+	assert.Len(t, colos, 4)
+	assert.Equal(t, coloLocation{IATA: "MAN", City: "Manchester", Country: "GB", Region: "Europe", Latitude: 53.35, Longitude: -2.27}, colos[0])
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err = fetchColoLocations(context.Background(), server.Client(), server.URL+"/locations")
+	assert.EqualError(t, err, fmt.Sprintf("unexpected HTTP status 404 from %s/locations", server.URL))
+}
+
+func TestDataSourceCloudflareColosRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testColoLocationsFixture)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	client := &apiClient{httpClient: &http.Client{Transport: testColoLocationsTransport{server: server}}}
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareColosSchema(), map[string]interface{}{"country": "gb"})
+
+	diags := dataSourceCloudflareColosRead(context.Background(), d, client)
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	assert.Equal(t, []interface{}{"LHR", "MAN"}, d.Get("iata_codes").([]interface{}))
+	assert.Equal(t, "London", d.Get("colos.0.city"))
+	assert.Equal(t, 51.47, d.Get("colos.0.latitude"))
+	assert.NotEmpty(t, d.Id())
+}
+
+func TestAccCloudflareColos_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_colos.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareColosConfig(rnd, "GB"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "colos.#"),
+					resource.TestCheckTypeSetElemAttr(name, "iata_codes.*", "LHR"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "colos.*", map[string]string{
+						"iata":    "LHR",
+						"city":    "London",
+						"country": "GB",
+						"region":  "Europe",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareColosConfig(name, country string) string {
+	return fmt.Sprintf(`
+data "cloudflare_colos" "%[1]s" {
+  country = "%[2]s"
+}
+`, name, country)
+}
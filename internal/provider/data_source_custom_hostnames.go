@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareCustomHostnames() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareCustomHostnamesSchema(),
+		ReadContext: dataSourceCloudflareCustomHostnamesRead,
+		Description: "Use this data source to search the custom hostnames of a zone by hostname, status " +
+			"and certificate status, e.g. to find hostnames stuck in `pending_validation`.",
+	}
+}
+
+func dataSourceCloudflareCustomHostnamesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	hostname := d.Get("hostname").(string)
+	status := d.Get("status").(string)
+	sslStatus := d.Get("ssl_status").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing custom hostnames for zone %s", zoneID))
+
+	var customHostnames []map[string]interface{}
+	for page := 1; ; page++ {
+		hostnames, resultInfo, err := client.CustomHostnames(ctx, zoneID, page, cloudflare.CustomHostname{Hostname: hostname})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing custom hostnames for zone %q: %w", zoneID, err))
+		}
+
+		for _, ch := range hostnames {
+			if !customHostnameMatches(ch, hostname, status, sslStatus) {
+				continue
+			}
+			customHostnames = append(customHostnames, flattenCustomHostnameSummary(ch))
+		}
+
+		if page >= resultInfo.TotalPages {
+			break
+		}
+	}
+
+	if err := d.Set("custom_hostnames", customHostnames); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting custom hostnames: %w", err))
+	}
+
+	d.SetId(stringChecksum(strings.Join([]string{zoneID, hostname, status, sslStatus}, "/")))
+
+	return nil
+}
+
+// customHostnameMatches reports whether the custom hostname satisfies the
+// filters. Empty filters match everything.
+func customHostnameMatches(ch cloudflare.CustomHostname, hostname, status, sslStatus string) bool {
+	if hostname != "" && !strings.Contains(ch.Hostname, hostname) {
+		return false
+	}
+
+	if status != "" && string(ch.Status) != status {
+		return false
+	}
+
+	if sslStatus != "" && (ch.SSL == nil || ch.SSL.Status != sslStatus) {
+		return false
+	}
+
+	return true
+}
+
+func flattenCustomHostnameSummary(ch cloudflare.CustomHostname) map[string]interface{} {
+	var sslStatus, sslMethod string
+	sslValidationErrors := []string{}
+	if ch.SSL != nil {
+		sslStatus, sslMethod = ch.SSL.Status, ch.SSL.Method
+		for _, e := range ch.SSL.ValidationErrors {
+			sslValidationErrors = append(sslValidationErrors, e.Message)
+		}
+	}
+
+	var createdAt string
+	if ch.CreatedAt != nil {
+		createdAt = ch.CreatedAt.Format(time.RFC3339)
+	}
+
+	return map[string]interface{}{
+		"id":                    ch.ID,
+		"hostname":              ch.Hostname,
+		"status":                string(ch.Status),
+		"ssl_status":            sslStatus,
+		"ssl_method":            sslMethod,
+		"custom_origin_server":  ch.CustomOriginServer,
+		"created_at":            createdAt,
+		"verification_errors":   ch.VerificationErrors,
+		"ssl_validation_errors": sslValidationErrors,
+		"ownership_verification": map[string]interface{}{
+			"type":  ch.OwnershipVerification.Type,
+			"name":  ch.OwnershipVerification.Name,
+			"value": ch.OwnershipVerification.Value,
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestCustomHostnameMatches(t *testing.T) {
+	ch := cloudflare.CustomHostname{
+		Hostname: "shop.customer.example.com",
+		Status:   cloudflare.PENDING,
+		SSL:      &cloudflare.CustomHostnameSSL{Status: "pending_validation"},
+	}
+
+	testCases := map[string]struct {
+		hostname, status, sslStatus string
+		want                        bool
+	}{
+		"no filters":              {"", "", "", true},
+		"hostname substring":      {"customer", "", "", true},
+		"hostname mismatch":       {"other", "", "", false},
+		"status match":            {"", "pending", "", true},
+		"status mismatch":         {"", "active", "", false},
+		"ssl status match":        {"", "", "pending_validation", true},
+		"ssl status mismatch":     {"", "", "active", false},
+		"all filters match":       {"shop.", "pending", "pending_validation", true},
+		"one of several mismatch": {"shop.", "pending", "active", false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := customHostnameMatches(ch, tc.hostname, tc.status, tc.sslStatus); got != tc.want {
+				t.Errorf("customHostnameMatches() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if customHostnameMatches(cloudflare.CustomHostname{Hostname: "a.example.com"}, "", "", "active") {
+		t.Error("expected custom hostname without SSL not to match an SSL status filter")
+	}
+}
+
+func TestAccCloudflareCustomHostnames_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_custom_hostnames.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareCustomHostnamesConfig(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "custom_hostnames.#", "1"),
+					resource.TestCheckResourceAttr(name, "custom_hostnames.0.hostname", fmt.Sprintf("%s.%s", rnd, domain)),
+					resource.TestCheckResourceAttrPair(name, "custom_hostnames.0.id", fmt.Sprintf("cloudflare_custom_hostname.%s", rnd), "id"),
+					resource.TestCheckResourceAttr(name, "custom_hostnames.0.ssl_method", "txt"),
+					resource.TestCheckResourceAttrSet(name, "custom_hostnames.0.ssl_status"),
+					resource.TestCheckResourceAttrSet(name, "custom_hostnames.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareCustomHostnamesConfig(rnd, zoneID, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_custom_hostname" "%[1]s" {
+  zone_id  = "%[2]s"
+  hostname = "%[1]s.%[3]s"
+  ssl {
+    method = "txt"
+  }
+}
+
+data "cloudflare_custom_hostnames" "%[1]s" {
+  zone_id  = "%[2]s"
+  hostname = cloudflare_custom_hostname.%[1]s.hostname
+}
+`, rnd, zoneID, domain)
+}
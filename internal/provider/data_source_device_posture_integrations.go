@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareDevicePostureIntegrations() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareDevicePostureIntegrationsSchema(),
+		ReadContext: dataSourceCloudflareDevicePostureIntegrationsRead,
+		Description: "Use this data source to look up device posture integrations, for example to reference their IDs by name in device posture rules managed elsewhere.",
+	}
+}
+
+func dataSourceCloudflareDevicePostureIntegrationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+	integrationType := d.Get("type").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing device posture integrations for account %s", accountID))
+
+	integrations, _, err := client.DevicePostureIntegrations(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing device posture integrations for account %q: %w", accountID, err))
+	}
+
+	results := make([]map[string]interface{}, 0, len(integrations))
+	for _, integration := range integrations {
+		if name != "" && integration.Name != name {
+			continue
+		}
+		if integrationType != "" && integration.Type != integrationType {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":       integration.IntegrationID,
+			"name":     integration.Name,
+			"type":     integration.Type,
+			"interval": integration.Interval,
+		})
+	}
+
+	if err := d.Set("integrations", results); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting device posture integrations: %w", err))
+	}
+
+	d.SetId(stringChecksum(strings.Join([]string{accountID, name, integrationType}, "/")))
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareDevicePostureIntegrations_Basic(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_device_posture_integrations.%s", rnd)
+
+	clientID := os.Getenv("CLOUDFLARE_WORKSPACE_ONE_CLIENT_ID")
+	clientSecret := os.Getenv("CLOUDFLARE_WORKSPACE_ONE_CLIENT_SECRET")
+	apiURL := os.Getenv("CLOUDFLARE_WORKSPACE_ONE_API_URL")
+	authURL := os.Getenv("CLOUDFLARE_WORKSPACE_ONE_AUTH_URL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckWorkspaceOne(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDevicePostureIntegrationsConfig(rnd, accountID, clientID, clientSecret, apiURL, authURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "integrations.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "integrations.0.id", "cloudflare_device_posture_integration."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "integrations.0.name", rnd),
+					resource.TestCheckResourceAttr(name, "integrations.0.type", "workspace_one"),
+					resource.TestCheckResourceAttr(name, "integrations.0.interval", "24h"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareDevicePostureIntegrationsConfig(rnd, accountID, clientID, clientSecret, apiURL, authURL string) string {
+	return testAccCloudflareDevicePostureIntegration(rnd, accountID, clientID, clientSecret, apiURL, authURL) + fmt.Sprintf(`
+data "cloudflare_device_posture_integrations" "%[1]s" {
+	account_id = "%[2]s"
+	name       = cloudflare_device_posture_integration.%[1]s.name
+}
+`, rnd, accountID)
+}
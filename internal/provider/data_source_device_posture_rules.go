@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareDevicePostureRules() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareDevicePostureRulesSchema(),
+		ReadContext: dataSourceCloudflareDevicePostureRulesRead,
+		Description: "Use this data source to look up device posture rules, for example to reference their IDs by name in Access and Gateway policies managed elsewhere.",
+	}
+}
+
+func dataSourceCloudflareDevicePostureRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+	ruleType := d.Get("type").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing device posture rules for account %s", accountID))
+
+	rules, _, err := client.DevicePostureRules(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing device posture rules for account %q: %w", accountID, err))
+	}
+
+	results := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		if name != "" && rule.Name != name {
+			continue
+		}
+		if ruleType != "" && rule.Type != ruleType {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":          rule.ID,
+			"name":        rule.Name,
+			"type":        rule.Type,
+			"description": rule.Description,
+			"schedule":    rule.Schedule,
+			"expiration":  rule.Expiration,
+		})
+	}
+
+	if err := d.Set("rules", results); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting device posture rules: %w", err))
+	}
+
+	d.SetId(stringChecksum(strings.Join([]string{accountID, name, ruleType}, "/")))
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareDevicePostureRules_Basic(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_device_posture_rules.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDevicePostureRulesConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rules.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "rules.0.id", "cloudflare_device_posture_rule."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "rules.0.name", rnd),
+					resource.TestCheckResourceAttr(name, "rules.0.type", "serial_number"),
+					resource.TestCheckResourceAttr(name, "rules.0.schedule", "24h"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareDevicePostureRulesConfig(rnd, accountID string) string {
+	return testAccCloudflareDevicePostureRuleConfigSerialNumber(rnd, accountID) + fmt.Sprintf(`
+data "cloudflare_device_posture_rules" "%[1]s" {
+	account_id = "%[2]s"
+	name       = cloudflare_device_posture_rule.%[1]s.name
+	type       = "serial_number"
+}
+`, rnd, accountID)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,7 +16,7 @@ func dataSourceCloudflareDevices() *schema.Resource {
 }
 
 func dataResourceCloudflareDevicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	d.SetId(accountID)
 
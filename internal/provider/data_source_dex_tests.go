@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type dexTest struct {
+	TestID   string `json:"test_id"`
+	Name     string `json:"name"`
+	Enabled  bool   `json:"enabled"`
+	Interval string `json:"interval"`
+	Data     struct {
+		Kind string `json:"kind"`
+		Host string `json:"host"`
+	} `json:"data"`
+}
+
+type dexTestPercentiles struct {
+	P50 *float64 `json:"p50"`
+	P90 *float64 `json:"p90"`
+	P95 *float64 `json:"p95"`
+	P99 *float64 `json:"p99"`
+}
+
+type dexTestAvailability struct {
+	AvailabilityPct struct {
+		Avg *float64 `json:"avg"`
+	} `json:"availabilityPct"`
+}
+
+// dexTestResultPaths maps a DEX test kind to the API path segment used for its
+// results and the fields holding its availability and latency statistics.
+var dexTestResultPaths = map[string]struct {
+	path, stats, latency string
+}{
+	"http":       {path: "http-tests", stats: "httpStats", latency: "resourceFetchTimeMs"},
+	"traceroute": {path: "traceroute-tests", stats: "tracerouteStats", latency: "roundTripTimeMs"},
+}
+
+func dataSourceCloudflareDEXTests() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareDEXTestsSchema(),
+		ReadContext: dataSourceCloudflareDEXTestsRead,
+		Description: "Use this data source to retrieve a summary of recent Digital Experience Monitoring (DEX) test results, such as availability and latency percentiles.",
+	}
+}
+
+func dataSourceCloudflareDEXTestsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	until := time.Now().UTC()
+	if v, ok := d.GetOk("until"); ok {
+		until, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	since := until.Add(-24 * time.Hour)
+	if v, ok := d.GetOk("since"); ok {
+		since, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	if !since.Before(until) {
+		return diag.FromErr(fmt.Errorf("since (%s) must be before until (%s)", since.Format(time.RFC3339), until.Format(time.RFC3339)))
+	}
+
+	params := url.Values{}
+	params.Set("from", since.Format(time.RFC3339))
+	params.Set("to", until.Format(time.RFC3339))
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading DEX test results for account %s", accountID))
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/dex/devices/dex_tests", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing DEX tests for account %q: %w", accountID, err))
+	}
+
+	var tests []dexTest
+	if err := json.Unmarshal(res, &tests); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling DEX tests: %w", err))
+	}
+
+	results := make([]interface{}, 0, len(tests))
+	for _, test := range tests {
+		result := map[string]interface{}{
+			"id":       test.TestID,
+			"name":     test.Name,
+			"kind":     test.Data.Kind,
+			"host":     test.Data.Host,
+			"enabled":  test.Enabled,
+			"interval": test.Interval,
+		}
+
+		if paths, ok := dexTestResultPaths[test.Data.Kind]; ok {
+			uri := fmt.Sprintf("/accounts/%s/dex/%s/%s", accountID, paths.path, test.TestID)
+
+			availability, err := fetchDEXTestStat(ctx, client, fmt.Sprintf("%s?%s&interval=hour", uri, params.Encode()), paths.stats)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error fetching results of DEX test %q: %w", test.TestID, err))
+			}
+			var stats dexTestAvailability
+			if err := json.Unmarshal(availability, &stats); err != nil {
+				return diag.FromErr(fmt.Errorf("error unmarshalling results of DEX test %q: %w", test.TestID, err))
+			}
+			result["availability_pct"] = float64Value(stats.AvailabilityPct.Avg)
+
+			latency, err := fetchDEXTestStat(ctx, client, fmt.Sprintf("%s/percentiles?%s", uri, params.Encode()), paths.latency)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error fetching latency percentiles of DEX test %q: %w", test.TestID, err))
+			}
+			var percentiles dexTestPercentiles
+			if err := json.Unmarshal(latency, &percentiles); err != nil {
+				return diag.FromErr(fmt.Errorf("error unmarshalling latency percentiles of DEX test %q: %w", test.TestID, err))
+			}
+			result["latency_p50_ms"] = float64Value(percentiles.P50)
+			result["latency_p90_ms"] = float64Value(percentiles.P90)
+			result["latency_p95_ms"] = float64Value(percentiles.P95)
+			result["latency_p99_ms"] = float64Value(percentiles.P99)
+		}
+
+		results = append(results, result)
+	}
+
+	if err := d.Set("tests", results); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting DEX tests: %w", err))
+	}
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s/%s", accountID, d.Get("since"), d.Get("until"))))
+
+	return nil
+}
+
+// fetchDEXTestStat returns the raw JSON of a single top level field from a DEX
+// test results response, or "{}" when the field is absent.
+func fetchDEXTestStat(ctx context.Context, client *apiClient, uri, field string) (json.RawMessage, error) {
+	res, err := rawRequest(ctx, client, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(res, &fields); err != nil {
+		return nil, fmt.Errorf("error unmarshalling DEX test results: %w", err)
+	}
+
+	if v, ok := fields[field]; ok && string(v) != "null" {
+		return v, nil
+	}
+
+	return json.RawMessage("{}"), nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareDEXTests_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_dex_tests.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDEXTestsConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttrSet(name, "tests.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareDEXTestsConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_dex_tests" "%[1]s" {
+  account_id = "%[2]s"
+}
+`, name, accountID)
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dnsFirewallAnalyticsMetrics = []string{
+	"queryCount",
+	"uncachedCount",
+	"staleCount",
+	"responseTimeAvg",
+	"responseTimeMedian",
+	"responseTime90th",
+	"responseTime99th",
+}
+
+func dataSourceCloudflareDNSFirewallAnalytics() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareDNSFirewallAnalyticsSchema(),
+		ReadContext: dataSourceCloudflareDNSFirewallAnalyticsRead,
+		Description: "Use this data source to retrieve aggregated query analytics for a DNS Firewall cluster, such as query volume and cache hit ratio.",
+	}
+}
+
+func dataSourceCloudflareDNSFirewallAnalyticsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	params := url.Values{}
+	params.Set("metrics", strings.Join(dnsFirewallAnalyticsMetrics, ","))
+
+	until := time.Now().UTC()
+	if v, ok := d.GetOk("until"); ok {
+		until, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	params.Set("until", until.Format(time.RFC3339))
+
+	since := until.Add(-24 * time.Hour)
+	if v, ok := d.GetOk("since"); ok {
+		since, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	params.Set("since", since.Format(time.RFC3339))
+
+	if !since.Before(until) {
+		return diag.FromErr(fmt.Errorf("since (%s) must be before until (%s)", since.Format(time.RFC3339), until.Format(time.RFC3339)))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading DNS Firewall analytics for cluster %s in account %s", clusterID, accountID))
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/dns_firewall/%s/dns_analytics/report?%s", accountID, clusterID, params.Encode()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching DNS Firewall analytics for cluster %q: %w", clusterID, err))
+	}
+
+	var analytics cloudflare.DNSFirewallAnalytics
+	if err := json.Unmarshal(res, &analytics); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling DNS Firewall analytics for cluster %q: %w", clusterID, err))
+	}
+
+	totals := analytics.Totals
+	queryCount := int64Value(totals.QueryCount)
+	uncachedCount := int64Value(totals.UncachedCount)
+
+	d.Set("query_count", int(queryCount))
+	d.Set("uncached_count", int(uncachedCount))
+	d.Set("stale_count", int(int64Value(totals.StaleCount)))
+	d.Set("cache_hit_ratio", dnsFirewallCacheHitRatio(queryCount, uncachedCount))
+	d.Set("response_time_avg", float64Value(totals.ResponseTimeAvg))
+	d.Set("response_time_median", float64Value(totals.ResponseTimeMedian))
+	d.Set("response_time_90th", float64Value(totals.ResponseTime90th))
+	d.Set("response_time_99th", float64Value(totals.ResponseTime99th))
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s/%s/%s", accountID, clusterID, d.Get("since"), d.Get("until"))))
+
+	return nil
+}
+
+// dnsFirewallCacheHitRatio returns the share of queries answered from cache
+// as a value between 0 and 1.
+func dnsFirewallCacheHitRatio(queryCount, uncachedCount int64) float64 {
+	if queryCount <= 0 {
+		return 0
+	}
+
+	return float64(queryCount-uncachedCount) / float64(queryCount)
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func float64Value(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDNSFirewallCacheHitRatio(t *testing.T) {
+	tests := map[string]struct {
+		queryCount    int64
+		uncachedCount int64
+		expected      float64
+	}{
+		"no queries":     {queryCount: 0, uncachedCount: 0, expected: 0},
+		"all cached":     {queryCount: 100, uncachedCount: 0, expected: 1},
+		"none cached":    {queryCount: 100, uncachedCount: 100, expected: 0},
+		"partial cached": {queryCount: 200, uncachedCount: 50, expected: 0.75},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := dnsFirewallCacheHitRatio(tc.queryCount, tc.uncachedCount); got != tc.expected {
+				t.Fatalf("expected cache hit ratio %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccCloudflareDNSFirewallAnalytics_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_dns_firewall_analytics.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	clusterID := os.Getenv("CLOUDFLARE_DNS_FIREWALL_CLUSTER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckDNSFirewallCluster(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDNSFirewallAnalyticsConfig(rnd, accountID, clusterID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "cluster_id", clusterID),
+					resource.TestCheckResourceAttrSet(name, "query_count"),
+					resource.TestCheckResourceAttrSet(name, "cache_hit_ratio"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareDNSFirewallAnalyticsConfig(name, accountID, clusterID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_dns_firewall_analytics" "%[1]s" {
+  account_id = "%[2]s"
+  cluster_id = "%[3]s"
+}
+`, name, accountID, clusterID)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareDNSRecords() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareDNSRecordsSchema(),
+		ReadContext: dataSourceCloudflareDNSRecordsRead,
+		Description: "Use this data source to look up the DNS records of a zone, filtered by the API.",
+	}
+}
+
+func dataSourceCloudflareDNSRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	filter := buildDNSRecordsFilter(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading DNS records for zone %s with filter %q", zoneID, filter.Encode()))
+
+	records, err := listDNSRecordsWithFilter(ctx, client, zoneID, filter)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing DNS records for zone %q: %w", zoneID, err))
+	}
+
+	recordIDs := make([]string, 0, len(records))
+	results := make([]interface{}, 0, len(records))
+	for _, r := range records {
+		result := flattenDNSBatchRecord(r)
+		result["id"] = r.ID
+
+		results = append(results, result)
+		recordIDs = append(recordIDs, r.ID)
+	}
+
+	if err := d.Set("records", results); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting records: %w", err))
+	}
+
+	d.SetId(stringListChecksum(append(recordIDs, zoneID)))
+
+	return nil
+}
+
+// buildDNSRecordsFilter converts the `filter` block into the query
+// parameters used by the API to filter records.
+func buildDNSRecordsFilter(d *schema.ResourceData) url.Values {
+	filter := url.Values{}
+
+	if _, ok := d.GetOk("filter"); !ok {
+		return filter
+	}
+
+	params := map[string]string{
+		"type":             "type",
+		"name":             "name",
+		"name_contains":    "name.contains",
+		"name_starts_with": "name.startswith",
+		"content":          "content",
+		"tag":              "tag",
+	}
+	for attr, param := range params {
+		if value, ok := d.GetOk("filter.0." + attr); ok {
+			filter.Set(param, value.(string))
+		}
+	}
+
+	if proxied, ok := d.GetOkExists("filter.0.proxied"); ok {
+		filter.Set("proxied", strconv.FormatBool(proxied.(bool)))
+	}
+
+	filter.Set("match", "all")
+
+	return filter
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareDNSRecordsDataSource_Basic(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_dns_records.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDNSRecordsDataSourceConfig(zoneID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "1"),
+					resource.TestCheckResourceAttr(name, "records.0.name", fmt.Sprintf("%s-a.%s", rnd, domain)),
+					resource.TestCheckResourceAttr(name, "records.0.type", "A"),
+					resource.TestCheckResourceAttr(name, "records.0.value", "192.0.2.1"),
+					resource.TestCheckResourceAttr(name, "records.0.proxied", "true"),
+					resource.TestCheckResourceAttrSet(name, "records.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareDNSRecordsDataSourceConfig(zoneID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s_a" {
+  zone_id = "%[1]s"
+  name    = "%[2]s-a"
+  type    = "A"
+  value   = "192.0.2.1"
+  proxied = true
+}
+
+resource "cloudflare_record" "%[2]s_b" {
+  zone_id = "%[1]s"
+  name    = "%[2]s-b"
+  type    = "A"
+  value   = "192.0.2.1"
+  proxied = false
+}
+
+data "cloudflare_dns_records" "%[2]s" {
+  zone_id = "%[1]s"
+
+  filter {
+    type             = "A"
+    name_starts_with = "%[2]s-"
+    proxied          = true
+  }
+
+  depends_on = [cloudflare_record.%[2]s_a, cloudflare_record.%[2]s_b]
+}
+`, zoneID, rnd)
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const firewallEventsQuery = `query ($zoneTag: string, $filter: ZoneFirewallEventsAdaptiveFilter_InputObject, $limit: uint64!) {
+  viewer {
+    zones(filter: { zoneTag: $zoneTag }) {
+      firewallEventsAdaptive(filter: $filter, limit: $limit, orderBy: [datetime_DESC]) {
+        action
+        clientIP
+        clientRequestHTTPHost
+        clientRequestPath
+        clientRequestQuery
+        datetime
+        rayName
+        ruleId
+        source
+      }
+    }
+  }
+}`
+
+type firewallEvent struct {
+	Action                string `json:"action"`
+	ClientIP              string `json:"clientIP"`
+	ClientRequestHTTPHost string `json:"clientRequestHTTPHost"`
+	ClientRequestPath     string `json:"clientRequestPath"`
+	ClientRequestQuery    string `json:"clientRequestQuery"`
+	Datetime              string `json:"datetime"`
+	RayName               string `json:"rayName"`
+	RuleID                string `json:"ruleId"`
+	Source                string `json:"source"`
+}
+
+func dataSourceCloudflareFirewallEvents() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareFirewallEventsSchema(),
+		ReadContext: dataSourceCloudflareFirewallEventsRead,
+		Description: "Use this data source to retrieve a sample of recent security events for a zone from the GraphQL Analytics API, for example to check the false positive rate of a rule before changing it.",
+	}
+}
+
+func dataSourceCloudflareFirewallEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	until := time.Now().UTC()
+	if v, ok := d.GetOk("until"); ok {
+		until, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	since := until.Add(-1 * time.Hour)
+	if v, ok := d.GetOk("since"); ok {
+		since, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	if !since.Before(until) {
+		return diag.FromErr(fmt.Errorf("since (%s) must be before until (%s)", since.Format(time.RFC3339), until.Format(time.RFC3339)))
+	}
+
+	variables := map[string]interface{}{
+		"zoneTag": zoneID,
+		"filter":  firewallEventsFilter(since, until, d.Get("rule_id").(string), d.Get("action").(string), d.Get("source").(string)),
+		"limit":   d.Get("limit").(int),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading security events for zone %s", zoneID))
+
+	var result struct {
+		Viewer struct {
+			Zones []struct {
+				FirewallEventsAdaptive []firewallEvent `json:"firewallEventsAdaptive"`
+			} `json:"zones"`
+		} `json:"viewer"`
+	}
+	if err := graphQLRequest(ctx, client, firewallEventsQuery, variables, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching security events for zone %q: %w", zoneID, err))
+	}
+
+	events := make([]map[string]interface{}, 0)
+	for _, zone := range result.Viewer.Zones {
+		for _, e := range zone.FirewallEventsAdaptive {
+			events = append(events, map[string]interface{}{
+				"ray_id":    e.RayName,
+				"datetime":  e.Datetime,
+				"rule_id":   e.RuleID,
+				"action":    e.Action,
+				"source":    e.Source,
+				"client_ip": e.ClientIP,
+				"host":      e.ClientRequestHTTPHost,
+				"uri":       e.ClientRequestPath + e.ClientRequestQuery,
+			})
+		}
+	}
+
+	if err := d.Set("events", events); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting events: %w", err))
+	}
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s/%s/%s/%s/%s/%d", zoneID, d.Get("since"), d.Get("until"), d.Get("rule_id"), d.Get("action"), d.Get("source"), d.Get("limit"))))
+
+	return nil
+}
+
+// firewallEventsFilter builds the GraphQL filter for the sampling window and
+// the optional rule, action and source filters.
+func firewallEventsFilter(since, until time.Time, ruleID, action, source string) map[string]interface{} {
+	filter := map[string]interface{}{
+		"datetime_geq": since.Format(time.RFC3339),
+		"datetime_leq": until.Format(time.RFC3339),
+	}
+
+	if ruleID != "" {
+		filter["ruleId"] = ruleID
+	}
+	if action != "" {
+		filter["action"] = action
+	}
+	if source != "" {
+		filter["source"] = source
+	}
+
+	return filter
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFirewallEventsFilter(t *testing.T) {
+	since := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	until := since.Add(time.Hour)
+
+	tests := map[string]struct {
+		ruleID, action, source string
+		expected               map[string]interface{}
+	}{
+		"window only": {
+			expected: map[string]interface{}{
+				"datetime_geq": "2022-06-01T10:00:00Z",
+				"datetime_leq": "2022-06-01T11:00:00Z",
+			},
+		},
+		"all filters": {
+			ruleID: "100015",
+			action: "block",
+			source: "firewallManaged",
+			expected: map[string]interface{}{
+				"datetime_geq": "2022-06-01T10:00:00Z",
+				"datetime_leq": "2022-06-01T11:00:00Z",
+				"ruleId":       "100015",
+				"action":       "block",
+				"source":       "firewallManaged",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := firewallEventsFilter(since, until, tc.ruleID, tc.action, tc.source); !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("expected filter %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccCloudflareFirewallEvents_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_firewall_events.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareFirewallEventsConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "limit", "10"),
+					resource.TestCheckResourceAttrSet(name, "events.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareFirewallEventsConfig(name, zoneID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_firewall_events" "%[1]s" {
+  zone_id = "%[2]s"
+  action  = "block"
+  limit   = 10
+}`, name, zoneID)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const imagesDeliveryHost = "imagedelivery.net"
+
+func dataSourceCloudflareImagesDeliveryURL() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareImagesDeliveryURLSchema(),
+		ReadContext: dataSourceCloudflareImagesDeliveryURLRead,
+		Description: "Use this data source to look up the delivery URL hash of an account using Cloudflare Images. " +
+			"The hash is derived from the delivery URLs of existing images so the account must contain at least one image.",
+	}
+}
+
+func dataSourceCloudflareImagesDeliveryURLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Images delivery URL for account %s", accountID))
+
+	images, err := client.ListImages(ctx, accountID, cloudflare.PaginationOptions{PerPage: 1})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Images in account %q: %w", accountID, err))
+	}
+
+	if len(images) == 0 || len(images[0].Variants) == 0 {
+		return diag.FromErr(fmt.Errorf("unable to determine Images delivery URL hash for account %q: account contains no images", accountID))
+	}
+
+	hash, err := imagesAccountHashFromVariantURL(images[0].Variants[0])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(accountID)
+	d.Set("account_hash", hash)
+	d.Set("base_url", fmt.Sprintf("https://%s/%s", imagesDeliveryHost, hash))
+
+	return nil
+}
+
+// imagesAccountHashFromVariantURL extracts the account hash from an Images
+// delivery URL in the form https://imagedelivery.net/<hash>/<image>/<variant>.
+func imagesAccountHashFromVariantURL(variantURL string) (string, error) {
+	u, err := url.Parse(variantURL)
+	if err != nil {
+		return "", fmt.Errorf("error parsing Images delivery URL %q: %w", variantURL, err)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if u.Host != imagesDeliveryHost || len(segments) != 3 || segments[0] == "" {
+		return "", fmt.Errorf("unexpected Images delivery URL %q", variantURL)
+	}
+
+	return segments[0], nil
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestImagesAccountHashFromVariantURL(t *testing.T) {
+	hash, err := imagesAccountHashFromVariantURL("https://imagedelivery.net/ZWd9g1K7eljCn_KDTu_MWA/083eb7b2-5392-4565-b69e-aff66acddd00/public")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if hash != "ZWd9g1K7eljCn_KDTu_MWA" {
+		t.Errorf("got hash %q, expected %q", hash, "ZWd9g1K7eljCn_KDTu_MWA")
+	}
+
+	for _, u := range []string{
+		"https://example.com/ZWd9g1K7eljCn_KDTu_MWA/083eb7b2/public",
+		"https://imagedelivery.net/ZWd9g1K7eljCn_KDTu_MWA",
+		"://imagedelivery.net",
+	} {
+		if _, err := imagesAccountHashFromVariantURL(u); err == nil {
+			t.Errorf("expected error for %q", u)
+		}
+	}
+}
+
+func TestAccCloudflareImagesDeliveryURL_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_images_delivery_url.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "cloudflare_images_delivery_url" "%[1]s" {
+  account_id = "%[2]s"
+}
+`, rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "account_hash"),
+					resource.TestMatchResourceAttr(name, "base_url", regexp.MustCompile(`^https://imagedelivery\.net/`)),
+				),
+			},
+		},
+	})
+}
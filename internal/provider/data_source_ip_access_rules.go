@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareIPAccessRules() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareIPAccessRulesSchema(),
+		ReadContext: dataSourceCloudflareIPAccessRulesRead,
+		Description: "Use this data source to resolve which IP Access rule applies to an IP address. " +
+			"More specific rules take precedence (IP address, then the narrowest IP range, ASN and " +
+			"country) and, for equally specific rules, zone rules win over account and user rules.",
+	}
+}
+
+func dataSourceCloudflareIPAccessRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	ip := d.Get("ip").(string)
+	zoneID := d.Get("zone_id").(string)
+	accountID := d.Get("account_id").(string)
+	asn := d.Get("asn").(string)
+	country := d.Get("country").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Resolving IP Access rules for %s", ip))
+
+	var rules []cloudflare.AccessRule
+	if zoneID != "" {
+		zoneRules, err := listAllAccessRules(func(page int) (*cloudflare.AccessRuleListResponse, error) {
+			return client.ListZoneAccessRules(ctx, zoneID, cloudflare.AccessRule{}, page)
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing IP Access rules for zone %q: %w", zoneID, err))
+		}
+		rules = append(rules, withDefaultAccessRuleScope(zoneRules, "zone")...)
+	}
+
+	if accountID != "" {
+		accountRules, err := listAllAccessRules(func(page int) (*cloudflare.AccessRuleListResponse, error) {
+			return client.ListAccountAccessRules(ctx, accountID, cloudflare.AccessRule{}, page)
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing IP Access rules for account %q: %w", accountID, err))
+		}
+		rules = append(rules, withDefaultAccessRuleScope(accountRules, "organization")...)
+	}
+
+	matches := matchIPAccessRules(rules, net.ParseIP(ip), asn, country)
+
+	matchingRules := make([]map[string]interface{}, 0, len(matches))
+	for _, rule := range matches {
+		matchingRules = append(matchingRules, map[string]interface{}{
+			"id":     rule.ID,
+			"mode":   rule.Mode,
+			"scope":  ipAccessRuleScope(rule),
+			"target": rule.Configuration.Target,
+			"value":  rule.Configuration.Value,
+			"notes":  rule.Notes,
+		})
+	}
+
+	if err := d.Set("matching_rules", matchingRules); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting matching IP Access rules: %w", err))
+	}
+
+	var mode, ruleID, scope string
+	if len(matches) > 0 {
+		mode, ruleID, scope = matches[0].Mode, matches[0].ID, ipAccessRuleScope(matches[0])
+	}
+	d.Set("mode", mode)
+	d.Set("rule_id", ruleID)
+	d.Set("scope", scope)
+
+	d.SetId(stringChecksum(strings.Join([]string{ip, zoneID, accountID, asn, country}, "/")))
+
+	return nil
+}
+
+func listAllAccessRules(list func(page int) (*cloudflare.AccessRuleListResponse, error)) ([]cloudflare.AccessRule, error) {
+	var rules []cloudflare.AccessRule
+
+	for page := 1; ; page++ {
+		res, err := list(page)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, res.Result...)
+
+		if page >= res.ResultInfo.TotalPages {
+			return rules, nil
+		}
+	}
+}
+
+// withDefaultAccessRuleScope sets the scope of rules which were returned
+// without one to the scope they were listed at.
+func withDefaultAccessRuleScope(rules []cloudflare.AccessRule, scopeType string) []cloudflare.AccessRule {
+	for i := range rules {
+		if rules[i].Scope.Type == "" {
+			rules[i].Scope.Type = scopeType
+		}
+	}
+	return rules
+}
+
+// matchIPAccessRules returns the rules which apply to the IP address, ordered
+// by precedence with the effective rule first. Rules returned by more than
+// one scope listing are only included once.
+func matchIPAccessRules(rules []cloudflare.AccessRule, ip net.IP, asn, country string) []cloudflare.AccessRule {
+	seen := make(map[string]bool)
+	var matches []cloudflare.AccessRule
+
+	for _, rule := range rules {
+		if seen[rule.ID] {
+			continue
+		}
+		seen[rule.ID] = true
+
+		if ipAccessRuleMatches(rule, ip, asn, country) {
+			matches = append(matches, rule)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		si, sj := ipAccessRuleSpecificity(matches[i]), ipAccessRuleSpecificity(matches[j])
+		if si != sj {
+			return si > sj
+		}
+		return ipAccessRuleScopePrecedence(matches[i]) < ipAccessRuleScopePrecedence(matches[j])
+	})
+
+	return matches
+}
+
+func ipAccessRuleMatches(rule cloudflare.AccessRule, ip net.IP, asn, country string) bool {
+	value := rule.Configuration.Value
+
+	switch rule.Configuration.Target {
+	case "ip", "ip6":
+		ruleIP := net.ParseIP(value)
+		return ruleIP != nil && ruleIP.Equal(ip)
+	case "ip_range":
+		_, network, err := net.ParseCIDR(value)
+		return err == nil && network.Contains(ip)
+	case "asn":
+		return asn != "" && normalizeASN(value) == normalizeASN(asn)
+	case "country":
+		return country != "" && strings.EqualFold(value, country)
+	}
+
+	return false
+}
+
+// ipAccessRuleSpecificity ranks rules so that exact IP addresses beat IP
+// ranges (narrower first), which in turn beat ASN and country rules.
+func ipAccessRuleSpecificity(rule cloudflare.AccessRule) int {
+	switch rule.Configuration.Target {
+	case "ip", "ip6":
+		return 1000
+	case "ip_range":
+		if _, network, err := net.ParseCIDR(rule.Configuration.Value); err == nil {
+			ones, _ := network.Mask.Size()
+			return 100 + ones
+		}
+	case "asn":
+		return 10
+	case "country":
+		return 1
+	}
+
+	return 0
+}
+
+func ipAccessRuleScopePrecedence(rule cloudflare.AccessRule) int {
+	switch ipAccessRuleScope(rule) {
+	case "zone":
+		return 0
+	case "account":
+		return 1
+	default:
+		return 2
+	}
+}
+
+// ipAccessRuleScope returns the scope of the rule, reporting the API's
+// "organization" scope as "account".
+func ipAccessRuleScope(rule cloudflare.AccessRule) string {
+	if rule.Scope.Type == "organization" {
+		return "account"
+	}
+	return rule.Scope.Type
+}
+
+func normalizeASN(asn string) string {
+	return strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(asn)), "AS")
+}
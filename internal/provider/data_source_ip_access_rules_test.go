@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareIPAccessRules_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_ip_access_rules.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareIPAccessRulesConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "mode", "challenge"),
+					resource.TestCheckResourceAttr(name, "scope", "zone"),
+					resource.TestCheckResourceAttrPair(name, "rule_id", fmt.Sprintf("cloudflare_access_rule.%s_ip", rnd), "id"),
+					resource.TestCheckResourceAttr(name, "matching_rules.#", "2"),
+					resource.TestCheckResourceAttr(name, "matching_rules.1.target", "ip_range"),
+					resource.TestCheckResourceAttr(name, "matching_rules.1.mode", "block"),
+				),
+			},
+		},
+	})
+}
+
+func TestMatchIPAccessRules(t *testing.T) {
+	rule := func(id, target, value, scope string) cloudflare.AccessRule {
+		return cloudflare.AccessRule{
+			ID:            id,
+			Configuration: cloudflare.AccessRuleConfiguration{Target: target, Value: value},
+			Scope:         cloudflare.AccessRuleScope{Type: scope},
+		}
+	}
+
+	rules := []cloudflare.AccessRule{
+		rule("country", "country", "US", "zone"),
+		rule("asn", "asn", "AS64496", "zone"),
+		rule("wide-range", "ip_range", "198.51.100.0/16", "zone"),
+		rule("narrow-range", "ip_range", "198.51.100.0/24", "organization"),
+		rule("account-ip", "ip", "198.51.100.4", "organization"),
+		rule("zone-ip", "ip", "198.51.100.4", "zone"),
+		rule("zone-ip", "ip", "198.51.100.4", "zone"),
+		rule("other-ip", "ip", "198.51.100.5", "zone"),
+		rule("other-asn", "asn", "AS64497", "zone"),
+	}
+
+	matches := matchIPAccessRules(rules, net.ParseIP("198.51.100.4"), "64496", "us")
+
+	expected := []string{"zone-ip", "account-ip", "narrow-range", "wide-range", "asn", "country"}
+	if len(matches) != len(expected) {
+		t.Fatalf("expected %d matching rules, got %d", len(expected), len(matches))
+	}
+	for i, id := range expected {
+		if matches[i].ID != id {
+			t.Errorf("expected rule %q at position %d, got %q", id, i, matches[i].ID)
+		}
+	}
+
+	if scope := ipAccessRuleScope(matches[1]); scope != "account" {
+		t.Errorf("expected organization scope to be reported as account, got %q", scope)
+	}
+
+	if matches := matchIPAccessRules(rules, net.ParseIP("203.0.113.1"), "", ""); len(matches) != 0 {
+		t.Errorf("expected no matching rules, got %d", len(matches))
+	}
+}
+
+func testAccCloudflareIPAccessRulesConfig(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_rule" "%[1]s_ip" {
+  zone_id = "%[2]s"
+  notes   = "%[1]s"
+  mode    = "challenge"
+  configuration {
+    target = "ip"
+    value  = "198.51.100.4"
+  }
+}
+
+resource "cloudflare_access_rule" "%[1]s_range" {
+  zone_id = "%[2]s"
+  notes   = "%[1]s"
+  mode    = "block"
+  configuration {
+    target = "ip_range"
+    value  = "198.51.100.0/24"
+  }
+}
+
+data "cloudflare_ip_access_rules" "%[1]s" {
+  zone_id = "%[2]s"
+  ip      = "198.51.100.4"
+
+  depends_on = [
+    cloudflare_access_rule.%[1]s_ip,
+    cloudflare_access_rule.%[1]s_range,
+  ]
+}
+`, rnd, zoneID)
+}
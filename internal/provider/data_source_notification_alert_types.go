@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// notificationAlertType is an available alert type including the filters it
+// accepts, which cloudflare-go does not expose.
+type notificationAlertType struct {
+	Type          string                          `json:"type"`
+	DisplayName   string                          `json:"display_name"`
+	Description   string                          `json:"description"`
+	FilterOptions []notificationAlertFilterOption `json:"filter_options"`
+}
+
+// notificationAlertFilterOption is a filter accepted by an alert type. Unlike
+// the rest of the API, its fields are returned in PascalCase.
+type notificationAlertFilterOption struct {
+	Key                string `json:"Key"`
+	Optional           bool   `json:"Optional"`
+	ComparisonOperator string `json:"ComparisonOperator"`
+	Range              string `json:"Range"`
+	AvailableValues    []struct {
+		ID string `json:"ID"`
+	} `json:"AvailableValues"`
+}
+
+func dataSourceCloudflareNotificationAlertTypes() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareNotificationAlertTypesSchema(),
+		ReadContext: dataSourceCloudflareNotificationAlertTypesRead,
+		Description: "Use this data source to look up the alert types available to notification policies and the filters each of them accepts.",
+	}
+}
+
+func dataSourceCloudflareNotificationAlertTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	product := d.Get("product").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading notification alert types for account %s", accountID))
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/alerting/v3/available_alerts", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing notification alert types for account %q: %w", accountID, err))
+	}
+
+	var grouped map[string][]notificationAlertType
+	if err := json.Unmarshal(res, &grouped); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling notification alert types: %w", err))
+	}
+
+	products := make([]string, 0, len(grouped))
+	for p := range grouped {
+		if product == "" || p == product {
+			products = append(products, p)
+		}
+	}
+	sort.Strings(products)
+
+	alertTypes := make([]interface{}, 0)
+	types := make([]string, 0)
+	for _, p := range products {
+		for _, alertType := range grouped[p] {
+			alertTypes = append(alertTypes, map[string]interface{}{
+				"type":         alertType.Type,
+				"product":      p,
+				"display_name": alertType.DisplayName,
+				"description":  alertType.Description,
+				"filters":      flattenNotificationAlertFilterOptions(alertType.FilterOptions),
+			})
+			types = append(types, alertType.Type)
+		}
+	}
+
+	if err := d.Set("alert_types", alertTypes); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting alert_types: %w", err))
+	}
+
+	d.SetId(stringListChecksum(append(types, accountID)))
+
+	return nil
+}
+
+func flattenNotificationAlertFilterOptions(options []notificationAlertFilterOption) []interface{} {
+	supported := notificationPolicyFilterSchema().Elem.(*schema.Resource).Schema
+
+	filters := make([]interface{}, 0, len(options))
+	for _, option := range options {
+		values := make([]string, 0, len(option.AvailableValues))
+		for _, v := range option.AvailableValues {
+			values = append(values, v.ID)
+		}
+
+		_, ok := supported[option.Key]
+		filters = append(filters, map[string]interface{}{
+			"key":                 option.Key,
+			"optional":            option.Optional,
+			"comparison_operator": option.ComparisonOperator,
+			"range":               option.Range,
+			"available_values":    values,
+			"supported":           ok,
+		})
+	}
+
+	return filters
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFlattenNotificationAlertFilterOptions(t *testing.T) {
+	var options []notificationAlertFilterOption
+	raw := `[
+		{"ComparisonOperator": "==", "Key": "zones", "Optional": false, "Range": "1-n"},
+		{"ComparisonOperator": "==", "Key": "product", "Optional": true, "AvailableValues": [{"ID": "worker_requests"}, {"ID": "worker_durable_objects_requests"}]},
+		{"ComparisonOperator": "==", "Key": "not_a_filter", "Optional": true}
+	]`
+	if err := json.Unmarshal([]byte(raw), &options); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	filters := flattenNotificationAlertFilterOptions(options)
+	if len(filters) != 3 {
+		t.Fatalf("expected 3 filters, got %d", len(filters))
+	}
+
+	zones := filters[0].(map[string]interface{})
+	if zones["key"] != "zones" || zones["range"] != "1-n" || zones["optional"] != false || zones["supported"] != true {
+		t.Errorf("unexpected zones filter: %+v", zones)
+	}
+
+	product := filters[1].(map[string]interface{})
+	if !reflect.DeepEqual(product["available_values"], []string{"worker_requests", "worker_durable_objects_requests"}) {
+		t.Errorf("unexpected available values: %+v", product["available_values"])
+	}
+
+	if filters[2].(map[string]interface{})["supported"] != false {
+		t.Errorf("expected unknown filter keys to be unsupported")
+	}
+}
+
+func TestAccCloudflareNotificationAlertTypes_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_notification_alert_types.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareNotificationAlertTypesConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttrSet(name, "alert_types.#"),
+					resource.TestCheckResourceAttrSet(name, "alert_types.0.type"),
+					resource.TestCheckResourceAttrSet(name, "alert_types.0.display_name"),
+					resource.TestCheckResourceAttrSet(name, "alert_types.0.product"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareNotificationAlertTypesConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_notification_alert_types" "%[1]s" {
+  account_id = "%[2]s"
+}
+`, name, accountID)
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const r2BucketMetricsQuery = `query ($accountTag: string, $storageFilter: AccountR2StorageAdaptiveGroupsFilter_InputObject, $operationsFilter: AccountR2OperationsAdaptiveGroupsFilter_InputObject) {
+  viewer {
+    accounts(filter: { accountTag: $accountTag }) {
+      r2StorageAdaptiveGroups(filter: $storageFilter, limit: 10000) {
+        max {
+          objectCount
+          payloadSize
+          metadataSize
+        }
+        dimensions {
+          bucketName
+        }
+      }
+      r2OperationsAdaptiveGroups(filter: $operationsFilter, limit: 10000) {
+        sum {
+          requests
+        }
+        dimensions {
+          actionType
+          bucketName
+        }
+      }
+    }
+  }
+}`
+
+// r2ClassAOperations and r2ClassBOperations list the billable R2 operations
+// by class. Any other operation, such as DeleteObject, is free.
+var (
+	r2ClassAOperations = []string{
+		"ListBuckets", "PutBucket", "ListObjects", "PutObject", "CopyObject",
+		"CompleteMultipartUpload", "CreateMultipartUpload", "ListMultipartUploads",
+		"UploadPart", "UploadPartCopy", "ListParts", "PutBucketEncryption",
+		"PutBucketCors", "PutBucketLifecycleConfiguration",
+	}
+	r2ClassBOperations = []string{
+		"HeadBucket", "HeadObject", "GetObject", "UsageSummary", "GetBucketEncryption",
+		"GetBucketLocation", "GetBucketCors", "GetBucketLifecycleConfiguration",
+	}
+)
+
+type r2StorageGroup struct {
+	Max struct {
+		ObjectCount  int `json:"objectCount"`
+		PayloadSize  int `json:"payloadSize"`
+		MetadataSize int `json:"metadataSize"`
+	} `json:"max"`
+	Dimensions struct {
+		BucketName string `json:"bucketName"`
+	} `json:"dimensions"`
+}
+
+type r2OperationsGroup struct {
+	Sum struct {
+		Requests int `json:"requests"`
+	} `json:"sum"`
+	Dimensions struct {
+		ActionType string `json:"actionType"`
+		BucketName string `json:"bucketName"`
+	} `json:"dimensions"`
+}
+
+type r2BucketMetrics struct {
+	Name             string
+	ObjectCount      int
+	StorageBytes     int
+	ClassAOperations int
+	ClassBOperations int
+}
+
+func dataSourceCloudflareR2BucketMetrics() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareR2BucketMetricsSchema(),
+		ReadContext: dataSourceCloudflareR2BucketMetricsRead,
+		Description: "Use this data source to retrieve the storage and operation metrics of the R2 buckets of an account from the GraphQL Analytics API, for example to fail a plan when usage exceeds a budget.",
+	}
+}
+
+func dataSourceCloudflareR2BucketMetricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	bucketName := d.Get("bucket_name").(string)
+
+	until := time.Now().UTC()
+	if v, ok := d.GetOk("until"); ok {
+		until, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	since := time.Date(until.Year(), until.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if v, ok := d.GetOk("since"); ok {
+		since, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	if !since.Before(until) {
+		return diag.FromErr(fmt.Errorf("since (%s) must be before until (%s)", since.Format(time.RFC3339), until.Format(time.RFC3339)))
+	}
+
+	filter := map[string]interface{}{
+		"datetime_geq": since.Format(time.RFC3339),
+		"datetime_leq": until.Format(time.RFC3339),
+	}
+	if bucketName != "" {
+		filter["bucketName"] = bucketName
+	}
+
+	variables := map[string]interface{}{
+		"accountTag":       accountID,
+		"storageFilter":    filter,
+		"operationsFilter": filter,
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading R2 metrics for account %s", accountID))
+
+	var result struct {
+		Viewer struct {
+			Accounts []struct {
+				R2StorageAdaptiveGroups    []r2StorageGroup    `json:"r2StorageAdaptiveGroups"`
+				R2OperationsAdaptiveGroups []r2OperationsGroup `json:"r2OperationsAdaptiveGroups"`
+			} `json:"accounts"`
+		} `json:"viewer"`
+	}
+	if err := graphQLRequest(ctx, client, r2BucketMetricsQuery, variables, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching R2 metrics for account %q: %w", accountID, err))
+	}
+
+	var storage []r2StorageGroup
+	var operations []r2OperationsGroup
+	for _, account := range result.Viewer.Accounts {
+		storage = append(storage, account.R2StorageAdaptiveGroups...)
+		operations = append(operations, account.R2OperationsAdaptiveGroups...)
+	}
+
+	var storageBytes, classAOperations, classBOperations int
+	buckets := make([]map[string]interface{}, 0)
+	for _, m := range aggregateR2BucketMetrics(storage, operations) {
+		storageBytes += m.StorageBytes
+		classAOperations += m.ClassAOperations
+		classBOperations += m.ClassBOperations
+
+		buckets = append(buckets, map[string]interface{}{
+			"name":               m.Name,
+			"object_count":       m.ObjectCount,
+			"storage_bytes":      m.StorageBytes,
+			"class_a_operations": m.ClassAOperations,
+			"class_b_operations": m.ClassBOperations,
+		})
+	}
+
+	if err := d.Set("buckets", buckets); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting buckets: %w", err))
+	}
+	d.Set("storage_bytes", storageBytes)
+	d.Set("class_a_operations", classAOperations)
+	d.Set("class_b_operations", classBOperations)
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s/%s/%s", accountID, bucketName, d.Get("since"), d.Get("until"))))
+
+	return nil
+}
+
+// aggregateR2BucketMetrics combines the storage and operations groups into
+// per-bucket metrics, ordered by bucket name.
+func aggregateR2BucketMetrics(storage []r2StorageGroup, operations []r2OperationsGroup) []r2BucketMetrics {
+	metrics := make(map[string]*r2BucketMetrics)
+	bucket := func(name string) *r2BucketMetrics {
+		if _, ok := metrics[name]; !ok {
+			metrics[name] = &r2BucketMetrics{Name: name}
+		}
+		return metrics[name]
+	}
+
+	for _, s := range storage {
+		m := bucket(s.Dimensions.BucketName)
+		if s.Max.ObjectCount > m.ObjectCount {
+			m.ObjectCount = s.Max.ObjectCount
+		}
+		if size := s.Max.PayloadSize + s.Max.MetadataSize; size > m.StorageBytes {
+			m.StorageBytes = size
+		}
+	}
+
+	for _, o := range operations {
+		m := bucket(o.Dimensions.BucketName)
+		switch {
+		case contains(r2ClassAOperations, o.Dimensions.ActionType):
+			m.ClassAOperations += o.Sum.Requests
+		case contains(r2ClassBOperations, o.Dimensions.ActionType):
+			m.ClassBOperations += o.Sum.Requests
+		}
+	}
+
+	result := make([]r2BucketMetrics, 0, len(metrics))
+	for _, m := range metrics {
+		result = append(result, *m)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result
+}
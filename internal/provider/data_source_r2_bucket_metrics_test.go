@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAggregateR2BucketMetrics(t *testing.T) {
+	storage := func(bucket string, objects, payload, metadata int) r2StorageGroup {
+		var g r2StorageGroup
+		g.Dimensions.BucketName = bucket
+		g.Max.ObjectCount = objects
+		g.Max.PayloadSize = payload
+		g.Max.MetadataSize = metadata
+		return g
+	}
+	operations := func(bucket, action string, requests int) r2OperationsGroup {
+		var g r2OperationsGroup
+		g.Dimensions.BucketName = bucket
+		g.Dimensions.ActionType = action
+		g.Sum.Requests = requests
+		return g
+	}
+
+	got := aggregateR2BucketMetrics(
+		[]r2StorageGroup{
+			storage("logs", 10, 1000, 24),
+			storage("assets", 2, 500, 0),
+		},
+		[]r2OperationsGroup{
+			operations("assets", "PutObject", 3),
+			operations("assets", "ListObjects", 2),
+			operations("assets", "GetObject", 40),
+			operations("assets", "DeleteObject", 7),
+			operations("backups", "PutObject", 1),
+		},
+	)
+
+	expected := []r2BucketMetrics{
+		{Name: "assets", ObjectCount: 2, StorageBytes: 500, ClassAOperations: 5, ClassBOperations: 40},
+		{Name: "backups", ClassAOperations: 1},
+		{Name: "logs", ObjectCount: 10, StorageBytes: 1024},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected metrics %+v, got %+v", expected, got)
+	}
+}
+
+func TestAccCloudflareR2BucketMetrics_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_r2_bucket_metrics.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2BucketMetricsConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttrSet(name, "buckets.#"),
+					resource.TestCheckResourceAttrSet(name, "storage_bytes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareR2BucketMetricsConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_r2_bucket_metrics" "%[1]s" {
+  account_id = "%[2]s"
+}`, name, accountID)
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type r2Bucket struct {
+	Name         string `json:"name"`
+	CreationDate string `json:"creation_date"`
+	Location     string `json:"location"`
+	StorageClass string `json:"storage_class"`
+}
+
+func dataSourceCloudflareR2Buckets() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareR2BucketsSchema(),
+		ReadContext: dataSourceCloudflareR2BucketsRead,
+		Description: "Use this data source to list the R2 buckets of an account, including buckets not managed by Terraform.",
+	}
+}
+
+func dataSourceCloudflareR2BucketsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	nameContains := d.Get("name_contains").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing R2 buckets for account %s", accountID))
+
+	buckets, err := listR2Buckets(ctx, client, accountID, nameContains)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing R2 buckets for account %q: %w", accountID, err))
+	}
+
+	results := make([]map[string]interface{}, 0, len(buckets))
+	for _, bucket := range buckets {
+		results = append(results, map[string]interface{}{
+			"name":          bucket.Name,
+			"creation_date": bucket.CreationDate,
+			"location":      bucket.Location,
+			"storage_class": bucket.StorageClass,
+		})
+	}
+
+	if err := d.Set("buckets", results); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting R2 buckets: %w", err))
+	}
+
+	d.SetId(stringChecksum(strings.Join([]string{accountID, nameContains}, "/")))
+
+	return nil
+}
+
+// listR2Buckets returns the buckets of an account, following the cursor until
+// every page has been read.
+func listR2Buckets(ctx context.Context, client *apiClient, accountID, nameContains string) ([]r2Bucket, error) {
+	var buckets []r2Bucket
+	params := url.Values{}
+	params.Set("per_page", "1000")
+	if nameContains != "" {
+		params.Set("name_contains", nameContains)
+	}
+
+	for {
+		uri := fmt.Sprintf("/accounts/%s/r2/buckets?%s", accountID, params.Encode())
+
+		res, resultInfo, err := rawRequestWithResultInfo(ctx, client, http.MethodGet, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		var page struct {
+			Buckets []r2Bucket `json:"buckets"`
+		}
+		if err := json.Unmarshal(res, &page); err != nil {
+			return nil, fmt.Errorf("error unmarshalling R2 buckets: %w", err)
+		}
+		buckets = append(buckets, page.Buckets...)
+
+		if resultInfo.Cursor == "" || len(page.Buckets) == 0 {
+			return buckets, nil
+		}
+		params.Set("cursor", resultInfo.Cursor)
+	}
+}
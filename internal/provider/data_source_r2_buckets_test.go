@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareR2Buckets_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_r2_buckets.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	bucket := os.Getenv("CLOUDFLARE_R2_BUCKET_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckR2Bucket(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2BucketsConfig(rnd, accountID, bucket),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(name, "buckets.*", map[string]string{
+						"name": bucket,
+					}),
+					resource.TestCheckResourceAttrSet(name, "buckets.0.creation_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareR2BucketsConfig(name, accountID, bucket string) string {
+	return fmt.Sprintf(`
+data "cloudflare_r2_buckets" "%[1]s" {
+  account_id    = "%[2]s"
+  name_contains = "%[3]s"
+}
+`, name, accountID, bucket)
+}
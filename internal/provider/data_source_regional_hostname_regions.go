@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type regionalHostnameRegion struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+func dataSourceCloudflareRegionalHostnameRegions() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareRegionalHostnameRegionsSchema(),
+		ReadContext: dataSourceCloudflareRegionalHostnameRegionsRead,
+		Description: "Use this data source to look up the regions available to regional hostnames.",
+	}
+}
+
+func dataSourceCloudflareRegionalHostnameRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading regional hostname regions for account %s", accountID))
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/addressing/regional_hostnames/regions", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing regional hostname regions for account %q: %w", accountID, err))
+	}
+
+	var regions []regionalHostnameRegion
+	if err := json.Unmarshal(res, &regions); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling regional hostname regions: %w", err))
+	}
+
+	result := make([]interface{}, 0, len(regions))
+	for _, region := range regions {
+		result = append(result, map[string]interface{}{
+			"key":   region.Key,
+			"label": region.Label,
+		})
+	}
+
+	if err := d.Set("regions", result); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting regions: %w", err))
+	}
+
+	d.SetId(accountID)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRegionalHostnameRegions_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_regional_hostname_regions.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRegionalHostnameRegionsConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttrSet(name, "regions.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "regions.*", map[string]string{"key": "eu"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRegionalHostnameRegionsConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_regional_hostname_regions" "%[1]s" {
+  account_id = "%[2]s"
+}
+`, name, accountID)
+}
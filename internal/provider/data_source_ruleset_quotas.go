@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// rulesetPhaseQuotas holds the number of rules allowed in the entrypoint
+// ruleset of a phase for each plan. Enterprise contracts may have different
+// limits which can be set with `allowed_overrides`.
+var rulesetPhaseQuotas = map[string]map[string]int{
+	"http_request_firewall_custom":    {"free": 5, "pro": 20, "business": 100, "enterprise": 1000},
+	"http_ratelimit":                  {"free": 1, "pro": 2, "business": 5, "enterprise": 100},
+	"http_request_transform":          {"free": 10, "pro": 25, "business": 50, "enterprise": 300},
+	"http_request_late_transform":     {"free": 10, "pro": 25, "business": 50, "enterprise": 300},
+	"http_response_headers_transform": {"free": 10, "pro": 25, "business": 50, "enterprise": 300},
+	"http_request_origin":             {"free": 10, "pro": 25, "business": 50, "enterprise": 300},
+	"http_request_cache_settings":     {"free": 10, "pro": 25, "business": 50, "enterprise": 300},
+	"http_request_dynamic_redirect":   {"free": 10, "pro": 25, "business": 50, "enterprise": 300},
+	"http_config_settings":            {"free": 10, "pro": 25, "business": 50, "enterprise": 300},
+}
+
+func dataSourceCloudflareRulesetQuotas() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareRulesetQuotasSchema(),
+		ReadContext: dataSourceCloudflareRulesetQuotasRead,
+		Description: "Use this data source to look up how many rules are used and allowed in each ruleset phase, " +
+			"so large configurations can check they fit within the plan quotas before applying.",
+	}
+}
+
+func dataSourceCloudflareRulesetQuotasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	routeRoot, identifier := rulesetRouteRoot(d)
+
+	plan := "enterprise"
+	if routeRoot == cloudflare.ZoneRouteRoot {
+		zone, err := client.ZoneDetails(ctx, identifier)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error finding zone %q: %w", identifier, err))
+		}
+		plan = zone.Plan.LegacyID
+	}
+
+	phases := expandInterfaceToStringList(d.Get("phases").(*schema.Set).List())
+	if len(phases) == 0 {
+		for phase := range rulesetPhaseQuotas {
+			phases = append(phases, phase)
+		}
+	}
+	sort.Strings(phases)
+
+	overrides := d.Get("allowed_overrides").(map[string]interface{})
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading ruleset quotas for %s %s on plan %q", routeRoot, identifier, plan))
+
+	quotas := make([]interface{}, 0, len(phases))
+	for _, phase := range phases {
+		used, err := countRulesetPhaseRules(ctx, client, routeRoot, identifier, phase)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error fetching ruleset phase entrypoint %q: %w", phase, err))
+		}
+
+		allowed := rulesetPhaseQuotas[phase][plan]
+		if override, ok := overrides[phase]; ok {
+			allowed = override.(int)
+		}
+
+		quotas = append(quotas, map[string]interface{}{
+			"phase":     phase,
+			"used":      used,
+			"allowed":   allowed,
+			"remaining": allowed - used,
+		})
+	}
+
+	if err := d.Set("quotas", quotas); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting quotas: %w", err))
+	}
+	d.Set("plan", plan)
+
+	d.SetId(stringListChecksum(append(phases, string(routeRoot), identifier)))
+
+	return nil
+}
+
+// countRulesetPhaseRules returns the number of rules in the entrypoint
+// ruleset of a phase. A phase without an entrypoint has no rules.
+func countRulesetPhaseRules(ctx context.Context, client *apiClient, routeRoot cloudflare.RouteRoot, identifier, phase string) (int, error) {
+	uri := fmt.Sprintf("/%s/%s/rulesets/phases/%s/entrypoint", routeRoot, identifier, phase)
+
+	rs, err := rawRulesetWithConfig(ctx, client, http.MethodGet, uri, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return len(rs.Rules), nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRulesetQuotas_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_ruleset_quotas.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRulesetQuotasConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttrSet(name, "plan"),
+					resource.TestCheckResourceAttr(name, "quotas.#", "2"),
+					resource.TestCheckResourceAttr(name, "quotas.0.phase", "http_ratelimit"),
+					resource.TestCheckResourceAttrSet(name, "quotas.0.used"),
+					resource.TestCheckResourceAttr(name, "quotas.1.phase", "http_request_firewall_custom"),
+					resource.TestCheckResourceAttr(name, "quotas.1.allowed", "42"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRulesetQuotasConfig(name, zoneID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_ruleset_quotas" "%[1]s" {
+  zone_id = "%[2]s"
+  phases  = ["http_request_firewall_custom", "http_ratelimit"]
+
+  allowed_overrides = {
+    http_request_firewall_custom = 42
+  }
+}
+`, name, zoneID)
+}
@@ -88,7 +88,7 @@ func dataSourceCloudflareWAFGroups() *schema.Resource {
 }
 
 func dataSourceCloudflareWAFGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	// Prepare the filters to be applied to the search
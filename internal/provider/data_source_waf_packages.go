@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"regexp"
 
-	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -88,7 +87,7 @@ func dataSourceCloudflareWAFPackages() *schema.Resource {
 }
 
 func dataSourceCloudflareWAFPackagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	// Prepare the filters to be applied to the search
@@ -66,7 +66,7 @@ func dataSourceCloudflareZone() *schema.Resource {
 
 func dataSourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Reading Zones"))
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	name := d.Get("name").(string)
 	accountID := d.Get("account_id").(string)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -60,18 +59,26 @@ func dataSourceCloudflareZoneDNSSEC() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"dnssec_multi_signer": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"dnssec_presigned": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func dataSourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	zoneID := d.Get("zone_id").(string)
 
 	tflog.Debug(ctx, fmt.Sprintf("Reading Zone DNSSEC %s", zoneID))
 
-	dnssec, err := client.ZoneDNSSECSetting(ctx, zoneID)
+	dnssec, err := fetchZoneDNSSEC(ctx, client, zoneID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error finding Zone DNSSEC %q: %w", zoneID, err))
 	}
@@ -87,6 +94,8 @@ func dataSourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceD
 	d.Set("ds", dnssec.DS)
 	d.Set("key_tag", dnssec.KeyTag)
 	d.Set("public_key", dnssec.PublicKey)
+	d.Set("dnssec_multi_signer", dnssec.MultiSigner)
+	d.Set("dnssec_presigned", dnssec.Presigned)
 
 	d.SetId(stringChecksum(dnssec.ModifiedOn.String()))
 
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// zoneHold represents the hold status of a zone which prevents the zone (and
+// optionally its subdomains) from being added to another account.
+type zoneHold struct {
+	Hold              bool   `json:"hold"`
+	IncludeSubdomains bool   `json:"include_subdomains"`
+	HoldAfter         string `json:"hold_after,omitempty"`
+}
+
+func dataSourceCloudflareZoneHolds() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareZoneHoldsSchema(),
+		ReadContext: dataSourceCloudflareZoneHoldsRead,
+		Description: "Use this data source to look up the zone hold status of every zone in an account.",
+	}
+}
+
+func dataSourceCloudflareZoneHoldsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Zone Holds for account %s", accountID))
+
+	zones, err := client.ListZonesContext(ctx, cloudflare.WithZoneFilters("", accountID, ""))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing zones in account %q: %w", accountID, err))
+	}
+
+	onlyHeld := d.Get("held").(bool)
+	zoneIDs := make([]string, 0)
+	zoneHolds := make([]interface{}, 0)
+
+	for _, zone := range zones.Result {
+		hold, err := fetchZoneHold(ctx, client, zone.ID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error fetching zone hold for zone %q: %w", zone.Name, err))
+		}
+
+		if onlyHeld && !hold.Hold {
+			continue
+		}
+
+		zoneHolds = append(zoneHolds, map[string]interface{}{
+			"zone_id":            zone.ID,
+			"zone_name":          zone.Name,
+			"hold":               hold.Hold,
+			"include_subdomains": hold.IncludeSubdomains,
+			"hold_after":         hold.HoldAfter,
+		})
+		zoneIDs = append(zoneIDs, zone.ID)
+	}
+
+	if err := d.Set("zones", zoneHolds); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting zone holds: %w", err))
+	}
+
+	d.SetId(stringListChecksum(append(zoneIDs, accountID)))
+
+	return nil
+}
+
+func fetchZoneHold(ctx context.Context, client *apiClient, zoneID string) (zoneHold, error) {
+	var hold zoneHold
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/zones/%s/hold", zoneID), nil)
+	if err != nil {
+		return hold, err
+	}
+
+	if err := json.Unmarshal(res, &hold); err != nil {
+		return hold, fmt.Errorf("error unmarshalling zone hold: %w", err)
+	}
+
+	return hold, nil
+}
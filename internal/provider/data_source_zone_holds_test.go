@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareZoneHolds_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_zone_holds.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneHoldsConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "held", "false"),
+					resource.TestCheckResourceAttrSet(name, "zones.#"),
+					resource.TestCheckResourceAttrSet(name, "zones.0.zone_id"),
+					resource.TestCheckResourceAttrSet(name, "zones.0.zone_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneHoldsConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_zone_holds" "%[1]s" {
+  account_id = "%[2]s"
+}
+`, name, accountID)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -67,6 +68,31 @@ func dataSourceCloudflareZones() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"account_id": {
+							Description: "The account identifier the zone belongs to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Status of the zone.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"paused": {
+							Description: "Whether the zone is paused on Cloudflare.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"plan": {
+							Description: "The name of the rate plan applied to the zone.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_on": {
+							Description: "RFC3339 timestamp of when the zone was created.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -76,7 +102,7 @@ func dataSourceCloudflareZones() *schema.Resource {
 
 func dataSourceCloudflareZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Reading Zones"))
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	filter, err := expandFilter(d.Get("filter"))
 	if err != nil {
 		return diag.FromErr(err)
@@ -93,7 +119,7 @@ func dataSourceCloudflareZonesRead(ctx context.Context, d *schema.ResourceData,
 		filter.status,
 	)
 
-	zones, err := client.ListZonesContext(ctx, zoneFilter)
+	zones, err := client.ListZonesContext(withRequestTimeout(ctx, slowRequestTimeout), zoneFilter)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error listing Zone: %w", err))
 	}
@@ -112,8 +138,13 @@ func dataSourceCloudflareZonesRead(ctx context.Context, d *schema.ResourceData,
 		}
 
 		zoneDetails = append(zoneDetails, map[string]interface{}{
-			"id":   v.ID,
-			"name": v.Name,
+			"id":         v.ID,
+			"name":       v.Name,
+			"account_id": v.Account.ID,
+			"status":     v.Status,
+			"paused":     v.Paused,
+			"plan":       v.Plan.Name,
+			"created_on": v.CreatedOn.Format(time.RFC3339),
 		})
 		zoneIds = append(zoneIds, v.ID)
 	}
@@ -78,6 +78,12 @@ func TestAccCloudflareZonesMatchName(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "filter.0.name", "baa-com.cfapi.net"),
 					resource.TestCheckResourceAttr(name, "filter.0.paused", "false"),
 					resource.TestCheckResourceAttr(name, "zones.#", "1"),
+					resource.TestCheckResourceAttr(name, "zones.0.name", "baa-com.cfapi.net"),
+					resource.TestCheckResourceAttr(name, "zones.0.paused", "false"),
+					resource.TestCheckResourceAttrSet(name, "zones.0.account_id"),
+					resource.TestCheckResourceAttrSet(name, "zones.0.status"),
+					resource.TestCheckResourceAttrSet(name, "zones.0.plan"),
+					resource.TestCheckResourceAttrSet(name, "zones.0.created_on"),
 				),
 			},
 		},
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// cloudflareAPIError is implemented by the typed errors returned by
+// cloudflare-go and the raw request helpers for unsuccessful API responses.
+type cloudflareAPIError interface {
+	error
+	ErrorCodes() []int
+	RayID() string
+}
+
+// cloudflareErrorHints maps common Cloudflare API error codes to guidance on
+// how to resolve them.
+var cloudflareErrorHints = map[int]string{
+	1004:  "The DNS record failed validation. Check that the record content is valid for its type and that the name is within the zone.",
+	6003:  "The request headers are invalid. Check that the API token, or the API key and email, are set and correctly formatted.",
+	7003:  "The zone or account identifier does not exist or is not accessible. Check `zone_id` and `account_id`.",
+	9103:  "The API key or email is unknown. Check `api_key` and `email`, or use an API token instead.",
+	9109:  "The credentials are not authorized for this resource. Check that the API token has the permissions required by the resource.",
+	10000: "Authentication failed. Check that the API token is valid and has the permissions required by the resource.",
+	81053: "A record with the same name already exists and conflicts with this one. Import the existing record or remove it first.",
+	81057: "An identical record already exists. Import it with `terraform import`, or set `allow_overwrite` on `cloudflare_record` to take it over.",
+}
+
+// cloudflareErrorDiagnostics converts err into an error diagnostic. When err
+// wraps a Cloudflare API error, the detail includes the error codes, the Ray
+// ID and hints for known codes, and the diagnostic is attributed to the path
+// registered for the first matching code in paths.
+func cloudflareErrorDiagnostics(summary string, err error, paths map[int]cty.Path) diag.Diagnostics {
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   err.Error(),
+	}
+
+	var apiErr cloudflareAPIError
+	if !errors.As(err, &apiErr) {
+		return diag.Diagnostics{diagnostic}
+	}
+
+	codes := cloudflareErrorCodes(apiErr)
+
+	var details []string
+	for _, code := range codes {
+		if hint, ok := cloudflareErrorHints[code]; ok {
+			details = append(details, hint)
+		}
+		if path, ok := paths[code]; ok && diagnostic.AttributePath == nil {
+			diagnostic.AttributePath = path
+		}
+	}
+
+	if len(codes) > 0 {
+		var s []string
+		for _, code := range codes {
+			s = append(s, fmt.Sprint(code))
+		}
+		details = append(details, fmt.Sprintf("Cloudflare error codes: %s", strings.Join(s, ", ")))
+	}
+
+	if rayID := apiErr.RayID(); rayID != "" {
+		details = append(details, fmt.Sprintf("Ray ID: %s", rayID))
+	}
+
+	if len(details) > 0 {
+		diagnostic.Detail = fmt.Sprintf("%s\n\n%s", diagnostic.Detail, strings.Join(details, "\n"))
+	}
+
+	return diag.Diagnostics{diagnostic}
+}
+
+// cloudflareErrorCodes returns the sorted, unique error codes of err.
+func cloudflareErrorCodes(err cloudflareAPIError) []int {
+	seen := make(map[int]bool)
+	var codes []int
+	for _, code := range err.ErrorCodes() {
+		if code == 0 || seen[code] {
+			continue
+		}
+		seen[code] = true
+		codes = append(codes, code)
+	}
+
+	sort.Ints(codes)
+
+	return codes
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCloudflareAPIError struct {
+	codes []int
+	rayID string
+}
+
+func (e testCloudflareAPIError) Error() string     { return "request failed" }
+func (e testCloudflareAPIError) ErrorCodes() []int { return e.codes }
+func (e testCloudflareAPIError) RayID() string     { return e.rayID }
+
+func TestCloudflareErrorDiagnostics(t *testing.T) {
+	paths := map[int]cty.Path{
+		81057: cty.GetAttrPath("name"),
+		1004:  cty.GetAttrPath("value"),
+	}
+
+	testCases := map[string]struct {
+		err      error
+		expected diag.Diagnostic
+	}{
+		"plain error": {
+			err: errors.New("boom"),
+			expected: diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "error creating DNS record",
+				Detail:   "boom",
+			},
+		},
+		"wrapped API error with hint and path": {
+			err: fmt.Errorf("failed to create DNS record: %w", testCloudflareAPIError{codes: []int{81057, 81057}, rayID: "6d3ba9a1ec6a2c8e"}),
+			expected: diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "error creating DNS record",
+				Detail:        "failed to create DNS record: request failed\n\n" + cloudflareErrorHints[81057] + "\nCloudflare error codes: 81057\nRay ID: 6d3ba9a1ec6a2c8e",
+				AttributePath: cty.GetAttrPath("name"),
+			},
+		},
+		"API error without known codes": {
+			err: testCloudflareAPIError{codes: []int{99999}},
+			expected: diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "error creating DNS record",
+				Detail:   "request failed\n\nCloudflare error codes: 99999",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, diag.Diagnostics{tc.expected}, cloudflareErrorDiagnostics("error creating DNS record", tc.err, paths))
+		})
+	}
+}
+
+func TestRawRequestErrorDiagnostics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("cf-ray", "6d3ba9a1ec6a2c8e")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"success":false,"errors":[{"code":7003,"message":"Could not route to /zones/abc"}],"messages":[],"result":null}`)
+	}))
+	defer server.Close()
+
+	api, err := cloudflare.New("key", "email@example.com", cloudflare.BaseURL(server.URL))
+	assert.NoError(t, err)
+	client := &apiClient{API: api, httpClient: server.Client()}
+
+	_, _, err = rawRequestWithResultInfo(context.Background(), client, http.MethodGet, "/zones/abc")
+	assert.True(t, isNotFoundError(err))
+	assert.Equal(t, diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "error reading zone",
+		Detail:   "HTTP status 404: Could not route to /zones/abc (7003)\n\n" + cloudflareErrorHints[7003] + "\nCloudflare error codes: 7003\nRay ID: 6d3ba9a1ec6a2c8e",
+	}}, cloudflareErrorDiagnostics("error reading zone", err, nil))
+}
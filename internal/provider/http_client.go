@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"golang.org/x/time/rate"
+)
+
+// apiClient is the provider meta. It embeds the cloudflare-go client and
+// carries the HTTP client it is configured with, so that the raw requests
+// cloudflare-go can't make share its retries, rate limit and timeout.
+type apiClient struct {
+	*cloudflare.API
+	httpClient *http.Client
+}
+
+// apiHTTPClientConfig holds the provider settings applied to every API
+// request by the HTTP client.
+type apiHTTPClientConfig struct {
+	rps            int
+	retries        int
+	minBackoff     time.Duration
+	maxBackoff     time.Duration
+	requestTimeout time.Duration
+}
+
+// newAPIHTTPClient returns the HTTP client used for every API request. The
+// retries and rate limit are applied here rather than by cloudflare-go so
+// that they also cover the raw requests.
+func newAPIHTTPClient(config apiHTTPClientConfig) *http.Client {
+	c := cleanhttp.DefaultClient()
+	c.Transport = &retryTransport{
+		maxRetries: config.retries,
+		minBackoff: config.minBackoff,
+		maxBackoff: config.maxBackoff,
+		transport: &rateLimitTransport{
+			limiter: rate.NewLimiter(rate.Limit(config.rps), 1),
+			transport: &requestTimeoutTransport{
+				timeout:   config.requestTimeout,
+				transport: logging.NewTransport("Cloudflare", c.Transport),
+			},
+		},
+	}
+
+	return c
+}
+
+// rateLimitTransport delays requests to stay within the provider rps.
+type rateLimitTransport struct {
+	limiter   *rate.Limiter
+	transport http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+// retryTransport retries requests which fail, are rate limited or return a
+// server error, backing off exponentially between attempts the same way
+// cloudflare-go does.
+type retryTransport struct {
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	transport  http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for i := 0; ; i++ {
+		attempt := req
+		if i > 0 {
+			backoff := time.Duration(math.Pow(2, float64(i-1)) * float64(t.minBackoff))
+			if backoff > t.maxBackoff {
+				backoff = t.maxBackoff
+			}
+
+			tflog.Debug(ctx, "Sleeping before retrying request", map[string]interface{}{
+				"backoff": backoff.String(),
+				"attempt": i,
+				"method":  req.Method,
+				"url":     req.URL.String(),
+			})
+
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+
+			attempt = req.Clone(ctx)
+			if req.Body != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				attempt.Body = body
+			}
+		}
+
+		res, err := t.transport.RoundTrip(attempt)
+
+		retryable := err != nil && ctx.Err() == nil ||
+			err == nil && (res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError)
+		if !retryable || i >= t.maxRetries || req.Body != nil && req.GetBody == nil {
+			return res, err
+		}
+
+		if err == nil {
+			io.Copy(ioutil.Discard, res.Body) //nolint:errcheck
+			res.Body.Close()
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIHTTPClientRetries(t *testing.T) {
+	testCases := []struct {
+		name     string
+		failures int32
+		status   int
+		requests int32
+	}{
+		{"success", 0, http.StatusOK, 1},
+		{"recovers from server errors", 2, http.StatusOK, 3},
+		{"gives up after the retries", 5, http.StatusServiceUnavailable, 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) <= tc.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{}}`)) //nolint:errcheck
+			}))
+			defer server.Close()
+
+			client := newAPIHTTPClient(apiHTTPClientConfig{rps: 100, retries: 2, minBackoff: time.Millisecond, maxBackoff: time.Millisecond})
+
+			req, err := http.NewRequest(http.MethodPost, server.URL, nil)
+			assert.NoError(t, err)
+
+			res, err := client.Do(req)
+			assert.NoError(t, err)
+			res.Body.Close()
+
+			assert.Equal(t, tc.status, res.StatusCode)
+			assert.Equal(t, tc.requests, atomic.LoadInt32(&requests))
+		})
+	}
+}
+
+func TestRawRequestCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	api, err := cloudflare.New("key", "email@example.com", cloudflare.BaseURL(server.URL))
+	assert.NoError(t, err)
+	client := &apiClient{
+		API:        api,
+		httpClient: newAPIHTTPClient(apiHTTPClientConfig{rps: 100, retries: 2, minBackoff: time.Second, maxBackoff: time.Second}),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = rawRequest(ctx, client, http.MethodGet, "/zones", nil)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "expected a deadline exceeded error, got %v", err)
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -8,15 +8,15 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
-	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
+	"golang.org/x/time/rate"
 )
 
 func init() {
@@ -112,6 +112,13 @@ func New(version string) func() *schema.Provider {
 					Description: "Maximum backoff period in seconds after failed API calls. Alternatively, can be configured using the `CLOUDFLARE_MAX_BACKOFF` environment variable.",
 				},
 
+				"request_timeout": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_REQUEST_TIMEOUT", 0),
+					Description: "Timeout in seconds of each API request, after which it is cancelled and retried. Endpoints known to be slow, such as script uploads, are given at least 5 minutes. `0` disables the timeout. Alternatively, can be configured using the `CLOUDFLARE_REQUEST_TIMEOUT` environment variable.",
+				},
+
 				"api_client_logging": {
 					Type:        schema.TypeBool,
 					Optional:    true,
@@ -143,95 +150,179 @@ func New(version string) func() *schema.Provider {
 			},
 
 			DataSourcesMap: map[string]*schema.Resource{
+				"cloudflare_access_groups":               dataSourceCloudflareAccessGroups(),
 				"cloudflare_access_identity_provider":    dataSourceCloudflareAccessIdentityProvider(),
+				"cloudflare_account_audit_token":         dataSourceCloudflareAccountAuditToken(),
 				"cloudflare_account_roles":               dataSourceCloudflareAccountRoles(),
+				"cloudflare_ai_gateway":                  dataSourceCloudflareAIGateway(),
 				"cloudflare_api_token_permission_groups": dataSourceCloudflareApiTokenPermissionGroups(),
+				"cloudflare_bot_management_signals":      dataSourceCloudflareBotManagementSignals(),
+				"cloudflare_colos":                       dataSourceCloudflareColos(),
+				"cloudflare_custom_hostnames":            dataSourceCloudflareCustomHostnames(),
+				"cloudflare_device_posture_integrations": dataSourceCloudflareDevicePostureIntegrations(),
+				"cloudflare_device_posture_rules":        dataSourceCloudflareDevicePostureRules(),
 				"cloudflare_devices":                     dataSourceCloudflareDevices(),
+				"cloudflare_dex_tests":                   dataSourceCloudflareDEXTests(),
+				"cloudflare_dns_firewall_analytics":      dataSourceCloudflareDNSFirewallAnalytics(),
+				"cloudflare_dns_records":                 dataSourceCloudflareDNSRecords(),
+				"cloudflare_firewall_events":             dataSourceCloudflareFirewallEvents(),
+				"cloudflare_images_delivery_url":         dataSourceCloudflareImagesDeliveryURL(),
+				"cloudflare_ip_access_rules":             dataSourceCloudflareIPAccessRules(),
 				"cloudflare_ip_ranges":                   dataSourceCloudflareIPRanges(),
+				"cloudflare_notification_alert_types":    dataSourceCloudflareNotificationAlertTypes(),
 				"cloudflare_origin_ca_root_certificate":  dataSourceCloudflareOriginCARootCertificate(),
+				"cloudflare_r2_bucket_metrics":           dataSourceCloudflareR2BucketMetrics(),
+				"cloudflare_r2_buckets":                  dataSourceCloudflareR2Buckets(),
+				"cloudflare_regional_hostname_regions":   dataSourceCloudflareRegionalHostnameRegions(),
+				"cloudflare_ruleset_quotas":              dataSourceCloudflareRulesetQuotas(),
 				"cloudflare_waf_groups":                  dataSourceCloudflareWAFGroups(),
 				"cloudflare_waf_packages":                dataSourceCloudflareWAFPackages(),
 				"cloudflare_waf_rules":                   dataSourceCloudflareWAFRules(),
 				"cloudflare_zone_dnssec":                 dataSourceCloudflareZoneDNSSEC(),
+				"cloudflare_zone_holds":                  dataSourceCloudflareZoneHolds(),
 				"cloudflare_zone":                        dataSourceCloudflareZone(),
 				"cloudflare_zones":                       dataSourceCloudflareZones(),
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
-				"cloudflare_access_application":                     resourceCloudflareAccessApplication(),
-				"cloudflare_access_ca_certificate":                  resourceCloudflareAccessCACertificate(),
-				"cloudflare_access_group":                           resourceCloudflareAccessGroup(),
-				"cloudflare_access_identity_provider":               resourceCloudflareAccessIdentityProvider(),
-				"cloudflare_access_keys_configuration":              resourceCloudflareAccessKeysConfiguration(),
-				"cloudflare_access_mutual_tls_certificate":          resourceCloudflareAccessMutualTLSCertificate(),
-				"cloudflare_access_policy":                          resourceCloudflareAccessPolicy(),
-				"cloudflare_access_rule":                            resourceCloudflareAccessRule(),
-				"cloudflare_access_service_token":                   resourceCloudflareAccessServiceToken(),
-				"cloudflare_access_bookmark":                        resourceCloudflareAccessBookmark(),
-				"cloudflare_account_member":                         resourceCloudflareAccountMember(),
-				"cloudflare_api_token":                              resourceCloudflareApiToken(),
-				"cloudflare_argo_tunnel":                            resourceCloudflareArgoTunnel(),
-				"cloudflare_argo":                                   resourceCloudflareArgo(),
-				"cloudflare_authenticated_origin_pulls_certificate": resourceCloudflareAuthenticatedOriginPullsCertificate(),
-				"cloudflare_authenticated_origin_pulls":             resourceCloudflareAuthenticatedOriginPulls(),
-				"cloudflare_byo_ip_prefix":                          resourceCloudflareBYOIPPrefix(),
-				"cloudflare_certificate_pack":                       resourceCloudflareCertificatePack(),
-				"cloudflare_custom_hostname_fallback_origin":        resourceCloudflareCustomHostnameFallbackOrigin(),
-				"cloudflare_custom_hostname":                        resourceCloudflareCustomHostname(),
-				"cloudflare_custom_pages":                           resourceCloudflareCustomPages(),
-				"cloudflare_custom_ssl":                             resourceCloudflareCustomSsl(),
-				"cloudflare_device_posture_rule":                    resourceCloudflareDevicePostureRule(),
-				"cloudflare_device_policy_certificates":             resourceCloudflareDevicePolicyCertificates(),
-				"cloudflare_device_posture_integration":             resourceCloudflareDevicePostureIntegration(),
-				"cloudflare_fallback_domain":                        resourceCloudflareFallbackDomain(),
-				"cloudflare_filter":                                 resourceCloudflareFilter(),
-				"cloudflare_firewall_rule":                          resourceCloudflareFirewallRule(),
-				"cloudflare_gre_tunnel":                             resourceCloudflareGRETunnel(),
-				"cloudflare_healthcheck":                            resourceCloudflareHealthcheck(),
-				"cloudflare_ip_list":                                resourceCloudflareIPList(),
-				"cloudflare_ipsec_tunnel":                           resourceCloudflareIPsecTunnel(),
-				"cloudflare_list":                                   resourceCloudflareList(),
-				"cloudflare_load_balancer_monitor":                  resourceCloudflareLoadBalancerMonitor(),
-				"cloudflare_load_balancer_pool":                     resourceCloudflareLoadBalancerPool(),
-				"cloudflare_load_balancer":                          resourceCloudflareLoadBalancer(),
-				"cloudflare_logpull_retention":                      resourceCloudflareLogpullRetention(),
-				"cloudflare_logpush_job":                            resourceCloudflareLogpushJob(),
-				"cloudflare_logpush_ownership_challenge":            resourceCloudflareLogpushOwnershipChallenge(),
-				"cloudflare_magic_firewall_ruleset":                 resourceCloudflareMagicFirewallRuleset(),
-				"cloudflare_managed_headers":                        resourceCloudflareManagedHeaders(),
-				"cloudflare_notification_policy_webhooks":           resourceCloudflareNotificationPolicyWebhooks(),
-				"cloudflare_notification_policy":                    resourceCloudflareNotificationPolicy(),
-				"cloudflare_origin_ca_certificate":                  resourceCloudflareOriginCACertificate(),
-				"cloudflare_page_rule":                              resourceCloudflarePageRule(),
-				"cloudflare_rate_limit":                             resourceCloudflareRateLimit(),
-				"cloudflare_record":                                 resourceCloudflareRecord(),
-				"cloudflare_ruleset":                                resourceCloudflareRuleset(),
-				"cloudflare_spectrum_application":                   resourceCloudflareSpectrumApplication(),
-				"cloudflare_split_tunnel":                           resourceCloudflareSplitTunnel(),
-				"cloudflare_static_route":                           resourceCloudflareStaticRoute(),
-				"cloudflare_teams_account":                          resourceCloudflareTeamsAccount(),
-				"cloudflare_teams_list":                             resourceCloudflareTeamsList(),
-				"cloudflare_teams_location":                         resourceCloudflareTeamsLocation(),
-				"cloudflare_teams_rule":                             resourceCloudflareTeamsRule(),
-				"cloudflare_teams_proxy_endpoint":                   resourceCloudflareTeamsProxyEndpoint(),
-				"cloudflare_tunnel_route":                           resourceCloudflareTunnelRoute(),
-				"cloudflare_tunnel_virtual_network":                 resourceCloudflareTunnelVirtualNetwork(),
-				"cloudflare_waf_group":                              resourceCloudflareWAFGroup(),
-				"cloudflare_waf_override":                           resourceCloudflareWAFOverride(),
-				"cloudflare_waf_package":                            resourceCloudflareWAFPackage(),
-				"cloudflare_waf_rule":                               resourceCloudflareWAFRule(),
-				"cloudflare_waiting_room":                           resourceCloudflareWaitingRoom(),
-				"cloudflare_waiting_room_event":                     resourceCloudflareWaitingRoomEvent(),
-				"cloudflare_worker_cron_trigger":                    resourceCloudflareWorkerCronTrigger(),
-				"cloudflare_worker_route":                           resourceCloudflareWorkerRoute(),
-				"cloudflare_worker_script":                          resourceCloudflareWorkerScript(),
-				"cloudflare_workers_kv_namespace":                   resourceCloudflareWorkersKVNamespace(),
-				"cloudflare_workers_kv":                             resourceCloudflareWorkerKV(),
-				"cloudflare_zone_cache_variants":                    resourceCloudflareZoneCacheVariants(),
-				"cloudflare_zone_dnssec":                            resourceCloudflareZoneDNSSEC(),
-				"cloudflare_zone_lockdown":                          resourceCloudflareZoneLockdown(),
-				"cloudflare_zone_settings_override":                 resourceCloudflareZoneSettingsOverride(),
-				"cloudflare_zone":                                   resourceCloudflareZone(),
+				"cloudflare_access_application":                              resourceCloudflareAccessApplication(),
+				"cloudflare_access_ca_certificate":                           resourceCloudflareAccessCACertificate(),
+				"cloudflare_access_group":                                    resourceCloudflareAccessGroup(),
+				"cloudflare_access_identity_provider":                        resourceCloudflareAccessIdentityProvider(),
+				"cloudflare_access_keys_configuration":                       resourceCloudflareAccessKeysConfiguration(),
+				"cloudflare_access_mutual_tls_certificate":                   resourceCloudflareAccessMutualTLSCertificate(),
+				"cloudflare_access_policy":                                   resourceCloudflareAccessPolicy(),
+				"cloudflare_access_rule":                                     resourceCloudflareAccessRule(),
+				"cloudflare_access_service_token":                            resourceCloudflareAccessServiceToken(),
+				"cloudflare_access_bookmark":                                 resourceCloudflareAccessBookmark(),
+				"cloudflare_account_member":                                  resourceCloudflareAccountMember(),
+				"cloudflare_ai_gateway":                                      resourceCloudflareAIGateway(),
+				"cloudflare_api_shield_operation":                            resourceCloudflareAPIShieldOperation(),
+				"cloudflare_api_shield_operation_schema_validation_settings": resourceCloudflareAPIShieldOperationSchemaValidationSettings(),
+				"cloudflare_api_shield_operations":                           resourceCloudflareAPIShieldOperations(),
+				"cloudflare_api_shield_schema":                               resourceCloudflareAPIShieldSchema(),
+				"cloudflare_api_shield_token_validation_config":              resourceCloudflareAPIShieldTokenValidationConfig(),
+				"cloudflare_api_token":                                       resourceCloudflareApiToken(),
+				"cloudflare_argo_tunnel":                                     resourceCloudflareArgoTunnel(),
+				"cloudflare_argo":                                            resourceCloudflareArgo(),
+				"cloudflare_authenticated_origin_pulls_certificate":          resourceCloudflareAuthenticatedOriginPullsCertificate(),
+				"cloudflare_authenticated_origin_pulls":                      resourceCloudflareAuthenticatedOriginPulls(),
+				"cloudflare_byo_ip_prefix":                                   resourceCloudflareBYOIPPrefix(),
+				"cloudflare_certificate_pack":                                resourceCloudflareCertificatePack(),
+				"cloudflare_custom_hostname_batch":                           resourceCloudflareCustomHostnameBatch(),
+				"cloudflare_custom_hostname_fallback_origin":                 resourceCloudflareCustomHostnameFallbackOrigin(),
+				"cloudflare_custom_hostname":                                 resourceCloudflareCustomHostname(),
+				"cloudflare_custom_pages":                                    resourceCloudflareCustomPages(),
+				"cloudflare_custom_ssl":                                      resourceCloudflareCustomSsl(),
+				"cloudflare_d1_database":                                     resourceCloudflareD1Database(),
+				"cloudflare_device_posture_rule":                             resourceCloudflareDevicePostureRule(),
+				"cloudflare_device_policy_certificates":                      resourceCloudflareDevicePolicyCertificates(),
+				"cloudflare_device_posture_integration":                      resourceCloudflareDevicePostureIntegration(),
+				"cloudflare_dns_records":                                     resourceCloudflareDNSRecords(),
+				"cloudflare_email_routing_address":                           resourceCloudflareEmailRoutingAddress(),
+				"cloudflare_email_routing_catch_all":                         resourceCloudflareEmailRoutingCatchAll(),
+				"cloudflare_email_routing_rule":                              resourceCloudflareEmailRoutingRule(),
+				"cloudflare_email_routing_settings":                          resourceCloudflareEmailRoutingSettings(),
+				"cloudflare_email_security_allow_policy":                     resourceCloudflareEmailSecurityAllowPolicy(),
+				"cloudflare_email_security_block_sender":                     resourceCloudflareEmailSecurityBlockSender(),
+				"cloudflare_email_security_impersonation_registry":           resourceCloudflareEmailSecurityImpersonationRegistry(),
+				"cloudflare_email_security_trusted_domain":                   resourceCloudflareEmailSecurityTrustedDomain(),
+				"cloudflare_fallback_domain":                                 resourceCloudflareFallbackDomain(),
+				"cloudflare_filter":                                          resourceCloudflareFilter(),
+				"cloudflare_firewall_rule":                                   resourceCloudflareFirewallRule(),
+				"cloudflare_gre_tunnel":                                      resourceCloudflareGRETunnel(),
+				"cloudflare_healthcheck":                                     resourceCloudflareHealthcheck(),
+				"cloudflare_hostname_tls_setting":                            resourceCloudflareHostnameTLSSetting(),
+				"cloudflare_images_batch_token":                              resourceCloudflareImagesBatchToken(),
+				"cloudflare_images_signing_key":                              resourceCloudflareImagesSigningKey(),
+				"cloudflare_images_variant":                                  resourceCloudflareImagesVariant(),
+				"cloudflare_ip_list":                                         resourceCloudflareIPList(),
+				"cloudflare_ipsec_tunnel":                                    resourceCloudflareIPsecTunnel(),
+				"cloudflare_keyless_certificate":                             resourceCloudflareKeylessCertificate(),
+				"cloudflare_leaked_credential_check":                         resourceCloudflareLeakedCredentialCheck(),
+				"cloudflare_leaked_credential_check_rule":                    resourceCloudflareLeakedCredentialCheckRule(),
+				"cloudflare_list":                                            resourceCloudflareList(),
+				"cloudflare_list_item":                                       resourceCloudflareListItem(),
+				"cloudflare_load_balancer_monitor":                           resourceCloudflareLoadBalancerMonitor(),
+				"cloudflare_load_balancer_pool":                              resourceCloudflareLoadBalancerPool(),
+				"cloudflare_load_balancer":                                   resourceCloudflareLoadBalancer(),
+				"cloudflare_logpull_retention":                               resourceCloudflareLogpullRetention(),
+				"cloudflare_logpush_job":                                     resourceCloudflareLogpushJob(),
+				"cloudflare_logpush_ownership_challenge":                     resourceCloudflareLogpushOwnershipChallenge(),
+				"cloudflare_magic_firewall_ruleset":                          resourceCloudflareMagicFirewallRuleset(),
+				"cloudflare_magic_network_monitoring_rule":                   resourceCloudflareMagicNetworkMonitoringRule(),
+				"cloudflare_magic_transit_connector":                         resourceCloudflareMagicTransitConnector(),
+				"cloudflare_managed_headers":                                 resourceCloudflareManagedHeaders(),
+				"cloudflare_mtls_certificate":                                resourceCloudflareMTLSCertificate(),
+				"cloudflare_mtls_certificate_association":                    resourceCloudflareMTLSCertificateAssociation(),
+				"cloudflare_notification_policy_webhooks":                    resourceCloudflareNotificationPolicyWebhooks(),
+				"cloudflare_notification_policy":                             resourceCloudflareNotificationPolicy(),
+				"cloudflare_observatory_schedule":                            resourceCloudflareObservatorySchedule(),
+				"cloudflare_origin_ca_certificate":                           resourceCloudflareOriginCACertificate(),
+				"cloudflare_page_rule":                                       resourceCloudflarePageRule(),
+				"cloudflare_page_shield_policy":                              resourceCloudflarePageShieldPolicy(),
+				"cloudflare_page_shield_settings":                            resourceCloudflarePageShieldSettings(),
+				"cloudflare_pages_domain":                                    resourceCloudflarePagesDomain(),
+				"cloudflare_pages_project":                                   resourceCloudflarePagesProject(),
+				"cloudflare_queue":                                           resourceCloudflareQueue(),
+				"cloudflare_queue_consumer":                                  resourceCloudflareQueueConsumer(),
+				"cloudflare_r2_bucket_cors":                                  resourceCloudflareR2BucketCORS(),
+				"cloudflare_r2_bucket_event_notification":                    resourceCloudflareR2BucketEventNotification(),
+				"cloudflare_r2_bucket_lifecycle":                             resourceCloudflareR2BucketLifecycle(),
+				"cloudflare_rate_limit":                                      resourceCloudflareRateLimit(),
+				"cloudflare_record":                                          resourceCloudflareRecord(),
+				"cloudflare_regional_hostname":                               resourceCloudflareRegionalHostname(),
+				"cloudflare_ruleset":                                         resourceCloudflareRuleset(),
+				"cloudflare_secondary_dns_incoming":                          resourceCloudflareSecondaryDNSIncoming(),
+				"cloudflare_secondary_dns_outgoing":                          resourceCloudflareSecondaryDNSOutgoing(),
+				"cloudflare_secondary_dns_peer":                              resourceCloudflareSecondaryDNSPeer(),
+				"cloudflare_secondary_dns_tsig":                              resourceCloudflareSecondaryDNSTSIG(),
+				"cloudflare_snippet":                                         resourceCloudflareSnippet(),
+				"cloudflare_snippet_rules":                                   resourceCloudflareSnippetRules(),
+				"cloudflare_spectrum_application":                            resourceCloudflareSpectrumApplication(),
+				"cloudflare_split_tunnel":                                    resourceCloudflareSplitTunnel(),
+				"cloudflare_static_route":                                    resourceCloudflareStaticRoute(),
+				"cloudflare_stream":                                          resourceCloudflareStream(),
+				"cloudflare_stream_audio_track":                              resourceCloudflareStreamAudioTrack(),
+				"cloudflare_stream_caption":                                  resourceCloudflareStreamCaption(),
+				"cloudflare_stream_key":                                      resourceCloudflareStreamKey(),
+				"cloudflare_stream_live_input":                               resourceCloudflareStreamLiveInput(),
+				"cloudflare_stream_webhook":                                  resourceCloudflareStreamWebhook(),
+				"cloudflare_teams_account":                                   resourceCloudflareTeamsAccount(),
+				"cloudflare_teams_device_enrollment":                         resourceCloudflareTeamsDeviceEnrollment(),
+				"cloudflare_teams_list":                                      resourceCloudflareTeamsList(),
+				"cloudflare_teams_location":                                  resourceCloudflareTeamsLocation(),
+				"cloudflare_teams_rule":                                      resourceCloudflareTeamsRule(),
+				"cloudflare_teams_proxy_endpoint":                            resourceCloudflareTeamsProxyEndpoint(),
+				"cloudflare_tiered_cache":                                    resourceCloudflareTieredCache(),
+				"cloudflare_total_tls":                                       resourceCloudflareTotalTLS(),
+				"cloudflare_tunnel_route":                                    resourceCloudflareTunnelRoute(),
+				"cloudflare_tunnel_virtual_network":                          resourceCloudflareTunnelVirtualNetwork(),
+				"cloudflare_turnstile_widget":                                resourceCloudflareTurnstileWidget(),
+				"cloudflare_waf_group":                                       resourceCloudflareWAFGroup(),
+				"cloudflare_waf_override":                                    resourceCloudflareWAFOverride(),
+				"cloudflare_waf_package":                                     resourceCloudflareWAFPackage(),
+				"cloudflare_waf_rule":                                        resourceCloudflareWAFRule(),
+				"cloudflare_waiting_room":                                    resourceCloudflareWaitingRoom(),
+				"cloudflare_waiting_room_event":                              resourceCloudflareWaitingRoomEvent(),
+				"cloudflare_waiting_room_rules":                              resourceCloudflareWaitingRoomRules(),
+				"cloudflare_waiting_room_settings":                           resourceCloudflareWaitingRoomSettings(),
+				"cloudflare_web3_hostname":                                   resourceCloudflareWeb3Hostname(),
+				"cloudflare_worker_cron_trigger":                             resourceCloudflareWorkerCronTrigger(),
+				"cloudflare_worker_route":                                    resourceCloudflareWorkerRoute(),
+				"cloudflare_worker_script":                                   resourceCloudflareWorkerScript(),
+				"cloudflare_workers_kv_bulk":                                 resourceCloudflareWorkersKVBulk(),
+				"cloudflare_workers_kv_namespace":                            resourceCloudflareWorkersKVNamespace(),
+				"cloudflare_workers_kv":                                      resourceCloudflareWorkerKV(),
+				"cloudflare_zaraz_workflow":                                  resourceCloudflareZarazWorkflow(),
+				"cloudflare_zone_cache_reserve":                              resourceCloudflareZoneCacheReserve(),
+				"cloudflare_zone_cache_variants":                             resourceCloudflareZoneCacheVariants(),
+				"cloudflare_zone_dnssec":                                     resourceCloudflareZoneDNSSEC(),
+				"cloudflare_zone_lockdown":                                   resourceCloudflareZoneLockdown(),
+				"cloudflare_zone_security_header":                            resourceCloudflareZoneSecurityHeader(),
+				"cloudflare_zone_security_level_schedule":                    resourceCloudflareZoneSecurityLevelSchedule(),
+				"cloudflare_zone_settings_override":                          resourceCloudflareZoneSettingsOverride(),
+				"cloudflare_zone":                                            resourceCloudflareZone(),
 			},
 		}
 
@@ -249,16 +340,25 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		baseURL := cloudflare.BaseURL(
 			"https://" + d.Get("api_hostname").(string) + d.Get("api_base_path").(string),
 		)
-		limitOpt := cloudflare.UsingRateLimit(float64(d.Get("rps").(int)))
-		retryOpt := cloudflare.UsingRetryPolicy(d.Get("retries").(int), d.Get("min_backoff").(int), d.Get("max_backoff").(int))
+		// Retries and rate limiting are handled by the HTTP client, so that
+		// they also apply to raw requests. cloudflare-go rate limits to 4rps
+		// unless told otherwise, so its limiter is lifted entirely rather
+		// than left out.
+		limitOpt := cloudflare.UsingRateLimit(float64(rate.Inf))
+		retryOpt := cloudflare.UsingRetryPolicy(0, d.Get("min_backoff").(int), d.Get("max_backoff").(int))
 		options := []cloudflare.Option{limitOpt, retryOpt, baseURL}
 
 		if d.Get("api_client_logging").(bool) {
 			options = append(options, cloudflare.UsingLogger(log.New(os.Stderr, "", log.LstdFlags)))
 		}
 
-		c := cleanhttp.DefaultClient()
-		c.Transport = logging.NewTransport("Cloudflare", c.Transport)
+		c := newAPIHTTPClient(apiHTTPClientConfig{
+			rps:            d.Get("rps").(int),
+			retries:        d.Get("retries").(int),
+			minBackoff:     time.Duration(d.Get("min_backoff").(int)) * time.Second,
+			maxBackoff:     time.Duration(d.Get("max_backoff").(int)) * time.Second,
+			requestTimeout: time.Duration(d.Get("request_timeout").(int)) * time.Second,
+		})
 		options = append(options, cloudflare.HTTPClient(c))
 
 		ua := fmt.Sprintf("terraform/%s terraform-plugin-sdk/%s terraform-provider-cloudflare/%s", p.TerraformVersion, meta.SDKVersionString(), version)
@@ -301,7 +401,7 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			tflog.Info(ctx, fmt.Sprintf("using specified account id %s in Cloudflare provider", accountID.(string)))
 			options = append(options, cloudflare.UsingAccount(accountID.(string)))
 		} else {
-			return client, diag.FromErr(err)
+			return &apiClient{API: client, httpClient: c}, diag.FromErr(err)
 		}
 
 		config.Options = options
@@ -311,6 +411,6 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			return nil, diag.FromErr(err)
 		}
 
-		return client, nil
+		return &apiClient{API: client, httpClient: c}, nil
 	}
 }
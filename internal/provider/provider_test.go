@@ -166,6 +166,56 @@ func testAccPreCheckBYOIPPrefix(t *testing.T) {
 	}
 }
 
+func testAccPreCheckDNSFirewallCluster(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_DNS_FIREWALL_CLUSTER_ID"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_DNS_FIREWALL_CLUSTER_ID is not set")
+	}
+}
+
+func testAccPreCheckR2Bucket(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_R2_BUCKET_NAME"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_R2_BUCKET_NAME is not set")
+	}
+}
+
+func testAccPreCheckQueue(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_QUEUE_ID"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_QUEUE_ID is not set")
+	}
+}
+
+func testAccPreCheckSecondaryDNSZone(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_SECONDARY_DNS_ZONE_ID"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_SECONDARY_DNS_ZONE_ID is not set")
+	}
+}
+
+func testAccPreCheckMagicTransitConnector(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_MAGIC_TRANSIT_CONNECTOR_ID"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_MAGIC_TRANSIT_CONNECTOR_ID is not set")
+	}
+}
+
+func testAccPreCheckPagesRepository(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_PAGES_OWNER"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_PAGES_OWNER is not set")
+	}
+
+	if v := os.Getenv("CLOUDFLARE_PAGES_REPO"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_PAGES_REPO is not set")
+	}
+}
+
+func testAccPreCheckAccountMemberPolicy(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_PERMISSION_GROUP_ID"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_PERMISSION_GROUP_ID is not set")
+	}
+
+	if v := os.Getenv("CLOUDFLARE_RESOURCE_GROUP_ID"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_RESOURCE_GROUP_ID is not set")
+	}
+}
+
 func generateRandomResourceName() string {
 	return acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 }
@@ -189,3 +239,9 @@ func skipV1WAFTestForNonConfiguredDefaultZone(t *testing.T) {
 		t.Skipf("Skipping acceptance test as %s is using WAF v2 and cannot assert v1 resource configurations", testAccCloudflareZoneID)
 	}
 }
+
+func testAccPreCheckKeylessCertificate(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_KEYLESS_CERTIFICATE"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_KEYLESS_CERTIFICATE is not set")
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// slowRequestTimeout is the minimum request timeout of endpoints known to be
+// slow, such as script uploads and bulk list reads.
+const slowRequestTimeout = 5 * time.Minute
+
+type requestTimeoutContextKey struct{}
+
+// withRequestTimeout raises the request timeout of the provider to at least
+// timeout for the API requests made with ctx. It has no effect when the
+// provider request timeout is disabled.
+func withRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutContextKey{}, timeout)
+}
+
+// requestTimeoutTransport bounds the duration of every request, including
+// reading its response body, to the provider request timeout.
+type requestTimeoutTransport struct {
+	timeout   time.Duration
+	transport http.RoundTripper
+}
+
+func (t *requestTimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	timeout := t.timeout
+	if timeout <= 0 {
+		return t.transport.RoundTrip(req)
+	}
+	if override, ok := req.Context().Value(requestTimeoutContextKey{}).(time.Duration); ok && override > timeout {
+		timeout = override
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+
+	res, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) && req.Context().Err() == nil {
+			return nil, fmt.Errorf("request did not complete within %s, increase the provider request_timeout if the endpoint is expected to be this slow: %w", timeout, err)
+		}
+		return nil, err
+	}
+
+	res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+
+	return res, nil
+}
+
+// cancelOnCloseBody releases the timeout of a request once its response body
+// has been read.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+func TestRequestTimeoutTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+		case <-r.Context().Done():
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		name     string
+		timeout  time.Duration
+		override time.Duration
+		timedOut bool
+	}{
+		{"disabled", 0, 0, false},
+		{"disabled with override", 0, 10 * time.Millisecond, false},
+		{"exceeded", 50 * time.Millisecond, 0, true},
+		{"raised by override", 50 * time.Millisecond, time.Second, false},
+		{"not lowered by override", time.Second, 50 * time.Millisecond, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := cleanhttp.DefaultClient()
+			client.Transport = &requestTimeoutTransport{timeout: tc.timeout, transport: client.Transport}
+
+			ctx := context.Background()
+			if tc.override > 0 {
+				ctx = withRequestTimeout(ctx, tc.override)
+			}
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := client.Do(req)
+			if tc.timedOut {
+				if err == nil || !strings.Contains(err.Error(), "increase the provider request_timeout") {
+					t.Fatalf("expected a request timeout error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+			defer res.Body.Close()
+
+			body, err := ioutil.ReadAll(res.Body)
+			if err != nil || string(body) != "ok" {
+				t.Fatalf("expected body %q, got %q (%v)", "ok", body, err)
+			}
+		})
+	}
+}
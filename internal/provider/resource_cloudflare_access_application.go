@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -30,7 +32,7 @@ authorisation gateway managed by Cloudflare.
 }
 
 func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	allowedIDPList := expandInterfaceToStringList(d.Get("allowed_idps"))
 	appType := d.Get("type").(string)
@@ -71,39 +73,36 @@ func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
-	var accessApplication cloudflare.AccessApplication
-	if identifier.Type == AccountType {
-		accessApplication, err = client.CreateAccessApplication(ctx, identifier.Value, newAccessApplication)
-	} else {
-		accessApplication, err = client.CreateZoneLevelAccessApplication(ctx, identifier.Value, newAccessApplication)
-	}
+	accessApplication, err := createAccessApplicationWithSaasApp(ctx, client, identifier, accessApplicationWithSaasApp{
+		AccessApplication: newAccessApplication,
+		SaasApplication:   buildAccessSaasApplicationFromResource(d),
+	})
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Access Application for %s %q: %w", identifier.Type, identifier.Value, err))
 	}
 
 	d.SetId(accessApplication.ID)
 
+	// The OIDC client secret is only returned when the application is
+	// created so it needs to be persisted here rather than on read.
+	if accessApplication.SaasApplication != nil && accessApplication.SaasApplication.ClientSecret != "" {
+		d.Set("saas_app", flattenAccessSaasApplication(accessApplication.SaasApplication, accessApplication.SaasApplication.ClientSecret))
+	}
+
 	return resourceCloudflareAccessApplicationRead(ctx, d, meta)
 }
 
 func resourceCloudflareAccessApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var accessApplication cloudflare.AccessApplication
-	if identifier.Type == AccountType {
-		accessApplication, err = client.AccessApplication(ctx, identifier.Value, d.Id())
-	} else {
-		accessApplication, err = client.ZoneLevelAccessApplication(ctx, identifier.Value, d.Id())
-	}
-
+	accessApplication, err := getAccessApplicationWithSaasApp(ctx, client, identifier, d.Id())
 	if err != nil {
-		var notFoundError *cloudflare.NotFoundError
-		if errors.As(err, &notFoundError) {
+		if isNotFoundError(err) {
 			tflog.Info(ctx, fmt.Sprintf("Access Application %s no longer exists", d.Id()))
 			d.SetId("")
 			return nil
@@ -133,11 +132,19 @@ func resourceCloudflareAccessApplicationRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(fmt.Errorf("error setting Access Application CORS header configuration: %w", corsConfigErr))
 	}
 
+	var saasApp []map[string]interface{}
+	if accessApplication.SaasApplication != nil {
+		saasApp = flattenAccessSaasApplication(accessApplication.SaasApplication, d.Get("saas_app.0.client_secret").(string))
+	}
+	if err := d.Set("saas_app", saasApp); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting Access Application SaaS configuration: %w", err))
+	}
+
 	return nil
 }
 
 func resourceCloudflareAccessApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	allowedIDPList := expandInterfaceToStringList(d.Get("allowed_idps"))
 	appType := d.Get("type").(string)
@@ -179,12 +186,10 @@ func resourceCloudflareAccessApplicationUpdate(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
-	var accessApplication cloudflare.AccessApplication
-	if identifier.Type == AccountType {
-		accessApplication, err = client.UpdateAccessApplication(ctx, identifier.Value, updatedAccessApplication)
-	} else {
-		accessApplication, err = client.UpdateZoneLevelAccessApplication(ctx, identifier.Value, updatedAccessApplication)
-	}
+	accessApplication, err := updateAccessApplicationWithSaasApp(ctx, client, identifier, accessApplicationWithSaasApp{
+		AccessApplication: updatedAccessApplication,
+		SaasApplication:   buildAccessSaasApplicationFromResource(d),
+	})
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Access Application for %s %q: %w", identifier.Type, identifier.Value, err))
 	}
@@ -197,7 +202,7 @@ func resourceCloudflareAccessApplicationUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceCloudflareAccessApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	appID := d.Id()
 
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Access Application using ID: %s", appID))
@@ -245,3 +250,206 @@ func resourceCloudflareAccessApplicationImport(ctx context.Context, d *schema.Re
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// accessApplicationWithSaasApp extends the upstream Access Application with
+// the OIDC SaaS settings and the custom claim mappings which are not yet
+// modelled by cloudflare-go.
+type accessApplicationWithSaasApp struct {
+	cloudflare.AccessApplication
+	SaasApplication *accessSaasApplication `json:"saas_app,omitempty"`
+}
+
+type accessSaasApplication struct {
+	cloudflare.SaasApplication
+	AuthType            string                             `json:"auth_type,omitempty"`
+	ClientID            string                             `json:"client_id,omitempty"`
+	ClientSecret        string                             `json:"client_secret,omitempty"`
+	RedirectURIs        []string                           `json:"redirect_uris,omitempty"`
+	GrantTypes          []string                           `json:"grant_types,omitempty"`
+	Scopes              []string                           `json:"scopes,omitempty"`
+	AppLauncherURL      string                             `json:"app_launcher_url,omitempty"`
+	GroupFilterRegex    string                             `json:"group_filter_regex,omitempty"`
+	CustomClaims        []accessSaasApplicationClaim       `json:"custom_claims,omitempty"`
+	RefreshTokenOptions *accessSaasApplicationRefreshToken `json:"refresh_token_options,omitempty"`
+}
+
+type accessSaasApplicationClaim struct {
+	Name     string                  `json:"name,omitempty"`
+	Required bool                    `json:"required,omitempty"`
+	Scope    string                  `json:"scope,omitempty"`
+	Source   cloudflare.SourceConfig `json:"source"`
+}
+
+type accessSaasApplicationRefreshToken struct {
+	Lifetime string `json:"lifetime,omitempty"`
+}
+
+func accessApplicationRouteRoot(identifier *AccessIdentifier) cloudflare.RouteRoot {
+	if identifier.Type == AccountType {
+		return cloudflare.AccountRouteRoot
+	}
+	return cloudflare.ZoneRouteRoot
+}
+
+func createAccessApplicationWithSaasApp(ctx context.Context, client *apiClient, identifier *AccessIdentifier, app accessApplicationWithSaasApp) (accessApplicationWithSaasApp, error) {
+	uri := fmt.Sprintf("/%s/%s/access/apps", accessApplicationRouteRoot(identifier), identifier.Value)
+	return rawAccessApplicationWithSaasApp(ctx, client, http.MethodPost, uri, app)
+}
+
+func getAccessApplicationWithSaasApp(ctx context.Context, client *apiClient, identifier *AccessIdentifier, applicationID string) (accessApplicationWithSaasApp, error) {
+	uri := fmt.Sprintf("/%s/%s/access/apps/%s", accessApplicationRouteRoot(identifier), identifier.Value, applicationID)
+	return rawAccessApplicationWithSaasApp(ctx, client, http.MethodGet, uri, nil)
+}
+
+func updateAccessApplicationWithSaasApp(ctx context.Context, client *apiClient, identifier *AccessIdentifier, app accessApplicationWithSaasApp) (accessApplicationWithSaasApp, error) {
+	uri := fmt.Sprintf("/%s/%s/access/apps/%s", accessApplicationRouteRoot(identifier), identifier.Value, app.ID)
+	return rawAccessApplicationWithSaasApp(ctx, client, http.MethodPut, uri, app)
+}
+
+func rawAccessApplicationWithSaasApp(ctx context.Context, client *apiClient, method, uri string, payload interface{}) (accessApplicationWithSaasApp, error) {
+	var app accessApplicationWithSaasApp
+
+	res, err := rawRequest(ctx, client, method, uri, payload)
+	if err != nil {
+		return app, err
+	}
+
+	if err := json.Unmarshal(res, &app); err != nil {
+		return app, fmt.Errorf("error unmarshalling Access Application: %w", err)
+	}
+
+	return app, nil
+}
+
+func buildAccessSaasApplicationFromResource(d *schema.ResourceData) *accessSaasApplication {
+	if _, ok := d.GetOk("saas_app"); !ok {
+		return nil
+	}
+
+	saasApp := &accessSaasApplication{
+		SaasApplication: cloudflare.SaasApplication{
+			SPEntityID:         d.Get("saas_app.0.sp_entity_id").(string),
+			ConsumerServiceUrl: d.Get("saas_app.0.consumer_service_url").(string),
+			NameIDFormat:       d.Get("saas_app.0.name_id_format").(string),
+		},
+		AuthType:         d.Get("saas_app.0.auth_type").(string),
+		RedirectURIs:     expandInterfaceToStringList(d.Get("saas_app.0.redirect_uris").(*schema.Set).List()),
+		GrantTypes:       expandInterfaceToStringList(d.Get("saas_app.0.grant_types").(*schema.Set).List()),
+		Scopes:           expandInterfaceToStringList(d.Get("saas_app.0.scopes").(*schema.Set).List()),
+		AppLauncherURL:   d.Get("saas_app.0.app_launcher_url").(string),
+		GroupFilterRegex: d.Get("saas_app.0.group_filter_regex").(string),
+	}
+
+	for _, attr := range d.Get("saas_app.0.custom_attribute").([]interface{}) {
+		attribute := attr.(map[string]interface{})
+		saasApp.CustomAttributes = append(saasApp.CustomAttributes, cloudflare.SAMLAttributeConfig{
+			Name:         attribute["name"].(string),
+			NameFormat:   attribute["name_format"].(string),
+			FriendlyName: attribute["friendly_name"].(string),
+			Required:     attribute["required"].(bool),
+			Source:       buildAccessSaasApplicationSource(attribute["source"].([]interface{})),
+		})
+	}
+
+	for _, c := range d.Get("saas_app.0.custom_claim").([]interface{}) {
+		claim := c.(map[string]interface{})
+		saasApp.CustomClaims = append(saasApp.CustomClaims, accessSaasApplicationClaim{
+			Name:     claim["name"].(string),
+			Scope:    claim["scope"].(string),
+			Required: claim["required"].(bool),
+			Source:   buildAccessSaasApplicationSource(claim["source"].([]interface{})),
+		})
+	}
+
+	if _, ok := d.GetOk("saas_app.0.refresh_token_options"); ok {
+		saasApp.RefreshTokenOptions = &accessSaasApplicationRefreshToken{
+			Lifetime: d.Get("saas_app.0.refresh_token_options.0.lifetime").(string),
+		}
+	}
+
+	return saasApp
+}
+
+func buildAccessSaasApplicationSource(source []interface{}) cloudflare.SourceConfig {
+	var config cloudflare.SourceConfig
+	if len(source) == 0 || source[0] == nil {
+		return config
+	}
+
+	s := source[0].(map[string]interface{})
+	config.Name = s["name"].(string)
+
+	if nameByIDP, ok := s["name_by_idp"].(map[string]interface{}); ok && len(nameByIDP) > 0 {
+		config.NameByIDP = make(map[string]string, len(nameByIDP))
+		for idp, name := range nameByIDP {
+			config.NameByIDP[idp] = name.(string)
+		}
+	}
+
+	return config
+}
+
+// flattenAccessSaasApplication converts the SaaS application into state. The
+// client secret is never returned on read so the caller provides the value to
+// retain.
+func flattenAccessSaasApplication(saasApp *accessSaasApplication, clientSecret string) []map[string]interface{} {
+	authType := saasApp.AuthType
+	if authType == "" {
+		authType = "saml"
+	}
+
+	var customAttributes []map[string]interface{}
+	for _, attr := range saasApp.CustomAttributes {
+		customAttributes = append(customAttributes, map[string]interface{}{
+			"name":          attr.Name,
+			"name_format":   attr.NameFormat,
+			"friendly_name": attr.FriendlyName,
+			"required":      attr.Required,
+			"source":        flattenAccessSaasApplicationSource(attr.Source),
+		})
+	}
+
+	var customClaims []map[string]interface{}
+	for _, claim := range saasApp.CustomClaims {
+		customClaims = append(customClaims, map[string]interface{}{
+			"name":     claim.Name,
+			"scope":    claim.Scope,
+			"required": claim.Required,
+			"source":   flattenAccessSaasApplicationSource(claim.Source),
+		})
+	}
+
+	var refreshTokenOptions []map[string]interface{}
+	if saasApp.RefreshTokenOptions != nil {
+		refreshTokenOptions = []map[string]interface{}{{
+			"lifetime": saasApp.RefreshTokenOptions.Lifetime,
+		}}
+	}
+
+	return []map[string]interface{}{{
+		"auth_type":             authType,
+		"sp_entity_id":          saasApp.SPEntityID,
+		"consumer_service_url":  saasApp.ConsumerServiceUrl,
+		"name_id_format":        saasApp.NameIDFormat,
+		"custom_attribute":      customAttributes,
+		"idp_entity_id":         saasApp.IDPEntityID,
+		"public_key":            saasApp.PublicKey,
+		"sso_endpoint":          saasApp.SSOEndpoint,
+		"redirect_uris":         saasApp.RedirectURIs,
+		"grant_types":           saasApp.GrantTypes,
+		"scopes":                saasApp.Scopes,
+		"app_launcher_url":      saasApp.AppLauncherURL,
+		"group_filter_regex":    saasApp.GroupFilterRegex,
+		"custom_claim":          customClaims,
+		"refresh_token_options": refreshTokenOptions,
+		"client_id":             saasApp.ClientID,
+		"client_secret":         clientSecret,
+	}}
+}
+
+func flattenAccessSaasApplicationSource(source cloudflare.SourceConfig) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"name":        source.Name,
+		"name_by_idp": source.NameByIDP,
+	}}
+}
@@ -7,10 +7,8 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/pkg/errors"
 )
 
 var (
@@ -365,6 +363,78 @@ func TestAccCloudflareAccessApplication_WithAppLauncherVisible(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAccessApplication_WithSAMLSaasApp(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithSAMLSaasApp(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "type", "saas"),
+					resource.TestCheckResourceAttr(name, "saas_app.#", "1"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.auth_type", "saml"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.sp_entity_id", "saas-app.example"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.consumer_service_url", "https://saas-app.example/sso/saml/consume"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.name_id_format", "email"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_attribute.#", "1"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_attribute.0.name", "email"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_attribute.0.name_format", "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_attribute.0.required", "true"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_attribute.0.source.0.name", "user_email"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.idp_entity_id"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.public_key"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.sso_endpoint"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessApplication_WithOIDCSaasApp(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithOIDCSaasApp(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "type", "saas"),
+					resource.TestCheckResourceAttr(name, "saas_app.#", "1"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.auth_type", "oidc"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.redirect_uris.#", "1"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.grant_types.#", "2"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.scopes.#", "3"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_claim.#", "1"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_claim.0.name", "rank"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_claim.0.scope", "profile"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_claim.0.source.0.name", "rank"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.refresh_token_options.0.lifetime", "30d"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.client_id"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.client_secret"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCloudflareAccessApplicationConfigBasic(rnd string, domain string, identifier AccessIdentifier) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_application" "%[1]s" {
@@ -534,25 +604,78 @@ resource "cloudflare_access_application" "%[1]s" {
 `, rnd, zoneID, domain)
 }
 
+func testAccCloudflareAccessApplicationConfigWithSAMLSaasApp(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  type       = "saas"
+
+  saas_app {
+    sp_entity_id         = "saas-app.example"
+    consumer_service_url = "https://saas-app.example/sso/saml/consume"
+    name_id_format       = "email"
+
+    custom_attribute {
+      name        = "email"
+      name_format = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+      required    = true
+      source {
+        name = "user_email"
+      }
+    }
+  }
+}
+`, rnd, accountID)
+}
+
+func testAccCloudflareAccessApplicationConfigWithOIDCSaasApp(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  type       = "saas"
+
+  saas_app {
+    auth_type     = "oidc"
+    redirect_uris = ["https://saas-app.example/sso/oauth2/callback"]
+    grant_types   = ["authorization_code", "refresh_tokens"]
+    scopes        = ["openid", "email", "profile"]
+
+    custom_claim {
+      name  = "rank"
+      scope = "profile"
+      source {
+        name = "rank"
+      }
+    }
+
+    refresh_token_options {
+      lifetime = "30d"
+    }
+  }
+}
+`, rnd, accountID)
+}
+
 func testAccCheckCloudflareAccessApplicationDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_access_application" {
 			continue
 		}
 
-		var notFoundError *cloudflare.NotFoundError
 		if rs.Primary.Attributes["zone_id"] != "" {
 			_, err := client.ZoneLevelAccessApplication(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
-			if !errors.As(err, &notFoundError) {
+			if !isNotFoundError(err) {
 				return fmt.Errorf("AccessApplication still exists")
 			}
 		}
 
 		if rs.Primary.Attributes["account_id"] != "" {
 			_, err := client.AccessApplication(context.Background(), rs.Primary.Attributes["account_id"], rs.Primary.ID)
-			if !errors.As(err, &notFoundError) {
+			if !isNotFoundError(err) {
 				return fmt.Errorf("AccessApplication still exists")
 			}
 		}
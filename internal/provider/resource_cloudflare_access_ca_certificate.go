@@ -27,7 +27,7 @@ func resourceCloudflareAccessCACertificate() *schema.Resource {
 }
 
 func resourceCloudflareAccessCACertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
@@ -50,7 +50,7 @@ func resourceCloudflareAccessCACertificateCreate(ctx context.Context, d *schema.
 }
 
 func resourceCloudflareAccessCACertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	applicationID := d.Get("application_id").(string)
 	identifier, err := initIdentifier(d)
 	if err != nil {
@@ -84,7 +84,7 @@ func resourceCloudflareAccessCACertificateUpdate(ctx context.Context, d *schema.
 }
 
 func resourceCloudflareAccessCACertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	applicationID := d.Get("application_id").(string)
 
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare CA Certificate using ID: %s", d.Id()))
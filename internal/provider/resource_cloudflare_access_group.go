@@ -21,12 +21,13 @@ func resourceCloudflareAccessGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareAccessGroupImport,
 		},
-		Description: "Provides a Cloudflare Access Group resource. Access Groups are used in conjunction with Access Policies to restrict access to a particular resource based on group membership.",
+		CustomizeDiff: resourceCloudflareAccessGroupCycleDiff,
+		Description:   "Provides a Cloudflare Access Group resource. Access Groups are used in conjunction with Access Policies to restrict access to a particular resource based on group membership.",
 	}
 }
 
 func resourceCloudflareAccessGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
@@ -67,7 +68,7 @@ func resourceCloudflareAccessGroupRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareAccessGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	newAccessGroup := cloudflare.AccessGroup{
 		Name: d.Get("name").(string),
 	}
@@ -96,7 +97,7 @@ func resourceCloudflareAccessGroupCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	updatedAccessGroup := cloudflare.AccessGroup{
 		Name: d.Get("name").(string),
 		ID:   d.Id(),
@@ -129,7 +130,7 @@ func resourceCloudflareAccessGroupUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareAccessGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Access Group using ID: %s", d.Id()))
 
@@ -171,6 +172,150 @@ func resourceCloudflareAccessGroupImport(ctx context.Context, d *schema.Resource
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceCloudflareAccessGroupCycleDiff rejects plans where the `group`
+// references of an existing Access Group lead back to the group itself. The
+// API accepts such hierarchies but never evaluates them, so members silently
+// lose access.
+func resourceCloudflareAccessGroupCycleDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// A group which doesn't exist yet can't be referenced by other groups.
+	if d.Id() == "" {
+		return nil
+	}
+
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	zoneID := d.Get("zone_id").(string)
+
+	var references []string
+	for _, rule := range []string{"include", "require", "exclude"} {
+		references = append(references, accessGroupSchemaReferences(d.Get(rule).([]interface{}))...)
+	}
+
+	fetch := func(id string) ([]string, error) {
+		var group cloudflare.AccessGroup
+		var err error
+		if accountID != "" {
+			group, err = client.AccessGroup(ctx, accountID, id)
+		} else {
+			group, err = client.ZoneLevelAccessGroup(ctx, zoneID, id)
+		}
+		if err != nil {
+			// Missing groups are reported by the API when the plan is applied.
+			if strings.Contains(err.Error(), "HTTP status 404") {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		var conditions []interface{}
+		conditions = append(conditions, group.Include...)
+		conditions = append(conditions, group.Require...)
+		conditions = append(conditions, group.Exclude...)
+
+		return accessGroupConditionReferences(conditions), nil
+	}
+
+	cycle, err := findAccessGroupCycle(d.Id(), references, fetch)
+	if err != nil {
+		return fmt.Errorf("error checking Access Group %q for circular references: %w", d.Id(), err)
+	}
+
+	if cycle != nil {
+		return fmt.Errorf("Access Group %q references itself through nested groups: %s", d.Id(), strings.Join(cycle, " -> "))
+	}
+
+	return nil
+}
+
+// accessGroupSchemaReferences returns the group IDs referenced by the
+// `group` attribute of the include, require or exclude blocks. IDs which are
+// not known until apply are skipped.
+func accessGroupSchemaReferences(rules []interface{}) []string {
+	var ids []string
+	for _, rule := range rules {
+		r, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		groups, _ := r["group"].([]interface{})
+		for _, id := range groups {
+			if s, ok := id.(string); ok && s != "" {
+				ids = append(ids, s)
+			}
+		}
+	}
+
+	return ids
+}
+
+// accessGroupConditionReferences returns the group IDs referenced by
+// conditions returned from the API.
+func accessGroupConditionReferences(conditions []interface{}) []string {
+	var ids []string
+	for _, condition := range conditions {
+		c, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		group, ok := c["group"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if id, ok := group["id"].(string); ok && id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// findAccessGroupCycle walks the group references starting from the groups
+// referenced by self and returns the path back to self, or nil when there is
+// no cycle. fetch returns the groups referenced by the given group.
+func findAccessGroupCycle(self string, references []string, fetch func(id string) ([]string, error)) ([]string, error) {
+	visited := make(map[string]bool)
+
+	var walk func(id string, path []string) ([]string, error)
+	walk = func(id string, path []string) ([]string, error) {
+		path = append(append([]string{}, path...), id)
+
+		if id == self {
+			return path, nil
+		}
+
+		if visited[id] {
+			return nil, nil
+		}
+		visited[id] = true
+
+		children, err := fetch(id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, child := range children {
+			cycle, err := walk(child, path)
+			if err != nil || cycle != nil {
+				return cycle, err
+			}
+		}
+
+		return nil, nil
+	}
+
+	for _, id := range references {
+		cycle, err := walk(id, []string{self})
+		if err != nil || cycle != nil {
+			return cycle, err
+		}
+	}
+
+	return nil, nil
+}
+
 // appendConditionalAccessGroupFields determines which of the
 // conditional group enforcement fields it should append to the
 // AccessGroup by iterating over the provided values and generating the
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
+	"regexp"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -405,7 +407,7 @@ func testAccCheckCloudflareAccessGroupExists(n string, accessIdentifier AccessId
 			return fmt.Errorf("No AccessGroup ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		var foundAccessGroup cloudflare.AccessGroup
 		var err error
 
@@ -433,7 +435,7 @@ func testAccCheckCloudflareAccessGroupExists(n string, accessIdentifier AccessId
 }
 
 func testAccCheckCloudflareAccessGroupDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_access_group" {
@@ -470,7 +472,7 @@ func testAccManuallyDeleteAccessGroup(name string, initialID *string) resource.T
 			return fmt.Errorf("not found: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		*initialID = rs.Primary.ID
 		err := client.DeleteAccessGroup(context.Background(), rs.Primary.Attributes["account_id"], rs.Primary.ID)
 		if err != nil {
@@ -488,3 +490,111 @@ func testAccCheckCloudflareAccessGroupRecreated(before, after *cloudflare.Access
 		return nil
 	}
 }
+
+func TestFindAccessGroupCycle(t *testing.T) {
+	graph := map[string][]string{
+		"a": {"b"},
+		"b": {"c", "d"},
+		"c": {},
+		"d": {"self"},
+		"e": {"f"},
+		"f": {"e"},
+	}
+	fetch := func(id string) ([]string, error) {
+		return graph[id], nil
+	}
+
+	cycle, err := findAccessGroupCycle("self", []string{"c", "a"}, fetch)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(cycle, []string{"self", "a", "b", "d", "self"}) {
+		t.Errorf("unexpected cycle: %v", cycle)
+	}
+
+	cycle, err = findAccessGroupCycle("self", []string{"self"}, fetch)
+	if err != nil || !reflect.DeepEqual(cycle, []string{"self", "self"}) {
+		t.Errorf("expected direct self reference to be detected, got %v (%v)", cycle, err)
+	}
+
+	// Cycles which don't involve the group itself are not reported and
+	// must not cause an infinite walk.
+	cycle, err = findAccessGroupCycle("self", []string{"e", "c"}, fetch)
+	if err != nil || cycle != nil {
+		t.Errorf("expected no cycle, got %v (%v)", cycle, err)
+	}
+}
+
+func TestAccessGroupConditionReferences(t *testing.T) {
+	conditions := []interface{}{
+		map[string]interface{}{"email": map[string]interface{}{"email": "test@example.com"}},
+		map[string]interface{}{"group": map[string]interface{}{"id": "a"}},
+		map[string]interface{}{"group": map[string]interface{}{"id": "b"}},
+	}
+
+	if got := accessGroupConditionReferences(conditions); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("unexpected references: %v", got)
+	}
+}
+
+func TestAccCloudflareAccessGroup_NestedGroupCycle(t *testing.T) {
+	rnd := generateRandomResourceName()
+	var child cloudflare.AccessGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessGroupConfigNested(rnd, accountID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareAccessGroupExists(fmt.Sprintf("cloudflare_access_group.%s_child", rnd), AccessIdentifier{Type: AccountType, Value: accountID}, &child),
+					resource.TestCheckResourceAttrPair(fmt.Sprintf("cloudflare_access_group.%s_child", rnd), "include.0.group.0", fmt.Sprintf("cloudflare_access_group.%s_parent", rnd), "id"),
+				),
+			},
+			{
+				// The child's ID is passed in as a variable as referencing it
+				// directly would be a cycle in the Terraform graph itself.
+				PreConfig:   func() { t.Setenv("TF_VAR_child_group_id", child.ID) },
+				Config:      testAccCloudflareAccessGroupConfigNested(rnd, accountID, true),
+				ExpectError: regexp.MustCompile(`references itself through nested groups`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessGroupConfigNested(rnd, accountID string, cycle bool) string {
+	parentGroups := "[]"
+	if cycle {
+		parentGroups = "[var.child_group_id]"
+	}
+
+	return fmt.Sprintf(`
+variable "child_group_id" {
+  default = ""
+}
+
+resource "cloudflare_access_group" "%[1]s_parent" {
+  account_id = "%[2]s"
+  name       = "%[1]s-parent"
+
+  include {
+    email = ["test@example.com"]
+    group = %[3]s
+  }
+}
+
+resource "cloudflare_access_group" "%[1]s_child" {
+  account_id = "%[2]s"
+  name       = "%[1]s-child"
+
+  include {
+    group = [cloudflare_access_group.%[1]s_parent.id]
+  }
+}
+`, rnd, accountID, parentGroups)
+}
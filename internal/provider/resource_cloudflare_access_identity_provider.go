@@ -28,7 +28,7 @@ func resourceCloudflareAccessIdentityProvider() *schema.Resource {
 }
 
 func resourceCloudflareAccessIdentityProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
@@ -63,7 +63,7 @@ func resourceCloudflareAccessIdentityProviderRead(ctx context.Context, d *schema
 }
 
 func resourceCloudflareAccessIdentityProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	IDPConfig, _ := convertSchemaToStruct(d)
 
@@ -96,7 +96,7 @@ func resourceCloudflareAccessIdentityProviderCreate(ctx context.Context, d *sche
 }
 
 func resourceCloudflareAccessIdentityProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	IDPConfig, conversionErr := convertSchemaToStruct(d)
 	if conversionErr != nil {
@@ -135,7 +135,7 @@ func resourceCloudflareAccessIdentityProviderUpdate(ctx context.Context, d *sche
 }
 
 func resourceCloudflareAccessIdentityProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Access Identity Provider using ID: %s", d.Id()))
 
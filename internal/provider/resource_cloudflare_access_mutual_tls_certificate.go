@@ -27,7 +27,7 @@ func resourceCloudflareAccessMutualTLSCertificate() *schema.Resource {
 }
 
 func resourceCloudflareAccessMutualTLSCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	newAccessMutualTLSCertificate := cloudflare.AccessMutualTLSCertificate{
 		Name:        d.Get("name").(string),
@@ -58,7 +58,7 @@ func resourceCloudflareAccessMutualTLSCertificateCreate(ctx context.Context, d *
 }
 
 func resourceCloudflareAccessMutualTLSCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
@@ -89,7 +89,7 @@ func resourceCloudflareAccessMutualTLSCertificateRead(ctx context.Context, d *sc
 }
 
 func resourceCloudflareAccessMutualTLSCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	updatedAccessMutualTLSCert := cloudflare.AccessMutualTLSCertificate{
 		ID:   d.Id(),
@@ -117,7 +117,7 @@ func resourceCloudflareAccessMutualTLSCertificateUpdate(ctx context.Context, d *
 }
 
 func resourceCloudflareAccessMutualTLSCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	certID := d.Id()
 
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Access Mutual TLS Certificate using ID: %s", certID))
@@ -2,8 +2,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -53,7 +56,7 @@ func schemaAccessPolicyApprovalGroupToAPI(data map[string]interface{}) cloudflar
 }
 
 func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	appID := d.Get("application_id").(string)
 
 	identifier, err := initIdentifier(d)
@@ -104,6 +107,10 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 		d.Set("approval_required", accessPolicy.ApprovalRequired)
 	}
 
+	if err := setAccessPolicyApplicationAUD(ctx, client, identifier, appID, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(accessPolicy.ApprovalGroups) != 0 {
 		approvalGroups := make([]map[string]interface{}, 0, len(accessPolicy.ApprovalGroups))
 		for _, apiApprovalGroup := range accessPolicy.ApprovalGroups {
@@ -118,7 +125,7 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	appID := d.Get("application_id").(string)
 	newAccessPolicy := cloudflare.AccessPolicy{
 		Name:       d.Get("name").(string),
@@ -130,6 +137,10 @@ func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.Resourc
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Access Policy from struct: %+v", newAccessPolicy))
 
+	if err := validateAccessPolicyExternalEvaluationKeys(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	identifier, err := initIdentifier(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -147,11 +158,15 @@ func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.Resourc
 
 	d.SetId(accessPolicy.ID)
 
+	if err := setAccessPolicyApplicationAUD(ctx, client, identifier, appID, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
 func resourceCloudflareAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	appID := d.Get("application_id").(string)
 	updatedAccessPolicy := cloudflare.AccessPolicy{
 		Name:       d.Get("name").(string),
@@ -164,6 +179,10 @@ func resourceCloudflareAccessPolicyUpdate(ctx context.Context, d *schema.Resourc
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Access Policy from struct: %+v", updatedAccessPolicy))
 
+	if err := validateAccessPolicyExternalEvaluationKeys(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	identifier, err := initIdentifier(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -187,7 +206,7 @@ func resourceCloudflareAccessPolicyUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceCloudflareAccessPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	appID := d.Get("application_id").(string)
 
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Access Policy using ID: %s", d.Id()))
@@ -280,3 +299,102 @@ func appendConditionalAccessPolicyFields(policy cloudflare.AccessPolicy, d *sche
 
 	return policy
 }
+
+// accessPolicyExternalEvaluations returns the `external_evaluation`
+// conditions of the policy.
+func accessPolicyExternalEvaluations(d *schema.ResourceData) []map[string]interface{} {
+	var evaluations []map[string]interface{}
+
+	for _, conditionType := range []string{"include", "exclude", "require"} {
+		for _, condition := range d.Get(conditionType).([]interface{}) {
+			if condition == nil {
+				continue
+			}
+			for _, evaluation := range condition.(map[string]interface{})["external_evaluation"].([]interface{}) {
+				if evaluation != nil {
+					evaluations = append(evaluations, evaluation.(map[string]interface{}))
+				}
+			}
+		}
+	}
+
+	return evaluations
+}
+
+// setAccessPolicyApplicationAUD sets the audience tag of the application
+// when the policy uses external evaluation, as the external service needs it
+// to validate requests from Access.
+func setAccessPolicyApplicationAUD(ctx context.Context, client *apiClient, identifier *AccessIdentifier, appID string, d *schema.ResourceData) error {
+	if len(accessPolicyExternalEvaluations(d)) == 0 {
+		d.Set("application_aud", "")
+		return nil
+	}
+
+	var app cloudflare.AccessApplication
+	var err error
+	if identifier.Type == AccountType {
+		app, err = client.AccessApplication(ctx, identifier.Value, appID)
+	} else {
+		app, err = client.ZoneLevelAccessApplication(ctx, identifier.Value, appID)
+	}
+	if err != nil {
+		return fmt.Errorf("error finding Access Application %q: %w", appID, err)
+	}
+
+	d.Set("application_aud", app.AUD)
+
+	return nil
+}
+
+// validateAccessPolicyExternalEvaluationKeys checks that the `keys_url` of
+// every external evaluation serves a JSON Web Key Set, so a misconfigured
+// endpoint fails the apply instead of denying every request.
+func validateAccessPolicyExternalEvaluationKeys(ctx context.Context, d *schema.ResourceData) error {
+	if !d.Get("validate_external_evaluation_keys").(bool) {
+		return nil
+	}
+
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	for _, evaluation := range accessPolicyExternalEvaluations(d) {
+		keysURL := evaluation["keys_url"].(string)
+		if keysURL == "" {
+			continue
+		}
+
+		if err := checkAccessExternalEvaluationKeys(ctx, httpClient, keysURL); err != nil {
+			return fmt.Errorf("error validating external evaluation keys_url %q: %w", keysURL, err)
+		}
+	}
+
+	return nil
+}
+
+func checkAccessExternalEvaluationKeys(ctx context.Context, httpClient *http.Client, keysURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, keysURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+	}
+
+	var keySet struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&keySet); err != nil {
+		return fmt.Errorf("response is not a JSON Web Key Set: %w", err)
+	}
+
+	if len(keySet.Keys) == 0 {
+		return fmt.Errorf("JSON Web Key Set contains no keys")
+	}
+
+	return nil
+}
@@ -1,7 +1,10 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -835,12 +838,46 @@ func TestAccCloudflareAccessPolicy_ExternalEvaluation(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "account_id", accountID),
 					resource.TestCheckResourceAttr(name, "include.0.external_evaluation.0.evaluate_url", "https://example.com"),
 					resource.TestCheckResourceAttr(name, "include.0.external_evaluation.0.keys_url", "https://example.com/keys"),
+					resource.TestCheckResourceAttrPair(name, "application_aud", "cloudflare_access_application."+rnd, "aud"),
 				),
 			},
 		},
 	})
 }
 
+func TestCheckAccessExternalEvaluationKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/keys":
+			fmt.Fprint(w, `{"keys": [{"kty": "RSA", "kid": "1", "n": "abc", "e": "AQAB"}]}`)
+		case "/empty":
+			fmt.Fprint(w, `{"keys": []}`)
+		case "/html":
+			fmt.Fprint(w, `<html></html>`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cases := map[string]bool{
+		"/keys":    true,
+		"/empty":   false,
+		"/html":    false,
+		"/missing": false,
+	}
+
+	for path, valid := range cases {
+		err := checkAccessExternalEvaluationKeys(context.Background(), server.Client(), server.URL+path)
+		if valid && err != nil {
+			t.Errorf("%s: unexpected error: %s", path, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("%s: expected an error", path)
+		}
+	}
+}
+
 func testAccessPolicyExternalEvalautionConfig(resourceID, zone, accountID string) string {
 	return fmt.Sprintf(`
     resource "cloudflare_access_application" "%[1]s" {
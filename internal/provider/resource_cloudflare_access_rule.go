@@ -37,7 +37,7 @@ func resourceCloudflareAccessRule() *schema.Resource {
 }
 
 func resourceCloudflareAccessRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	newRule := cloudflare.AccessRule{
@@ -81,7 +81,7 @@ func resourceCloudflareAccessRuleCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareAccessRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	var accessRuleResponse *cloudflare.AccessRuleResponse
@@ -128,7 +128,7 @@ func resourceCloudflareAccessRuleRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCloudflareAccessRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	updatedRule := cloudflare.AccessRule{
@@ -166,7 +166,7 @@ func resourceCloudflareAccessRuleUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareAccessRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare Access Rule: id %s for zone_id %s", d.Id(), zoneID))
@@ -191,7 +191,7 @@ func resourceCloudflareAccessRuleDelete(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareAccessRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	attributes := strings.Split(d.Id(), "/")
 
 	var (
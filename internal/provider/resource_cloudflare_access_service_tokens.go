@@ -52,7 +52,7 @@ func resourceCloudflareAccessServiceTokenExpireDiff(ctx context.Context, d *sche
 }
 
 func resourceCloudflareAccessServiceTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
@@ -83,7 +83,7 @@ func resourceCloudflareAccessServiceTokenRead(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudflareAccessServiceTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	tokenName := d.Get("name").(string)
 
 	identifier, err := initIdentifier(d)
@@ -113,7 +113,7 @@ func resourceCloudflareAccessServiceTokenCreate(ctx context.Context, d *schema.R
 }
 
 func resourceCloudflareAccessServiceTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	tokenName := d.Get("name").(string)
 
 	identifier, err := initIdentifier(d)
@@ -137,7 +137,7 @@ func resourceCloudflareAccessServiceTokenUpdate(ctx context.Context, d *schema.R
 }
 
 func resourceCloudflareAccessServiceTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -11,6 +13,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// accountMemberPolicy is a policy of a policy-scoped member, which the library
+// has no support for.
+type accountMemberPolicy struct {
+	Access           string                   `json:"access"`
+	PermissionGroups []accountMemberPolicyRef `json:"permission_groups"`
+	ResourceGroups   []accountMemberPolicyRef `json:"resource_groups"`
+}
+
+type accountMemberPolicyRef struct {
+	ID string `json:"id"`
+}
+
 func resourceCloudflareAccountMember() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareAccountMemberSchema(),
@@ -26,7 +40,7 @@ func resourceCloudflareAccountMember() *schema.Resource {
 }
 
 func resourceCloudflareAccountMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	member, err := client.AccountMember(ctx, client.AccountID, d.Id())
 	if err != nil {
@@ -39,20 +53,29 @@ func resourceCloudflareAccountMemberRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	var memberIDs []string
-	for _, role := range member.Roles {
-		memberIDs = append(memberIDs, role.ID)
+	d.Set("email_address", member.User.Email)
+
+	if d.Get("policies").(*schema.Set).Len() > 0 {
+		policies, err := accountMemberPolicies(ctx, client, client.AccountID, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("policies", flattenAccountMemberPolicies(policies))
+	} else {
+		var memberIDs []string
+		for _, role := range member.Roles {
+			memberIDs = append(memberIDs, role.ID)
+		}
+		d.Set("role_ids", memberIDs)
 	}
 
-	d.Set("email_address", member.User.Email)
-	d.Set("role_ids", memberIDs)
 	d.SetId(d.Id())
 
 	return nil
 }
 
 func resourceCloudflareAccountMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare account member ID: %s", d.Id()))
 
@@ -68,7 +91,35 @@ func resourceCloudflareAccountMemberCreate(ctx context.Context, d *schema.Resour
 	memberEmailAddress := d.Get("email_address").(string)
 	requestedMemberRoles := d.Get("role_ids").(*schema.Set).List()
 
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
+
+	if policies := d.Get("policies").(*schema.Set); policies.Len() > 0 {
+		member := map[string]interface{}{
+			"email":    memberEmailAddress,
+			"policies": expandAccountMemberPolicies(policies),
+			"status":   "pending",
+		}
+
+		res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/members", client.AccountID), member)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error creating Cloudflare account member: %w", err))
+		}
+
+		var r struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(res, &r); err != nil {
+			return diag.FromErr(fmt.Errorf("error unmarshalling Cloudflare account member: %w", err))
+		}
+
+		if r.ID == "" {
+			return diag.FromErr(fmt.Errorf("failed to find ID in create response; resource was empty"))
+		}
+
+		d.SetId(r.ID)
+
+		return resourceCloudflareAccountMemberRead(ctx, d, meta)
+	}
 
 	var accountMemberRoleIDs []string
 	for _, roleID := range requestedMemberRoles {
@@ -91,7 +142,19 @@ func resourceCloudflareAccountMemberCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceCloudflareAccountMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
+
+	if policies := d.Get("policies").(*schema.Set); policies.Len() > 0 {
+		member := map[string]interface{}{
+			"policies": expandAccountMemberPolicies(policies),
+		}
+
+		if _, err := rawRequest(ctx, client, http.MethodPut, fmt.Sprintf("/accounts/%s/members/%s", client.AccountID, d.Id()), member); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to update Cloudflare account member: %w", err))
+		}
+
+		return resourceCloudflareAccountMemberRead(ctx, d, meta)
+	}
 	accountRoles := []cloudflare.AccountRole{}
 	memberRoles := d.Get("role_ids").(*schema.Set).List()
 
@@ -110,7 +173,7 @@ func resourceCloudflareAccountMemberUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceCloudflareAccountMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	// split the id so we can lookup the account member
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -130,14 +193,84 @@ func resourceCloudflareAccountMemberImport(ctx context.Context, d *schema.Resour
 
 	tflog.Info(ctx, fmt.Sprintf("Found account member: %s", member.User.Email))
 
-	var memberIDs []string
-	for _, role := range member.Roles {
-		memberIDs = append(memberIDs, role.ID)
+	d.Set("email_address", member.User.Email)
+
+	// Policy-scoped members have no roles.
+	if len(member.Roles) == 0 {
+		policies, err := accountMemberPolicies(ctx, client, accountID, accountMemberID)
+		if err != nil {
+			return nil, err
+		}
+		d.Set("policies", flattenAccountMemberPolicies(policies))
+	} else {
+		var memberIDs []string
+		for _, role := range member.Roles {
+			memberIDs = append(memberIDs, role.ID)
+		}
+		d.Set("role_ids", memberIDs)
 	}
 
-	d.Set("email_address", member.User.Email)
-	d.Set("role_ids", memberIDs)
 	d.SetId(accountMemberID)
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func accountMemberPolicies(ctx context.Context, client *apiClient, accountID, memberID string) ([]accountMemberPolicy, error) {
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/members/%s", accountID, memberID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching Cloudflare account member policies: %w", err)
+	}
+
+	var member struct {
+		Policies []accountMemberPolicy `json:"policies"`
+	}
+	if err := json.Unmarshal(res, &member); err != nil {
+		return nil, fmt.Errorf("error unmarshalling Cloudflare account member policies: %w", err)
+	}
+
+	return member.Policies, nil
+}
+
+func expandAccountMemberPolicies(set *schema.Set) []accountMemberPolicy {
+	policies := make([]accountMemberPolicy, 0, set.Len())
+	for _, p := range set.List() {
+		policy := p.(map[string]interface{})
+
+		var permissionGroups, resourceGroups []accountMemberPolicyRef
+		for _, id := range policy["permission_group_ids"].(*schema.Set).List() {
+			permissionGroups = append(permissionGroups, accountMemberPolicyRef{ID: id.(string)})
+		}
+		for _, id := range policy["resource_group_ids"].(*schema.Set).List() {
+			resourceGroups = append(resourceGroups, accountMemberPolicyRef{ID: id.(string)})
+		}
+
+		policies = append(policies, accountMemberPolicy{
+			Access:           policy["access"].(string),
+			PermissionGroups: permissionGroups,
+			ResourceGroups:   resourceGroups,
+		})
+	}
+
+	return policies
+}
+
+func flattenAccountMemberPolicies(policies []accountMemberPolicy) []interface{} {
+	result := make([]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		var permissionGroups, resourceGroups []string
+		for _, group := range policy.PermissionGroups {
+			permissionGroups = append(permissionGroups, group.ID)
+		}
+		for _, group := range policy.ResourceGroups {
+			resourceGroups = append(resourceGroups, group.ID)
+		}
+
+		result = append(result, map[string]interface{}{
+			"access":               policy.Access,
+			"permission_group_ids": permissionGroups,
+			"resource_group_ids":   resourceGroups,
+		})
+	}
+
+	return result
+}
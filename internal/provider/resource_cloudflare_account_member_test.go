@@ -48,3 +48,60 @@ func testCloudflareAccountMemberBasicConfig(resourceID, emailAddress string) str
     role_ids = [ "05784afa30c1afe1440e79d9351c7430" ]
   }`, resourceID, emailAddress)
 }
+
+func TestAccCloudflareAccountMemberPolicies(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN as the API token won't have
+	// permission to manage account members.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := "cloudflare_account_member." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	permissionGroupID := os.Getenv("CLOUDFLARE_PERMISSION_GROUP_ID")
+	resourceGroupID := os.Getenv("CLOUDFLARE_RESOURCE_GROUP_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+			testAccPreCheckEmail(t)
+			testAccPreCheckApiKey(t)
+			testAccPreCheckAccountMemberPolicy(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCloudflareAccountMemberPoliciesConfig(rnd, fmt.Sprintf("%s@example.com", rnd), permissionGroupID, resourceGroupID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "email_address", fmt.Sprintf("%s@example.com", rnd)),
+					resource.TestCheckResourceAttr(name, "role_ids.#", "0"),
+					resource.TestCheckResourceAttr(name, "policies.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "policies.*", map[string]string{"access": "allow"}),
+					resource.TestCheckTypeSetElemAttr(name, "policies.*.permission_group_ids.*", permissionGroupID),
+					resource.TestCheckTypeSetElemAttr(name, "policies.*.resource_group_ids.*", resourceGroupID),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testCloudflareAccountMemberPoliciesConfig(resourceID, emailAddress, permissionGroupID, resourceGroupID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_account_member" "%[1]s" {
+    email_address = "%[2]s"
+    policies {
+      permission_group_ids = [ "%[3]s" ]
+      resource_group_ids   = [ "%[4]s" ]
+    }
+  }`, resourceID, emailAddress, permissionGroupID, resourceGroupID)
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	aiGatewayRateLimitingTechniques  = []string{"fixed", "sliding"}
+	aiGatewayLogManagementStrategies = []string{"STOP_INSERTING", "DELETE_OLDEST"}
+)
+
+type aiGateway struct {
+	ID                      string  `json:"id"`
+	CacheTTL                int     `json:"cache_ttl"`
+	CacheInvalidateOnUpdate bool    `json:"cache_invalidate_on_update"`
+	CollectLogs             bool    `json:"collect_logs"`
+	RateLimitingLimit       int     `json:"rate_limiting_limit"`
+	RateLimitingInterval    int     `json:"rate_limiting_interval"`
+	RateLimitingTechnique   string  `json:"rate_limiting_technique"`
+	LogManagement           *int    `json:"log_management"`
+	LogManagementStrategy   *string `json:"log_management_strategy"`
+	CreatedAt               string  `json:"created_at,omitempty"`
+	ModifiedAt              string  `json:"modified_at,omitempty"`
+}
+
+func resourceCloudflareAIGateway() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAIGatewaySchema(),
+		CreateContext: resourceCloudflareAIGatewayCreate,
+		ReadContext:   resourceCloudflareAIGatewayRead,
+		UpdateContext: resourceCloudflareAIGatewayUpdate,
+		DeleteContext: resourceCloudflareAIGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAIGatewayImport,
+		},
+		Description: "Provides a resource for managing AI Gateway gateways, which proxy requests to AI providers with caching, rate limiting and logging.",
+	}
+}
+
+func resourceCloudflareAIGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	gateway := buildAIGateway(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating AI Gateway from struct: %+v", gateway))
+
+	_, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/ai-gateway/gateways", accountID), gateway)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating AI Gateway %q: %w", gateway.ID, err))
+	}
+
+	d.SetId(gateway.ID)
+
+	return resourceCloudflareAIGatewayRead(ctx, d, meta)
+}
+
+func resourceCloudflareAIGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	gateway, err := fetchAIGateway(ctx, client, accountID, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("AI Gateway %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding AI Gateway %q: %w", d.Id(), err))
+	}
+
+	setAIGatewayData(d, gateway)
+
+	return nil
+}
+
+func resourceCloudflareAIGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	gateway := buildAIGateway(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating AI Gateway %s from struct: %+v", d.Id(), gateway))
+
+	_, err := rawRequest(ctx, client, http.MethodPut, aiGatewayURI(accountID, d.Id()), gateway)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating AI Gateway %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareAIGatewayRead(ctx, d, meta)
+}
+
+func resourceCloudflareAIGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, aiGatewayURI(accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting AI Gateway %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAIGatewayImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/gatewayName"`, d.Id())
+	}
+
+	accountID, name := attributes[0], attributes[1]
+
+	d.SetId(name)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareAIGatewayRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildAIGateway(d *schema.ResourceData) aiGateway {
+	gateway := aiGateway{
+		ID:                      d.Get("name").(string),
+		CacheTTL:                d.Get("cache_ttl").(int),
+		CacheInvalidateOnUpdate: d.Get("cache_invalidate_on_update").(bool),
+		CollectLogs:             d.Get("collect_logs").(bool),
+		RateLimitingLimit:       d.Get("rate_limiting_limit").(int),
+		RateLimitingInterval:    d.Get("rate_limiting_interval").(int),
+		RateLimitingTechnique:   d.Get("rate_limiting_technique").(string),
+	}
+
+	if v, ok := d.GetOk("log_management"); ok {
+		limit := v.(int)
+		gateway.LogManagement = &limit
+	}
+
+	if v, ok := d.GetOk("log_management_strategy"); ok {
+		strategy := v.(string)
+		gateway.LogManagementStrategy = &strategy
+	}
+
+	return gateway
+}
+
+func setAIGatewayData(d *schema.ResourceData, gateway aiGateway) {
+	d.Set("name", gateway.ID)
+	d.Set("cache_ttl", gateway.CacheTTL)
+	d.Set("cache_invalidate_on_update", gateway.CacheInvalidateOnUpdate)
+	d.Set("collect_logs", gateway.CollectLogs)
+	d.Set("rate_limiting_limit", gateway.RateLimitingLimit)
+	d.Set("rate_limiting_interval", gateway.RateLimitingInterval)
+	d.Set("rate_limiting_technique", gateway.RateLimitingTechnique)
+	d.Set("log_management", gateway.LogManagement)
+	d.Set("log_management_strategy", gateway.LogManagementStrategy)
+	d.Set("created_at", gateway.CreatedAt)
+	d.Set("modified_at", gateway.ModifiedAt)
+}
+
+func fetchAIGateway(ctx context.Context, client *apiClient, accountID, name string) (aiGateway, error) {
+	var gateway aiGateway
+
+	res, err := rawRequest(ctx, client, http.MethodGet, aiGatewayURI(accountID, name), nil)
+	if err != nil {
+		return gateway, err
+	}
+
+	if err := json.Unmarshal(res, &gateway); err != nil {
+		return gateway, fmt.Errorf("error unmarshalling AI Gateway: %w", err)
+	}
+
+	return gateway, nil
+}
+
+func aiGatewayURI(accountID, name string) string {
+	return fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s", accountID, name)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAIGateway_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_ai_gateway.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAIGatewayConfig(rnd, accountID, 60, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "cache_ttl", "60"),
+					resource.TestCheckResourceAttr(name, "collect_logs", "true"),
+					resource.TestCheckResourceAttr(name, "rate_limiting_limit", "100"),
+					resource.TestCheckResourceAttr(name, "rate_limiting_interval", "60"),
+					resource.TestCheckResourceAttr(name, "rate_limiting_technique", "sliding"),
+					resource.TestCheckResourceAttrSet(name, "created_at"),
+				),
+			},
+			{
+				Config: testAccCloudflareAIGatewayConfig(rnd, accountID, 0, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "cache_ttl", "0"),
+					resource.TestCheckResourceAttr(name, "rate_limiting_limit", "0"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAIGatewayConfig(rnd, accountID string, cacheTTL, rateLimit int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_ai_gateway" "%[1]s" {
+  account_id              = "%[2]s"
+  name                    = "%[1]s"
+  cache_ttl               = %[3]d
+  rate_limiting_limit     = %[4]d
+  rate_limiting_interval  = 60
+  rate_limiting_technique = "sliding"
+}
+`, rnd, accountID, cacheTTL, rateLimit)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type apiShieldOperation struct {
+	OperationID string `json:"operation_id,omitempty"`
+	Method      string `json:"method"`
+	Host        string `json:"host"`
+	Endpoint    string `json:"endpoint"`
+	LastUpdated string `json:"last_updated,omitempty"`
+}
+
+func resourceCloudflareAPIShieldOperation() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAPIShieldOperationSchema(),
+		CreateContext: resourceCloudflareAPIShieldOperationCreate,
+		ReadContext:   resourceCloudflareAPIShieldOperationRead,
+		DeleteContext: resourceCloudflareAPIShieldOperationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAPIShieldOperationImport,
+		},
+		Description: "Provides a resource to manage an operation in API Shield Endpoint Management.",
+	}
+}
+
+func resourceCloudflareAPIShieldOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	operation := apiShieldOperation{
+		Method:   d.Get("method").(string),
+		Host:     d.Get("host").(string),
+		Endpoint: d.Get("endpoint").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating API Shield operation from struct: %+v", operation))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/api_gateway/operations", zoneID), []apiShieldOperation{operation})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating API Shield operation: %w", err))
+	}
+
+	var operations []apiShieldOperation
+	if err := json.Unmarshal(res, &operations); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling API Shield operation: %w", err))
+	}
+
+	if len(operations) != 1 {
+		return diag.FromErr(fmt.Errorf("expected exactly one API Shield operation to be created, got %d", len(operations)))
+	}
+
+	d.SetId(operations[0].OperationID)
+
+	return resourceCloudflareAPIShieldOperationRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, apiShieldOperationURI(zoneID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("API Shield operation %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error fetching API Shield operation %q: %w", d.Id(), err))
+	}
+
+	var operation apiShieldOperation
+	if err := json.Unmarshal(res, &operation); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling API Shield operation %q: %w", d.Id(), err))
+	}
+
+	d.Set("method", operation.Method)
+	d.Set("host", operation.Host)
+	d.Set("endpoint", operation.Endpoint)
+	d.Set("last_updated", operation.LastUpdated)
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, apiShieldOperationURI(zoneID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting API Shield operation %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldOperationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/operationID"`, d.Id())
+	}
+
+	zoneID, operationID := attributes[0], attributes[1]
+
+	d.SetId(operationID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareAPIShieldOperationRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func apiShieldOperationURI(zoneID, operationID string) string {
+	return fmt.Sprintf("/zones/%s/api_gateway/operations/%s", zoneID, operationID)
+}
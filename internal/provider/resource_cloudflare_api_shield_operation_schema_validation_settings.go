@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type apiShieldOperationSchemaValidationSettings struct {
+	OperationID      string  `json:"operation_id,omitempty"`
+	MitigationAction *string `json:"mitigation_action"`
+}
+
+func resourceCloudflareAPIShieldOperationSchemaValidationSettings() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAPIShieldOperationSchemaValidationSettingsSchema(),
+		CreateContext: resourceCloudflareAPIShieldOperationSchemaValidationSettingsUpdate,
+		ReadContext:   resourceCloudflareAPIShieldOperationSchemaValidationSettingsRead,
+		UpdateContext: resourceCloudflareAPIShieldOperationSchemaValidationSettingsUpdate,
+		DeleteContext: resourceCloudflareAPIShieldOperationSchemaValidationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAPIShieldOperationSchemaValidationSettingsImport,
+		},
+		Description: "Provides a resource to manage the API Shield Schema Validation mitigation action of a single operation.",
+	}
+}
+
+func resourceCloudflareAPIShieldOperationSchemaValidationSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	operationID := d.Get("operation_id").(string)
+
+	settings := apiShieldOperationSchemaValidationSettings{}
+	if v, ok := d.GetOk("mitigation_action"); ok {
+		action := v.(string)
+		settings.MitigationAction = &action
+	}
+
+	_, err := rawRequest(ctx, client, http.MethodPut, apiShieldOperationSchemaValidationSettingsURI(zoneID, operationID), settings)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating API Shield schema validation settings for operation %q: %w", operationID, err))
+	}
+
+	d.SetId(operationID)
+
+	return resourceCloudflareAPIShieldOperationSchemaValidationSettingsRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldOperationSchemaValidationSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, apiShieldOperationSchemaValidationSettingsURI(zoneID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("API Shield operation %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error fetching API Shield schema validation settings for operation %q: %w", d.Id(), err))
+	}
+
+	var settings apiShieldOperationSchemaValidationSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling API Shield schema validation settings for operation %q: %w", d.Id(), err))
+	}
+
+	d.Set("operation_id", d.Id())
+	if settings.MitigationAction != nil {
+		d.Set("mitigation_action", *settings.MitigationAction)
+	} else {
+		d.Set("mitigation_action", "")
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldOperationSchemaValidationSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	// Resetting the mitigation action makes the operation fall back to the
+	// zone default.
+	_, err := rawRequest(ctx, client, http.MethodPut, apiShieldOperationSchemaValidationSettingsURI(zoneID, d.Id()), apiShieldOperationSchemaValidationSettings{})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error resetting API Shield schema validation settings for operation %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldOperationSchemaValidationSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/operationID"`, d.Id())
+	}
+
+	zoneID, operationID := attributes[0], attributes[1]
+
+	d.SetId(operationID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareAPIShieldOperationSchemaValidationSettingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func apiShieldOperationSchemaValidationSettingsURI(zoneID, operationID string) string {
+	return fmt.Sprintf("/zones/%s/schema_validation/settings/operations/%s", zoneID, operationID)
+}
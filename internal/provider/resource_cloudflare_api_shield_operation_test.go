@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAPIShieldOperation_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_api_shield_operation.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPIShieldOperationConfig(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "method", "GET"),
+					resource.TestCheckResourceAttr(name, "host", domain),
+					resource.TestCheckResourceAttr(name, "endpoint", fmt.Sprintf("/%s/{var1}", rnd)),
+					resource.TestCheckResourceAttrSet(name, "last_updated"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAPIShieldOperationConfig(rnd, zoneID, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_api_shield_operation" "%[1]s" {
+  zone_id  = "%[2]s"
+  method   = "GET"
+  host     = "%[3]s"
+  endpoint = "/%[1]s/{var1}"
+}
+`, rnd, zoneID, domain)
+}
@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+var apiShieldEndpointParameter = regexp.MustCompile(`\{[^}]*\}`)
+
+type openAPIDocument struct {
+	OpenAPI string                            `yaml:"openapi"`
+	Servers []openAPIServer                   `yaml:"servers"`
+	Paths   map[string]map[string]interface{} `yaml:"paths"`
+}
+
+type openAPIServer struct {
+	URL       string `yaml:"url"`
+	Variables map[string]struct {
+		Default string `yaml:"default"`
+	} `yaml:"variables"`
+}
+
+func resourceCloudflareAPIShieldOperations() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAPIShieldOperationsSchema(),
+		CreateContext: resourceCloudflareAPIShieldOperationsCreate,
+		ReadContext:   resourceCloudflareAPIShieldOperationsRead,
+		UpdateContext: resourceCloudflareAPIShieldOperationsUpdate,
+		DeleteContext: resourceCloudflareAPIShieldOperationsDelete,
+		CustomizeDiff: resourceCloudflareAPIShieldOperationsDiff,
+		Description:   "Provides a resource to register every operation of an OpenAPI document in API Shield Endpoint Management. Operations that already exist in the zone are adopted rather than duplicated.",
+	}
+}
+
+func resourceCloudflareAPIShieldOperationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+
+	return resourceCloudflareAPIShieldOperationsUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldOperationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	existing, err := listAPIShieldOperations(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing API Shield operations: %w", err))
+	}
+
+	byID := make(map[string]apiShieldOperation, len(existing))
+	for _, op := range existing {
+		byID[op.OperationID] = op
+	}
+
+	var operations []apiShieldOperation
+	for _, op := range expandAPIShieldOperations(d.Get("operations").(*schema.Set)) {
+		if current, ok := byID[op.OperationID]; ok {
+			operations = append(operations, current)
+		}
+	}
+
+	if err := d.Set("operations", flattenAPIShieldOperations(operations)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting operations: %w", err))
+	}
+
+	return nil
+}
+
+// resourceCloudflareAPIShieldOperationsUpdate converges the registered
+// operations on the document: missing operations are created (or adopted
+// when the zone already has them) and operations previously registered by
+// the resource that are no longer in the document are deleted.
+func resourceCloudflareAPIShieldOperationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	desired, err := readAPIShieldOperationsDocument(d.Get("openapi_path").(string), d.Get("host").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existing, err := listAPIShieldOperations(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing API Shield operations: %w", err))
+	}
+
+	existingByKey := make(map[string]apiShieldOperation, len(existing))
+	existingByID := make(map[string]bool, len(existing))
+	for _, op := range existing {
+		existingByKey[apiShieldOperationKey(op)] = op
+		existingByID[op.OperationID] = true
+	}
+
+	desiredKeys := make(map[string]bool, len(desired))
+	var operations, missing []apiShieldOperation
+	for _, op := range desired {
+		key := apiShieldOperationKey(op)
+		desiredKeys[key] = true
+		if current, ok := existingByKey[key]; ok {
+			operations = append(operations, current)
+		} else {
+			missing = append(missing, op)
+		}
+	}
+
+	for _, op := range expandAPIShieldOperations(d.Get("operations").(*schema.Set)) {
+		if desiredKeys[apiShieldOperationKey(op)] || !existingByID[op.OperationID] {
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Deleting API Shield operation %s %s%s", op.Method, op.Host, op.Endpoint))
+
+		if _, err := rawRequest(ctx, client, http.MethodDelete, apiShieldOperationURI(zoneID, op.OperationID), nil); err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting API Shield operation %q: %w", op.OperationID, err))
+		}
+	}
+
+	if len(missing) > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Creating %d API Shield operations", len(missing)))
+
+		res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/api_gateway/operations", zoneID), missing)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error creating API Shield operations: %w", err))
+		}
+
+		var created []apiShieldOperation
+		if err := json.Unmarshal(res, &created); err != nil {
+			return diag.FromErr(fmt.Errorf("error unmarshalling API Shield operations: %w", err))
+		}
+		operations = append(operations, created...)
+	}
+
+	if err := d.Set("operations", flattenAPIShieldOperations(operations)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting operations: %w", err))
+	}
+
+	return resourceCloudflareAPIShieldOperationsRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldOperationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	for _, op := range expandAPIShieldOperations(d.Get("operations").(*schema.Set)) {
+		_, err := rawRequest(ctx, client, http.MethodDelete, apiShieldOperationURI(zoneID, op.OperationID), nil)
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("error deleting API Shield operation %q: %w", op.OperationID, err))
+		}
+	}
+
+	return nil
+}
+
+// resourceCloudflareAPIShieldOperationsDiff re-reads the document so that
+// edits to it, or operations removed outside of Terraform, show up as a diff
+// even though the path itself is unchanged.
+func resourceCloudflareAPIShieldOperationsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	desired, err := readAPIShieldOperationsDocument(d.Get("openapi_path").(string), d.Get("host").(string))
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]bool)
+	for _, op := range expandAPIShieldOperations(d.Get("operations").(*schema.Set)) {
+		current[apiShieldOperationKey(op)] = true
+	}
+
+	if len(current) != len(desired) {
+		return d.SetNewComputed("operations")
+	}
+	for _, op := range desired {
+		if !current[apiShieldOperationKey(op)] {
+			return d.SetNewComputed("operations")
+		}
+	}
+
+	return nil
+}
+
+func listAPIShieldOperations(ctx context.Context, client *apiClient, zoneID string) ([]apiShieldOperation, error) {
+	var operations []apiShieldOperation
+
+	for page := 1; ; page++ {
+		uri := fmt.Sprintf("/zones/%s/api_gateway/operations?per_page=100&page=%d", zoneID, page)
+
+		res, resultInfo, err := rawRequestWithResultInfo(ctx, client, http.MethodGet, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		var results []apiShieldOperation
+		if err := json.Unmarshal(res, &results); err != nil {
+			return nil, fmt.Errorf("error unmarshalling API Shield operations: %w", err)
+		}
+		operations = append(operations, results...)
+
+		if len(results) == 0 || page >= resultInfo.TotalPages {
+			return operations, nil
+		}
+	}
+}
+
+func readAPIShieldOperationsDocument(path, host string) ([]apiShieldOperation, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read OpenAPI document %q: %w", path, err)
+	}
+
+	operations, err := apiShieldOperationsFromOpenAPI(content, host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document %q: %w", path, err)
+	}
+
+	return operations, nil
+}
+
+// apiShieldOperationsFromOpenAPI returns the operations of an OpenAPI v3
+// document, in JSON or YAML, once for each of its servers. host replaces the
+// host of every server when set.
+func apiShieldOperationsFromOpenAPI(content []byte, host string) ([]apiShieldOperation, error) {
+	var doc openAPIDocument
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("only OpenAPI 3 documents are supported")
+	}
+
+	type server struct{ host, basePath string }
+	var servers []server
+	for _, s := range doc.Servers {
+		raw := s.URL
+		for name, variable := range s.Variables {
+			raw = strings.ReplaceAll(raw, "{"+name+"}", variable.Default)
+		}
+
+		u, err := url.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid server URL %q: %w", s.URL, err)
+		}
+
+		serverHost := u.Hostname()
+		if host != "" {
+			serverHost = host
+		}
+		if serverHost == "" {
+			return nil, fmt.Errorf("server URL %q has no host, set host to register its operations", s.URL)
+		}
+
+		servers = append(servers, server{host: strings.ToLower(serverHost), basePath: strings.TrimSuffix(u.Path, "/")})
+	}
+
+	if len(servers) == 0 {
+		if host == "" {
+			return nil, fmt.Errorf("document has no servers, set host to register its operations")
+		}
+		servers = append(servers, server{host: strings.ToLower(host)})
+	}
+
+	seen := make(map[string]bool)
+	var operations []apiShieldOperation
+	for path, item := range doc.Paths {
+		for method := range item {
+			if !contains(apiShieldOperationMethods, strings.ToUpper(method)) {
+				continue
+			}
+
+			for _, s := range servers {
+				op := apiShieldOperation{
+					Method:   strings.ToUpper(method),
+					Host:     s.host,
+					Endpoint: s.basePath + path,
+				}
+
+				key := apiShieldOperationKey(op)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				operations = append(operations, op)
+			}
+		}
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		return apiShieldOperationKey(operations[i]) < apiShieldOperationKey(operations[j])
+	})
+
+	return operations, nil
+}
+
+// apiShieldOperationKey identifies an operation by method, host and
+// endpoint. Path parameters are numbered the way the API normalizes them so
+// that operations from a document match the registered ones.
+func apiShieldOperationKey(op apiShieldOperation) string {
+	n := 0
+	endpoint := apiShieldEndpointParameter.ReplaceAllStringFunc(op.Endpoint, func(string) string {
+		n++
+		return fmt.Sprintf("{var%d}", n)
+	})
+
+	return fmt.Sprintf("%s %s%s", strings.ToUpper(op.Method), strings.ToLower(op.Host), endpoint)
+}
+
+func expandAPIShieldOperations(set *schema.Set) []apiShieldOperation {
+	var operations []apiShieldOperation
+	for _, item := range set.List() {
+		op := item.(map[string]interface{})
+		operations = append(operations, apiShieldOperation{
+			OperationID: op["operation_id"].(string),
+			Method:      op["method"].(string),
+			Host:        op["host"].(string),
+			Endpoint:    op["endpoint"].(string),
+		})
+	}
+
+	return operations
+}
+
+func flattenAPIShieldOperations(operations []apiShieldOperation) []interface{} {
+	result := make([]interface{}, 0, len(operations))
+	for _, op := range operations {
+		result = append(result, map[string]interface{}{
+			"operation_id": op.OperationID,
+			"method":       op.Method,
+			"host":         op.Host,
+			"endpoint":     op.Endpoint,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIShieldOperationsFromOpenAPI(t *testing.T) {
+	yamlDoc := `
+openapi: 3.0.0
+servers:
+  - url: https://{region}.example.com/v1/
+    variables:
+      region:
+        default: eu
+paths:
+  /users/{userId}:
+    parameters:
+      - name: userId
+        in: path
+    get: {}
+    delete: {}
+  /users:
+    post: {}
+`
+	operations, err := apiShieldOperationsFromOpenAPI([]byte(yamlDoc), "")
+	assert.NoError(t, err)
+	assert.Equal(t, []apiShieldOperation{
+		{Method: "DELETE", Host: "eu.example.com", Endpoint: "/v1/users/{userId}"},
+		{Method: "GET", Host: "eu.example.com", Endpoint: "/v1/users/{userId}"},
+		{Method: "POST", Host: "eu.example.com", Endpoint: "/v1/users"},
+	}, operations)
+
+	jsonDoc := `{"openapi": "3.1.0", "servers": [{"url": "/api"}], "paths": {"/items": {"get": {}}}}`
+	operations, err = apiShieldOperationsFromOpenAPI([]byte(jsonDoc), "API.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []apiShieldOperation{
+		{Method: "GET", Host: "api.example.com", Endpoint: "/api/items"},
+	}, operations)
+
+	_, err = apiShieldOperationsFromOpenAPI([]byte(jsonDoc), "")
+	assert.Error(t, err)
+
+	_, err = apiShieldOperationsFromOpenAPI([]byte(`{"swagger": "2.0", "paths": {}}`), "example.com")
+	assert.Error(t, err)
+}
+
+func TestAPIShieldOperationKey(t *testing.T) {
+	fromDocument := apiShieldOperation{Method: "get", Host: "API.example.com", Endpoint: "/users/{userId}/posts/{postId}"}
+	registered := apiShieldOperation{Method: "GET", Host: "api.example.com", Endpoint: "/users/{var1}/posts/{var2}"}
+
+	assert.Equal(t, apiShieldOperationKey(registered), apiShieldOperationKey(fromDocument))
+	assert.Equal(t, "GET api.example.com/users/{var1}/posts/{var2}", apiShieldOperationKey(fromDocument))
+}
+
+func TestAccCloudflareAPIShieldOperations_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_api_shield_operations.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	path := filepath.Join(t.TempDir(), "openapi.yaml")
+
+	writeDocument := func(paths string) func() {
+		return func() {
+			doc := fmt.Sprintf("openapi: 3.0.0\nservers:\n  - url: https://%s/%s\npaths:\n%s", domain, rnd, paths)
+			if err := ioutil.WriteFile(path, []byte(doc), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: writeDocument("  /users:\n    get: {}\n    post: {}\n  /users/{id}:\n    get: {}\n"),
+				Config:    testAccCloudflareAPIShieldOperationsConfig(rnd, zoneID, path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "operations.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "operations.*", map[string]string{
+						"method":   "GET",
+						"host":     domain,
+						"endpoint": fmt.Sprintf("/%s/users/{var1}", rnd),
+					}),
+				),
+			},
+			{
+				PreConfig: writeDocument("  /users:\n    get: {}\n"),
+				Config:    testAccCloudflareAPIShieldOperationsConfig(rnd, zoneID, path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "operations.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "operations.*", map[string]string{
+						"method":   "GET",
+						"endpoint": fmt.Sprintf("/%s/users", rnd),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAPIShieldOperationsConfig(rnd, zoneID, path string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_api_shield_operations" "%[1]s" {
+  zone_id      = "%[2]s"
+  openapi_path = "%[3]s"
+}
+`, rnd, zoneID, path)
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type apiShieldSchema struct {
+	SchemaID          string `json:"schema_id,omitempty"`
+	Name              string `json:"name"`
+	Kind              string `json:"kind"`
+	Source            string `json:"source"`
+	ValidationEnabled bool   `json:"validation_enabled"`
+	CreatedAt         string `json:"created_at,omitempty"`
+}
+
+func resourceCloudflareAPIShieldSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAPIShieldSchemaSchema(),
+		CreateContext: resourceCloudflareAPIShieldSchemaCreate,
+		ReadContext:   resourceCloudflareAPIShieldSchemaRead,
+		UpdateContext: resourceCloudflareAPIShieldSchemaUpdate,
+		DeleteContext: resourceCloudflareAPIShieldSchemaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAPIShieldSchemaImport,
+		},
+		Description: "Provides a resource to manage a schema used by API Shield Schema Validation.",
+	}
+}
+
+func resourceCloudflareAPIShieldSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	s := apiShieldSchema{
+		Name:              d.Get("name").(string),
+		Kind:              d.Get("kind").(string),
+		Source:            d.Get("source").(string),
+		ValidationEnabled: d.Get("validation_enabled").(bool),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Uploading API Shield schema %q", s.Name))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/schema_validation/schemas", zoneID), s)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error uploading API Shield schema %q: %w", s.Name, err))
+	}
+
+	var created apiShieldSchema
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling API Shield schema %q: %w", s.Name, err))
+	}
+
+	d.SetId(created.SchemaID)
+
+	return resourceCloudflareAPIShieldSchemaRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, apiShieldSchemaURI(zoneID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("API Shield schema %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error fetching API Shield schema %q: %w", d.Id(), err))
+	}
+
+	var s apiShieldSchema
+	if err := json.Unmarshal(res, &s); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling API Shield schema %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", s.Name)
+	d.Set("kind", s.Kind)
+	d.Set("validation_enabled", s.ValidationEnabled)
+	d.Set("created_at", s.CreatedAt)
+
+	// The API may reformat the uploaded document so the configured source is
+	// kept unless there is none in state, such as after an import.
+	if d.Get("source").(string) == "" {
+		d.Set("source", s.Source)
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	params := map[string]interface{}{
+		"validation_enabled": d.Get("validation_enabled").(bool),
+	}
+
+	_, err := rawRequest(ctx, client, http.MethodPatch, apiShieldSchemaURI(zoneID, d.Id()), params)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating API Shield schema %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareAPIShieldSchemaRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, apiShieldSchemaURI(zoneID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting API Shield schema %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldSchemaImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/schemaID"`, d.Id())
+	}
+
+	zoneID, schemaID := attributes[0], attributes[1]
+
+	d.SetId(schemaID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareAPIShieldSchemaRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func apiShieldSchemaURI(zoneID, schemaID string) string {
+	return fmt.Sprintf("/zones/%s/schema_validation/schemas/%s", zoneID, schemaID)
+}
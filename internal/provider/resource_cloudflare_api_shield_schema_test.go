@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAPIShieldSchema_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_api_shield_schema.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPIShieldSchemaConfig(rnd, zoneID, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "kind", "openapi_v3"),
+					resource.TestCheckResourceAttr(name, "validation_enabled", "false"),
+					resource.TestCheckResourceAttrSet(name, "created_at"),
+				),
+			},
+			{
+				Config: testAccCloudflareAPIShieldSchemaConfig(rnd, zoneID, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "validation_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", zoneID),
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAPIShieldOperationSchemaValidationSettings_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_api_shield_operation_schema_validation_settings.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPIShieldOperationSchemaValidationSettingsConfig(rnd, zoneID, domain, "log"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "operation_id", fmt.Sprintf("cloudflare_api_shield_operation.%s", rnd), "id"),
+					resource.TestCheckResourceAttr(name, "mitigation_action", "log"),
+				),
+			},
+			{
+				Config: testAccCloudflareAPIShieldOperationSchemaValidationSettingsConfig(rnd, zoneID, domain, "block"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "mitigation_action", "block"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAPIShieldSchemaConfig(rnd, zoneID, domain string, validationEnabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_api_shield_schema" "%[1]s" {
+  zone_id            = "%[2]s"
+  name               = "%[1]s"
+  validation_enabled = %[4]t
+  source = jsonencode({
+    openapi = "3.0.0"
+    info    = { title = "%[1]s", version = "1.0.0" }
+    servers = [{ url = "https://%[3]s" }]
+    paths = {
+      "/%[1]s/{id}" = {
+        get = {
+          parameters = [{ name = "id", in = "path", required = true, schema = { type = "integer" } }]
+          responses  = { "200" = { description = "OK" } }
+        }
+      }
+    }
+  })
+}
+`, rnd, zoneID, domain, validationEnabled)
+}
+
+func testAccCloudflareAPIShieldOperationSchemaValidationSettingsConfig(rnd, zoneID, domain, action string) string {
+	return testAccCloudflareAPIShieldOperationConfig(rnd, zoneID, domain) + fmt.Sprintf(`
+resource "cloudflare_api_shield_operation_schema_validation_settings" "%[1]s" {
+  zone_id           = "%[2]s"
+  operation_id      = cloudflare_api_shield_operation.%[1]s.id
+  mitigation_action = "%[3]s"
+}
+`, rnd, zoneID, action)
+}
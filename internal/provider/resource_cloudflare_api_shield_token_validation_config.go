@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type apiShieldTokenValidationConfig struct {
+	ID           string                               `json:"id,omitempty"`
+	Title        string                               `json:"title"`
+	Description  string                               `json:"description"`
+	TokenType    string                               `json:"token_type,omitempty"`
+	TokenSources []string                             `json:"token_sources"`
+	Credentials  *apiShieldTokenValidationCredentials `json:"credentials,omitempty"`
+	CreatedAt    string                               `json:"created_at,omitempty"`
+	LastUpdated  string                               `json:"last_updated,omitempty"`
+}
+
+type apiShieldTokenValidationCredentials struct {
+	Keys []apiShieldTokenValidationKey `json:"keys"`
+}
+
+type apiShieldTokenValidationKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+func resourceCloudflareAPIShieldTokenValidationConfig() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAPIShieldTokenValidationConfigSchema(),
+		CreateContext: resourceCloudflareAPIShieldTokenValidationConfigCreate,
+		ReadContext:   resourceCloudflareAPIShieldTokenValidationConfigRead,
+		UpdateContext: resourceCloudflareAPIShieldTokenValidationConfigUpdate,
+		DeleteContext: resourceCloudflareAPIShieldTokenValidationConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAPIShieldTokenValidationConfigImport,
+		},
+		Description: "Provides a resource to manage an API Shield token validation configuration, which describes where JWTs are found in requests and the keys used to verify them.",
+	}
+}
+
+func resourceCloudflareAPIShieldTokenValidationConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	config, err := buildAPIShieldTokenValidationConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating API Shield token validation config %q", config.Title))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/token_validation/config", zoneID), config)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating API Shield token validation config %q: %w", config.Title, err))
+	}
+
+	var created apiShieldTokenValidationConfig
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling API Shield token validation config %q: %w", config.Title, err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareAPIShieldTokenValidationConfigRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldTokenValidationConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, apiShieldTokenValidationConfigURI(zoneID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("API Shield token validation config %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error fetching API Shield token validation config %q: %w", d.Id(), err))
+	}
+
+	var config apiShieldTokenValidationConfig
+	if err := json.Unmarshal(res, &config); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling API Shield token validation config %q: %w", d.Id(), err))
+	}
+
+	d.Set("title", config.Title)
+	d.Set("description", config.Description)
+	d.Set("token_type", config.TokenType)
+	d.Set("token_sources", config.TokenSources)
+	d.Set("created_at", config.CreatedAt)
+	d.Set("last_updated", config.LastUpdated)
+
+	if err := d.Set("credentials", flattenAPIShieldTokenValidationCredentials(config.Credentials)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set credentials: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldTokenValidationConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	config, err := buildAPIShieldTokenValidationConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("title", "description", "token_sources") {
+		params := map[string]interface{}{
+			"title":         config.Title,
+			"description":   config.Description,
+			"token_sources": config.TokenSources,
+		}
+
+		if _, err := rawRequest(ctx, client, http.MethodPatch, apiShieldTokenValidationConfigURI(zoneID, d.Id()), params); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating API Shield token validation config %q: %w", d.Id(), err))
+		}
+	}
+
+	// Keys are replaced through a dedicated endpoint so that rotating a key
+	// does not require recreating the configuration and its rules.
+	if d.HasChange("credentials") {
+		if _, err := rawRequest(ctx, client, http.MethodPut, apiShieldTokenValidationConfigURI(zoneID, d.Id())+"/credentials", config.Credentials); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating API Shield token validation config %q credentials: %w", d.Id(), err))
+		}
+	}
+
+	return resourceCloudflareAPIShieldTokenValidationConfigRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldTokenValidationConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, apiShieldTokenValidationConfigURI(zoneID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting API Shield token validation config %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldTokenValidationConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/configID"`, d.Id())
+	}
+
+	zoneID, configID := attributes[0], attributes[1]
+
+	d.SetId(configID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareAPIShieldTokenValidationConfigRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildAPIShieldTokenValidationConfig(d *schema.ResourceData) (apiShieldTokenValidationConfig, error) {
+	config := apiShieldTokenValidationConfig{
+		Title:        d.Get("title").(string),
+		Description:  d.Get("description").(string),
+		TokenType:    d.Get("token_type").(string),
+		TokenSources: expandInterfaceToStringList(d.Get("token_sources").([]interface{})),
+		Credentials:  &apiShieldTokenValidationCredentials{},
+	}
+
+	for _, k := range d.Get("credentials.0.key").([]interface{}) {
+		key := k.(map[string]interface{})
+		jwk := apiShieldTokenValidationKey{
+			Kid: key["kid"].(string),
+			Kty: key["kty"].(string),
+			Alg: key["alg"].(string),
+			N:   key["n"].(string),
+			E:   key["e"].(string),
+			Crv: key["crv"].(string),
+			X:   key["x"].(string),
+			Y:   key["y"].(string),
+		}
+
+		if err := validateAPIShieldTokenValidationKey(jwk); err != nil {
+			return config, err
+		}
+
+		config.Credentials.Keys = append(config.Credentials.Keys, jwk)
+	}
+
+	return config, nil
+}
+
+// validateAPIShieldTokenValidationKey checks that a key has the parameters
+// required by its key type and uses an algorithm matching that type.
+func validateAPIShieldTokenValidationKey(key apiShieldTokenValidationKey) error {
+	switch key.Kty {
+	case "RSA":
+		if key.N == "" || key.E == "" {
+			return fmt.Errorf("key %q: `n` and `e` are required for RSA keys", key.Kid)
+		}
+		if !strings.HasPrefix(key.Alg, "RS") && !strings.HasPrefix(key.Alg, "PS") {
+			return fmt.Errorf("key %q: algorithm %q cannot be used with RSA keys", key.Kid, key.Alg)
+		}
+	case "EC":
+		if key.Crv == "" || key.X == "" || key.Y == "" {
+			return fmt.Errorf("key %q: `crv`, `x` and `y` are required for EC keys", key.Kid)
+		}
+		if !strings.HasPrefix(key.Alg, "ES") {
+			return fmt.Errorf("key %q: algorithm %q cannot be used with EC keys", key.Kid, key.Alg)
+		}
+	}
+
+	return nil
+}
+
+func flattenAPIShieldTokenValidationCredentials(credentials *apiShieldTokenValidationCredentials) []map[string]interface{} {
+	if credentials == nil {
+		return nil
+	}
+
+	keys := make([]map[string]interface{}, 0, len(credentials.Keys))
+	for _, key := range credentials.Keys {
+		keys = append(keys, map[string]interface{}{
+			"kid": key.Kid,
+			"kty": key.Kty,
+			"alg": key.Alg,
+			"n":   key.N,
+			"e":   key.E,
+			"crv": key.Crv,
+			"x":   key.X,
+			"y":   key.Y,
+		})
+	}
+
+	return []map[string]interface{}{{"key": keys}}
+}
+
+func apiShieldTokenValidationConfigURI(zoneID, configID string) string {
+	return fmt.Sprintf("/zones/%s/token_validation/config/%s", zoneID, configID)
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestValidateAPIShieldTokenValidationKey(t *testing.T) {
+	testCases := map[string]struct {
+		key     apiShieldTokenValidationKey
+		wantErr bool
+	}{
+		"valid RSA key":        {apiShieldTokenValidationKey{Kid: "a", Kty: "RSA", Alg: "RS256", N: "n", E: "AQAB"}, false},
+		"valid RSA-PSS key":    {apiShieldTokenValidationKey{Kid: "a", Kty: "RSA", Alg: "PS384", N: "n", E: "AQAB"}, false},
+		"RSA key missing e":    {apiShieldTokenValidationKey{Kid: "a", Kty: "RSA", Alg: "RS256", N: "n"}, true},
+		"RSA key with EC alg":  {apiShieldTokenValidationKey{Kid: "a", Kty: "RSA", Alg: "ES256", N: "n", E: "AQAB"}, true},
+		"valid EC key":         {apiShieldTokenValidationKey{Kid: "a", Kty: "EC", Alg: "ES256", Crv: "P-256", X: "x", Y: "y"}, false},
+		"EC key missing curve": {apiShieldTokenValidationKey{Kid: "a", Kty: "EC", Alg: "ES256", X: "x", Y: "y"}, true},
+		"EC key with RSA alg":  {apiShieldTokenValidationKey{Kid: "a", Kty: "EC", Alg: "RS256", Crv: "P-256", X: "x", Y: "y"}, true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateAPIShieldTokenValidationKey(tc.key)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateAPIShieldTokenValidationKey() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccCloudflareAPIShieldTokenValidationConfig_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_api_shield_token_validation_config.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPIShieldTokenValidationConfig(rnd, zoneID, "authorization"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "title", rnd),
+					resource.TestCheckResourceAttr(name, "token_type", "JWT"),
+					resource.TestCheckResourceAttr(name, "token_sources.#", "1"),
+					resource.TestCheckResourceAttr(name, "token_sources.0", `http.request.headers["authorization"][0]`),
+					resource.TestCheckResourceAttr(name, "credentials.0.key.#", "1"),
+					resource.TestCheckResourceAttr(name, "credentials.0.key.0.kid", "2011-04-29"),
+					resource.TestCheckResourceAttrSet(name, "created_at"),
+				),
+			},
+			{
+				Config: testAccCloudflareAPIShieldTokenValidationConfig(rnd, zoneID, "x-auth"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "token_sources.0", `http.request.headers["x-auth"][0]`),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAPIShieldTokenValidationConfig(rnd, zoneID, header string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_api_shield_token_validation_config" "%[1]s" {
+  zone_id       = "%[2]s"
+  title         = "%[1]s"
+  description   = "Managed by Terraform"
+  token_sources = ["http.request.headers[\"%[3]s\"][0]"]
+
+  credentials {
+    key {
+      kid = "2011-04-29"
+      kty = "RSA"
+      alg = "RS256"
+      e   = "AQAB"
+      n   = "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw"
+    }
+  }
+}
+`, rnd, zoneID, header)
+}
@@ -65,7 +65,7 @@ func buildAPIToken(d *schema.ResourceData) cloudflare.APIToken {
 }
 
 func resourceCloudflareApiTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	name := d.Get("name").(string)
 
@@ -124,7 +124,7 @@ func resourceDataToApiTokenPolices(d *schema.ResourceData) []cloudflare.APIToken
 }
 
 func resourceCloudflareApiTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	tokenID := d.Id()
 
 	t, err := client.GetAPIToken(ctx, tokenID)
@@ -184,7 +184,7 @@ func resourceCloudflareApiTokenRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCloudflareApiTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	name := d.Get("name").(string)
 	tokenID := d.Id()
@@ -202,7 +202,7 @@ func resourceCloudflareApiTokenUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCloudflareApiTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	tokenID := d.Id()
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare API Token: id %s", tokenID))
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,7 +25,7 @@ func resourceCloudflareArgo() *schema.Resource {
 }
 
 func resourceCloudflareArgoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	tieredCaching := d.Get("tiered_caching").(string)
 	smartRouting := d.Get("smart_routing").(string)
@@ -59,7 +58,7 @@ func resourceCloudflareArgoRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceCloudflareArgoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	tieredCaching := d.Get("tiered_caching").(string)
 	smartRouting := d.Get("smart_routing").(string)
@@ -84,7 +83,7 @@ func resourceCloudflareArgoUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceCloudflareArgoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	tflog.Debug(ctx, fmt.Sprintf("Resetting Argo values to 'off'"))
@@ -27,7 +27,7 @@ func resourceCloudflareArgoTunnel() *schema.Resource {
 }
 
 func resourceCloudflareArgoTunnelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accID := d.Get("account_id").(string)
 	name := d.Get("name").(string)
 	secret := d.Get("secret").(string)
@@ -43,7 +43,7 @@ func resourceCloudflareArgoTunnelCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareArgoTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accID := d.Get("account_id").(string)
 
 	tunnel, err := client.ArgoTunnel(ctx, accID, d.Id())
@@ -69,7 +69,7 @@ func resourceCloudflareArgoTunnelRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCloudflareArgoTunnelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accID := d.Get("account_id").(string)
 
 	cleanupErr := client.CleanupArgoTunnelConnections(ctx, accID, d.Id())
@@ -88,7 +88,7 @@ func resourceCloudflareArgoTunnelDelete(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareArgoTunnelImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	attributes := strings.Split(d.Id(), "/")
 
 	if len(attributes) != 2 {
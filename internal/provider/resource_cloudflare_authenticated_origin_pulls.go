@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -21,11 +24,16 @@ func resourceCloudflareAuthenticatedOriginPulls() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareAuthenticatedOriginPullsImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Description: "Provides a Cloudflare Authenticated Origin Pulls resource. The arguments that are set determine whether Authenticated Origin Pulls is configured globally for the zone, with a Per-Zone certificate or with a Per-Hostname certificate.",
 	}
 }
 
 func resourceCloudflareAuthenticatedOriginPullsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	hostname := d.Get("hostname").(string)
 	aopCert := d.Get("authenticated_origin_pulls_certificate").(string)
@@ -45,6 +53,33 @@ func resourceCloudflareAuthenticatedOriginPullsCreate(ctx context.Context, d *sc
 		}
 		checksum = stringChecksum(fmt.Sprintf("PerHostnameAOP/%s/%s/%s", zoneID, hostname, aopCert))
 
+		if isEnabled.(bool) {
+			timeout := d.Timeout(schema.TimeoutCreate)
+			if !d.IsNewResource() {
+				timeout = d.Timeout(schema.TimeoutUpdate)
+			}
+
+			err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+				res, err := client.GetPerHostnameAuthenticatedOriginPullsConfig(ctx, zoneID, hostname)
+				if err != nil {
+					return resource.NonRetryableError(fmt.Errorf("error reading Per-Hostname Authenticated Origin Pulls setting: %w", err))
+				}
+
+				active, err := authenticatedOriginPullsStatusReached(res.Status)
+				if err != nil {
+					return resource.NonRetryableError(fmt.Errorf("Per-Hostname Authenticated Origin Pulls on %q %w", hostname, err))
+				}
+				if !active {
+					return resource.RetryableError(fmt.Errorf("expected Per-Hostname Authenticated Origin Pulls on %q to be active but was in state %s", hostname, res.Status))
+				}
+
+				return nil
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
 	case aopCert != "":
 		// Per Zone AOP
 		_, err := client.SetPerZoneAuthenticatedOriginPullsStatus(ctx, zoneID, isEnabled.(bool))
@@ -67,7 +102,7 @@ func resourceCloudflareAuthenticatedOriginPullsCreate(ctx context.Context, d *sc
 }
 
 func resourceCloudflareAuthenticatedOriginPullsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	hostname := d.Get("hostname").(string)
 	aopCert := d.Get("authenticated_origin_pulls_certificate").(string)
@@ -76,9 +111,15 @@ func resourceCloudflareAuthenticatedOriginPullsRead(ctx context.Context, d *sche
 		// Per Hostname AOP
 		res, err := client.GetPerHostnameAuthenticatedOriginPullsConfig(ctx, zoneID, hostname)
 		if err != nil {
+			if isNotFoundError(err) {
+				tflog.Info(ctx, fmt.Sprintf("Per-Hostname Authenticated Origin Pulls on %s no longer exists", hostname))
+				d.SetId("")
+				return nil
+			}
 			return diag.FromErr(errors.Wrap(err, "failed to get Per-Hostname Authenticated Origin Pulls setting"))
 		}
 		d.Set("enabled", res.Enabled)
+		d.Set("status", res.Status)
 	} else if aopCert != "" {
 		// Per Zone AOP
 		res, err := client.GetPerZoneAuthenticatedOriginPullsStatus(ctx, zoneID)
@@ -102,7 +143,7 @@ func resourceCloudflareAuthenticatedOriginPullsRead(ctx context.Context, d *sche
 }
 
 func resourceCloudflareAuthenticatedOriginPullsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	hostname := d.Get("hostname").(string)
 	aopCert := d.Get("authenticated_origin_pulls_certificate").(string)
@@ -13,6 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// authenticatedOriginPullsFailedStatuses are the statuses certificates and
+// Per-Hostname configurations don't recover from without being re-created.
+var authenticatedOriginPullsFailedStatuses = []string{"pending_deletion", "deleted", "deployment_timed_out", "deletion_timed_out"}
+
 func resourceCloudflareAuthenticatedOriginPullsCertificate() *schema.Resource {
 	return &schema.Resource{
 		// You cannot edit AOP certificates, rather, only upload new ones.
@@ -27,11 +31,12 @@ func resourceCloudflareAuthenticatedOriginPullsCertificate() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
 		},
+		Description: "Provides a Cloudflare Authenticated Origin Pulls certificate resource. An uploaded client certificate is required to use Per-Zone or Per-Hostname Authenticated Origin Pulls.",
 	}
 }
 
 func resourceCloudflareAuthenticatedOriginPullsCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	switch aopType, ok := d.GetOk("type"); ok {
@@ -52,7 +57,11 @@ func resourceCloudflareAuthenticatedOriginPullsCertificateCreate(ctx context.Con
 				return resource.NonRetryableError(fmt.Errorf("error reading Per Zone AOP certificate details: %w", err))
 			}
 
-			if resp.Status != "active" {
+			active, err := authenticatedOriginPullsStatusReached(resp.Status)
+			if err != nil {
+				return resource.NonRetryableError(fmt.Errorf("Per Zone AOP certificate %w", err))
+			}
+			if !active {
 				return resource.RetryableError(fmt.Errorf("expected Per Zone AOP certificate to be active but was in state %s", resp.Status))
 			}
 
@@ -83,7 +92,11 @@ func resourceCloudflareAuthenticatedOriginPullsCertificateCreate(ctx context.Con
 				return resource.NonRetryableError(fmt.Errorf("error reading Per Hostname AOP certificate details: %w", err))
 			}
 
-			if resp.Status != "active" {
+			active, err := authenticatedOriginPullsStatusReached(resp.Status)
+			if err != nil {
+				return resource.NonRetryableError(fmt.Errorf("Per Hostname AOP certificate %w", err))
+			}
+			if !active {
 				return resource.RetryableError(fmt.Errorf("expected Per Hostname AOP certificate to be active but was in state %s", resp.Status))
 			}
 
@@ -101,7 +114,7 @@ func resourceCloudflareAuthenticatedOriginPullsCertificateCreate(ctx context.Con
 }
 
 func resourceCloudflareAuthenticatedOriginPullsCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	certID := d.Id()
 
@@ -142,7 +155,7 @@ func resourceCloudflareAuthenticatedOriginPullsCertificateRead(ctx context.Conte
 }
 
 func resourceCloudflareAuthenticatedOriginPullsCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	certID := d.Id()
 
@@ -176,3 +189,13 @@ func resourceCloudflareAuthenticatedOriginPullsCertificateImport(ctx context.Con
 	resourceCloudflareAuthenticatedOriginPullsCertificateRead(ctx, d, meta)
 	return []*schema.ResourceData{d}, nil
 }
+
+// authenticatedOriginPullsStatusReached reports whether status is active and
+// returns an error for statuses that will never become active.
+func authenticatedOriginPullsStatusReached(status string) (bool, error) {
+	if contains(authenticatedOriginPullsFailedStatuses, status) {
+		return false, fmt.Errorf("deployment failed with status %s", status)
+	}
+
+	return status == "active", nil
+}
@@ -111,7 +111,7 @@ func testAccCheckCloudflareAuthenticatedOriginPullsCertificatePerZoneExists(n st
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No cert ID is set")
 		}
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundPerZoneAOPCert, err := client.GetPerZoneAuthenticatedOriginPullsCertificateDetails(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
 		if err != nil {
 			return err
@@ -133,7 +133,7 @@ func testAccCheckCloudflareAuthenticatedOriginPullsCertificatePerHostnameExists(
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No cert ID is set")
 		}
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundPerHostnameAOPCert, err := client.GetPerHostnameAuthenticatedOriginPullsCertificate(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
 		if err != nil {
 			return err
@@ -157,7 +157,7 @@ func testAccCheckCloudflareAuthenticatedOriginPullsCertificateConfig(zoneID, nam
 }
 
 func testAccCheckCloudflareAuthenticatedOriginPullsCertificateDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 	for _, rs := range s.RootModule().Resources {
 		if rs.Primary.Attributes["type"] == "per-zone" {
 			_, err := client.DeletePerZoneAuthenticatedOriginPullsCertificate(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
@@ -173,3 +173,28 @@ func testAccCheckCloudflareAuthenticatedOriginPullsCertificateDestroy(s *terrafo
 	}
 	return nil
 }
+
+func TestAuthenticatedOriginPullsStatusReached(t *testing.T) {
+	tests := map[string]struct {
+		status   string
+		expected bool
+		wantErr  bool
+	}{
+		"pending":   {status: "pending_deployment", expected: false},
+		"active":    {status: "active", expected: true},
+		"timed out": {status: "deployment_timed_out", wantErr: true},
+		"deleted":   {status: "deleted", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := authenticatedOriginPullsStatusReached(tc.status)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %t, got %v", tc.wantErr, err)
+			}
+			if got != tc.expected {
+				t.Fatalf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -62,6 +62,7 @@ func TestAccCloudflareAuthenticatedOriginPullsPerHostname(t *testing.T) {
 				Config: testAccCheckCloudflareAuthenticatedOriginPullsConfig(zoneID, rnd, "per-hostname", hostname),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "status", "active"),
 				),
 			},
 		},
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -44,7 +43,7 @@ func resourceCloudflareBYOIPPrefixImport(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareBYOIPPrefixRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	prefix, err := client.GetPrefix(ctx, accountID, d.Id())
@@ -65,7 +64,7 @@ func resourceCloudflareBYOIPPrefixRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareBYOIPPrefixUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	if _, ok := d.GetOk("description"); ok && d.HasChange("description") {
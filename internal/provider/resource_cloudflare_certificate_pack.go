@@ -2,8 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"reflect"
+	"net/http"
 	"strings"
 	"time"
 
@@ -15,20 +16,40 @@ import (
 	"github.com/pkg/errors"
 )
 
+// certificatePackFailedStatuses are the statuses a certificate pack cannot
+// recover from without being replaced.
+var certificatePackFailedStatuses = []string{"validation_timed_out", "issuance_timed_out", "deployment_timed_out", "deletion_timed_out", "expired", "deleted"}
+
+// certificatePack is a certificate pack along with the attributes of
+// advanced certificate packs that cloudflare-go does not expose.
+type certificatePack struct {
+	cloudflare.CertificatePack
+	Status               string `json:"status"`
+	ValidationMethod     string `json:"validation_method"`
+	ValidityDays         int    `json:"validity_days"`
+	CertificateAuthority string `json:"certificate_authority"`
+	CloudflareBranding   bool   `json:"cloudflare_branding"`
+}
+
 func resourceCloudflareCertificatePack() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareCertificatePackSchema(),
 		CreateContext: resourceCloudflareCertificatePackCreate,
 		ReadContext:   resourceCloudflareCertificatePackRead,
 		DeleteContext: resourceCloudflareCertificatePackDelete,
+		CustomizeDiff: resourceCloudflareCertificatePackDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareCertificatePackImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Description: "Provides a Cloudflare Certificate Pack resource that is used to provision managed TLS certificates, including Advanced Certificate Manager certificates. Certificate packs cannot be updated in place and are replaced on changes.",
 	}
 }
 
 func resourceCloudflareCertificatePackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	certificatePackType := d.Get("type").(string)
 	certificateHostSet := d.Get("hosts").(*schema.Set)
@@ -82,6 +103,27 @@ func resourceCloudflareCertificatePackCreate(ctx context.Context, d *schema.Reso
 			return nil
 		})
 
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if certificatePackType == "advanced" {
+		// Validation records are added asynchronously, wait for them so that
+		// they can be used to create the validation DNS records in the same
+		// apply.
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *resource.RetryError {
+			certificatePack, err := fetchCertificatePack(ctx, client, zoneID, certificatePackID)
+			if err != nil {
+				return resource.NonRetryableError(errors.Wrap(err, "failed to fetch certificate pack"))
+			}
+			if contains(certificatePackFailedStatuses, certificatePack.Status) {
+				return resource.NonRetryableError(fmt.Errorf("certificate pack %s failed with status %s", certificatePackID, certificatePack.Status))
+			}
+			if len(certificatePack.ValidationRecords) == 0 && certificatePack.Status != "active" {
+				return resource.RetryableError(fmt.Errorf("expected validation records for certificate pack %s but it was in state %s", certificatePackID, certificatePack.Status))
+			}
+			return nil
+		})
+
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -93,46 +135,56 @@ func resourceCloudflareCertificatePackCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceCloudflareCertificatePackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
-	certificatePack, err := client.CertificatePack(ctx, zoneID, d.Id())
+	certificatePack, err := fetchCertificatePack(ctx, client, zoneID, d.Id())
 	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Certificate pack %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(errors.Wrap(err, "failed to fetch certificate pack"))
 	}
 
 	d.Set("type", certificatePack.Type)
 	d.Set("hosts", expandStringListToSet(certificatePack.Hosts))
+	d.Set("status", certificatePack.Status)
 
-	if !reflect.ValueOf(certificatePack.ValidationErrors).IsNil() {
-		errors := []map[string]interface{}{}
-		for _, e := range certificatePack.ValidationErrors {
-			errors = append(errors, map[string]interface{}{"message": e.Message})
-		}
-		d.Set("validation_errors", errors)
-	}
-	if !reflect.ValueOf(certificatePack.ValidationRecords).IsNil() {
-		records := []map[string]interface{}{}
-		for _, e := range certificatePack.ValidationRecords {
-			records = append(records,
-				map[string]interface{}{
-					"cname_name":   e.CnameName,
-					"cname_target": e.CnameTarget,
-					"txt_name":     e.TxtName,
-					"txt_value":    e.TxtValue,
-					"http_body":    e.HTTPBody,
-					"http_url":     e.HTTPUrl,
-					"emails":       e.Emails,
-				})
-		}
-		d.Set("validation_records", records)
+	if certificatePack.Type == "advanced" {
+		d.Set("validation_method", certificatePack.ValidationMethod)
+		d.Set("validity_days", certificatePack.ValidityDays)
+		d.Set("certificate_authority", certificatePack.CertificateAuthority)
+		d.Set("cloudflare_branding", certificatePack.CloudflareBranding)
+	}
+
+	validationErrors := []map[string]interface{}{}
+	for _, e := range certificatePack.ValidationErrors {
+		validationErrors = append(validationErrors, map[string]interface{}{"message": e.Message})
+	}
+	d.Set("validation_errors", validationErrors)
+
+	records := []map[string]interface{}{}
+	for _, e := range certificatePack.ValidationRecords {
+		records = append(records,
+			map[string]interface{}{
+				"cname_name":   e.CnameName,
+				"cname_target": e.CnameTarget,
+				"txt_name":     e.TxtName,
+				"txt_value":    e.TxtValue,
+				"http_body":    e.HTTPBody,
+				"http_url":     e.HTTPUrl,
+				"emails":       e.Emails,
+			})
 	}
+	d.Set("validation_records", records)
 
 	return nil
 }
 
 func resourceCloudflareCertificatePackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	err := client.DeleteCertificatePack(ctx, zoneID, d.Id())
@@ -140,8 +192,6 @@ func resourceCloudflareCertificatePackDelete(ctx context.Context, d *schema.Reso
 		return diag.FromErr(errors.Wrap(err, "failed to delete certificate pack"))
 	}
 
-	resourceCloudflareCertificatePackRead(ctx, d, meta)
-
 	return nil
 }
 
@@ -163,3 +213,72 @@ func resourceCloudflareCertificatePackImport(ctx context.Context, d *schema.Reso
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func resourceCloudflareCertificatePackDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// certificate_authority is computed, use the configuration so that
+	// omitting it isn't mistaken for a value only known after apply.
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return nil
+	}
+	for _, key := range []string{"type", "validation_method", "validity_days", "certificate_authority"} {
+		if !config.GetAttr(key).IsKnown() {
+			return nil
+		}
+	}
+
+	ca := ""
+	if value := config.GetAttr("certificate_authority"); !value.IsNull() {
+		ca = value.AsString()
+	}
+
+	return validateCertificatePackConfig(
+		d.Get("type").(string),
+		d.Get("validation_method").(string),
+		d.Get("validity_days").(int),
+		ca,
+	)
+}
+
+// validateCertificatePackConfig ensures the advanced certificate attributes
+// are set for, and only for, advanced certificate packs and that they are
+// supported by the certificate authority, as the API rejects the order
+// otherwise.
+func validateCertificatePackConfig(certificatePackType, validationMethod string, validityDays int, ca string) error {
+	if certificatePackType != "advanced" {
+		if validationMethod != "" || validityDays != 0 || ca != "" {
+			return fmt.Errorf("validation_method, validity_days and certificate_authority are only supported for advanced certificate packs")
+		}
+		return nil
+	}
+
+	if validationMethod == "" || validityDays == 0 || ca == "" {
+		return fmt.Errorf("validation_method, validity_days and certificate_authority are required for advanced certificate packs")
+	}
+
+	if ca == "lets_encrypt" {
+		if validityDays != 90 {
+			return fmt.Errorf("certificates issued by lets_encrypt are only valid for 90 days, got %d", validityDays)
+		}
+		if validationMethod == "email" {
+			return fmt.Errorf("certificates issued by lets_encrypt do not support email validation")
+		}
+	}
+
+	return nil
+}
+
+func fetchCertificatePack(ctx context.Context, client *apiClient, zoneID, certificatePackID string) (certificatePack, error) {
+	var pack certificatePack
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/zones/%s/ssl/certificate_packs/%s", zoneID, certificatePackID), nil)
+	if err != nil {
+		return pack, err
+	}
+
+	if err := json.Unmarshal(res, &pack); err != nil {
+		return pack, fmt.Errorf("error unmarshalling certificate pack: %w", err)
+	}
+
+	return pack, nil
+}
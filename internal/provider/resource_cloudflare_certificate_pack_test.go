@@ -66,6 +66,9 @@ func TestAccCertificatePack_AdvancedDigicert(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "certificate_authority", "digicert"),
 					resource.TestCheckResourceAttr(name, "cloudflare_branding", "false"),
 					resource.TestCheckResourceAttr(name, "wait_for_active_status", "false"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+					resource.TestCheckResourceAttrSet(name, "validation_records.0.txt_name"),
+					resource.TestCheckResourceAttrSet(name, "validation_records.0.txt_value"),
 				),
 			},
 		},
@@ -210,3 +213,35 @@ resource "cloudflare_certificate_pack" "%[3]s" {
   wait_for_active_status = true
 }`, zoneID, domain, rnd, certType)
 }
+
+func TestValidateCertificatePackConfig(t *testing.T) {
+	testCases := []struct {
+		name             string
+		certificateType  string
+		validationMethod string
+		validityDays     int
+		ca               string
+		valid            bool
+	}{
+		{"dedicated custom", "dedicated_custom", "", 0, "", true},
+		{"dedicated custom with advanced attributes", "dedicated_custom", "txt", 90, "", false},
+		{"advanced digicert", "advanced", "email", 365, "digicert", true},
+		{"advanced lets encrypt", "advanced", "http", 90, "lets_encrypt", true},
+		{"advanced without certificate authority", "advanced", "txt", 90, "", false},
+		{"advanced without validation method", "advanced", "", 90, "digicert", false},
+		{"lets encrypt for a year", "advanced", "txt", 365, "lets_encrypt", false},
+		{"lets encrypt with email validation", "advanced", "email", 90, "lets_encrypt", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateCertificatePackConfig(tc.certificateType, tc.validationMethod, tc.validityDays, tc.ca)
+			if tc.valid && err != nil {
+				t.Errorf("expected no error, got %s", err)
+			}
+			if !tc.valid && err == nil {
+				t.Error("expected an error, got none")
+			}
+		})
+	}
+}
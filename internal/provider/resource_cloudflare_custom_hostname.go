@@ -5,14 +5,20 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
+// customHostnameSSLFailedStatuses are the SSL statuses a certificate cannot
+// recover from without changes.
+var customHostnameSSLFailedStatuses = []string{"validation_timed_out", "issuance_timed_out", "deployment_timed_out", "deletion_timed_out", "expired", "deleted"}
+
 func resourceCloudflareCustomHostname() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareCustomHostnameSchema(),
@@ -23,11 +29,15 @@ func resourceCloudflareCustomHostname() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareCustomHostnameImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
 	}
 }
 
 func resourceCloudflareCustomHostnameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	hostnameID := d.Id()
 
@@ -39,6 +49,18 @@ func resourceCloudflareCustomHostnameRead(ctx context.Context, d *schema.Resourc
 	d.Set("hostname", customHostname.Hostname)
 	d.Set("custom_origin_server", customHostname.CustomOriginServer)
 	d.Set("custom_origin_sni", customHostname.CustomOriginSNI)
+	d.Set("status", string(customHostname.Status))
+
+	customMetadata := make(map[string]interface{})
+	if customHostname.CustomMetadata != nil {
+		for k, v := range *customHostname.CustomMetadata {
+			customMetadata[k] = fmt.Sprint(v)
+		}
+	}
+	if err := d.Set("custom_metadata", customMetadata); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set custom_metadata: %w", err))
+	}
+
 	var sslConfig []map[string]interface{}
 
 	if !reflect.ValueOf(customHostname.SSL).IsNil() {
@@ -109,7 +131,7 @@ func resourceCloudflareCustomHostnameRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceCloudflareCustomHostnameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	hostnameID := d.Id()
 
@@ -122,7 +144,7 @@ func resourceCloudflareCustomHostnameDelete(ctx context.Context, d *schema.Resou
 }
 
 func resourceCloudflareCustomHostnameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	certificate := buildCustomHostname(d)
@@ -134,11 +156,15 @@ func resourceCloudflareCustomHostnameCreate(ctx context.Context, d *schema.Resou
 
 	d.SetId(newCertificate.Result.ID)
 
+	if err := waitForCustomHostnameSSLStatus(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceCloudflareCustomHostnameRead(ctx, d, meta)
 }
 
 func resourceCloudflareCustomHostnameUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	hostnameID := d.Id()
 	certificate := buildCustomHostname(d)
@@ -148,6 +174,10 @@ func resourceCloudflareCustomHostnameUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(errors.Wrap(err, "failed to update custom hostname certificate"))
 	}
 
+	if err := waitForCustomHostnameSSLStatus(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceCloudflareCustomHostnameRead(ctx, d, meta)
 }
 
@@ -177,6 +207,13 @@ func buildCustomHostname(d *schema.ResourceData) cloudflare.CustomHostname {
 		CustomOriginSNI:    d.Get("custom_origin_sni").(string),
 	}
 
+	customMetadata := cloudflare.CustomMetadata{}
+	for k, v := range d.Get("custom_metadata").(map[string]interface{}) {
+		customMetadata[k] = v
+	}
+	// Sent even when empty so that removed metadata is cleared.
+	ch.CustomMetadata = &customMetadata
+
 	if _, ok := d.GetOk("ssl"); ok {
 		ch.SSL = &cloudflare.CustomHostnameSSL{
 			Method:               d.Get("ssl.0.method").(string),
@@ -197,3 +234,49 @@ func buildCustomHostname(d *schema.ResourceData) cloudflare.CustomHostname {
 
 	return ch
 }
+
+// waitForCustomHostnameSSLStatus polls the custom hostname until its
+// certificate reaches the status configured in `wait_for`, or any later one.
+func waitForCustomHostnameSSLStatus(ctx context.Context, client *apiClient, d *schema.ResourceData, timeout time.Duration) error {
+	if _, ok := d.GetOk("wait_for"); !ok {
+		return nil
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	target := d.Get("wait_for.0.ssl_status").(string)
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		customHostname, err := client.CustomHostname(ctx, zoneID, d.Id())
+		if err != nil {
+			return resource.NonRetryableError(errors.Wrap(err, fmt.Sprintf("error reading custom hostname %q", d.Id())))
+		}
+
+		if customHostname.SSL == nil {
+			return resource.NonRetryableError(fmt.Errorf("custom hostname %q has no certificate to wait for", d.Id()))
+		}
+
+		status := customHostname.SSL.Status
+		if contains(customHostnameSSLFailedStatuses, status) {
+			return resource.NonRetryableError(fmt.Errorf("certificate of custom hostname %q failed with status %q", d.Id(), status))
+		}
+
+		if customHostnameSSLStatusReached(status, target) {
+			return nil
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for certificate of custom hostname %s to be %s, currently %s", d.Id(), target, status))
+
+		return resource.RetryableError(fmt.Errorf("certificate of custom hostname %q is %q, waiting for %q", d.Id(), status, target))
+	})
+}
+
+// customHostnameSSLStatusReached returns whether status is target or comes
+// after it in the issuance of a certificate.
+func customHostnameSSLStatusReached(status, target string) bool {
+	switch target {
+	case "pending_validation":
+		return contains([]string{"pending_validation", "pending_issuance", "pending_deployment", "active"}, status)
+	default:
+		return status == target
+	}
+}
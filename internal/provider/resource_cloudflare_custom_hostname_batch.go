@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+func resourceCloudflareCustomHostnameBatch() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareCustomHostnameBatchSchema(),
+		CreateContext: resourceCloudflareCustomHostnameBatchCreate,
+		ReadContext:   resourceCloudflareCustomHostnameBatchRead,
+		UpdateContext: resourceCloudflareCustomHostnameBatchUpdate,
+		DeleteContext: resourceCloudflareCustomHostnameBatchDelete,
+		Description: "Provides a Cloudflare resource for provisioning many custom hostnames which share the same " +
+			"origin and SSL settings. Requests are issued concurrently and hostnames which fail to provision " +
+			"are reported as warnings and retried on the next apply instead of failing the whole batch.",
+	}
+}
+
+// customHostnameBatchResult holds the outcome of a single hostname operation
+// within a batch.
+type customHostnameBatchResult struct {
+	hostname       string
+	customHostname cloudflare.CustomHostname
+	err            error
+}
+
+// runCustomHostnameBatch calls fn for every hostname using at most
+// concurrency goroutines and returns the results in hostname order.
+func runCustomHostnameBatch(hostnames []string, concurrency int, fn func(hostname string) (cloudflare.CustomHostname, error)) []customHostnameBatchResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]customHostnameBatchResult, len(hostnames))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, hostname := range hostnames {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, hostname string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			ch, err := fn(hostname)
+			results[i] = customHostnameBatchResult{hostname: hostname, customHostname: ch, err: err}
+		}(i, hostname)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// diffCustomHostnameBatch compares the desired hostnames with the hostnames
+// already managed by the batch and returns what needs to be created, kept and
+// deleted, each sorted.
+func diffCustomHostnameBatch(desired []string, managed map[string]string) (create, keep, remove []string) {
+	wanted := make(map[string]bool, len(desired))
+	for _, hostname := range desired {
+		wanted[hostname] = true
+		if _, ok := managed[hostname]; ok {
+			keep = append(keep, hostname)
+		} else {
+			create = append(create, hostname)
+		}
+	}
+
+	for hostname := range managed {
+		if !wanted[hostname] {
+			remove = append(remove, hostname)
+		}
+	}
+
+	sort.Strings(create)
+	sort.Strings(keep)
+	sort.Strings(remove)
+
+	return create, keep, remove
+}
+
+// customHostnameBatchManaged returns the hostname to custom hostname ID
+// mapping currently held in state.
+func customHostnameBatchManaged(d *schema.ResourceData) map[string]string {
+	managed := make(map[string]string)
+	for _, item := range d.Get("custom_hostnames").([]interface{}) {
+		ch := item.(map[string]interface{})
+		managed[ch["hostname"].(string)] = ch["id"].(string)
+	}
+
+	return managed
+}
+
+func setCustomHostnameBatchManaged(d *schema.ResourceData, managed map[string]string) error {
+	hostnames := make([]string, 0, len(managed))
+	for hostname := range managed {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+
+	customHostnames := make([]map[string]interface{}, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		customHostnames = append(customHostnames, map[string]interface{}{
+			"hostname": hostname,
+			"id":       managed[hostname],
+		})
+	}
+
+	return d.Set("custom_hostnames", customHostnames)
+}
+
+// buildCustomHostnameBatchTemplate returns the shared settings applied to
+// every hostname in the batch.
+func buildCustomHostnameBatchTemplate(d *schema.ResourceData) cloudflare.CustomHostname {
+	ch := cloudflare.CustomHostname{
+		CustomOriginServer: d.Get("custom_origin_server").(string),
+		CustomOriginSNI:    d.Get("custom_origin_sni").(string),
+	}
+
+	if _, ok := d.GetOk("ssl"); ok {
+		ch.SSL = &cloudflare.CustomHostnameSSL{
+			Method:               d.Get("ssl.0.method").(string),
+			Type:                 d.Get("ssl.0.type").(string),
+			Wildcard:             cloudflare.BoolPtr(d.Get("ssl.0.wildcard").(bool)),
+			CertificateAuthority: d.Get("ssl.0.certificate_authority").(string),
+			Settings: cloudflare.CustomHostnameSSLSettings{
+				HTTP2:         d.Get("ssl.0.settings.0.http2").(string),
+				TLS13:         d.Get("ssl.0.settings.0.tls13").(string),
+				MinTLSVersion: d.Get("ssl.0.settings.0.min_tls_version").(string),
+				Ciphers:       expandInterfaceToStringList(d.Get("ssl.0.settings.0.ciphers").(*schema.Set).List()),
+				EarlyHints:    d.Get("ssl.0.settings.0.early_hints").(string),
+			},
+		}
+	}
+
+	return ch
+}
+
+// customHostnameBatchWarnings converts failed results into warning
+// diagnostics so that a partially applied batch is still saved to state.
+func customHostnameBatchWarnings(action string, results []customHostnameBatchResult) (diag.Diagnostics, int) {
+	var diags diag.Diagnostics
+	failed := 0
+	for _, r := range results {
+		if r.err == nil {
+			continue
+		}
+		failed++
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("failed to %s custom hostname %q", action, r.hostname),
+			Detail:   fmt.Sprintf("%s. The change will be retried on the next apply.", r.err),
+		})
+	}
+
+	return diags, failed
+}
+
+func resourceCloudflareCustomHostnameBatchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(resource.UniqueId())
+
+	diags := reconcileCustomHostnameBatch(ctx, d, meta, false)
+	if len(customHostnameBatchManaged(d)) == 0 {
+		d.SetId("")
+		return append(diags, diag.Errorf("failed to create any custom hostnames in batch")...)
+	}
+
+	return append(diags, resourceCloudflareCustomHostnameBatchRead(ctx, d, meta)...)
+}
+
+func resourceCloudflareCustomHostnameBatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	managed := customHostnameBatchManaged(d)
+
+	hostnames := make([]string, 0, len(managed))
+	for hostname := range managed {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+
+	results := runCustomHostnameBatch(hostnames, d.Get("concurrency").(int), func(hostname string) (cloudflare.CustomHostname, error) {
+		return client.CustomHostname(ctx, zoneID, managed[hostname])
+	})
+
+	customHostnames := make([]map[string]interface{}, 0, len(results))
+	found := make([]string, 0, len(results))
+	for _, r := range results {
+		if r.err != nil {
+			if isNotFoundError(r.err) {
+				tflog.Info(ctx, fmt.Sprintf("Custom hostname %q in batch %s not found", r.hostname, d.Id()))
+				continue
+			}
+			return diag.FromErr(errors.Wrap(r.err, fmt.Sprintf("error reading custom hostname %q", r.hostname)))
+		}
+
+		sslStatus := ""
+		if r.customHostname.SSL != nil {
+			sslStatus = r.customHostname.SSL.Status
+		}
+
+		found = append(found, r.hostname)
+		customHostnames = append(customHostnames, map[string]interface{}{
+			"hostname":   r.hostname,
+			"id":         r.customHostname.ID,
+			"status":     string(r.customHostname.Status),
+			"ssl_status": sslStatus,
+			"ownership_verification": map[string]interface{}{
+				"type":  r.customHostname.OwnershipVerification.Type,
+				"name":  r.customHostname.OwnershipVerification.Name,
+				"value": r.customHostname.OwnershipVerification.Value,
+			},
+		})
+	}
+
+	if len(found) == 0 {
+		tflog.Info(ctx, fmt.Sprintf("Custom hostname batch %s no longer has any hostnames, removing from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("hostnames", found); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set hostnames: %w", err))
+	}
+
+	if err := d.Set("custom_hostnames", customHostnames); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set custom_hostnames: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareCustomHostnameBatchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sharedSettingsChanged := d.HasChanges("custom_origin_server", "custom_origin_sni", "ssl")
+
+	diags := reconcileCustomHostnameBatch(ctx, d, meta, sharedSettingsChanged)
+
+	return append(diags, resourceCloudflareCustomHostnameBatchRead(ctx, d, meta)...)
+}
+
+func resourceCloudflareCustomHostnameBatchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	managed := customHostnameBatchManaged(d)
+
+	_, _, remove := diffCustomHostnameBatch(nil, managed)
+	results := runCustomHostnameBatch(remove, d.Get("concurrency").(int), func(hostname string) (cloudflare.CustomHostname, error) {
+		return cloudflare.CustomHostname{}, deleteCustomHostnameInBatch(ctx, client, zoneID, managed[hostname])
+	})
+
+	var diags diag.Diagnostics
+	for _, r := range results {
+		if r.err != nil {
+			diags = append(diags, diag.FromErr(errors.Wrap(r.err, fmt.Sprintf("failed to delete custom hostname %q", r.hostname)))...)
+		}
+	}
+
+	return diags
+}
+
+// reconcileCustomHostnameBatch creates, deletes and, when updateExisting is
+// set, updates custom hostnames so that the batch matches the configuration.
+// The hostnames which were successfully applied are written to state and
+// failures are returned as warnings.
+func reconcileCustomHostnameBatch(ctx context.Context, d *schema.ResourceData, meta interface{}, updateExisting bool) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	concurrency := d.Get("concurrency").(int)
+	template := buildCustomHostnameBatchTemplate(d)
+	managed := customHostnameBatchManaged(d)
+
+	create, keep, remove := diffCustomHostnameBatch(expandInterfaceToStringList(d.Get("hostnames").(*schema.Set).List()), managed)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reconciling custom hostname batch %s: %d to create, %d to delete", d.Id(), len(create), len(remove)))
+
+	var diags diag.Diagnostics
+
+	created := runCustomHostnameBatch(create, concurrency, func(hostname string) (cloudflare.CustomHostname, error) {
+		ch := template
+		ch.Hostname = hostname
+		res, err := client.CreateCustomHostname(ctx, zoneID, ch)
+		if err != nil {
+			return cloudflare.CustomHostname{}, err
+		}
+		return res.Result, nil
+	})
+	for _, r := range created {
+		if r.err == nil {
+			managed[r.hostname] = r.customHostname.ID
+		}
+	}
+	createDiags, _ := customHostnameBatchWarnings("create", created)
+	diags = append(diags, createDiags...)
+
+	deleted := runCustomHostnameBatch(remove, concurrency, func(hostname string) (cloudflare.CustomHostname, error) {
+		return cloudflare.CustomHostname{}, deleteCustomHostnameInBatch(ctx, client, zoneID, managed[hostname])
+	})
+	for _, r := range deleted {
+		if r.err == nil {
+			delete(managed, r.hostname)
+		}
+	}
+	deleteDiags, _ := customHostnameBatchWarnings("delete", deleted)
+	diags = append(diags, deleteDiags...)
+
+	if updateExisting {
+		updated := runCustomHostnameBatch(keep, concurrency, func(hostname string) (cloudflare.CustomHostname, error) {
+			ch := template
+			ch.Hostname = hostname
+			_, err := client.UpdateCustomHostname(ctx, zoneID, managed[hostname], ch)
+			return cloudflare.CustomHostname{}, err
+		})
+		updateDiags, failed := customHostnameBatchWarnings("update", updated)
+		diags = append(diags, updateDiags...)
+
+		// Restore the previous shared settings in state so that the next
+		// plan shows a diff and retries the hostnames which failed.
+		if failed > 0 {
+			for _, k := range []string{"custom_origin_server", "custom_origin_sni", "ssl"} {
+				o, _ := d.GetChange(k)
+				d.Set(k, o)
+			}
+		}
+	}
+
+	if err := setCustomHostnameBatchManaged(d, managed); err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("failed to set custom_hostnames: %w", err))...)
+	}
+
+	return diags
+}
+
+// deleteCustomHostnameInBatch deletes a custom hostname, treating an already
+// deleted hostname as success.
+func deleteCustomHostnameInBatch(ctx context.Context, client *apiClient, zoneID, hostnameID string) error {
+	err := client.DeleteCustomHostname(ctx, zoneID, hostnameID)
+	if isNotFoundError(err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/pkg/errors"
+)
+
+func TestDiffCustomHostnameBatch(t *testing.T) {
+	managed := map[string]string{
+		"a.example.com": "1",
+		"b.example.com": "2",
+		"c.example.com": "3",
+	}
+
+	create, keep, remove := diffCustomHostnameBatch([]string{"d.example.com", "b.example.com", "a.example.com"}, managed)
+
+	if !reflect.DeepEqual(create, []string{"d.example.com"}) {
+		t.Errorf("unexpected create: %v", create)
+	}
+	if !reflect.DeepEqual(keep, []string{"a.example.com", "b.example.com"}) {
+		t.Errorf("unexpected keep: %v", keep)
+	}
+	if !reflect.DeepEqual(remove, []string{"c.example.com"}) {
+		t.Errorf("unexpected remove: %v", remove)
+	}
+}
+
+func TestRunCustomHostnameBatch(t *testing.T) {
+	hostnames := []string{"a.example.com", "b.example.com", "c.example.com", "d.example.com", "e.example.com"}
+	concurrency := 2
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	results := runCustomHostnameBatch(hostnames, concurrency, func(hostname string) (cloudflare.CustomHostname, error) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+
+		if hostname == "c.example.com" {
+			return cloudflare.CustomHostname{}, errors.New("boom")
+		}
+		return cloudflare.CustomHostname{ID: hostname, Hostname: hostname}, nil
+	})
+
+	if maxRunning > concurrency {
+		t.Errorf("expected at most %d concurrent calls, got %d", concurrency, maxRunning)
+	}
+
+	if len(results) != len(hostnames) {
+		t.Fatalf("expected %d results, got %d", len(hostnames), len(results))
+	}
+
+	for i, r := range results {
+		if r.hostname != hostnames[i] {
+			t.Errorf("result %d: expected hostname %q, got %q", i, hostnames[i], r.hostname)
+		}
+		if r.hostname == "c.example.com" {
+			if r.err == nil {
+				t.Errorf("expected error for %q", r.hostname)
+			}
+			continue
+		}
+		if r.err != nil || r.customHostname.ID != r.hostname {
+			t.Errorf("unexpected result for %q: %+v", r.hostname, r)
+		}
+	}
+
+	diags, failed := customHostnameBatchWarnings("create", results)
+	if failed != 1 || len(diags) != 1 || diags.HasError() {
+		t.Errorf("expected a single warning, got %d failures and %+v", failed, diags)
+	}
+}
+
+func TestAccCloudflareCustomHostnameBatch_Basic(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_custom_hostname_batch." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareCustomHostnameBatchConfig(zoneID, rnd, domain, []string{"a", "b"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "hostnames.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_hostnames.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_hostnames.0.hostname", fmt.Sprintf("a-%s.%s", rnd, domain)),
+					resource.TestCheckResourceAttrSet(resourceName, "custom_hostnames.0.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "custom_hostnames.0.status"),
+					resource.TestCheckResourceAttrSet(resourceName, "custom_hostnames.0.ownership_verification.value"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareCustomHostnameBatchConfig(zoneID, rnd, domain, []string{"b", "c", "d"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hostnames.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "custom_hostnames.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "custom_hostnames.0.hostname", fmt.Sprintf("b-%s.%s", rnd, domain)),
+					resource.TestCheckResourceAttr(resourceName, "custom_hostnames.2.hostname", fmt.Sprintf("d-%s.%s", rnd, domain)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareCustomHostnameBatchConfig(zoneID, rnd, domain string, prefixes []string) string {
+	hostnames := ""
+	for _, prefix := range prefixes {
+		hostnames += fmt.Sprintf("    \"%s-%s.%s\",\n", prefix, rnd, domain)
+	}
+
+	return fmt.Sprintf(`
+resource "cloudflare_custom_hostname_batch" "%[2]s" {
+  zone_id     = "%[1]s"
+  concurrency = 2
+  hostnames = [
+%[3]s  ]
+
+  ssl {
+    method = "txt"
+    settings {
+      min_tls_version = "1.2"
+    }
+  }
+}
+`, zoneID, rnd, hostnames)
+}
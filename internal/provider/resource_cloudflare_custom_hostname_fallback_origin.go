@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -13,6 +14,10 @@ import (
 	"github.com/pkg/errors"
 )
 
+// customHostnameFallbackOriginFailedStatuses are the statuses a fallback
+// origin cannot recover from without changes.
+var customHostnameFallbackOriginFailedStatuses = []string{"deployment_timed_out", "deletion_timed_out"}
+
 func resourceCloudflareCustomHostnameFallbackOrigin() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareCustomHostnameFallbackOriginSchema(),
@@ -23,11 +28,16 @@ func resourceCloudflareCustomHostnameFallbackOrigin() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareCustomHostnameFallbackOriginImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Description: "Provides a Cloudflare custom hostname fallback origin resource, the origin that requests for custom hostnames of a zone are sent to by default.",
 	}
 }
 
 func resourceCloudflareCustomHostnameFallbackOriginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	customHostnameFallbackOrigin, err := client.CustomHostnameFallbackOrigin(ctx, zoneID)
@@ -37,12 +47,13 @@ func resourceCloudflareCustomHostnameFallbackOriginRead(ctx context.Context, d *
 
 	d.Set("origin", customHostnameFallbackOrigin.Origin)
 	d.Set("status", customHostnameFallbackOrigin.Status)
+	d.Set("errors", customHostnameFallbackOrigin.Errors)
 
 	return nil
 }
 
 func resourceCloudflareCustomHostnameFallbackOriginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	err := client.DeleteCustomHostnameFallbackOrigin(ctx, zoneID)
@@ -54,7 +65,7 @@ func resourceCloudflareCustomHostnameFallbackOriginDelete(ctx context.Context, d
 }
 
 func resourceCloudflareCustomHostnameFallbackOriginCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	origin := d.Get("origin").(string)
 
@@ -62,7 +73,7 @@ func resourceCloudflareCustomHostnameFallbackOriginCreate(ctx context.Context, d
 		Origin: origin,
 	}
 
-	retry := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDefault), func() *resource.RetryError {
+	retry := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, err := client.UpdateCustomHostnameFallbackOrigin(ctx, zoneID, fallbackOrigin)
 		if err != nil {
 			var requestError *cloudflare.RequestError
@@ -73,23 +84,6 @@ func resourceCloudflareCustomHostnameFallbackOriginCreate(ctx context.Context, d
 			}
 		}
 
-		fallbackHostname, err := client.CustomHostnameFallbackOrigin(ctx, zoneID)
-
-		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("failed to fetch custom hostname: %w", err))
-		}
-
-		// Address an eventual consistency issue where deleting a fallback hostname
-		// and then adding it _may_ cause some issues. It is possible that the status does
-		// move into the active state during the retry period.
-		if fallbackHostname.Status != "pending_deployment" && fallbackHostname.Status != "active" {
-			return resource.RetryableError(fmt.Errorf("expected custom hostname fallback to be created but was %s", fallbackHostname.Status))
-		}
-
-		id := stringChecksum(fmt.Sprintf("%s/custom_hostnames_fallback_origin", zoneID))
-		d.SetId(id)
-
-		resourceCloudflareCustomHostnameFallbackOriginRead(ctx, d, meta)
 		return nil
 	})
 
@@ -97,11 +91,18 @@ func resourceCloudflareCustomHostnameFallbackOriginCreate(ctx context.Context, d
 		return diag.FromErr(retry)
 	}
 
-	return nil
+	id := stringChecksum(fmt.Sprintf("%s/custom_hostnames_fallback_origin", zoneID))
+	d.SetId(id)
+
+	if err := waitForCustomHostnameFallbackOriginStatus(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareCustomHostnameFallbackOriginRead(ctx, d, meta)
 }
 
 func resourceCloudflareCustomHostnameFallbackOriginUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	origin := d.Get("origin").(string)
 
@@ -109,7 +110,7 @@ func resourceCloudflareCustomHostnameFallbackOriginUpdate(ctx context.Context, d
 		Origin: origin,
 	}
 
-	retry := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDefault), func() *resource.RetryError {
+	retry := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		_, err := client.UpdateCustomHostnameFallbackOrigin(ctx, zoneID, fallbackOrigin)
 		if err != nil {
 			var requestError *cloudflare.RequestError
@@ -119,7 +120,6 @@ func resourceCloudflareCustomHostnameFallbackOriginUpdate(ctx context.Context, d
 			return resource.NonRetryableError(fmt.Errorf("failed to update custom hostname fallback origin: %w", err))
 		}
 
-		resourceCloudflareCustomHostnameFallbackOriginRead(ctx, d, meta)
 		return nil
 	})
 
@@ -127,7 +127,51 @@ func resourceCloudflareCustomHostnameFallbackOriginUpdate(ctx context.Context, d
 		return diag.FromErr(retry)
 	}
 
-	return nil
+	if err := waitForCustomHostnameFallbackOriginStatus(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareCustomHostnameFallbackOriginRead(ctx, d, meta)
+}
+
+// waitForCustomHostnameFallbackOriginStatus polls the fallback origin until
+// it is being deployed, or until it is active when `wait_for_active` is set.
+func waitForCustomHostnameFallbackOriginStatus(ctx context.Context, client *apiClient, d *schema.ResourceData, timeout time.Duration) error {
+	zoneID := d.Get("zone_id").(string)
+	waitForActive := d.Get("wait_for_active").(bool)
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		fallbackOrigin, err := client.CustomHostnameFallbackOrigin(ctx, zoneID)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("failed to fetch custom hostname fallback origin: %w", err))
+		}
+
+		status := fallbackOrigin.Status
+		if contains(customHostnameFallbackOriginFailedStatuses, status) {
+			return resource.NonRetryableError(fmt.Errorf("custom hostname fallback origin %q failed with status %q: %s", fallbackOrigin.Origin, status, strings.Join(fallbackOrigin.Errors, ", ")))
+		}
+
+		if customHostnameFallbackOriginStatusReached(status, waitForActive) {
+			return nil
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for custom hostname fallback origin of zone %s, currently %s", zoneID, status))
+
+		// Address an eventual consistency issue where deleting a fallback hostname
+		// and then adding it _may_ cause some issues. It is possible that the status does
+		// move into the active state during the retry period.
+		return resource.RetryableError(fmt.Errorf("expected custom hostname fallback origin to be deployed but was %s", status))
+	})
+}
+
+// customHostnameFallbackOriginStatusReached returns whether the fallback
+// origin is far enough along in its deployment.
+func customHostnameFallbackOriginStatusReached(status string, waitForActive bool) bool {
+	if waitForActive {
+		return status == "active"
+	}
+
+	return status == "pending_deployment" || status == "active"
 }
 
 func resourceCloudflareCustomHostnameFallbackOriginImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -6,7 +6,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -100,7 +99,7 @@ func TestAccCloudflareCustomHostnameFallbackOriginUpdate(t *testing.T) {
 }
 
 func testAccCheckCloudflareCustomHostnameFallbackOriginDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_custom_hostname_fallback_origin" {
@@ -118,3 +117,24 @@ func testAccCheckCloudflareCustomHostnameFallbackOriginDestroy(s *terraform.Stat
 
 	return nil
 }
+
+func TestCustomHostnameFallbackOriginStatusReached(t *testing.T) {
+	testCases := []struct {
+		status        string
+		waitForActive bool
+		reached       bool
+	}{
+		{"initializing", false, false},
+		{"pending_deployment", false, true},
+		{"active", false, true},
+		{"initializing", true, false},
+		{"pending_deployment", true, false},
+		{"active", true, true},
+	}
+
+	for _, tc := range testCases {
+		if got := customHostnameFallbackOriginStatusReached(tc.status, tc.waitForActive); got != tc.reached {
+			t.Errorf("customHostnameFallbackOriginStatusReached(%q, %t) = %t, want %t", tc.status, tc.waitForActive, got, tc.reached)
+		}
+	}
+}
@@ -95,6 +95,74 @@ resource "cloudflare_custom_hostname" "%[2]s" {
 `, zoneID, rnd, domain)
 }
 
+func TestAccCloudflareCustomHostname_WithCustomMetadataAndWaitFor(t *testing.T) {
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_custom_hostname." + rnd
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareCustomHostnameWithCustomMetadataAndWaitFor(zoneID, rnd, domain, "customer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.tier", "customer"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for.0.ssl_status", "pending_validation"),
+					resource.TestCheckResourceAttrSet(resourceName, "ssl.0.validation_records.#"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareCustomHostnameWithCustomMetadataAndWaitFor(zoneID, rnd, domain, "enterprise"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.tier", "enterprise"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareCustomHostnameWithCustomMetadataAndWaitFor(zoneID, rnd, domain, tier string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_custom_hostname" "%[2]s" {
+  zone_id  = "%[1]s"
+  hostname = "%[2]s.%[3]s"
+  ssl {
+    method = "txt"
+  }
+  custom_metadata = {
+    tier = "%[4]s"
+  }
+  wait_for {
+    ssl_status = "pending_validation"
+  }
+}
+`, zoneID, rnd, domain, tier)
+}
+
+func TestCustomHostnameSSLStatusReached(t *testing.T) {
+	testCases := []struct {
+		status, target string
+		reached        bool
+	}{
+		{"initializing", "pending_validation", false},
+		{"pending_validation", "pending_validation", true},
+		{"pending_deployment", "pending_validation", true},
+		{"active", "pending_validation", true},
+		{"pending_validation", "active", false},
+		{"pending_deployment", "active", false},
+		{"active", "active", true},
+	}
+
+	for _, tc := range testCases {
+		if got := customHostnameSSLStatusReached(tc.status, tc.target); got != tc.reached {
+			t.Errorf("customHostnameSSLStatusReached(%q, %q) = %t, want %t", tc.status, tc.target, got, tc.reached)
+		}
+	}
+}
+
 func TestAccCloudflareCustomHostname_WithCustomOriginServer(t *testing.T) {
 	t.Parallel()
 	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
@@ -420,7 +488,7 @@ func testAccCheckCloudflareCustomHostnameExists(n string, customHostname *cloudf
 			return fmt.Errorf("No CustomHostname ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundCustomHostname, err := client.CustomHostname(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
 		if err != nil {
 			return err
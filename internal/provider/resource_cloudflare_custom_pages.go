@@ -26,7 +26,7 @@ func resourceCloudflareCustomPages() *schema.Resource {
 }
 
 func resourceCloudflareCustomPagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	accountID := d.Get("account_id").(string)
 	pageType := d.Get("type").(string)
@@ -73,7 +73,7 @@ func resourceCloudflareCustomPagesRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareCustomPagesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
 
@@ -98,7 +98,7 @@ func resourceCloudflareCustomPagesUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareCustomPagesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
 
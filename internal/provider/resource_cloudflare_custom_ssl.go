@@ -41,7 +41,7 @@ func resourceCloudflareCustomSsl() *schema.Resource {
 }
 
 func resourceCloudflareCustomSslCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	tflog.Debug(ctx, fmt.Sprintf("zone ID: %s", zoneID))
 	zcso, err := expandToZoneCustomSSLOptions(ctx, d)
@@ -82,7 +82,7 @@ func resourceCloudflareCustomSslCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareCustomSslUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	certID := d.Id()
 	var uErr error
@@ -129,7 +129,7 @@ func resourceCloudflareCustomSslUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareCustomSslRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	certID := d.Id()
 
@@ -163,7 +163,7 @@ func resourceCloudflareCustomSslRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceCloudflareCustomSslDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	certID := d.Id()
 
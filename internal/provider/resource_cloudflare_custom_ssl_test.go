@@ -52,7 +52,7 @@ resource "cloudflare_custom_ssl" "%[2]s" {
 }
 
 func testAccCheckCloudflareCustomSSLDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_custom_ssl" {
@@ -79,7 +79,7 @@ func testAccCheckCloudflareCustomSSLExists(n string, customSSL *cloudflare.ZoneC
 			return fmt.Errorf("No cert ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundCustomSSL, err := client.SSLDetails(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
 		if err != nil {
 			return err
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type d1Database struct {
+	UUID                string `json:"uuid,omitempty"`
+	Name                string `json:"name"`
+	PrimaryLocationHint string `json:"primary_location_hint,omitempty"`
+	Version             string `json:"version,omitempty"`
+	NumTables           int    `json:"num_tables,omitempty"`
+	FileSize            int    `json:"file_size,omitempty"`
+	CreatedAt           string `json:"created_at,omitempty"`
+}
+
+// d1BulkOperation is the state of an import or export, which are started
+// and then polled with the returned bookmark until they complete.
+type d1BulkOperation struct {
+	AtBookmark string   `json:"at_bookmark"`
+	Status     string   `json:"status"`
+	Error      string   `json:"error"`
+	Messages   []string `json:"messages"`
+	UploadURL  string   `json:"upload_url"`
+	Filename   string   `json:"filename"`
+	Result     struct {
+		Filename  string `json:"filename"`
+		SignedURL string `json:"signed_url"`
+	} `json:"result"`
+}
+
+func resourceCloudflareD1Database() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareD1DatabaseSchema(),
+		CreateContext: resourceCloudflareD1DatabaseCreate,
+		ReadContext:   resourceCloudflareD1DatabaseRead,
+		UpdateContext: resourceCloudflareD1DatabaseUpdate,
+		DeleteContext: resourceCloudflareD1DatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareD1DatabaseImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Description: "Provides a resource to manage a D1 database, optionally seeded from a SQL dump and exported to R2 before it is destroyed.",
+	}
+}
+
+func resourceCloudflareD1DatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	db := d1Database{
+		Name:                d.Get("name").(string),
+		PrimaryLocationHint: d.Get("primary_location_hint").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating D1 database from struct: %+v", db))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/d1/database", accountID), db)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating D1 database %q: %w", db.Name, err))
+	}
+
+	var created d1Database
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling D1 database %q: %w", db.Name, err))
+	}
+
+	d.SetId(created.UUID)
+
+	if path, ok := d.GetOk("import_sql_path"); ok {
+		if err := importD1Database(ctx, client, accountID, d.Id(), path.(string), d.Timeout(schema.TimeoutCreate)-time.Minute); err != nil {
+			return diag.FromErr(fmt.Errorf("error importing %q into D1 database %q: %w", path, db.Name, err))
+		}
+	}
+
+	return resourceCloudflareD1DatabaseRead(ctx, d, meta)
+}
+
+func resourceCloudflareD1DatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, d1DatabaseURI(accountID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("D1 database %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error fetching D1 database %q: %w", d.Id(), err))
+	}
+
+	var db d1Database
+	if err := json.Unmarshal(res, &db); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling D1 database %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", db.Name)
+	d.Set("version", db.Version)
+	d.Set("num_tables", db.NumTables)
+	d.Set("file_size", db.FileSize)
+	d.Set("created_at", db.CreatedAt)
+
+	return nil
+}
+
+// resourceCloudflareD1DatabaseUpdate only records changes to the import and
+// export settings, which don't exist in the API.
+func resourceCloudflareD1DatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceCloudflareD1DatabaseRead(ctx, d, meta)
+}
+
+func resourceCloudflareD1DatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if _, ok := d.GetOk("export_on_destroy"); ok {
+		bucket := d.Get("export_on_destroy.0.bucket_name").(string)
+		key := fmt.Sprintf("%s%s-%s.sql", d.Get("export_on_destroy.0.key_prefix").(string), d.Get("name").(string), time.Now().UTC().Format("20060102T150405Z"))
+
+		if err := exportD1DatabaseToR2(ctx, client, accountID, d.Id(), bucket, key, d.Timeout(schema.TimeoutDelete)-time.Minute); err != nil {
+			return diag.FromErr(fmt.Errorf("error exporting D1 database %q before deletion: %w", d.Id(), err))
+		}
+	}
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, d1DatabaseURI(accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting D1 database %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareD1DatabaseImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/databaseID"`, d.Id())
+	}
+
+	accountID, databaseID := attributes[0], attributes[1]
+
+	d.SetId(databaseID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareD1DatabaseRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// importD1Database uploads a SQL dump and waits for the database to ingest
+// it. The upload is skipped when the API already has a file with the same
+// checksum.
+func importD1Database(ctx context.Context, client *apiClient, accountID, databaseID, path string, timeout time.Duration) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	sum := md5.Sum(content)
+	etag := hex.EncodeToString(sum[:])
+	uri := d1DatabaseURI(accountID, databaseID) + "/import"
+
+	op, err := d1BulkOperationRequest(ctx, client, uri, map[string]interface{}{"action": "init", "etag": etag})
+	if err != nil {
+		return err
+	}
+
+	if op.UploadURL != "" {
+		req, err := http.NewRequestWithContext(withRequestTimeout(ctx, slowRequestTimeout), http.MethodPut, op.UploadURL, bytes.NewReader(content))
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("error uploading SQL dump: %w", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("error uploading SQL dump: unexpected status %s", resp.Status)
+		}
+	}
+
+	op, err = d1BulkOperationRequest(ctx, client, uri, map[string]interface{}{"action": "ingest", "etag": etag, "filename": op.Filename})
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForD1BulkOperation(ctx, client, uri, op, timeout, func(bookmark string) interface{} {
+		return map[string]interface{}{"action": "poll", "current_bookmark": bookmark}
+	})
+
+	return err
+}
+
+// exportD1DatabaseToR2 exports the database as SQL and stores the dump as
+// key in an R2 bucket of the same account.
+func exportD1DatabaseToR2(ctx context.Context, client *apiClient, accountID, databaseID, bucket, key string, timeout time.Duration) error {
+	uri := d1DatabaseURI(accountID, databaseID) + "/export"
+	params := func(bookmark string) interface{} {
+		return map[string]interface{}{"output_format": "polling", "current_bookmark": bookmark}
+	}
+
+	op, err := d1BulkOperationRequest(ctx, client, uri, params(""))
+	if err != nil {
+		return err
+	}
+
+	op, err = waitForD1BulkOperation(ctx, client, uri, op, timeout, params)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(withRequestTimeout(ctx, slowRequestTimeout), http.MethodGet, op.Result.SignedURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error downloading export: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error downloading export: unexpected status %s", resp.Status)
+	}
+
+	dump, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error downloading export: %w", err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Storing export of D1 database %s in R2 bucket %s as %s", databaseID, bucket, key))
+
+	_, err = rawMultipartRequest(ctx, client, http.MethodPut, fmt.Sprintf("/accounts/%s/r2/buckets/%s/objects/%s", accountID, bucket, key), "", "application/sql", dump)
+	if err != nil {
+		return fmt.Errorf("error storing export in R2 bucket %q: %w", bucket, err)
+	}
+
+	return nil
+}
+
+func waitForD1BulkOperation(ctx context.Context, client *apiClient, uri string, op d1BulkOperation, timeout time.Duration, params func(bookmark string) interface{}) (d1BulkOperation, error) {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		if op.Status != "complete" && op.Status != "error" {
+			next, err := d1BulkOperationRequest(ctx, client, uri, params(op.AtBookmark))
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			op = next
+		}
+
+		switch op.Status {
+		case "complete":
+			return nil
+		case "error":
+			return resource.NonRetryableError(errors.New(op.Error))
+		default:
+			return resource.RetryableError(fmt.Errorf("expected operation to be complete but was in state %s", op.Status))
+		}
+	})
+
+	return op, err
+}
+
+func d1BulkOperationRequest(ctx context.Context, client *apiClient, uri string, params interface{}) (d1BulkOperation, error) {
+	res, err := rawRequest(ctx, client, http.MethodPost, uri, params)
+	if err != nil {
+		return d1BulkOperation{}, err
+	}
+
+	var op d1BulkOperation
+	if err := json.Unmarshal(res, &op); err != nil {
+		return d1BulkOperation{}, fmt.Errorf("error unmarshalling D1 operation: %w", err)
+	}
+
+	return op, nil
+}
+
+func d1DatabaseURI(accountID, databaseID string) string {
+	return fmt.Sprintf("/accounts/%s/d1/database/%s", accountID, databaseID)
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareD1Database_ImportSQL(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_d1_database.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	dump := filepath.Join(t.TempDir(), "dump.sql")
+	sql := "CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT);\nINSERT INTO users (name) VALUES ('alice');\n"
+	if err := ioutil.WriteFile(dump, []byte(sql), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareD1DatabaseImportSQLConfig(rnd, accountID, dump),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "num_tables", "1"),
+					resource.TestCheckResourceAttrSet(name, "version"),
+					resource.TestCheckResourceAttrSet(name, "created_at"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", accountID),
+				ImportStateVerifyIgnore: []string{"import_sql_path", "file_size"},
+			},
+		},
+	})
+}
+
+func TestAccCloudflareD1Database_ExportOnDestroy(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_d1_database.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	bucketName := os.Getenv("CLOUDFLARE_R2_BUCKET_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckR2Bucket(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareD1DatabaseExportOnDestroyConfig(rnd, accountID, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "export_on_destroy.0.bucket_name", bucketName),
+					resource.TestCheckResourceAttr(name, "export_on_destroy.0.key_prefix", "d1-exports/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareD1DatabaseImportSQLConfig(rnd, accountID, path string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_d1_database" "%[1]s" {
+  account_id      = "%[2]s"
+  name            = "%[1]s"
+  import_sql_path = "%[3]s"
+}
+`, rnd, accountID, path)
+}
+
+func testAccCloudflareD1DatabaseExportOnDestroyConfig(rnd, accountID, bucketName string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_d1_database" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+
+  export_on_destroy {
+    bucket_name = "%[3]s"
+    key_prefix  = "d1-exports/"
+  }
+}
+`, rnd, accountID, bucketName)
+}
@@ -32,7 +32,7 @@ func resourceCloudflareDevicePostureIntegration() *schema.Resource {
 }
 
 func resourceCloudflareDevicePostureIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	newDevicePostureIntegration := cloudflare.DevicePostureIntegration{
@@ -67,7 +67,7 @@ func resourceCloudflareDevicePostureIntegrationRead(ctx context.Context, d *sche
 }
 
 func devicePostureIntegrationReadHelper(ctx context.Context, d *schema.ResourceData, meta interface{}, secret string) error {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	devicePostureIntegration, err := client.DevicePostureIntegration(ctx, accountID, d.Id())
@@ -90,7 +90,7 @@ func devicePostureIntegrationReadHelper(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareDevicePostureIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	updatedDevicePostureIntegration := cloudflare.DevicePostureIntegration{
@@ -120,7 +120,7 @@ func resourceCloudflareDevicePostureIntegrationUpdate(ctx context.Context, d *sc
 }
 
 func resourceCloudflareDevicePostureIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	appID := d.Id()
 	accountID := d.Get("account_id").(string)
 
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,7 +28,7 @@ func resourceCloudflareDevicePostureRule() *schema.Resource {
 }
 
 func resourceCloudflareDevicePostureRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	newDevicePostureRule := cloudflare.DevicePostureRule{
@@ -56,7 +58,7 @@ func resourceCloudflareDevicePostureRuleCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceCloudflareDevicePostureRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	devicePostureRule, err := client.DevicePostureRule(ctx, accountID, d.Id())
@@ -81,7 +83,7 @@ func resourceCloudflareDevicePostureRuleRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceCloudflareDevicePostureRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	updatedDevicePostureRule := cloudflare.DevicePostureRule{
@@ -114,7 +116,7 @@ func resourceCloudflareDevicePostureRuleUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceCloudflareDevicePostureRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	appID := d.Id()
 	accountID := d.Get("account_id").(string)
 
@@ -245,3 +247,37 @@ func convertInputToSchema(input cloudflare.DevicePostureRuleInput) []map[string]
 
 	return []map[string]interface{}{m}
 }
+
+var devicePostureRuleDurationRegexp = regexp.MustCompile(`^(\d+[mh])+$`)
+
+// validateDevicePostureRuleDuration ensures the schedule and expiration are
+// positive durations using only the units accepted by the API.
+func validateDevicePostureRuleDuration(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if !devicePostureRuleDurationRegexp.MatchString(v) {
+		errs = append(errs, fmt.Errorf(`%q must be a duration such as "30m" or "1h" using only "m" or "h" as units, got %q`, key, v))
+		return
+	}
+
+	if dur, err := time.ParseDuration(v); err != nil || dur <= 0 {
+		errs = append(errs, fmt.Errorf("%q must be a positive duration, got %q", key, v))
+	}
+
+	return
+}
+
+// suppressEquivalentDevicePostureRuleDurations prevents a diff when the API
+// returns the same duration in a different format, such as "60m" for "1h".
+func suppressEquivalentDevicePostureRuleDurations(k, old, new string, d *schema.ResourceData) bool {
+	oldDuration, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+
+	newDuration, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+
+	return oldDuration == newDuration
+}
@@ -6,7 +6,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -293,7 +292,7 @@ resource "cloudflare_device_posture_rule" "%[1]s" {
 }
 
 func testAccCheckCloudflareDevicePostureRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_device_posture_rule" {
@@ -308,3 +307,48 @@ func testAccCheckCloudflareDevicePostureRuleDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestValidateDevicePostureRuleDuration(t *testing.T) {
+	testCases := map[string]bool{
+		"30s":   false,
+		"5m":    true,
+		"24h":   true,
+		"1h30m": true,
+		"":      false,
+		"0m":    false,
+		"5":     false,
+		"1d":    false,
+		"300ms": false,
+		"-5m":   false,
+	}
+
+	for value, valid := range testCases {
+		_, errs := validateDevicePostureRuleDuration(value, "schedule")
+		if valid && len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got: %v", value, errs)
+		}
+		if !valid && len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", value)
+		}
+	}
+}
+
+func TestSuppressEquivalentDevicePostureRuleDurations(t *testing.T) {
+	testCases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"1h", "1h", true},
+		{"60m", "1h", true},
+		{"1h0m0s", "1h", true},
+		{"30m", "1h", false},
+		{"", "1h", false},
+		{"1h", "", false},
+	}
+
+	for _, tc := range testCases {
+		if got := suppressEquivalentDevicePostureRuleDurations("schedule", tc.old, tc.new, nil); got != tc.suppress {
+			t.Errorf("suppressEquivalentDevicePostureRuleDurations(%q, %q) = %t, expected %t", tc.old, tc.new, got, tc.suppress)
+		}
+	}
+}
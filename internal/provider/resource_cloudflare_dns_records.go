@@ -0,0 +1,488 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dnsBatchRecord is the representation of a DNS record used by the batch
+// API. cloudflare-go has no support for the batch endpoint.
+type dnsBatchRecord struct {
+	ID       string   `json:"id,omitempty"`
+	Type     string   `json:"type,omitempty"`
+	Name     string   `json:"name,omitempty"`
+	Content  string   `json:"content,omitempty"`
+	TTL      int      `json:"ttl,omitempty"`
+	Proxied  *bool    `json:"proxied,omitempty"`
+	Priority *int     `json:"priority,omitempty"`
+	Comment  string   `json:"comment"`
+	Tags     []string `json:"tags"`
+}
+
+// dnsBatchRecordRef identifies a record to delete in a batch.
+type dnsBatchRecordRef struct {
+	ID string `json:"id"`
+}
+
+type dnsRecordsBatchRequest struct {
+	Deletes []dnsBatchRecordRef `json:"deletes,omitempty"`
+	Patches []dnsBatchRecord    `json:"patches,omitempty"`
+	Posts   []dnsBatchRecord    `json:"posts,omitempty"`
+}
+
+type dnsRecordsBatchResponse struct {
+	Posts []dnsBatchRecord `json:"posts"`
+}
+
+// dnsRecordsBatchPlan holds the changes needed to reconcile a zone with the
+// desired records. postKeys and patchKeys hold the record key of each entry
+// of posts and patches respectively.
+type dnsRecordsBatchPlan struct {
+	posts     []dnsBatchRecord
+	postKeys  []string
+	patches   []dnsBatchRecord
+	patchKeys []string
+	deletes   []dnsBatchRecordRef
+	// keep holds the records which need no changes, including adopted ones.
+	keep map[string]string
+}
+
+func resourceCloudflareDNSRecords() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareDNSRecordsSchema(),
+		CreateContext: resourceCloudflareDNSRecordsCreate,
+		ReadContext:   resourceCloudflareDNSRecordsRead,
+		UpdateContext: resourceCloudflareDNSRecordsUpdate,
+		DeleteContext: resourceCloudflareDNSRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareDNSRecordsImport,
+		},
+		Description: "Provides a Cloudflare resource for managing many DNS records of a zone as a single resource. " +
+			"Changes are applied with the DNS batch API and existing records matching a configured record are " +
+			"adopted instead of duplicated, which keeps plans fast for zones with thousands of records.",
+	}
+}
+
+func resourceCloudflareDNSRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+
+	if err := reconcileDNSRecords(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareDNSRecordsRead(ctx, d, meta)
+}
+
+func resourceCloudflareDNSRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	existing, err := listDNSRecordsForBatch(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing DNS records for zone %q: %w", zoneID, err))
+	}
+
+	managed := dnsRecordsManaged(d)
+	byID := make(map[string]dnsBatchRecord, len(existing))
+	for _, r := range existing {
+		byID[r.ID] = r
+	}
+
+	records := make([]interface{}, 0, len(managed))
+	recordIDs := make(map[string]string, len(managed))
+	for _, id := range managed {
+		r, ok := byID[id]
+		if !ok {
+			continue
+		}
+		recordIDs[dnsRecordsKey(r)] = r.ID
+		records = append(records, flattenDNSBatchRecord(r))
+	}
+
+	// Unmanaged records are surfaced in `record` so that their deletion
+	// shows up in the plan.
+	if d.Get("delete_unmanaged").(bool) {
+		apex, err := dnsRecordsZoneApex(ctx, client, zoneID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, r := range existing {
+			if _, ok := recordIDs[dnsRecordsKey(r)]; ok || !dnsRecordDeletable(r, apex) {
+				continue
+			}
+			records = append(records, flattenDNSBatchRecord(r))
+		}
+	}
+
+	if err := d.Set("record", records); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set record: %w", err))
+	}
+
+	if err := d.Set("record_ids", recordIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set record_ids: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareDNSRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := reconcileDNSRecords(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareDNSRecordsRead(ctx, d, meta)
+}
+
+func resourceCloudflareDNSRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	managed := dnsRecordsManaged(d)
+
+	existing, err := listDNSRecordsForBatch(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing DNS records for zone %q: %w", zoneID, err))
+	}
+
+	plan := planDNSRecordsBatch(nil, existing, managed, false, "")
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting %d DNS records from zone %s", len(plan.deletes), zoneID))
+
+	if err := applyDNSRecordsBatch(ctx, client, zoneID, d.Get("batch_size").(int), plan, managed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareDNSRecordsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*apiClient)
+	zoneID := d.Id()
+
+	existing, err := listDNSRecordsForBatch(ctx, client, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing DNS records for zone %q: %w", zoneID, err)
+	}
+
+	apex, err := dnsRecordsZoneApex(ctx, client, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Importing adopts every record of the zone the resource can manage.
+	recordIDs := make(map[string]string, len(existing))
+	for _, r := range existing {
+		if dnsRecordDeletable(r, apex) && contains(dnsRecordsBatchTypes, r.Type) {
+			recordIDs[dnsRecordsKey(r)] = r.ID
+		}
+	}
+
+	d.Set("zone_id", zoneID)
+	d.Set("record_ids", recordIDs)
+	d.Set("batch_size", 200)
+	d.Set("delete_unmanaged", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// reconcileDNSRecords applies the changes needed for the zone to match the
+// configured records. record_ids is updated with the outcome of every batch
+// that was applied, so a failure part way through keeps track of the records
+// which were already created.
+func reconcileDNSRecords(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	managed := dnsRecordsManaged(d)
+
+	existing, err := listDNSRecordsForBatch(ctx, client, zoneID)
+	if err != nil {
+		return fmt.Errorf("error listing DNS records for zone %q: %w", zoneID, err)
+	}
+
+	apex := ""
+	deleteUnmanaged := d.Get("delete_unmanaged").(bool)
+	if deleteUnmanaged {
+		if apex, err = dnsRecordsZoneApex(ctx, client, zoneID); err != nil {
+			return err
+		}
+	}
+
+	desired := expandDNSBatchRecords(d.Get("record").(*schema.Set).List())
+	plan := planDNSRecordsBatch(desired, existing, managed, deleteUnmanaged, apex)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reconciling DNS records for zone %s: %d to create, %d to update, %d to delete",
+		zoneID, len(plan.posts), len(plan.patches), len(plan.deletes)))
+
+	recordIDs := plan.keep
+	err = applyDNSRecordsBatch(ctx, client, zoneID, d.Get("batch_size").(int), plan, recordIDs)
+
+	if setErr := d.Set("record_ids", recordIDs); setErr != nil && err == nil {
+		err = fmt.Errorf("failed to set record_ids: %w", setErr)
+	}
+
+	return err
+}
+
+// planDNSRecordsBatch works out the changes needed to get from the existing
+// records to the desired ones. Existing records matching a desired record
+// are updated in place whether or not they are managed yet. Records are only
+// deleted when they are managed or, with deleteUnmanaged, when they are not
+// protected (see dnsRecordDeletable).
+func planDNSRecordsBatch(desired map[string]dnsBatchRecord, existing []dnsBatchRecord, managed map[string]string, deleteUnmanaged bool, apex string) dnsRecordsBatchPlan {
+	plan := dnsRecordsBatchPlan{keep: make(map[string]string)}
+
+	byID := make(map[string]dnsBatchRecord, len(existing))
+	byKey := make(map[string]dnsBatchRecord, len(existing))
+	for _, r := range existing {
+		byID[r.ID] = r
+		if _, ok := byKey[dnsRecordsKey(r)]; !ok {
+			byKey[dnsRecordsKey(r)] = r
+		}
+	}
+
+	claimed := make(map[string]bool)
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		want := desired[key]
+
+		current, ok := byID[managed[key]]
+		if !ok {
+			current, ok = byKey[key]
+		}
+		if !ok || claimed[current.ID] {
+			plan.posts = append(plan.posts, want)
+			plan.postKeys = append(plan.postKeys, key)
+			continue
+		}
+
+		claimed[current.ID] = true
+		plan.keep[key] = current.ID
+
+		if !dnsBatchRecordEqual(current, want) {
+			want.ID = current.ID
+			plan.patches = append(plan.patches, want)
+			plan.patchKeys = append(plan.patchKeys, key)
+		}
+	}
+
+	managedIDs := make(map[string]bool, len(managed))
+	for _, id := range managed {
+		managedIDs[id] = true
+	}
+
+	for _, r := range existing {
+		if claimed[r.ID] {
+			continue
+		}
+		if managedIDs[r.ID] || (deleteUnmanaged && dnsRecordDeletable(r, apex)) {
+			plan.deletes = append(plan.deletes, dnsBatchRecordRef{ID: r.ID})
+		}
+	}
+
+	return plan
+}
+
+// applyDNSRecordsBatch sends the plan in chunks of at most batchSize
+// changes. Deletes are sent first so that records replaced with a different
+// value don't conflict with their replacement. recordIDs is updated as each
+// chunk succeeds.
+func applyDNSRecordsBatch(ctx context.Context, client *apiClient, zoneID string, batchSize int, plan dnsRecordsBatchPlan, recordIDs map[string]string) error {
+	uri := fmt.Sprintf("/zones/%s/dns_records/batch", zoneID)
+
+	for start := 0; start < len(plan.deletes); start += batchSize {
+		end := minInt(start+batchSize, len(plan.deletes))
+		if _, err := rawRequest(ctx, client, http.MethodPost, uri, dnsRecordsBatchRequest{Deletes: plan.deletes[start:end]}); err != nil {
+			return fmt.Errorf("error deleting DNS records in zone %q: %w", zoneID, err)
+		}
+	}
+
+	for start := 0; start < len(plan.patches); start += batchSize {
+		end := minInt(start+batchSize, len(plan.patches))
+		if _, err := rawRequest(ctx, client, http.MethodPost, uri, dnsRecordsBatchRequest{Patches: plan.patches[start:end]}); err != nil {
+			return fmt.Errorf("error updating DNS records in zone %q: %w", zoneID, err)
+		}
+	}
+
+	for start := 0; start < len(plan.posts); start += batchSize {
+		end := minInt(start+batchSize, len(plan.posts))
+
+		res, err := rawRequest(ctx, client, http.MethodPost, uri, dnsRecordsBatchRequest{Posts: plan.posts[start:end]})
+		if err != nil {
+			return fmt.Errorf("error creating DNS records in zone %q: %w", zoneID, err)
+		}
+
+		var result dnsRecordsBatchResponse
+		if err := json.Unmarshal(res, &result); err != nil {
+			return fmt.Errorf("error unmarshalling DNS records batch response: %w", err)
+		}
+
+		// Created records are returned in the order they were sent.
+		for i, r := range result.Posts {
+			if start+i < end {
+				recordIDs[plan.postKeys[start+i]] = r.ID
+			}
+		}
+	}
+
+	return nil
+}
+
+// listDNSRecordsForBatch returns every record of the zone using large pages,
+// as the default page size makes listing big zones slow.
+func listDNSRecordsForBatch(ctx context.Context, client *apiClient, zoneID string) ([]dnsBatchRecord, error) {
+	return listDNSRecordsWithFilter(ctx, client, zoneID, url.Values{})
+}
+
+// listDNSRecordsWithFilter returns every record of the zone matching the
+// filter, which is passed through to the API as query parameters.
+func listDNSRecordsWithFilter(ctx context.Context, client *apiClient, zoneID string, filter url.Values) ([]dnsBatchRecord, error) {
+	var records []dnsBatchRecord
+
+	filter.Set("per_page", "5000")
+	for page := 1; ; page++ {
+		filter.Set("page", strconv.Itoa(page))
+		uri := fmt.Sprintf("/zones/%s/dns_records?%s", zoneID, filter.Encode())
+
+		res, resultInfo, err := rawRequestWithResultInfo(ctx, client, http.MethodGet, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		var results []dnsBatchRecord
+		if err := json.Unmarshal(res, &results); err != nil {
+			return nil, fmt.Errorf("error unmarshalling DNS records: %w", err)
+		}
+		records = append(records, results...)
+
+		if len(results) == 0 || page >= resultInfo.TotalPages {
+			return records, nil
+		}
+	}
+}
+
+func dnsRecordsZoneApex(ctx context.Context, client *apiClient, zoneID string) (string, error) {
+	zone, err := client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return "", fmt.Errorf("error finding zone %q: %w", zoneID, err)
+	}
+
+	return strings.ToLower(zone.Name), nil
+}
+
+// dnsRecordDeletable reports whether an unmanaged record may be removed by
+// delete_unmanaged. The SOA and the apex NS records are never touched.
+func dnsRecordDeletable(r dnsBatchRecord, apex string) bool {
+	switch r.Type {
+	case "SOA":
+		return false
+	case "NS":
+		return strings.ToLower(r.Name) != apex
+	}
+
+	return true
+}
+
+func dnsRecordsKey(r dnsBatchRecord) string {
+	return fmt.Sprintf("%s/%s/%s", r.Type, strings.ToLower(r.Name), r.Content)
+}
+
+func dnsBatchRecordEqual(a, b dnsBatchRecord) bool {
+	intValue := func(p *int) int {
+		if p == nil {
+			return 0
+		}
+		return *p
+	}
+
+	sortedTags := func(tags []string) string {
+		sorted := append([]string{}, tags...)
+		sort.Strings(sorted)
+		return strings.Join(sorted, ",")
+	}
+
+	return a.TTL == b.TTL &&
+		cloudflare.Bool(a.Proxied) == cloudflare.Bool(b.Proxied) &&
+		intValue(a.Priority) == intValue(b.Priority) &&
+		a.Comment == b.Comment &&
+		sortedTags(a.Tags) == sortedTags(b.Tags)
+}
+
+func dnsRecordsManaged(d *schema.ResourceData) map[string]string {
+	managed := make(map[string]string)
+	for key, id := range d.Get("record_ids").(map[string]interface{}) {
+		managed[key] = id.(string)
+	}
+
+	return managed
+}
+
+func expandDNSBatchRecords(records []interface{}) map[string]dnsBatchRecord {
+	desired := make(map[string]dnsBatchRecord, len(records))
+
+	for _, item := range records {
+		data := item.(map[string]interface{})
+
+		r := dnsBatchRecord{
+			Type:    data["type"].(string),
+			Name:    strings.ToLower(data["name"].(string)),
+			Content: data["value"].(string),
+			TTL:     data["ttl"].(int),
+			Comment: data["comment"].(string),
+			Tags:    expandInterfaceToStringList(data["tags"].(*schema.Set).List()),
+		}
+
+		switch r.Type {
+		case "A", "AAAA", "CNAME":
+			r.Proxied = cloudflare.BoolPtr(data["proxied"].(bool))
+		}
+
+		if priority := data["priority"].(int); priority != 0 || r.Type == "MX" {
+			r.Priority = &priority
+		}
+
+		desired[dnsRecordsKey(r)] = r
+	}
+
+	return desired
+}
+
+func flattenDNSBatchRecord(r dnsBatchRecord) map[string]interface{} {
+	priority := 0
+	if r.Priority != nil {
+		priority = *r.Priority
+	}
+
+	return map[string]interface{}{
+		"name":     r.Name,
+		"type":     r.Type,
+		"value":    r.Content,
+		"ttl":      r.TTL,
+		"proxied":  cloudflare.Bool(r.Proxied),
+		"priority": priority,
+		"comment":  r.Comment,
+		"tags":     r.Tags,
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
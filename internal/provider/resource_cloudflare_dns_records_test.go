@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestPlanDNSRecordsBatch(t *testing.T) {
+	ttl := func(r dnsBatchRecord, ttl int) dnsBatchRecord {
+		r.TTL = ttl
+		return r
+	}
+
+	www := dnsBatchRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 1}
+	api := dnsBatchRecord{Type: "A", Name: "api.example.com", Content: "192.0.2.2", TTL: 1}
+	txt := dnsBatchRecord{Type: "TXT", Name: "example.com", Content: "v=spf1 -all", TTL: 1}
+	mail := dnsBatchRecord{Type: "A", Name: "mail.example.com", Content: "192.0.2.3", TTL: 1}
+
+	existing := []dnsBatchRecord{
+		{ID: "1", Type: "SOA", Name: "example.com", Content: "ns.example.com", TTL: 3600},
+		{ID: "2", Type: "NS", Name: "example.com", Content: "ns.example.com", TTL: 3600},
+		{ID: "3", Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 300},
+		{ID: "4", Type: "TXT", Name: "example.com", Content: "v=spf1 -all", TTL: 1},
+		{ID: "5", Type: "A", Name: "old.example.com", Content: "192.0.2.9", TTL: 1},
+		{ID: "6", Type: "A", Name: "unmanaged.example.com", Content: "192.0.2.10", TTL: 1},
+	}
+	managed := map[string]string{
+		dnsRecordsKey(www):            "3",
+		"A/old.example.com/192.0.2.9": "5",
+	}
+	desired := map[string]dnsBatchRecord{
+		dnsRecordsKey(www):  www,
+		dnsRecordsKey(api):  api,
+		dnsRecordsKey(txt):  txt,
+		dnsRecordsKey(mail): mail,
+	}
+
+	plan := planDNSRecordsBatch(desired, existing, managed, false, "example.com")
+
+	if !reflect.DeepEqual(plan.posts, []dnsBatchRecord{api, mail}) {
+		t.Errorf("unexpected posts: %+v", plan.posts)
+	}
+	if !reflect.DeepEqual(plan.postKeys, []string{dnsRecordsKey(api), dnsRecordsKey(mail)}) {
+		t.Errorf("unexpected post keys: %v", plan.postKeys)
+	}
+
+	patched := ttl(www, 1)
+	patched.ID = "3"
+	if !reflect.DeepEqual(plan.patches, []dnsBatchRecord{patched}) {
+		t.Errorf("unexpected patches: %+v", plan.patches)
+	}
+	if !reflect.DeepEqual(plan.deletes, []dnsBatchRecordRef{{ID: "5"}}) {
+		t.Errorf("unexpected deletes: %+v", plan.deletes)
+	}
+	if !reflect.DeepEqual(plan.keep, map[string]string{dnsRecordsKey(www): "3", dnsRecordsKey(txt): "4"}) {
+		t.Errorf("unexpected keep: %v", plan.keep)
+	}
+
+	plan = planDNSRecordsBatch(desired, existing, managed, true, "example.com")
+
+	if !reflect.DeepEqual(plan.deletes, []dnsBatchRecordRef{{ID: "5"}, {ID: "6"}}) {
+		t.Errorf("unexpected deletes with delete_unmanaged: %+v", plan.deletes)
+	}
+}
+
+func TestDNSBatchRecordEqual(t *testing.T) {
+	a := dnsBatchRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 1, Tags: []string{"a:1", "b:2"}}
+	b := a
+	b.Tags = []string{"b:2", "a:1"}
+
+	if !dnsBatchRecordEqual(a, b) {
+		t.Errorf("expected records with tags in a different order to be equal")
+	}
+
+	b.Comment = "managed by terraform"
+	if dnsBatchRecordEqual(a, b) {
+		t.Errorf("expected records with different comments to differ")
+	}
+
+	if !dnsBatchRecordEqual(dnsBatchRecord{}, dnsBatchRecord{Tags: []string{}}) {
+		t.Errorf("expected missing and empty tags to be equal")
+	}
+}
+
+func TestAccCloudflareDNSRecords_Basic(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_dns_records." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareDNSRecordsConfig(zoneID, rnd, domain, []string{"a", "b"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "record_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("record_ids.A/a-%s.%s/192.0.2.1", rnd, domain)),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareDNSRecordsConfig(zoneID, rnd, domain, []string{"b", "c", "d"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "record_ids.%", "3"),
+					resource.TestCheckNoResourceAttr(resourceName, fmt.Sprintf("record_ids.A/a-%s.%s/192.0.2.1", rnd, domain)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareDNSRecordsConfig(zoneID, rnd, domain string, prefixes []string) string {
+	records := ""
+	for _, prefix := range prefixes {
+		records += fmt.Sprintf(`
+  record {
+    name  = "%s-%s.%s"
+    type  = "A"
+    value = "192.0.2.1"
+  }
+`, prefix, rnd, domain)
+	}
+
+	return fmt.Sprintf(`
+resource "cloudflare_dns_records" "%[2]s" {
+  zone_id    = "%[1]s"
+  batch_size = 2
+%[3]s}
+`, zoneID, rnd, records)
+}
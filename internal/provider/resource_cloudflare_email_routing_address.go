@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type emailRoutingAddress struct {
+	Tag      string `json:"tag,omitempty"`
+	Email    string `json:"email"`
+	Verified string `json:"verified,omitempty"`
+	Created  string `json:"created,omitempty"`
+	Modified string `json:"modified,omitempty"`
+}
+
+func resourceCloudflareEmailRoutingAddress() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareEmailRoutingAddressSchema(),
+		CreateContext: resourceCloudflareEmailRoutingAddressCreate,
+		ReadContext:   resourceCloudflareEmailRoutingAddressRead,
+		DeleteContext: resourceCloudflareEmailRoutingAddressDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareEmailRoutingAddressImport,
+		},
+		Description: "Provides a resource to manage Email Routing destination addresses. Addresses must be verified before email can be forwarded to them.",
+	}
+}
+
+func resourceCloudflareEmailRoutingAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	address := emailRoutingAddress{Email: d.Get("email").(string)}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Email Routing address %s in account %s", address.Email, accountID))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, emailRoutingAddressURI(accountID, ""), address)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Email Routing address %q: %w", address.Email, err))
+	}
+
+	if err := json.Unmarshal(res, &address); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Routing address: %w", err))
+	}
+
+	d.SetId(address.Tag)
+
+	return resourceCloudflareEmailRoutingAddressRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailRoutingAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, emailRoutingAddressURI(accountID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Email Routing address %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Email Routing address %q: %w", d.Id(), err))
+	}
+
+	var address emailRoutingAddress
+	if err := json.Unmarshal(res, &address); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Routing address: %w", err))
+	}
+
+	d.Set("email", address.Email)
+	d.Set("verified", address.Verified)
+	d.Set("created", address.Created)
+	d.Set("modified", address.Modified)
+
+	return nil
+}
+
+func resourceCloudflareEmailRoutingAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodDelete, emailRoutingAddressURI(accountID, d.Id()), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Email Routing address %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailRoutingAddressImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/addressID\"", d.Id())
+	}
+
+	accountID, addressID := attributes[0], attributes[1]
+
+	d.Set("account_id", accountID)
+	d.SetId(addressID)
+
+	resourceCloudflareEmailRoutingAddressRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func emailRoutingAddressURI(accountID, addressID string) string {
+	uri := fmt.Sprintf("/accounts/%s/email/routing/addresses", accountID)
+	if addressID != "" {
+		uri += "/" + addressID
+	}
+	return uri
+}
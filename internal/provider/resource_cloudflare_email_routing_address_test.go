@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareEmailRoutingAddress_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_email_routing_address.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	email := fmt.Sprintf("%s@%s", rnd, domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareEmailRoutingAddressConfig(rnd, accountID, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "email", email),
+					resource.TestCheckResourceAttr(name, "verified", ""),
+					resource.TestCheckResourceAttrSet(name, "created"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareEmailRoutingAddressConfig(rnd, accountID, email string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_email_routing_address" "%[1]s" {
+  account_id = "%[2]s"
+  email      = "%[3]s"
+}
+`, rnd, accountID, email)
+}
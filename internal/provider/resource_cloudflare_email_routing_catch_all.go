@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareEmailRoutingCatchAll() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareEmailRoutingCatchAllSchema(),
+		CreateContext: resourceCloudflareEmailRoutingCatchAllUpdate,
+		ReadContext:   resourceCloudflareEmailRoutingCatchAllRead,
+		UpdateContext: resourceCloudflareEmailRoutingCatchAllUpdate,
+		DeleteContext: resourceCloudflareEmailRoutingCatchAllDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareEmailRoutingCatchAllImport,
+		},
+		Description: "Provides a resource to manage the Email Routing catch-all rule of a zone, which applies to emails not matched by any other rule.",
+	}
+}
+
+func resourceCloudflareEmailRoutingCatchAllRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, emailRoutingRuleURI(zoneID, "catch_all"), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Email Routing catch-all rule for zone %q: %w", zoneID, err))
+	}
+
+	var rule emailRoutingRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Routing catch-all rule: %w", err))
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("enabled", rule.Enabled)
+	d.Set("tag", rule.Tag)
+
+	if err := d.Set("action", flattenEmailRoutingRuleActions(rule.Actions)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set action: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailRoutingCatchAllUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	rule := emailRoutingRule{
+		Name:     d.Get("name").(string),
+		Enabled:  d.Get("enabled").(bool),
+		Matchers: []emailRoutingRuleMatcher{{Type: "all"}},
+		Actions:  expandEmailRoutingRuleActions(d.Get("action").([]interface{})),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Email Routing catch-all rule for zone %s: %+v", zoneID, rule))
+
+	if err := putEmailRoutingCatchAll(ctx, client, zoneID, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareEmailRoutingCatchAllRead(ctx, d, meta)
+}
+
+// resourceCloudflareEmailRoutingCatchAllDelete resets the catch-all rule to
+// its default of dropping emails, as it can't be removed.
+func resourceCloudflareEmailRoutingCatchAllDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	rule := emailRoutingRule{
+		Enabled:  false,
+		Matchers: []emailRoutingRuleMatcher{{Type: "all"}},
+		Actions:  []emailRoutingRuleAction{{Type: "drop"}},
+	}
+
+	if err := putEmailRoutingCatchAll(ctx, client, zoneID, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailRoutingCatchAllImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareEmailRoutingCatchAllRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func putEmailRoutingCatchAll(ctx context.Context, client *apiClient, zoneID string, rule emailRoutingRule) error {
+	if _, err := rawRequest(ctx, client, http.MethodPut, emailRoutingRuleURI(zoneID, "catch_all"), rule); err != nil {
+		return fmt.Errorf("error updating Email Routing catch-all rule for zone %q: %w", zoneID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareEmailRoutingCatchAll_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_email_routing_catch_all.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareEmailRoutingCatchAllConfig(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "action.0.type", "forward"),
+					resource.TestCheckResourceAttr(name, "action.0.value.0", fmt.Sprintf("catch-all@%s", domain)),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareEmailRoutingCatchAllConfig(rnd, zoneID, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_email_routing_catch_all" "%[1]s" {
+  zone_id = "%[2]s"
+  name    = "%[1]s"
+  enabled = true
+
+  action {
+    type  = "forward"
+    value = ["catch-all@%[3]s"]
+  }
+}
+`, rnd, zoneID, domain)
+}
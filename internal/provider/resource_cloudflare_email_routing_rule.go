@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type emailRoutingRule struct {
+	Tag      string                    `json:"tag,omitempty"`
+	Name     string                    `json:"name"`
+	Enabled  bool                      `json:"enabled"`
+	Priority *int                      `json:"priority,omitempty"`
+	Matchers []emailRoutingRuleMatcher `json:"matchers"`
+	Actions  []emailRoutingRuleAction  `json:"actions"`
+}
+
+type emailRoutingRuleMatcher struct {
+	Type  string `json:"type"`
+	Field string `json:"field,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type emailRoutingRuleAction struct {
+	Type  string   `json:"type"`
+	Value []string `json:"value,omitempty"`
+}
+
+func resourceCloudflareEmailRoutingRule() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareEmailRoutingRuleSchema(),
+		CreateContext: resourceCloudflareEmailRoutingRuleCreate,
+		ReadContext:   resourceCloudflareEmailRoutingRuleRead,
+		UpdateContext: resourceCloudflareEmailRoutingRuleUpdate,
+		DeleteContext: resourceCloudflareEmailRoutingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareEmailRoutingRuleImport,
+		},
+		Description: "Provides a resource to manage Email Routing rules, which forward, drop or hand emails sent to matching addresses of a zone to a Worker.",
+	}
+}
+
+func resourceCloudflareEmailRoutingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	rule := buildEmailRoutingRule(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Email Routing rule for zone %s: %+v", zoneID, rule))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, emailRoutingRuleURI(zoneID, ""), rule)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Email Routing rule for zone %q: %w", zoneID, err))
+	}
+
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Routing rule: %w", err))
+	}
+
+	d.SetId(rule.Tag)
+
+	return resourceCloudflareEmailRoutingRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailRoutingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, emailRoutingRuleURI(zoneID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Email Routing rule %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Email Routing rule %q: %w", d.Id(), err))
+	}
+
+	var rule emailRoutingRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Routing rule: %w", err))
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("enabled", rule.Enabled)
+	d.Set("tag", rule.Tag)
+	if rule.Priority != nil {
+		d.Set("priority", *rule.Priority)
+	}
+
+	matchers := make([]interface{}, 0, len(rule.Matchers))
+	for _, m := range rule.Matchers {
+		matchers = append(matchers, map[string]interface{}{
+			"type":  m.Type,
+			"field": m.Field,
+			"value": m.Value,
+		})
+	}
+	if err := d.Set("matcher", matchers); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set matcher: %w", err))
+	}
+
+	if err := d.Set("action", flattenEmailRoutingRuleActions(rule.Actions)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set action: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailRoutingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	rule := buildEmailRoutingRule(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Email Routing rule %s for zone %s: %+v", d.Id(), zoneID, rule))
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, emailRoutingRuleURI(zoneID, d.Id()), rule); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Email Routing rule %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareEmailRoutingRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailRoutingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodDelete, emailRoutingRuleURI(zoneID, d.Id()), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Email Routing rule %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailRoutingRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/ruleID\"", d.Id())
+	}
+
+	zoneID, ruleID := attributes[0], attributes[1]
+
+	d.Set("zone_id", zoneID)
+	d.SetId(ruleID)
+
+	resourceCloudflareEmailRoutingRuleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildEmailRoutingRule(d *schema.ResourceData) emailRoutingRule {
+	rule := emailRoutingRule{
+		Name:    d.Get("name").(string),
+		Enabled: d.Get("enabled").(bool),
+		Actions: expandEmailRoutingRuleActions(d.Get("action").([]interface{})),
+	}
+
+	if priority, ok := d.GetOkExists("priority"); ok {
+		p := priority.(int)
+		rule.Priority = &p
+	}
+
+	for _, item := range d.Get("matcher").([]interface{}) {
+		matcher := item.(map[string]interface{})
+		rule.Matchers = append(rule.Matchers, emailRoutingRuleMatcher{
+			Type:  matcher["type"].(string),
+			Field: matcher["field"].(string),
+			Value: matcher["value"].(string),
+		})
+	}
+
+	return rule
+}
+
+func expandEmailRoutingRuleActions(items []interface{}) []emailRoutingRuleAction {
+	actions := make([]emailRoutingRuleAction, 0, len(items))
+	for _, item := range items {
+		action := item.(map[string]interface{})
+		actions = append(actions, emailRoutingRuleAction{
+			Type:  action["type"].(string),
+			Value: expandInterfaceToStringList(action["value"]),
+		})
+	}
+
+	return actions
+}
+
+func flattenEmailRoutingRuleActions(actions []emailRoutingRuleAction) []interface{} {
+	flattened := make([]interface{}, 0, len(actions))
+	for _, a := range actions {
+		flattened = append(flattened, map[string]interface{}{
+			"type":  a.Type,
+			"value": a.Value,
+		})
+	}
+
+	return flattened
+}
+
+func emailRoutingRuleURI(zoneID, ruleID string) string {
+	uri := fmt.Sprintf("%s/rules", emailRoutingURI(zoneID))
+	if ruleID != "" {
+		uri += "/" + ruleID
+	}
+	return uri
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareEmailRoutingRule_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_email_routing_rule.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareEmailRoutingRuleConfig(rnd, zoneID, domain, "forward"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "matcher.0.type", "literal"),
+					resource.TestCheckResourceAttr(name, "matcher.0.field", "to"),
+					resource.TestCheckResourceAttr(name, "matcher.0.value", fmt.Sprintf("%s@%s", rnd, domain)),
+					resource.TestCheckResourceAttr(name, "action.0.type", "forward"),
+					resource.TestCheckResourceAttr(name, "action.0.value.0", fmt.Sprintf("destination@%s", domain)),
+					resource.TestCheckResourceAttrSet(name, "priority"),
+				),
+			},
+			{
+				Config: testAccCloudflareEmailRoutingRuleConfig(rnd, zoneID, domain, "drop"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "action.0.type", "drop"),
+					resource.TestCheckResourceAttr(name, "action.0.value.#", "0"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareEmailRoutingRuleConfig(rnd, zoneID, domain, actionType string) string {
+	value := ""
+	if actionType == "forward" {
+		value = fmt.Sprintf(`value = ["destination@%s"]`, domain)
+	}
+
+	return fmt.Sprintf(`
+resource "cloudflare_email_routing_rule" "%[1]s" {
+  zone_id = "%[2]s"
+  name    = "%[1]s"
+
+  matcher {
+    value = "%[1]s@%[3]s"
+  }
+
+  action {
+    type = "%[4]s"
+    %[5]s
+  }
+}
+`, rnd, zoneID, domain, actionType, value)
+}
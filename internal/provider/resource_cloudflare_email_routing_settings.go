@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type emailRoutingSettings struct {
+	Tag        string `json:"tag"`
+	Name       string `json:"name"`
+	Enabled    bool   `json:"enabled"`
+	SkipWizard bool   `json:"skip_wizard"`
+	Status     string `json:"status"`
+	Created    string `json:"created"`
+	Modified   string `json:"modified"`
+}
+
+func resourceCloudflareEmailRoutingSettings() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareEmailRoutingSettingsSchema(),
+		CreateContext: resourceCloudflareEmailRoutingSettingsCreate,
+		ReadContext:   resourceCloudflareEmailRoutingSettingsRead,
+		UpdateContext: resourceCloudflareEmailRoutingSettingsUpdate,
+		DeleteContext: resourceCloudflareEmailRoutingSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareEmailRoutingSettingsImport,
+		},
+		Description: "Provides a resource to enable or disable Email Routing for a zone. Enabling Email Routing adds the MX and SPF records it requires to the zone.",
+	}
+}
+
+func resourceCloudflareEmailRoutingSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	d.SetId(zoneID)
+
+	if err := setEmailRoutingEnabled(ctx, client, zoneID, d.Get("enabled").(bool), d.Get("skip_wizard").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareEmailRoutingSettingsRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailRoutingSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, emailRoutingURI(zoneID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Email Routing settings for zone %q: %w", zoneID, err))
+	}
+
+	var settings emailRoutingSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Routing settings: %w", err))
+	}
+
+	d.Set("enabled", settings.Enabled)
+	d.Set("skip_wizard", settings.SkipWizard)
+	d.Set("name", settings.Name)
+	d.Set("status", settings.Status)
+	d.Set("tag", settings.Tag)
+	d.Set("created", settings.Created)
+	d.Set("modified", settings.Modified)
+
+	return nil
+}
+
+func resourceCloudflareEmailRoutingSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	if d.HasChanges("enabled", "skip_wizard") {
+		if err := setEmailRoutingEnabled(ctx, client, zoneID, d.Get("enabled").(bool), d.Get("skip_wizard").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflareEmailRoutingSettingsRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailRoutingSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Disabling Email Routing for zone %s", zoneID))
+
+	if err := setEmailRoutingEnabled(ctx, client, zoneID, false, false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailRoutingSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareEmailRoutingSettingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setEmailRoutingEnabled toggles Email Routing, which is managed through
+// dedicated endpoints rather than by updating the settings.
+func setEmailRoutingEnabled(ctx context.Context, client *apiClient, zoneID string, enabled, skipWizard bool) error {
+	action := "disable"
+	var payload interface{}
+	if enabled {
+		action = "enable"
+		payload = struct {
+			SkipWizard bool `json:"skip_wizard"`
+		}{SkipWizard: skipWizard}
+	}
+
+	if _, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("%s/%s", emailRoutingURI(zoneID), action), payload); err != nil {
+		return fmt.Errorf("failed to %s Email Routing for zone %q: %w", action, zoneID, err)
+	}
+
+	return nil
+}
+
+func emailRoutingURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/email/routing", zoneID)
+}
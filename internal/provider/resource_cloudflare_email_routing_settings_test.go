@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareEmailRoutingSettings_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_email_routing_settings.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareEmailRoutingSettingsConfig(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "name", domain),
+					resource.TestCheckResourceAttrSet(name, "tag"),
+				),
+			},
+			{
+				Config: testAccCloudflareEmailRoutingSettingsConfig(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareEmailRoutingSettingsConfig(rnd, zoneID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_email_routing_settings" "%[1]s" {
+  zone_id     = "%[2]s"
+  enabled     = %[3]t
+  skip_wizard = true
+}
+`, rnd, zoneID, enabled)
+}
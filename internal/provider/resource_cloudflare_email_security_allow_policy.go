@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type emailSecurityAllowPolicy struct {
+	ID                 int    `json:"id,omitempty"`
+	Pattern            string `json:"pattern"`
+	PatternType        string `json:"pattern_type"`
+	IsRegex            bool   `json:"is_regex"`
+	IsAcceptableSender bool   `json:"is_acceptable_sender"`
+	IsExemptRecipient  bool   `json:"is_exempt_recipient"`
+	IsTrustedSender    bool   `json:"is_trusted_sender"`
+	VerifySender       bool   `json:"verify_sender"`
+	Comments           string `json:"comments"`
+	CreatedAt          string `json:"created_at,omitempty"`
+	LastModified       string `json:"last_modified,omitempty"`
+}
+
+func resourceCloudflareEmailSecurityAllowPolicy() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareEmailSecurityAllowPolicySchema(),
+		CreateContext: resourceCloudflareEmailSecurityAllowPolicyCreate,
+		ReadContext:   resourceCloudflareEmailSecurityAllowPolicyRead,
+		UpdateContext: resourceCloudflareEmailSecurityAllowPolicyUpdate,
+		DeleteContext: resourceCloudflareEmailSecurityAllowPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareEmailSecurityAllowPolicyImport,
+		},
+		Description: "Provides a resource to manage Email Security allow policies, which exempt matching senders or recipients from detections.",
+	}
+}
+
+func resourceCloudflareEmailSecurityAllowPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	item := buildEmailSecurityAllowPolicy(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Email Security allow policy in account %s: %+v", accountID, item))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, emailSecurityURI(accountID, "allow_policies", ""), item)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Email Security allow policy: %w", err))
+	}
+
+	if err := json.Unmarshal(res, &item); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Security allow policy: %w", err))
+	}
+
+	d.SetId(strconv.Itoa(item.ID))
+
+	return resourceCloudflareEmailSecurityAllowPolicyRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailSecurityAllowPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, emailSecurityURI(accountID, "allow_policies", d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Email Security allow policy %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Email Security allow policy %q: %w", d.Id(), err))
+	}
+
+	var item emailSecurityAllowPolicy
+	if err := json.Unmarshal(res, &item); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Security allow policy: %w", err))
+	}
+
+	d.Set("pattern", item.Pattern)
+	d.Set("pattern_type", item.PatternType)
+	d.Set("is_regex", item.IsRegex)
+	d.Set("is_acceptable_sender", item.IsAcceptableSender)
+	d.Set("is_exempt_recipient", item.IsExemptRecipient)
+	d.Set("is_trusted_sender", item.IsTrustedSender)
+	d.Set("verify_sender", item.VerifySender)
+	d.Set("comments", item.Comments)
+	d.Set("created_at", item.CreatedAt)
+	d.Set("last_modified", item.LastModified)
+
+	return nil
+}
+
+func resourceCloudflareEmailSecurityAllowPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	item := buildEmailSecurityAllowPolicy(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Email Security allow policy %s: %+v", d.Id(), item))
+
+	if _, err := rawRequest(ctx, client, http.MethodPatch, emailSecurityURI(accountID, "allow_policies", d.Id()), item); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Email Security allow policy %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareEmailSecurityAllowPolicyRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailSecurityAllowPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodDelete, emailSecurityURI(accountID, "allow_policies", d.Id()), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Email Security allow policy %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailSecurityAllowPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/allowPolicyID\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	d.SetId(attributes[1])
+
+	resourceCloudflareEmailSecurityAllowPolicyRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildEmailSecurityAllowPolicy(d *schema.ResourceData) emailSecurityAllowPolicy {
+	return emailSecurityAllowPolicy{
+		Pattern:            d.Get("pattern").(string),
+		PatternType:        d.Get("pattern_type").(string),
+		IsRegex:            d.Get("is_regex").(bool),
+		IsAcceptableSender: d.Get("is_acceptable_sender").(bool),
+		IsExemptRecipient:  d.Get("is_exempt_recipient").(bool),
+		IsTrustedSender:    d.Get("is_trusted_sender").(bool),
+		VerifySender:       d.Get("verify_sender").(bool),
+		Comments:           d.Get("comments").(string),
+	}
+}
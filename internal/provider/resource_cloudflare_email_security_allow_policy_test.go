@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareEmailSecurityAllowPolicy_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_email_security_allow_policy.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	pattern := fmt.Sprintf("%s.example.com", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareEmailSecurityAllowPolicyConfig(rnd, accountID, pattern, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "pattern", pattern),
+					resource.TestCheckResourceAttr(name, "pattern_type", "DOMAIN"),
+					resource.TestCheckResourceAttr(name, "is_trusted_sender", "true"),
+					resource.TestCheckResourceAttr(name, "verify_sender", "false"),
+					resource.TestCheckResourceAttr(name, "is_acceptable_sender", "false"),
+					resource.TestCheckResourceAttr(name, "is_exempt_recipient", "false"),
+					resource.TestCheckResourceAttrSet(name, "created_at"),
+				),
+			},
+			{
+				Config: testAccCloudflareEmailSecurityAllowPolicyConfig(rnd, accountID, pattern, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "is_trusted_sender", "true"),
+					resource.TestCheckResourceAttr(name, "verify_sender", "true"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareEmailSecurityAllowPolicyConfig(rnd, accountID, pattern string, trustedSender, verifySender bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_email_security_allow_policy" "%[1]s" {
+  account_id        = "%[2]s"
+  pattern_type      = "DOMAIN"
+  pattern           = "%[3]s"
+  is_trusted_sender = %[4]t
+  verify_sender     = %[5]t
+  comments          = "%[1]s"
+}
+`, rnd, accountID, pattern, trustedSender, verifySender)
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type emailSecurityBlockSender struct {
+	ID           int    `json:"id,omitempty"`
+	Pattern      string `json:"pattern"`
+	PatternType  string `json:"pattern_type"`
+	IsRegex      bool   `json:"is_regex"`
+	Comments     string `json:"comments"`
+	CreatedAt    string `json:"created_at,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+func resourceCloudflareEmailSecurityBlockSender() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareEmailSecurityBlockSenderSchema(),
+		CreateContext: resourceCloudflareEmailSecurityBlockSenderCreate,
+		ReadContext:   resourceCloudflareEmailSecurityBlockSenderRead,
+		UpdateContext: resourceCloudflareEmailSecurityBlockSenderUpdate,
+		DeleteContext: resourceCloudflareEmailSecurityBlockSenderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareEmailSecurityBlockSenderImport,
+		},
+		Description: "Provides a resource to manage Email Security block senders, which block emails from matching senders.",
+	}
+}
+
+func resourceCloudflareEmailSecurityBlockSenderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	item := buildEmailSecurityBlockSender(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Email Security block sender in account %s: %+v", accountID, item))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, emailSecurityURI(accountID, "block_senders", ""), item)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Email Security block sender: %w", err))
+	}
+
+	if err := json.Unmarshal(res, &item); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Security block sender: %w", err))
+	}
+
+	d.SetId(strconv.Itoa(item.ID))
+
+	return resourceCloudflareEmailSecurityBlockSenderRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailSecurityBlockSenderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, emailSecurityURI(accountID, "block_senders", d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Email Security block sender %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Email Security block sender %q: %w", d.Id(), err))
+	}
+
+	var item emailSecurityBlockSender
+	if err := json.Unmarshal(res, &item); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Security block sender: %w", err))
+	}
+
+	d.Set("pattern", item.Pattern)
+	d.Set("pattern_type", item.PatternType)
+	d.Set("is_regex", item.IsRegex)
+	d.Set("comments", item.Comments)
+	d.Set("created_at", item.CreatedAt)
+	d.Set("last_modified", item.LastModified)
+
+	return nil
+}
+
+func resourceCloudflareEmailSecurityBlockSenderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	item := buildEmailSecurityBlockSender(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Email Security block sender %s: %+v", d.Id(), item))
+
+	if _, err := rawRequest(ctx, client, http.MethodPatch, emailSecurityURI(accountID, "block_senders", d.Id()), item); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Email Security block sender %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareEmailSecurityBlockSenderRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailSecurityBlockSenderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodDelete, emailSecurityURI(accountID, "block_senders", d.Id()), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Email Security block sender %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailSecurityBlockSenderImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/blockSenderID\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	d.SetId(attributes[1])
+
+	resourceCloudflareEmailSecurityBlockSenderRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildEmailSecurityBlockSender(d *schema.ResourceData) emailSecurityBlockSender {
+	return emailSecurityBlockSender{
+		Pattern:     d.Get("pattern").(string),
+		PatternType: d.Get("pattern_type").(string),
+		IsRegex:     d.Get("is_regex").(bool),
+		Comments:    d.Get("comments").(string),
+	}
+}
+
+// emailSecurityURI returns the URI of an Email Security setting, or of the
+// collection when id is empty.
+func emailSecurityURI(accountID, setting, id string) string {
+	uri := fmt.Sprintf("/accounts/%s/email-security/settings/%s", accountID, setting)
+	if id != "" {
+		uri += "/" + id
+	}
+	return uri
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareEmailSecurityBlockSender_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_email_security_block_sender.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareEmailSecurityBlockSenderConfig(rnd, accountID, "EMAIL", fmt.Sprintf("%s@example.com", rnd), "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "pattern", fmt.Sprintf("%s@example.com", rnd)),
+					resource.TestCheckResourceAttr(name, "pattern_type", "EMAIL"),
+					resource.TestCheckResourceAttr(name, "is_regex", "false"),
+					resource.TestCheckResourceAttr(name, "comments", "initial"),
+					resource.TestCheckResourceAttrSet(name, "created_at"),
+				),
+			},
+			{
+				Config: testAccCloudflareEmailSecurityBlockSenderConfig(rnd, accountID, "DOMAIN", fmt.Sprintf("%s.example.com", rnd), "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "pattern", fmt.Sprintf("%s.example.com", rnd)),
+					resource.TestCheckResourceAttr(name, "pattern_type", "DOMAIN"),
+					resource.TestCheckResourceAttr(name, "comments", "updated"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareEmailSecurityBlockSenderConfig(rnd, accountID, patternType, pattern, comments string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_email_security_block_sender" "%[1]s" {
+  account_id   = "%[2]s"
+  pattern_type = "%[3]s"
+  pattern      = "%[4]s"
+  comments     = "%[5]s"
+}
+`, rnd, accountID, patternType, pattern, comments)
+}
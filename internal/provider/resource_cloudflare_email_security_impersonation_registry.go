@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type emailSecurityImpersonationRegistry struct {
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	IsEmailRegex bool   `json:"is_email_regex"`
+	CreatedAt    string `json:"created_at,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+func resourceCloudflareEmailSecurityImpersonationRegistry() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareEmailSecurityImpersonationRegistrySchema(),
+		CreateContext: resourceCloudflareEmailSecurityImpersonationRegistryCreate,
+		ReadContext:   resourceCloudflareEmailSecurityImpersonationRegistryRead,
+		UpdateContext: resourceCloudflareEmailSecurityImpersonationRegistryUpdate,
+		DeleteContext: resourceCloudflareEmailSecurityImpersonationRegistryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareEmailSecurityImpersonationRegistryImport,
+		},
+		Description: "Provides a resource to manage Email Security impersonation registry entries, the display names and addresses of people likely to be impersonated.",
+	}
+}
+
+func resourceCloudflareEmailSecurityImpersonationRegistryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	item := buildEmailSecurityImpersonationRegistry(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Email Security impersonation registry entry in account %s: %+v", accountID, item))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, emailSecurityURI(accountID, "impersonation_registry", ""), item)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Email Security impersonation registry entry: %w", err))
+	}
+
+	if err := json.Unmarshal(res, &item); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Security impersonation registry entry: %w", err))
+	}
+
+	d.SetId(strconv.Itoa(item.ID))
+
+	return resourceCloudflareEmailSecurityImpersonationRegistryRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailSecurityImpersonationRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, emailSecurityURI(accountID, "impersonation_registry", d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Email Security impersonation registry entry %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Email Security impersonation registry entry %q: %w", d.Id(), err))
+	}
+
+	var item emailSecurityImpersonationRegistry
+	if err := json.Unmarshal(res, &item); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Security impersonation registry entry: %w", err))
+	}
+
+	d.Set("name", item.Name)
+	d.Set("email", item.Email)
+	d.Set("is_email_regex", item.IsEmailRegex)
+	d.Set("created_at", item.CreatedAt)
+	d.Set("last_modified", item.LastModified)
+
+	return nil
+}
+
+func resourceCloudflareEmailSecurityImpersonationRegistryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	item := buildEmailSecurityImpersonationRegistry(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Email Security impersonation registry entry %s: %+v", d.Id(), item))
+
+	if _, err := rawRequest(ctx, client, http.MethodPatch, emailSecurityURI(accountID, "impersonation_registry", d.Id()), item); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Email Security impersonation registry entry %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareEmailSecurityImpersonationRegistryRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailSecurityImpersonationRegistryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodDelete, emailSecurityURI(accountID, "impersonation_registry", d.Id()), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Email Security impersonation registry entry %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailSecurityImpersonationRegistryImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/impersonationRegistryID\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	d.SetId(attributes[1])
+
+	resourceCloudflareEmailSecurityImpersonationRegistryRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildEmailSecurityImpersonationRegistry(d *schema.ResourceData) emailSecurityImpersonationRegistry {
+	return emailSecurityImpersonationRegistry{
+		Name:         d.Get("name").(string),
+		Email:        d.Get("email").(string),
+		IsEmailRegex: d.Get("is_email_regex").(bool),
+	}
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareEmailSecurityImpersonationRegistry_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_email_security_impersonation_registry.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	email := fmt.Sprintf("%s@example.com", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareEmailSecurityImpersonationRegistryConfig(rnd, accountID, "Jane Doe", email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", "Jane Doe"),
+					resource.TestCheckResourceAttr(name, "email", email),
+					resource.TestCheckResourceAttr(name, "is_email_regex", "false"),
+					resource.TestCheckResourceAttrSet(name, "created_at"),
+				),
+			},
+			{
+				Config: testAccCloudflareEmailSecurityImpersonationRegistryConfig(rnd, accountID, "Jane A. Doe", email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "Jane A. Doe"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareEmailSecurityImpersonationRegistryConfig(rnd, accountID, displayName, email string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_email_security_impersonation_registry" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[3]s"
+  email      = "%[4]s"
+}
+`, rnd, accountID, displayName, email)
+}
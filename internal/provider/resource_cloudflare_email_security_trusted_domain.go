@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type emailSecurityTrustedDomain struct {
+	ID           int    `json:"id,omitempty"`
+	Pattern      string `json:"pattern"`
+	IsRegex      bool   `json:"is_regex"`
+	IsRecent     bool   `json:"is_recent"`
+	IsSimilarity bool   `json:"is_similarity"`
+	Comments     string `json:"comments"`
+	CreatedAt    string `json:"created_at,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+func resourceCloudflareEmailSecurityTrustedDomain() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareEmailSecurityTrustedDomainSchema(),
+		CreateContext: resourceCloudflareEmailSecurityTrustedDomainCreate,
+		ReadContext:   resourceCloudflareEmailSecurityTrustedDomainRead,
+		UpdateContext: resourceCloudflareEmailSecurityTrustedDomainUpdate,
+		DeleteContext: resourceCloudflareEmailSecurityTrustedDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareEmailSecurityTrustedDomainImport,
+		},
+		Description: "Provides a resource to manage Email Security trusted domains, which are not flagged as recently registered or lookalike domains.",
+	}
+}
+
+func resourceCloudflareEmailSecurityTrustedDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	item := buildEmailSecurityTrustedDomain(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Email Security trusted domain in account %s: %+v", accountID, item))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, emailSecurityURI(accountID, "trusted_domains", ""), item)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Email Security trusted domain: %w", err))
+	}
+
+	if err := json.Unmarshal(res, &item); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Security trusted domain: %w", err))
+	}
+
+	d.SetId(strconv.Itoa(item.ID))
+
+	return resourceCloudflareEmailSecurityTrustedDomainRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailSecurityTrustedDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, emailSecurityURI(accountID, "trusted_domains", d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Email Security trusted domain %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Email Security trusted domain %q: %w", d.Id(), err))
+	}
+
+	var item emailSecurityTrustedDomain
+	if err := json.Unmarshal(res, &item); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Email Security trusted domain: %w", err))
+	}
+
+	d.Set("pattern", item.Pattern)
+	d.Set("is_regex", item.IsRegex)
+	d.Set("is_recent", item.IsRecent)
+	d.Set("is_similarity", item.IsSimilarity)
+	d.Set("comments", item.Comments)
+	d.Set("created_at", item.CreatedAt)
+	d.Set("last_modified", item.LastModified)
+
+	return nil
+}
+
+func resourceCloudflareEmailSecurityTrustedDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	item := buildEmailSecurityTrustedDomain(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Email Security trusted domain %s: %+v", d.Id(), item))
+
+	if _, err := rawRequest(ctx, client, http.MethodPatch, emailSecurityURI(accountID, "trusted_domains", d.Id()), item); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Email Security trusted domain %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareEmailSecurityTrustedDomainRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailSecurityTrustedDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodDelete, emailSecurityURI(accountID, "trusted_domains", d.Id()), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Email Security trusted domain %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailSecurityTrustedDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/trustedDomainID\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	d.SetId(attributes[1])
+
+	resourceCloudflareEmailSecurityTrustedDomainRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildEmailSecurityTrustedDomain(d *schema.ResourceData) emailSecurityTrustedDomain {
+	return emailSecurityTrustedDomain{
+		Pattern:      d.Get("pattern").(string),
+		IsRegex:      d.Get("is_regex").(bool),
+		IsRecent:     d.Get("is_recent").(bool),
+		IsSimilarity: d.Get("is_similarity").(bool),
+		Comments:     d.Get("comments").(string),
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareEmailSecurityTrustedDomain_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_email_security_trusted_domain.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	pattern := fmt.Sprintf("%s.example.com", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareEmailSecurityTrustedDomainConfig(rnd, accountID, pattern, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "pattern", pattern),
+					resource.TestCheckResourceAttr(name, "is_recent", "true"),
+					resource.TestCheckResourceAttr(name, "is_similarity", "false"),
+					resource.TestCheckResourceAttr(name, "is_regex", "false"),
+					resource.TestCheckResourceAttrSet(name, "created_at"),
+				),
+			},
+			{
+				Config: testAccCloudflareEmailSecurityTrustedDomainConfig(rnd, accountID, pattern, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "is_recent", "false"),
+					resource.TestCheckResourceAttr(name, "is_similarity", "true"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareEmailSecurityTrustedDomainConfig(rnd, accountID, pattern string, recent, similarity bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_email_security_trusted_domain" "%[1]s" {
+  account_id    = "%[2]s"
+  pattern       = "%[3]s"
+  is_recent     = %[4]t
+  is_similarity = %[5]t
+}
+`, rnd, accountID, pattern, recent, similarity)
+}
@@ -23,7 +23,7 @@ func resourceCloudflareFallbackDomain() *schema.Resource {
 }
 
 func resourceCloudflareFallbackDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	domain, err := client.ListFallbackDomains(ctx, accountID)
@@ -39,7 +39,7 @@ func resourceCloudflareFallbackDomainRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceCloudflareFallbackDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	domainList := expandFallbackDomains(d.Get("domains").([]interface{}))
@@ -59,7 +59,7 @@ func resourceCloudflareFallbackDomainUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceCloudflareFallbackDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	err := client.RestoreFallbackDomainDefaults(ctx, accountID)
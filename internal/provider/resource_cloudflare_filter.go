@@ -26,7 +26,7 @@ func resourceCloudflareFilter() *schema.Resource {
 }
 
 func resourceCloudflareFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	var err error
@@ -69,7 +69,7 @@ func resourceCloudflareFilterCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCloudflareFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	tflog.Debug(ctx, fmt.Sprintf("Getting a Filter record for zone %q, id %s", zoneID, d.Id()))
@@ -98,7 +98,7 @@ func resourceCloudflareFilterRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceCloudflareFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	var newFilter cloudflare.Filter
@@ -136,7 +136,7 @@ func resourceCloudflareFilterUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCloudflareFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare Filter: id %s for zone %s", d.Id(), zoneID))
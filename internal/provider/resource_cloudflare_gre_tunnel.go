@@ -27,7 +27,7 @@ func resourceCloudflareGRETunnel() *schema.Resource {
 
 func resourceCloudflareGRETunnelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	accountID := d.Get("account_id").(string)
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	newTunnel, err := client.CreateMagicTransitGRETunnels(ctx, accountID, []cloudflare.MagicTransitGRETunnel{
 		GRETunnelFromResource(d),
@@ -63,7 +63,7 @@ func resourceCloudflareGRETunnelImport(ctx context.Context, d *schema.ResourceDa
 
 func resourceCloudflareGRETunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	accountID := d.Get("account_id").(string)
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	tunnel, err := client.GetMagicTransitGRETunnel(ctx, accountID, d.Id())
 	if err != nil {
@@ -94,7 +94,7 @@ func resourceCloudflareGRETunnelRead(ctx context.Context, d *schema.ResourceData
 
 func resourceCloudflareGRETunnelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	accountID := d.Get("account_id").(string)
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	_, err := client.UpdateMagicTransitGRETunnel(ctx, accountID, d.Id(), GRETunnelFromResource(d))
 	if err != nil {
@@ -106,7 +106,7 @@ func resourceCloudflareGRETunnelUpdate(ctx context.Context, d *schema.ResourceDa
 
 func resourceCloudflareGRETunnelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	accountID := d.Get("account_id").(string)
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting GRE tunnel:  %s", d.Id()))
 
@@ -34,7 +34,7 @@ func resourceCloudflareHealthcheck() *schema.Resource {
 }
 
 func resourceCloudflareHealthcheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	healthcheck, err := client.Healthcheck(ctx, zoneID, d.Id())
@@ -80,12 +80,14 @@ func resourceCloudflareHealthcheckRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("created_on", healthcheck.CreatedOn.Format(time.RFC3339Nano))
 	d.Set("modified_on", healthcheck.ModifiedOn.Format(time.RFC3339Nano))
 	d.Set("check_regions", healthcheck.CheckRegions)
+	d.Set("status", healthcheck.Status)
+	d.Set("failure_reason", healthcheck.FailureReason)
 
 	return nil
 }
 
 func resourceCloudflareHealthcheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	healthcheck, err := healthcheckSetStruct(d)
@@ -117,7 +119,7 @@ func resourceCloudflareHealthcheckCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareHealthcheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	healthcheck, err := healthcheckSetStruct(d)
@@ -134,7 +136,7 @@ func resourceCloudflareHealthcheckUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareHealthcheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	err := client.DeleteHealthcheck(ctx, zoneID, d.Id())
@@ -40,6 +40,7 @@ func TestAccCloudflareHealthcheckTCPExists(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "description", ""),
 					resource.TestCheckResourceAttr(name, "port", "80"),
 					resource.TestCheckResourceAttr(name, "method", "connection_established"),
+					resource.TestCheckResourceAttrSet(name, "status"),
 				),
 			},
 		},
@@ -156,7 +157,7 @@ func testAccCheckCloudflareHealthcheckExists(n string, zoneID string, load *clou
 			return fmt.Errorf("No Healthcheck ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundHealthcheck, err := client.Healthcheck(context.Background(), zoneID, rs.Primary.ID)
 		if err != nil {
 			return err
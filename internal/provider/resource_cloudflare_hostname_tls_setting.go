@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var hostnameTLSSettingValues = map[string][]string{
+	"min_tls_version": {"1.0", "1.1", "1.2", "1.3"},
+	"http2":           {"on", "off"},
+}
+
+type hostnameTLSSetting struct {
+	Hostname string          `json:"hostname"`
+	Value    json.RawMessage `json:"value"`
+	Status   string          `json:"status"`
+}
+
+func resourceCloudflareHostnameTLSSetting() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareHostnameTLSSettingSchema(),
+		CreateContext: resourceCloudflareHostnameTLSSettingUpdate,
+		ReadContext:   resourceCloudflareHostnameTLSSettingRead,
+		UpdateContext: resourceCloudflareHostnameTLSSettingUpdate,
+		DeleteContext: resourceCloudflareHostnameTLSSettingDelete,
+		CustomizeDiff: resourceCloudflareHostnameTLSSettingDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareHostnameTLSSettingImport,
+		},
+		Description: "Provides a resource to manage a TLS setting (minimum TLS version, cipher suites or HTTP/2) of a single hostname, overriding the setting of the zone.",
+	}
+}
+
+func resourceCloudflareHostnameTLSSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	setting := d.Get("setting").(string)
+	hostname := d.Get("hostname").(string)
+
+	var value interface{} = d.Get("value").(string)
+	if setting == "ciphers" {
+		value = expandInterfaceToStringList(d.Get("ciphers"))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting %s of hostname %s", setting, hostname))
+
+	_, err := rawRequest(ctx, client, http.MethodPut, hostnameTLSSettingURI(zoneID, setting, hostname), map[string]interface{}{"value": value})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting %s of hostname %q: %w", setting, hostname, err))
+	}
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s/%s", zoneID, setting, hostname)))
+
+	return resourceCloudflareHostnameTLSSettingRead(ctx, d, meta)
+}
+
+func resourceCloudflareHostnameTLSSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	setting := d.Get("setting").(string)
+	hostname := d.Get("hostname").(string)
+
+	current, err := fetchHostnameTLSSetting(ctx, client, zoneID, setting, hostname)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading %s of hostname %q: %w", setting, hostname, err))
+	}
+
+	if current == nil {
+		tflog.Info(ctx, fmt.Sprintf("%s of hostname %s no longer exists", setting, hostname))
+		d.SetId("")
+		return nil
+	}
+
+	if setting == "ciphers" {
+		var ciphers []string
+		if err := json.Unmarshal(current.Value, &ciphers); err != nil {
+			return diag.FromErr(fmt.Errorf("error unmarshalling %s of hostname %q: %w", setting, hostname, err))
+		}
+		d.Set("ciphers", ciphers)
+	} else {
+		var value string
+		if err := json.Unmarshal(current.Value, &value); err != nil {
+			return diag.FromErr(fmt.Errorf("error unmarshalling %s of hostname %q: %w", setting, hostname, err))
+		}
+		d.Set("value", value)
+	}
+	d.Set("status", current.Status)
+
+	return nil
+}
+
+func resourceCloudflareHostnameTLSSettingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	setting := d.Get("setting").(string)
+	hostname := d.Get("hostname").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, hostnameTLSSettingURI(zoneID, setting, hostname), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting %s of hostname %q: %w", setting, hostname, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareHostnameTLSSettingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/setting/hostname"`, d.Id())
+	}
+
+	zoneID, setting, hostname := attributes[0], attributes[1], attributes[2]
+	if !contains(hostnameTLSSettings, setting) {
+		return nil, fmt.Errorf("invalid setting %q specified, should be one of %s", setting, strings.Join(hostnameTLSSettings, ", "))
+	}
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s/%s", zoneID, setting, hostname)))
+	d.Set("zone_id", zoneID)
+	d.Set("setting", setting)
+	d.Set("hostname", hostname)
+
+	resourceCloudflareHostnameTLSSettingRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceCloudflareHostnameTLSSettingDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Values that aren't known yet are validated on the next plan.
+	if !d.NewValueKnown("value") || !d.NewValueKnown("ciphers") {
+		return nil
+	}
+
+	return validateHostnameTLSSetting(d.Get("setting").(string), d.Get("value").(string), len(d.Get("ciphers").([]interface{})))
+}
+
+// validateHostnameTLSSetting checks that the value is set in the attribute
+// matching the setting and is valid for it.
+func validateHostnameTLSSetting(setting, value string, ciphers int) error {
+	if setting == "ciphers" {
+		if value != "" {
+			return fmt.Errorf("value can't be used with the ciphers setting, use ciphers instead")
+		}
+		if ciphers == 0 {
+			return fmt.Errorf("ciphers is required for the ciphers setting")
+		}
+		return nil
+	}
+
+	if ciphers > 0 {
+		return fmt.Errorf("ciphers can only be used with the ciphers setting")
+	}
+
+	allowed := hostnameTLSSettingValues[setting]
+	if !contains(allowed, value) {
+		return fmt.Errorf("value for the %s setting must be one of %s, got %q", setting, strings.Join(allowed, ", "), value)
+	}
+
+	return nil
+}
+
+// fetchHostnameTLSSetting returns the setting of the hostname or nil when the
+// hostname does not override the setting of the zone.
+func fetchHostnameTLSSetting(ctx context.Context, client *apiClient, zoneID, setting, hostname string) (*hostnameTLSSetting, error) {
+	for page := 1; ; page++ {
+		uri := fmt.Sprintf("/zones/%s/hostnames/settings/%s?per_page=50&page=%d", zoneID, setting, page)
+
+		res, resultInfo, err := rawRequestWithResultInfo(ctx, client, http.MethodGet, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		var settings []hostnameTLSSetting
+		if err := json.Unmarshal(res, &settings); err != nil {
+			return nil, fmt.Errorf("error unmarshalling hostname TLS settings: %w", err)
+		}
+
+		for _, s := range settings {
+			if strings.EqualFold(s.Hostname, hostname) {
+				return &s, nil
+			}
+		}
+
+		if len(settings) == 0 || page >= resultInfo.TotalPages {
+			return nil, nil
+		}
+	}
+}
+
+func hostnameTLSSettingURI(zoneID, setting, hostname string) string {
+	return fmt.Sprintf("/zones/%s/hostnames/settings/%s/%s", zoneID, setting, hostname)
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestValidateHostnameTLSSetting(t *testing.T) {
+	tests := map[string]struct {
+		setting string
+		value   string
+		ciphers int
+		wantErr bool
+	}{
+		"min TLS version":             {setting: "min_tls_version", value: "1.2"},
+		"invalid min TLS version":     {setting: "min_tls_version", value: "1.4", wantErr: true},
+		"http2":                       {setting: "http2", value: "off"},
+		"missing http2 value":         {setting: "http2", wantErr: true},
+		"ciphers":                     {setting: "ciphers", ciphers: 2},
+		"ciphers without list":        {setting: "ciphers", wantErr: true},
+		"ciphers with value":          {setting: "ciphers", value: "1.2", ciphers: 1, wantErr: true},
+		"cipher list with other type": {setting: "http2", value: "on", ciphers: 1, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateHostnameTLSSetting(tc.setting, tc.value, tc.ciphers)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAccCloudflareHostnameTLSSetting_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_hostname_tls_setting." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	hostname := fmt.Sprintf("%s.%s", rnd, os.Getenv("CLOUDFLARE_DOMAIN"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareHostnameTLSSettingConfig(rnd, zoneID, hostname, "1.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostname", hostname),
+					resource.TestCheckResourceAttr(name, "setting", "min_tls_version"),
+					resource.TestCheckResourceAttr(name, "value", "1.2"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+				),
+			},
+			{
+				Config: testAccCloudflareHostnameTLSSettingConfig(rnd, zoneID, hostname, "1.3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "value", "1.3"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/min_tls_version/%s", zoneID, hostname),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareHostnameTLSSetting_Ciphers(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_hostname_tls_setting." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	hostname := fmt.Sprintf("%s.%s", rnd, os.Getenv("CLOUDFLARE_DOMAIN"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareHostnameTLSSettingCiphersConfig(rnd, zoneID, hostname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "setting", "ciphers"),
+					resource.TestCheckResourceAttr(name, "ciphers.#", "2"),
+					resource.TestCheckResourceAttr(name, "ciphers.0", "ECDHE-RSA-AES128-GCM-SHA256"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareHostnameTLSSettingConfig(rnd, zoneID, hostname, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_hostname_tls_setting" "%[1]s" {
+  zone_id  = "%[2]s"
+  hostname = "%[3]s"
+  setting  = "min_tls_version"
+  value    = "%[4]s"
+}`, rnd, zoneID, hostname, value)
+}
+
+func testAccCloudflareHostnameTLSSettingCiphersConfig(rnd, zoneID, hostname string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_hostname_tls_setting" "%[1]s" {
+  zone_id  = "%[2]s"
+  hostname = "%[3]s"
+  setting  = "ciphers"
+  ciphers  = ["ECDHE-RSA-AES128-GCM-SHA256", "AES128-GCM-SHA256"]
+}`, rnd, zoneID, hostname)
+}
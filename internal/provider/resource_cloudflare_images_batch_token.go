@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type imagesBatchToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func resourceCloudflareImagesBatchToken() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareImagesBatchTokenSchema(),
+		CreateContext: resourceCloudflareImagesBatchTokenCreate,
+		ReadContext:   resourceCloudflareImagesBatchTokenRead,
+		DeleteContext: resourceCloudflareImagesBatchTokenDelete,
+		Description: "Provides a resource issuing a token for the Cloudflare Images batch API. " +
+			"Tokens cannot be revoked, they are removed from state once they expire so that the next apply issues a new one.",
+	}
+}
+
+func resourceCloudflareImagesBatchTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/images/v1/batch_token", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Images batch token: %w", err))
+	}
+
+	var token imagesBatchToken
+	if err := json.Unmarshal(res, &token); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Images batch token: %w", err))
+	}
+
+	d.SetId(stringChecksum(token.Token))
+	d.Set("token", token.Token)
+	d.Set("expires_on", token.ExpiresAt.Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceCloudflareImagesBatchTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	expiresOn, err := time.Parse(time.RFC3339, d.Get("expires_on").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing expiry of Images batch token: %w", err))
+	}
+
+	if time.Now().After(expiresOn) {
+		tflog.Info(ctx, fmt.Sprintf("Images batch token %s expired on %s", d.Id(), expiresOn))
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceCloudflareImagesBatchTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Batch tokens cannot be revoked and only expire.
+	return nil
+}
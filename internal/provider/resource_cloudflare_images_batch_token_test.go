@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareImagesBatchToken_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_images_batch_token.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareImagesBatchTokenConfig(rnd, accountID, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "token"),
+					resource.TestCheckResourceAttrSet(name, "expires_on"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[name].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCloudflareImagesBatchTokenConfig(rnd, accountID, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "token"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources[name].Primary.ID == id {
+							return fmt.Errorf("expected a new Images batch token to be issued")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareImagesBatchTokenConfig(rnd, accountID, trigger string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_images_batch_token" "%[1]s" {
+  account_id       = "%[2]s"
+  rotation_trigger = "%[3]s"
+}
+`, rnd, accountID, trigger)
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type imagesSigningKey struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func resourceCloudflareImagesSigningKey() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareImagesSigningKeySchema(),
+		CreateContext: resourceCloudflareImagesSigningKeyCreate,
+		ReadContext:   resourceCloudflareImagesSigningKeyRead,
+		UpdateContext: resourceCloudflareImagesSigningKeyUpdate,
+		DeleteContext: resourceCloudflareImagesSigningKeyDelete,
+		CustomizeDiff: resourceCloudflareImagesSigningKeyDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareImagesSigningKeyImport,
+		},
+		Description: "Provides a resource for managing the keys used to sign Cloudflare Images delivery URLs. " +
+			"The value of a key is rotated in place whenever `rotation_trigger` changes. " +
+			"To keep the previous value valid during a rotation, replace the key with a new `name` instead.",
+	}
+}
+
+func resourceCloudflareImagesSigningKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodPut, fmt.Sprintf("/accounts/%s/images/v1/keys/%s", accountID, name), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Images signing key %q: %w", name, err))
+	}
+
+	d.SetId(name)
+
+	return resourceCloudflareImagesSigningKeyRead(ctx, d, meta)
+}
+
+// resourceCloudflareImagesSigningKeyUpdate rotates the value of the key, as
+// creating a key with the name of an existing one replaces its value.
+func resourceCloudflareImagesSigningKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if d.HasChange("rotation_trigger") {
+		tflog.Info(ctx, fmt.Sprintf("Rotating Images signing key %s", d.Id()))
+
+		_, err := rawRequest(ctx, client, http.MethodPut, fmt.Sprintf("/accounts/%s/images/v1/keys/%s", accountID, d.Id()), nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error rotating Images signing key %q: %w", d.Id(), err))
+		}
+	}
+
+	return resourceCloudflareImagesSigningKeyRead(ctx, d, meta)
+}
+
+func resourceCloudflareImagesSigningKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/images/v1/keys", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Images signing keys: %w", err))
+	}
+
+	var result struct {
+		Keys []imagesSigningKey `json:"keys"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Images signing keys: %w", err))
+	}
+
+	for _, key := range result.Keys {
+		if key.Name == d.Id() {
+			d.Set("name", key.Name)
+			d.Set("value", key.Value)
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Images signing key %s no longer exists", d.Id()))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareImagesSigningKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, fmt.Sprintf("/accounts/%s/images/v1/keys/%s", accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Images signing key %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareImagesSigningKeyDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("rotation_trigger") {
+		return d.SetNewComputed("value")
+	}
+
+	return nil
+}
+
+func resourceCloudflareImagesSigningKeyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/keyName"`, d.Id())
+	}
+
+	accountID, name := attributes[0], attributes[1]
+
+	d.SetId(name)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareImagesSigningKeyRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
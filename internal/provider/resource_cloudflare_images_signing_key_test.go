@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareImagesSigningKey_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_images_signing_key.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareImagesSigningKeyConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttrSet(name, "value"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareImagesSigningKeyConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_images_signing_key" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+`, rnd, accountID)
+}
+
+func TestAccCloudflareImagesSigningKey_Rotation(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_images_signing_key.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	var value string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareImagesSigningKeyRotationConfig(rnd, accountID, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rotation_trigger", "first"),
+					func(s *terraform.State) error {
+						value = s.RootModule().Resources[name].Primary.Attributes["value"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCloudflareImagesSigningKeyRotationConfig(rnd, accountID, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "rotation_trigger", "second"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources[name].Primary.Attributes["value"] == value {
+							return fmt.Errorf("expected the value of the signing key to be rotated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareImagesSigningKeyRotationConfig(rnd, accountID, trigger string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_images_signing_key" "%[1]s" {
+  account_id       = "%[2]s"
+  name             = "%[1]s"
+  rotation_trigger = "%[3]s"
+}
+`, rnd, accountID, trigger)
+}
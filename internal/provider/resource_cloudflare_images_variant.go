@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	imagesVariantFitValues      = []string{"scale-down", "contain", "cover", "crop", "pad"}
+	imagesVariantMetadataValues = []string{"keep", "copyright", "none"}
+)
+
+type imagesVariant struct {
+	ID                     string               `json:"id"`
+	Options                imagesVariantOptions `json:"options"`
+	NeverRequireSignedURLs bool                 `json:"neverRequireSignedURLs"`
+}
+
+type imagesVariantOptions struct {
+	Fit      string `json:"fit"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	Metadata string `json:"metadata"`
+}
+
+func resourceCloudflareImagesVariant() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareImagesVariantSchema(),
+		CreateContext: resourceCloudflareImagesVariantCreate,
+		ReadContext:   resourceCloudflareImagesVariantRead,
+		UpdateContext: resourceCloudflareImagesVariantUpdate,
+		DeleteContext: resourceCloudflareImagesVariantDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareImagesVariantImport,
+		},
+		Description: "Provides a resource for managing Cloudflare Images variants, which define how images are resized for delivery.",
+	}
+}
+
+func resourceCloudflareImagesVariantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	variant := buildImagesVariant(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Images variant from struct: %+v", variant))
+
+	_, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/images/v1/variants", accountID), variant)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Images variant %q: %w", variant.ID, err))
+	}
+
+	d.SetId(variant.ID)
+
+	return resourceCloudflareImagesVariantRead(ctx, d, meta)
+}
+
+func resourceCloudflareImagesVariantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, imagesVariantURI(accountID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Images variant %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Images variant %q: %w", d.Id(), err))
+	}
+
+	var result struct {
+		Variant imagesVariant `json:"variant"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Images variant: %w", err))
+	}
+
+	v := result.Variant
+	d.Set("name", v.ID)
+	d.Set("fit", v.Options.Fit)
+	d.Set("width", v.Options.Width)
+	d.Set("height", v.Options.Height)
+	d.Set("metadata", v.Options.Metadata)
+	d.Set("never_require_signed_urls", v.NeverRequireSignedURLs)
+
+	return nil
+}
+
+func resourceCloudflareImagesVariantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	variant := buildImagesVariant(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Images variant %s from struct: %+v", d.Id(), variant))
+
+	_, err := rawRequest(ctx, client, http.MethodPatch, imagesVariantURI(accountID, d.Id()), variant)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Images variant %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareImagesVariantRead(ctx, d, meta)
+}
+
+func resourceCloudflareImagesVariantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, imagesVariantURI(accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Images variant %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareImagesVariantImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/variantName"`, d.Id())
+	}
+
+	accountID, name := attributes[0], attributes[1]
+
+	d.SetId(name)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareImagesVariantRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildImagesVariant(d *schema.ResourceData) imagesVariant {
+	return imagesVariant{
+		ID: d.Get("name").(string),
+		Options: imagesVariantOptions{
+			Fit:      d.Get("fit").(string),
+			Width:    d.Get("width").(int),
+			Height:   d.Get("height").(int),
+			Metadata: d.Get("metadata").(string),
+		},
+		NeverRequireSignedURLs: d.Get("never_require_signed_urls").(bool),
+	}
+}
+
+func imagesVariantURI(accountID, name string) string {
+	return fmt.Sprintf("/accounts/%s/images/v1/variants/%s", accountID, name)
+}
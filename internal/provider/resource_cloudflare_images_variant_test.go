@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareImagesVariant_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_images_variant.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareImagesVariantConfig(rnd, accountID, "scale-down", 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "fit", "scale-down"),
+					resource.TestCheckResourceAttr(name, "width", "100"),
+					resource.TestCheckResourceAttr(name, "height", "100"),
+					resource.TestCheckResourceAttr(name, "metadata", "none"),
+					resource.TestCheckResourceAttr(name, "never_require_signed_urls", "false"),
+				),
+			},
+			{
+				Config: testAccCloudflareImagesVariantConfig(rnd, accountID, "cover", 250),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "fit", "cover"),
+					resource.TestCheckResourceAttr(name, "width", "250"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareImagesVariantConfig(rnd, accountID, fit string, size int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_images_variant" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  fit        = "%[3]s"
+  width      = %[4]d
+  height     = %[4]d
+}
+`, rnd, accountID, fit, size)
+}
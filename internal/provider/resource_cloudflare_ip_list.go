@@ -30,7 +30,7 @@ func resourceCloudflareIPList() *schema.Resource {
 }
 
 func resourceCloudflareIPListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	list, err := client.CreateList(ctx, cloudflare.ListCreateParams{
@@ -77,7 +77,7 @@ func resourceCloudflareIPListImport(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCloudflareIPListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	list, err := client.GetList(ctx, cloudflare.ListGetParams{
@@ -122,7 +122,7 @@ func resourceCloudflareIPListRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceCloudflareIPListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	_, err := client.UpdateList(ctx, cloudflare.ListUpdateParams{
@@ -150,7 +150,7 @@ func resourceCloudflareIPListUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCloudflareIPListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	_, err := client.DeleteList(ctx, cloudflare.ListDeleteParams{
@@ -159,7 +159,7 @@ func testAccCheckCloudflareIPListExists(n string, list *cloudflare.IPList) resou
 			return fmt.Errorf("No IP List ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundIPList, err := client.GetIPList(context.Background(), accountID, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -28,7 +28,7 @@ func resourceCloudflareIPsecTunnel() *schema.Resource {
 
 func resourceCloudflareIPsecTunnelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	accountID := d.Get("account_id").(string)
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	newTunnel, err := client.CreateMagicTransitIPsecTunnels(ctx, accountID, []cloudflare.MagicTransitIPsecTunnel{
 		IPsecTunnelFromResource(d),
@@ -77,7 +77,7 @@ func resourceCloudflareIPsecTunnelImport(ctx context.Context, d *schema.Resource
 
 func resourceCloudflareIPsecTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	accountID := d.Get("account_id").(string)
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	tunnel, err := client.GetMagicTransitIPsecTunnel(ctx, accountID, d.Id())
 	if err != nil {
@@ -113,7 +113,7 @@ func resourceCloudflareIPsecTunnelRead(ctx context.Context, d *schema.ResourceDa
 
 func resourceCloudflareIPsecTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	accountID := d.Get("account_id").(string)
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	_, err := client.UpdateMagicTransitIPsecTunnel(ctx, accountID, d.Id(), IPsecTunnelFromResource(d))
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating IPsec tunnel %q", d.Id())))
@@ -137,7 +137,7 @@ func resourceCloudflareIPsecTunnelUpdate(ctx context.Context, d *schema.Resource
 
 func resourceCloudflareIPsecTunnelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	accountID := d.Get("account_id").(string)
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting IPsec tunnel:  %s", d.Id()))
 
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// keylessCertificate is a Keyless SSL configuration including the tunnel
+// options cloudflare-go does not support.
+type keylessCertificate struct {
+	ID           string                    `json:"id,omitempty"`
+	Name         string                    `json:"name,omitempty"`
+	Host         string                    `json:"host,omitempty"`
+	Port         int                       `json:"port,omitempty"`
+	Certificate  string                    `json:"certificate,omitempty"`
+	BundleMethod string                    `json:"bundle_method,omitempty"`
+	Enabled      *bool                     `json:"enabled,omitempty"`
+	Status       string                    `json:"status,omitempty"`
+	Tunnel       *keylessCertificateTunnel `json:"tunnel"`
+}
+
+type keylessCertificateTunnel struct {
+	PrivateIP string `json:"private_ip"`
+	VnetID    string `json:"vnet_id"`
+}
+
+func resourceCloudflareKeylessCertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareKeylessCertificateSchema(),
+		CreateContext: resourceCloudflareKeylessCertificateCreate,
+		ReadContext:   resourceCloudflareKeylessCertificateRead,
+		UpdateContext: resourceCloudflareKeylessCertificateUpdate,
+		DeleteContext: resourceCloudflareKeylessCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareKeylessCertificateImport,
+		},
+		Description: "Provides a resource to manage Keyless SSL configurations, which terminate TLS with private keys held by your own key servers.",
+	}
+}
+
+func resourceCloudflareKeylessCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	certificate := keylessCertificate{
+		Name:         d.Get("name").(string),
+		Host:         d.Get("host").(string),
+		Port:         d.Get("port").(int),
+		Certificate:  d.Get("certificate").(string),
+		BundleMethod: d.Get("bundle_method").(string),
+		Tunnel:       expandKeylessCertificateTunnel(d),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Keyless SSL configuration for %s:%d", certificate.Host, certificate.Port))
+
+	cert, err := keylessCertificateRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/keyless_certificates", zoneID), certificate)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Keyless SSL configuration: %w", err))
+	}
+
+	d.SetId(cert.ID)
+
+	// Configurations are always created enabled.
+	if !d.Get("enabled").(bool) {
+		return resourceCloudflareKeylessCertificateUpdate(ctx, d, meta)
+	}
+
+	return resourceCloudflareKeylessCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareKeylessCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	cert, err := keylessCertificateRequest(ctx, client, http.MethodGet, keylessCertificateURI(zoneID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Keyless SSL configuration %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Keyless SSL configuration %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", cert.Name)
+	d.Set("host", cert.Host)
+	d.Set("port", cert.Port)
+	d.Set("status", cert.Status)
+	if cert.Enabled != nil {
+		d.Set("enabled", *cert.Enabled)
+	}
+
+	var tunnel []map[string]interface{}
+	if cert.Tunnel != nil {
+		tunnel = append(tunnel, map[string]interface{}{
+			"private_ip": cert.Tunnel.PrivateIP,
+			"vnet_id":    cert.Tunnel.VnetID,
+		})
+	}
+	if err := d.Set("tunnel", tunnel); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tunnel: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareKeylessCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	certificate := keylessCertificate{
+		Name:    d.Get("name").(string),
+		Host:    d.Get("host").(string),
+		Port:    d.Get("port").(int),
+		Enabled: cloudflare.BoolPtr(d.Get("enabled").(bool)),
+		Tunnel:  expandKeylessCertificateTunnel(d),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Keyless SSL configuration %s", d.Id()))
+
+	if _, err := keylessCertificateRequest(ctx, client, http.MethodPatch, keylessCertificateURI(zoneID, d.Id()), certificate); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Keyless SSL configuration %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareKeylessCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareKeylessCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	if err := client.DeleteKeylessSSL(ctx, zoneID, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Keyless SSL configuration %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareKeylessCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/keylessCertificateID"`, d.Id())
+	}
+
+	zoneID, keylessCertificateID := attributes[0], attributes[1]
+
+	d.SetId(keylessCertificateID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareKeylessCertificateRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandKeylessCertificateTunnel(d *schema.ResourceData) *keylessCertificateTunnel {
+	tunnel, ok := d.Get("tunnel").([]interface{})
+	if !ok || len(tunnel) == 0 || tunnel[0] == nil {
+		return nil
+	}
+
+	t := tunnel[0].(map[string]interface{})
+
+	return &keylessCertificateTunnel{
+		PrivateIP: t["private_ip"].(string),
+		VnetID:    t["vnet_id"].(string),
+	}
+}
+
+func keylessCertificateURI(zoneID, keylessCertificateID string) string {
+	return fmt.Sprintf("/zones/%s/keyless_certificates/%s", zoneID, keylessCertificateID)
+}
+
+func keylessCertificateRequest(ctx context.Context, client *apiClient, method, uri string, body interface{}) (keylessCertificate, error) {
+	var cert keylessCertificate
+
+	res, err := rawRequest(ctx, client, method, uri, body)
+	if err != nil {
+		return cert, err
+	}
+
+	if err := json.Unmarshal(res, &cert); err != nil {
+		return cert, fmt.Errorf("error unmarshalling Keyless SSL configuration: %w", err)
+	}
+
+	return cert, nil
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareKeylessCertificate_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_keyless_certificate." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	certificate := os.Getenv("CLOUDFLARE_KEYLESS_CERTIFICATE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckKeylessCertificate(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareKeylessCertificateConfig(rnd, zoneID, certificate, "keyless."+domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "host", "keyless."+domain),
+					resource.TestCheckResourceAttr(name, "port", "24008"),
+					resource.TestCheckResourceAttr(name, "bundle_method", "ubiquitous"),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+				),
+			},
+			{
+				Config: testAccCloudflareKeylessCertificateConfig(rnd, zoneID, certificate, "keyless-updated."+domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "host", "keyless-updated."+domain),
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", zoneID),
+				ImportStateVerifyIgnore: []string{"certificate", "bundle_method"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareKeylessCertificateConfig(rnd, zoneID, certificate, host string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_keyless_certificate" "%[1]s" {
+  zone_id     = "%[2]s"
+  name        = "%[1]s"
+  certificate = <<EOT
+%[3]s
+EOT
+  host        = "%[4]s"
+  enabled     = %[5]t
+}`, rnd, zoneID, certificate, host, enabled)
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type leakedCredentialCheck struct {
+	Enabled bool `json:"enabled"`
+}
+
+func resourceCloudflareLeakedCredentialCheck() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareLeakedCredentialCheckSchema(),
+		CreateContext: resourceCloudflareLeakedCredentialCheckCreate,
+		ReadContext:   resourceCloudflareLeakedCredentialCheckRead,
+		UpdateContext: resourceCloudflareLeakedCredentialCheckUpdate,
+		DeleteContext: resourceCloudflareLeakedCredentialCheckDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareLeakedCredentialCheckImport,
+		},
+		Description: "Provides a resource to manage leaked credential detection for a zone. Deleting the resource disables the detection.",
+	}
+}
+
+func resourceCloudflareLeakedCredentialCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+	return resourceCloudflareLeakedCredentialCheckUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareLeakedCredentialCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, leakedCredentialCheckURI(zoneID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading leaked credential check status for zone %q: %w", zoneID, err))
+	}
+
+	var check leakedCredentialCheck
+	if err := json.Unmarshal(res, &check); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling leaked credential check status: %w", err))
+	}
+
+	d.Set("enabled", check.Enabled)
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	check := leakedCredentialCheck{Enabled: d.Get("enabled").(bool)}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting leaked credential check status for zone %s: %+v", zoneID, check))
+
+	if _, err := rawRequest(ctx, client, http.MethodPost, leakedCredentialCheckURI(zoneID), check); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating leaked credential check status for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareLeakedCredentialCheckRead(ctx, d, meta)
+}
+
+func resourceCloudflareLeakedCredentialCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Disabling leaked credential check for zone %s", zoneID))
+
+	if _, err := rawRequest(ctx, client, http.MethodPost, leakedCredentialCheckURI(zoneID), leakedCredentialCheck{Enabled: false}); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling leaked credential check for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareLeakedCredentialCheckRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func leakedCredentialCheckURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/leaked-credential-checks", zoneID)
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type leakedCredentialCheckRule struct {
+	ID       string `json:"id,omitempty"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+func resourceCloudflareLeakedCredentialCheckRule() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareLeakedCredentialCheckRuleSchema(),
+		CreateContext: resourceCloudflareLeakedCredentialCheckRuleCreate,
+		ReadContext:   resourceCloudflareLeakedCredentialCheckRuleRead,
+		UpdateContext: resourceCloudflareLeakedCredentialCheckRuleUpdate,
+		DeleteContext: resourceCloudflareLeakedCredentialCheckRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareLeakedCredentialCheckRuleImport,
+		},
+		Description: "Provides a resource to manage a custom detection location for leaked credential checks, " +
+			"telling Cloudflare where the username and password are found in requests to the zone.",
+	}
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	rule := buildLeakedCredentialCheckRule(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating leaked credential check rule from struct: %+v", rule))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("%s/detections", leakedCredentialCheckURI(zoneID)), rule)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating leaked credential check rule: %w", err))
+	}
+
+	var created leakedCredentialCheckRule
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling leaked credential check rule: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareLeakedCredentialCheckRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	// Detections can only be listed, not fetched individually.
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("%s/detections", leakedCredentialCheckURI(zoneID)), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing leaked credential check rules for zone %q: %w", zoneID, err))
+	}
+
+	var rules []leakedCredentialCheckRule
+	if err := json.Unmarshal(res, &rules); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling leaked credential check rules: %w", err))
+	}
+
+	rule, ok := findLeakedCredentialCheckRule(rules, d.Id())
+	if !ok {
+		tflog.Info(ctx, fmt.Sprintf("Leaked credential check rule %s no longer exists", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("username", rule.Username)
+	d.Set("password", rule.Password)
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	rule := buildLeakedCredentialCheckRule(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating leaked credential check rule %s from struct: %+v", d.Id(), rule))
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, leakedCredentialCheckRuleURI(zoneID, d.Id()), rule); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating leaked credential check rule %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareLeakedCredentialCheckRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodDelete, leakedCredentialCheckRuleURI(zoneID, d.Id()), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting leaked credential check rule %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/ruleID"`, d.Id())
+	}
+
+	zoneID, ruleID := attributes[0], attributes[1]
+
+	d.SetId(ruleID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareLeakedCredentialCheckRuleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildLeakedCredentialCheckRule(d *schema.ResourceData) leakedCredentialCheckRule {
+	return leakedCredentialCheckRule{
+		Username: d.Get("username").(string),
+		Password: d.Get("password").(string),
+	}
+}
+
+func findLeakedCredentialCheckRule(rules []leakedCredentialCheckRule, id string) (leakedCredentialCheckRule, bool) {
+	for _, rule := range rules {
+		if rule.ID == id {
+			return rule, true
+		}
+	}
+
+	return leakedCredentialCheckRule{}, false
+}
+
+func leakedCredentialCheckRuleURI(zoneID, ruleID string) string {
+	return fmt.Sprintf("%s/detections/%s", leakedCredentialCheckURI(zoneID), ruleID)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFindLeakedCredentialCheckRule(t *testing.T) {
+	rules := []leakedCredentialCheckRule{
+		{ID: "a", Username: "u1", Password: "p1"},
+		{ID: "b", Username: "u2", Password: "p2"},
+	}
+
+	rule, ok := findLeakedCredentialCheckRule(rules, "b")
+	if !ok || rule.Username != "u2" {
+		t.Errorf("expected rule b to be found, got %+v (found: %t)", rule, ok)
+	}
+
+	if _, ok := findLeakedCredentialCheckRule(rules, "c"); ok {
+		t.Error("expected rule c not to be found")
+	}
+}
+
+func TestAccCloudflareLeakedCredentialCheckRule_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_leaked_credential_check_rule.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareLeakedCredentialCheckRuleConfig(rnd, zoneID, "user"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "username", `lookup_json_string(http.request.body.raw, "user")`),
+					resource.TestCheckResourceAttr(name, "password", `lookup_json_string(http.request.body.raw, "secret")`),
+				),
+			},
+			{
+				Config: testAccCloudflareLeakedCredentialCheckRuleConfig(rnd, zoneID, "email"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "username", `lookup_json_string(http.request.body.raw, "email")`),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareLeakedCredentialCheckRuleConfig(rnd, zoneID, usernameField string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_leaked_credential_check" "%[1]s" {
+  zone_id = "%[2]s"
+  enabled = true
+}
+
+resource "cloudflare_leaked_credential_check_rule" "%[1]s" {
+  zone_id  = cloudflare_leaked_credential_check.%[1]s.zone_id
+  username = "lookup_json_string(http.request.body.raw, \"%[3]s\")"
+  password = "lookup_json_string(http.request.body.raw, \"secret\")"
+}
+`, rnd, zoneID, usernameField)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareLeakedCredentialCheck_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_leaked_credential_check.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareLeakedCredentialCheckConfig(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareLeakedCredentialCheckConfig(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareLeakedCredentialCheckConfig(rnd, zoneID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_leaked_credential_check" "%[1]s" {
+  zone_id = "%[2]s"
+  enabled = %[3]t
+}
+`, rnd, zoneID, enabled)
+}
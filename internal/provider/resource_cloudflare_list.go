@@ -2,8 +2,12 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,12 +26,12 @@ func resourceCloudflareList() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareListImport,
 		},
-		Description: "Provides Lists (IPs, Redirects) to be used in Edge Rules Engine across all zones within the same account.",
+		Description: "Provides Lists (IPs, Redirects, Hostnames, ASNs) to be used in Edge Rules Engine across all zones within the same account.",
 	}
 }
 
 func resourceCloudflareListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	list, err := client.CreateList(ctx, cloudflare.ListCreateParams{
@@ -44,13 +48,9 @@ func resourceCloudflareListCreate(ctx context.Context, d *schema.ResourceData, m
 
 	if items, ok := d.GetOk("item"); ok {
 		items := buildListItemsCreateRequest(d, items.([]interface{}))
-		_, err = client.CreateListItems(ctx, cloudflare.ListCreateItemsParams{
-			AccountID: accountID,
-			ID:        d.Id(),
-			Items:     items,
-		})
+		err = createListItems(ctx, client, accountID, d.Id(), items)
 		if err != nil {
-			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))
+			return cloudflareErrorDiagnostics("error creating List Items", err, nil)
 		}
 	}
 
@@ -74,7 +74,7 @@ func resourceCloudflareListImport(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceCloudflareListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	list, err := client.GetList(ctx, cloudflare.ListGetParams{
@@ -94,12 +94,21 @@ func resourceCloudflareListRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("description", list.Description)
 	d.Set("kind", list.Kind)
 
-	items, err := client.ListListItems(ctx, cloudflare.ListListItemsParams{
-		AccountID: accountID,
-		ID:        d.Id(),
-	})
+	// Items may be managed through cloudflare_list_item resources instead, in
+	// which case they must not be pulled into this resource.
+	if !d.Get("ignore_items").(bool) {
+		if err := setListItemsState(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func setListItemsState(ctx context.Context, client *apiClient, d *schema.ResourceData) error {
+	items, err := listListItems(withRequestTimeout(ctx, slowRequestTimeout), client, d.Get("account_id").(string), d.Id(), "")
 	if err != nil {
-		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading List Items")))
+		return errors.Wrap(err, fmt.Sprintf("error reading List Items"))
 	}
 
 	var itemData []map[string]interface{}
@@ -114,16 +123,16 @@ func resourceCloudflareListRead(ctx context.Context, d *schema.ResourceData, met
 			value["ip"] = *i.IP
 		}
 		if i.Redirect != nil {
-			value["redirect"] = []map[string]interface{}{{
-				"source_url":            i.Redirect.SourceUrl,
-				"include_subdomains":    i.Redirect.IncludeSubdomains,
-				"target_url":            i.Redirect.TargetUrl,
-				"status_code":           i.Redirect.StatusCode,
-				"preserve_query_string": i.Redirect.PreserveQueryString,
-				"subpath_matching":      i.Redirect.SubpathMatching,
-				"preserve_path_suffix":  i.Redirect.PreservePathSuffix,
+			value["redirect"] = flattenListItemRedirect(i.Redirect)
+		}
+		if i.Hostname != nil {
+			value["hostname"] = []map[string]interface{}{{
+				"url_hostname": i.Hostname.URLHostname,
 			}}
 		}
+		if i.ASN != nil {
+			value["asn"] = *i.ASN
+		}
 
 		item["value"] = []map[string]interface{}{value}
 		item["comment"] = i.Comment
@@ -131,13 +140,11 @@ func resourceCloudflareListRead(ctx context.Context, d *schema.ResourceData, met
 		itemData = append(itemData, item)
 	}
 
-	d.Set("item", itemData)
-
-	return nil
+	return d.Set("item", itemData)
 }
 
 func resourceCloudflareListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	_, err := client.UpdateList(ctx, cloudflare.ListUpdateParams{
@@ -149,15 +156,14 @@ func resourceCloudflareListUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating List description")))
 	}
 
-	if items, ok := d.GetOk("item"); ok {
-		items := buildListItemsCreateRequest(d, items.([]interface{}))
-		_, err = client.ReplaceListItems(ctx, cloudflare.ListReplaceItemsParams{
-			AccountID: accountID,
-			ID:        d.Id(),
-			Items:     items,
-		})
+	if !d.Get("ignore_items").(bool) && d.HasChange("item") {
+		items := buildListItemsCreateRequest(d, d.Get("item").([]interface{}))
+		if items == nil {
+			items = []listItemCreateRequest{}
+		}
+		err = replaceListItems(ctx, client, accountID, d.Id(), items)
 		if err != nil {
-			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))
+			return cloudflareErrorDiagnostics("error creating List Items", err, nil)
 		}
 	}
 
@@ -165,7 +171,7 @@ func resourceCloudflareListUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceCloudflareListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	_, err := client.DeleteList(ctx, cloudflare.ListDeleteParams{
@@ -179,73 +185,200 @@ func resourceCloudflareListDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-func buildListItemsCreateRequest(resource *schema.ResourceData, items []interface{}) []cloudflare.ListItemCreateRequest {
-	var listItems []cloudflare.ListItemCreateRequest
+func buildListItemsCreateRequest(resource *schema.ResourceData, items []interface{}) []listItemCreateRequest {
+	var listItems []listItemCreateRequest
 
 	for i, item := range items {
 		value := item.(map[string]interface{})["value"].([]interface{})[0].(map[string]interface{})
+		prefix := fmt.Sprintf("item.%d.value.0", i)
+
+		hasField := func(field string) bool {
+			_, has := resource.GetOkExists(fmt.Sprintf("%s.%s", prefix, field))
+			return has
+		}
 
-		_, hasIP := resource.GetOkExists(fmt.Sprintf("item.%d.value.0.ip", i))
+		listItem := listItemCreateRequest{
+			ListItemCreateRequest: cloudflare.ListItemCreateRequest{
+				Comment: item.(map[string]interface{})["comment"].(string),
+			},
+		}
 
-		var ip *string = nil
-		if hasIP {
+		if hasField("ip") {
 			maybeIP := value["ip"].(string)
-			ip = &maybeIP
+			listItem.IP = &maybeIP
 		}
 
-		_, hasRedirect := resource.GetOkExists(fmt.Sprintf("item.%d.value.0.redirect", i))
+		if hasField("redirect") {
+			listItem.Redirect = buildListItemRedirect(value["redirect"].([]interface{})[0].(map[string]interface{}), func(field string) bool {
+				return hasField("redirect.0." + field)
+			})
+		}
 
-		var redirect *cloudflare.Redirect = nil
-		if hasRedirect {
-			r := value["redirect"].([]interface{})[0].(map[string]interface{})
+		if hostname, ok := value["hostname"].([]interface{}); ok && len(hostname) > 0 {
+			listItem.Hostname = &listItemHostname{
+				URLHostname: hostname[0].(map[string]interface{})["url_hostname"].(string),
+			}
+		}
 
-			sourceUrl := r["source_url"].(string)
-			targetUrl := r["target_url"].(string)
+		if asn, ok := value["asn"].(int); ok && asn != 0 {
+			listItem.ASN = &asn
+		}
 
-			var includeSubdomains *bool = nil
-			var subpathMatching *bool = nil
-			var statusCode *int = nil
-			var preserveQueryString *bool = nil
-			var preservePathSuffix *bool = nil
+		listItems = append(listItems, listItem)
+	}
 
-			hasField := func(field string) bool {
-				_, has := resource.GetOkExists(fmt.Sprintf("item.%d.value.0.redirect.0.%s", i, field))
-				return has
-			}
+	return listItems
+}
 
-			if hasField("include_subdomains") {
-				includeSubdomains = cloudflare.BoolPtr(r["include_subdomains"].(bool))
-			}
-			if hasField("subpath_matching") {
-				subpathMatching = cloudflare.BoolPtr(r["subpath_matching"].(bool))
-			}
-			if hasField("status_code") {
-				statusCode = cloudflare.IntPtr(r["status_code"].(int))
-			}
-			if hasField("preserve_query_string") {
-				preserveQueryString = cloudflare.BoolPtr(r["preserve_query_string"].(bool))
-			}
-			if hasField("preserve_path_suffix") {
-				preservePathSuffix = cloudflare.BoolPtr(r["preserve_path_suffix"].(bool))
-			}
+// buildListItemRedirect builds a redirect list item from its schema values.
+// Optional fields are only sent when hasField reports them as set.
+func buildListItemRedirect(r map[string]interface{}, hasField func(field string) bool) *cloudflare.Redirect {
+	redirect := &cloudflare.Redirect{
+		SourceUrl: r["source_url"].(string),
+		TargetUrl: r["target_url"].(string),
+	}
 
-			redirect = &cloudflare.Redirect{
-				SourceUrl:           sourceUrl,
-				IncludeSubdomains:   includeSubdomains,
-				TargetUrl:           targetUrl,
-				StatusCode:          statusCode,
-				PreserveQueryString: preserveQueryString,
-				SubpathMatching:     subpathMatching,
-				PreservePathSuffix:  preservePathSuffix,
-			}
+	if hasField("include_subdomains") {
+		redirect.IncludeSubdomains = cloudflare.BoolPtr(r["include_subdomains"].(bool))
+	}
+	if hasField("subpath_matching") {
+		redirect.SubpathMatching = cloudflare.BoolPtr(r["subpath_matching"].(bool))
+	}
+	if hasField("status_code") {
+		redirect.StatusCode = cloudflare.IntPtr(r["status_code"].(int))
+	}
+	if hasField("preserve_query_string") {
+		redirect.PreserveQueryString = cloudflare.BoolPtr(r["preserve_query_string"].(bool))
+	}
+	if hasField("preserve_path_suffix") {
+		redirect.PreservePathSuffix = cloudflare.BoolPtr(r["preserve_path_suffix"].(bool))
+	}
+
+	return redirect
+}
+
+func flattenListItemRedirect(redirect *cloudflare.Redirect) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"source_url":            redirect.SourceUrl,
+		"include_subdomains":    redirect.IncludeSubdomains,
+		"target_url":            redirect.TargetUrl,
+		"status_code":           redirect.StatusCode,
+		"preserve_query_string": redirect.PreserveQueryString,
+		"subpath_matching":      redirect.SubpathMatching,
+		"preserve_path_suffix":  redirect.PreservePathSuffix,
+	}}
+}
+
+// listItemHostname is a hostname list item. cloudflare-go does not support
+// hostname and ASN lists yet so list items are managed through the local
+// listItem and listItemCreateRequest types.
+type listItemHostname struct {
+	URLHostname string `json:"url_hostname"`
+}
+
+type listItem struct {
+	cloudflare.ListItem
+	Hostname *listItemHostname `json:"hostname,omitempty"`
+	ASN      *int              `json:"asn,omitempty"`
+}
+
+type listItemCreateRequest struct {
+	cloudflare.ListItemCreateRequest
+	Hostname *listItemHostname `json:"hostname,omitempty"`
+	ASN      *int              `json:"asn,omitempty"`
+}
+
+func createListItems(ctx context.Context, client *apiClient, accountID, listID string, items []listItemCreateRequest) error {
+	return doListItemsBulkOperation(ctx, client, http.MethodPost, accountID, listID, items)
+}
+
+func replaceListItems(ctx context.Context, client *apiClient, accountID, listID string, items []listItemCreateRequest) error {
+	return doListItemsBulkOperation(ctx, client, http.MethodPut, accountID, listID, items)
+}
+
+func deleteListItems(ctx context.Context, client *apiClient, accountID, listID string, itemIDs []string) error {
+	items := cloudflare.ListItemDeleteRequest{}
+	for _, id := range itemIDs {
+		items.Items = append(items.Items, cloudflare.ListItemDeleteItemRequest{ID: id})
+	}
+
+	return doListItemsBulkOperation(ctx, client, http.MethodDelete, accountID, listID, items)
+}
+
+// doListItemsBulkOperation sends an asynchronous list items request and waits
+// for the resulting bulk operation to finish.
+func doListItemsBulkOperation(ctx context.Context, client *apiClient, method, accountID, listID string, payload interface{}) error {
+	res, err := rawRequest(ctx, client, method, fmt.Sprintf("/accounts/%s/rules/lists/%s/items", accountID, listID), payload)
+	if err != nil {
+		return err
+	}
+
+	var operation struct {
+		OperationID string `json:"operation_id"`
+	}
+	if err := json.Unmarshal(res, &operation); err != nil {
+		return fmt.Errorf("error unmarshalling list bulk operation: %w", err)
+	}
+
+	for i := uint8(0); i < 16; i++ {
+		select {
+		case <-time.After(1 << (i / 2) * time.Second):
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 
-		listItems = append(listItems, cloudflare.ListItemCreateRequest{
-			IP:       ip,
-			Redirect: redirect,
-			Comment:  item.(map[string]interface{})["comment"].(string),
+		bulkOperation, err := client.GetListBulkOperation(ctx, cloudflare.ListGetBulkOperationParams{
+			AccountID: accountID,
+			ID:        operation.OperationID,
 		})
+		if err != nil {
+			return err
+		}
+
+		switch bulkOperation.Status {
+		case "failed":
+			return fmt.Errorf("list bulk operation %s failed: %s", operation.OperationID, bulkOperation.Error)
+		case "pending", "running":
+			continue
+		case "completed":
+			return nil
+		default:
+			return fmt.Errorf("list bulk operation %s has unexpected status %q", operation.OperationID, bulkOperation.Status)
+		}
 	}
 
-	return listItems
+	return fmt.Errorf("list bulk operation %s is still running", operation.OperationID)
+}
+
+// listListItems returns the items of a list. A non-empty search narrows the
+// items down to those whose value contains it.
+func listListItems(ctx context.Context, client *apiClient, accountID, listID, search string) ([]listItem, error) {
+	var items []listItem
+	params := url.Values{}
+	if search != "" {
+		params.Set("search", search)
+	}
+
+	for {
+		uri := fmt.Sprintf("/accounts/%s/rules/lists/%s/items", accountID, listID)
+		if len(params) > 0 {
+			uri += "?" + params.Encode()
+		}
+
+		res, resultInfo, err := rawRequestWithResultInfo(ctx, client, http.MethodGet, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []listItem
+		if err := json.Unmarshal(res, &page); err != nil {
+			return nil, fmt.Errorf("error unmarshalling list items: %w", err)
+		}
+		items = append(items, page...)
+
+		if resultInfo.Cursors.After == "" {
+			return items, nil
+		}
+		params.Set("cursor", resultInfo.Cursors.After)
+	}
 }
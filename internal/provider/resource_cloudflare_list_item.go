@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareListItem() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareListItemSchema(),
+		CreateContext: resourceCloudflareListItemCreate,
+		ReadContext:   resourceCloudflareListItemRead,
+		DeleteContext: resourceCloudflareListItemDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareListItemImport,
+		},
+		Description: "Provides individual list items (IPs, Redirects, Hostnames, ASNs) to be used in Edge Rules " +
+			"Engine across all zones within the same account. This allows large lists to be managed " +
+			"incrementally. The `cloudflare_list` the items belong to must set `ignore_items`.",
+	}
+}
+
+func resourceCloudflareListItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	listID := d.Get("list_id").(string)
+
+	item := buildListItemCreateRequest(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating List Item in list %s from struct: %+v", listID, item))
+
+	if err := createListItems(ctx, client, accountID, listID, []listItemCreateRequest{item}); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating List Item in list %q: %w", listID, err))
+	}
+
+	// The bulk operation doesn't return the identifier of the item so look
+	// it up by its value.
+	items, err := listListItems(ctx, client, accountID, listID, listItemSearchValue(item))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing items of list %q: %w", listID, err))
+	}
+
+	for _, i := range items {
+		if listItemMatches(i, item) {
+			d.SetId(i.ID)
+			return resourceCloudflareListItemRead(ctx, d, meta)
+		}
+	}
+
+	return diag.FromErr(fmt.Errorf("failed to find newly created List Item in list %q", listID))
+}
+
+func resourceCloudflareListItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	listID := d.Get("list_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/rules/lists/%s/items/%s", accountID, listID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) || strings.Contains(err.Error(), "could not find") {
+			tflog.Info(ctx, fmt.Sprintf("List Item %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading List Item %q: %w", d.Id(), err))
+	}
+
+	var item listItem
+	if err := json.Unmarshal(res, &item); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling List Item: %w", err))
+	}
+
+	if item.IP != nil {
+		d.Set("ip", *item.IP)
+	}
+	if item.Redirect != nil {
+		d.Set("redirect", flattenListItemRedirect(item.Redirect))
+	}
+	if item.Hostname != nil {
+		d.Set("hostname", []map[string]interface{}{{
+			"url_hostname": item.Hostname.URLHostname,
+		}})
+	}
+	if item.ASN != nil {
+		d.Set("asn", *item.ASN)
+	}
+	d.Set("comment", item.Comment)
+
+	return nil
+}
+
+func resourceCloudflareListItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	listID := d.Get("list_id").(string)
+
+	if err := deleteListItems(ctx, client, accountID, listID, []string{d.Id()}); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting List Item %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareListItemImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/listID/itemID"`, d.Id())
+	}
+
+	accountID, listID, itemID := attributes[0], attributes[1], attributes[2]
+
+	d.SetId(itemID)
+	d.Set("account_id", accountID)
+	d.Set("list_id", listID)
+
+	resourceCloudflareListItemRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildListItemCreateRequest(d *schema.ResourceData) listItemCreateRequest {
+	item := listItemCreateRequest{
+		ListItemCreateRequest: cloudflare.ListItemCreateRequest{
+			Comment: d.Get("comment").(string),
+		},
+	}
+
+	if v, ok := d.GetOk("ip"); ok {
+		ip := v.(string)
+		item.IP = &ip
+	}
+
+	if v, ok := d.GetOk("redirect"); ok {
+		item.Redirect = buildListItemRedirect(v.([]interface{})[0].(map[string]interface{}), func(field string) bool {
+			_, has := d.GetOkExists("redirect.0." + field)
+			return has
+		})
+	}
+
+	if v, ok := d.GetOk("hostname.0.url_hostname"); ok {
+		item.Hostname = &listItemHostname{URLHostname: v.(string)}
+	}
+
+	if v, ok := d.GetOk("asn"); ok {
+		asn := v.(int)
+		item.ASN = &asn
+	}
+
+	return item
+}
+
+// listItemSearchValue returns the value to search the list items for.
+func listItemSearchValue(item listItemCreateRequest) string {
+	switch {
+	case item.IP != nil:
+		return *item.IP
+	case item.Redirect != nil:
+		return item.Redirect.SourceUrl
+	case item.Hostname != nil:
+		return item.Hostname.URLHostname
+	case item.ASN != nil:
+		return strconv.Itoa(*item.ASN)
+	}
+
+	return ""
+}
+
+// listItemMatches returns whether an existing list item holds the same value
+// as the item that was requested to be created.
+func listItemMatches(existing listItem, item listItemCreateRequest) bool {
+	switch {
+	case item.IP != nil:
+		return existing.IP != nil && *existing.IP == *item.IP
+	case item.Redirect != nil:
+		return existing.Redirect != nil && existing.Redirect.SourceUrl == item.Redirect.SourceUrl
+	case item.Hostname != nil:
+		return existing.Hostname != nil && existing.Hostname.URLHostname == item.Hostname.URLHostname
+	case item.ASN != nil:
+		return existing.ASN != nil && *existing.ASN == *item.ASN
+	}
+
+	return false
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareListItem_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_list_item.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareListItemIPConfig(rnd, accountID, "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ip", "192.0.2.1"),
+					resource.TestCheckResourceAttr(name, "comment", rnd),
+					resource.TestCheckResourceAttrPair(name, "list_id", fmt.Sprintf("cloudflare_list.%s", rnd), "id"),
+				),
+			},
+			{
+				Config: testAccCloudflareListItemIPConfig(rnd, accountID, "192.0.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ip", "192.0.2.2"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccCloudflareListItemImportStateIdFunc(name),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareListItem_Hostname(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_list_item.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareListItemHostnameConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostname.0.url_hostname", "*.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareListItemImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["account_id"], rs.Primary.Attributes["list_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCloudflareListItemIPConfig(name, accountID, ip string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_list" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  kind       = "ip"
+
+  ignore_items = true
+}
+
+resource "cloudflare_list_item" "%[1]s" {
+  account_id = "%[2]s"
+  list_id    = cloudflare_list.%[1]s.id
+  ip         = "%[3]s"
+  comment    = "%[1]s"
+}
+`, name, accountID, ip)
+}
+
+func testAccCloudflareListItemHostnameConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_list" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  kind       = "hostname"
+
+  ignore_items = true
+}
+
+resource "cloudflare_list_item" "%[1]s" {
+  account_id = "%[2]s"
+  list_id    = cloudflare_list.%[1]s.id
+  hostname {
+    url_hostname = "*.example.com"
+  }
+}
+`, name, accountID)
+}
@@ -178,6 +178,94 @@ func TestAccCloudflareList_Update(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareList_HostnameAndASN(t *testing.T) {
+	rndHostname := generateRandomResourceName()
+	rndASN := generateRandomResourceName()
+
+	nameHostname := fmt.Sprintf("cloudflare_list.%s", rndHostname)
+	nameASN := fmt.Sprintf("cloudflare_list.%s", rndASN)
+
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	var list cloudflare.List
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareListHostnameAndASN(rndHostname, rndASN, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareListExists(nameHostname, &list),
+					resource.TestCheckResourceAttr(nameHostname, "kind", "hostname"),
+					resource.TestCheckResourceAttr(nameHostname, "item.#", "2"),
+					resource.TestCheckResourceAttr(nameHostname, "item.0.value.0.hostname.0.url_hostname", "example.com"),
+					resource.TestCheckResourceAttr(nameHostname, "item.1.value.0.hostname.0.url_hostname", "*.example.com"),
+					testAccCheckCloudflareListExists(nameASN, &list),
+					resource.TestCheckResourceAttr(nameASN, "kind", "asn"),
+					resource.TestCheckResourceAttr(nameASN, "item.#", "1"),
+					resource.TestCheckResourceAttr(nameASN, "item.0.value.0.asn", "13335"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareList_ItemsDrift(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_list.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	var list cloudflare.List
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareListIPUpdate(rnd, rnd, rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareListExists(name, &list),
+					resource.TestCheckResourceAttr(name, "item.#", "2"),
+					testAccAddCloudflareListItem(&list, "192.0.2.2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckCloudflareListIPUpdate(rnd, rnd, rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "item.#", "2"),
+					resource.TestCheckResourceAttr(name, "item.0.value.0.ip", "192.0.2.0"),
+					resource.TestCheckResourceAttr(name, "item.1.value.0.ip", "192.0.2.1"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareListIgnoreItems(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "item.#", "0"),
+					testAccAddCloudflareListItem(&list, "192.0.2.3"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAddCloudflareListItem adds an item to the list outside of Terraform.
+func testAccAddCloudflareListItem(list *cloudflare.List, ip string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*apiClient)
+		return createListItems(context.Background(), client, os.Getenv("CLOUDFLARE_ACCOUNT_ID"), list.ID, []listItemCreateRequest{
+			{ListItemCreateRequest: cloudflare.ListItemCreateRequest{IP: cloudflare.StringPtr(ip)}},
+		})
+	}
+}
+
 func testAccCheckCloudflareListExists(n string, list *cloudflare.List) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
@@ -190,7 +278,7 @@ func testAccCheckCloudflareListExists(n string, list *cloudflare.List) resource.
 			return fmt.Errorf("No List ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundList, err := client.GetList(context.Background(), cloudflare.ListGetParams{
 			AccountID: accountID,
 			ID:        rs.Primary.ID,
@@ -239,6 +327,17 @@ func testAccCheckCloudflareListIPUpdate(ID, name, description, accountID string)
   }`, ID, name, description, accountID)
 }
 
+func testAccCheckCloudflareListIgnoreItems(ID, accountID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_list" "%[1]s" {
+    account_id = "%[2]s"
+    name = "%[1]s"
+    description = "%[1]s"
+    kind = "ip"
+    ignore_items = true
+  }`, ID, accountID)
+}
+
 func testAccCheckCloudflareListRedirectUpdate(ID, name, description, accountID string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_list" "%[1]s" {
@@ -273,3 +372,43 @@ func testAccCheckCloudflareListRedirectUpdate(ID, name, description, accountID s
     }
   }`, ID, name, description, accountID)
 }
+
+func testAccCheckCloudflareListHostnameAndASN(hostnameID, asnID, accountID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_list" "%[1]s" {
+    account_id = "%[3]s"
+    name = "%[1]s"
+    kind = "hostname"
+
+    item {
+      value {
+        hostname {
+          url_hostname = "example.com"
+        }
+      }
+      comment = "apex"
+    }
+
+    item {
+      value {
+        hostname {
+          url_hostname = "*.example.com"
+        }
+      }
+      comment = "subdomains"
+    }
+  }
+
+  resource "cloudflare_list" "%[2]s" {
+    account_id = "%[3]s"
+    name = "%[2]s"
+    kind = "asn"
+
+    item {
+      value {
+        asn = 13335
+      }
+      comment = "cloudflare"
+    }
+  }`, hostnameID, asnID, accountID)
+}
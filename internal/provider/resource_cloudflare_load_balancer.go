@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -40,6 +42,31 @@ func resourceCloudflareLoadBalancer() *schema.Resource {
 	}
 }
 
+// loadBalancer extends cloudflare.LoadBalancer with the settings not yet
+// exposed by cloudflare-go: adaptive routing, location strategy and the
+// header based session affinity attributes.
+type loadBalancer struct {
+	cloudflare.LoadBalancer
+	SessionAffinityAttributes *loadBalancerSessionAffinityAttributes `json:"session_affinity_attributes,omitempty"`
+	AdaptiveRouting           *loadBalancerAdaptiveRouting           `json:"adaptive_routing,omitempty"`
+	LocationStrategy          *loadBalancerLocationStrategy          `json:"location_strategy,omitempty"`
+}
+
+type loadBalancerSessionAffinityAttributes struct {
+	cloudflare.SessionAffinityAttributes
+	Headers           []string `json:"headers,omitempty"`
+	RequireAllHeaders bool     `json:"require_all_headers,omitempty"`
+}
+
+type loadBalancerAdaptiveRouting struct {
+	FailoverAcrossPools bool `json:"failover_across_pools"`
+}
+
+type loadBalancerLocationStrategy struct {
+	PreferECS string `json:"prefer_ecs,omitempty"`
+	Mode      string `json:"mode,omitempty"`
+}
+
 var rulesElem = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"name": {
@@ -79,7 +106,7 @@ var rulesElem = &schema.Resource{
 					"session_affinity": {
 						Type:         schema.TypeString,
 						Optional:     true,
-						ValidateFunc: validation.StringInSlice([]string{"", "none", "cookie", "ip_cookie"}, false),
+						ValidateFunc: validation.StringInSlice([]string{"", "none", "cookie", "ip_cookie", "header"}, false),
 					},
 
 					"session_affinity_ttl": {
@@ -213,21 +240,26 @@ var localPoolElems = map[string]*schema.Resource{
 }
 
 func resourceCloudflareLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	zoneID := d.Get("zone_id").(string)
 
 	enabled := d.Get("enabled").(bool)
-	newLoadBalancer := cloudflare.LoadBalancer{
-		Name:           d.Get("name").(string),
-		FallbackPool:   d.Get("fallback_pool_id").(string),
-		DefaultPools:   expandInterfaceToStringList(d.Get("default_pool_ids")),
-		Proxied:        d.Get("proxied").(bool),
-		Enabled:        &enabled,
-		TTL:            d.Get("ttl").(int),
-		SteeringPolicy: d.Get("steering_policy").(string),
-		Persistence:    d.Get("session_affinity").(string),
+	newLoadBalancer := loadBalancer{
+		LoadBalancer: cloudflare.LoadBalancer{
+			Name:           d.Get("name").(string),
+			FallbackPool:   d.Get("fallback_pool_id").(string),
+			DefaultPools:   expandInterfaceToStringList(d.Get("default_pool_ids")),
+			Proxied:        d.Get("proxied").(bool),
+			Enabled:        &enabled,
+			TTL:            d.Get("ttl").(int),
+			SteeringPolicy: d.Get("steering_policy").(string),
+			Persistence:    d.Get("session_affinity").(string),
+		},
+		AdaptiveRouting:  expandLoadBalancerAdaptiveRouting(d.Get("adaptive_routing")),
+		LocationStrategy: expandLoadBalancerLocationStrategy(d.Get("location_strategy")),
 	}
+	newLoadBalancer.RandomSteering = expandLoadBalancerRandomSteering(d.Get("random_steering"))
 
 	if description, ok := d.GetOk("description"); ok {
 		newLoadBalancer.Description = description.(string)
@@ -275,7 +307,7 @@ func resourceCloudflareLoadBalancerCreate(ctx context.Context, d *schema.Resourc
 
 	tflog.Info(ctx, fmt.Sprintf("Creating Cloudflare Load Balancer from struct: %+v", newLoadBalancer))
 
-	r, err := client.CreateLoadBalancer(ctx, zoneID, newLoadBalancer)
+	r, err := loadBalancerRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/load_balancers", zoneID), newLoadBalancer)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating load balancer for zone"))
 	}
@@ -293,21 +325,26 @@ func resourceCloudflareLoadBalancerCreate(ctx context.Context, d *schema.Resourc
 
 func resourceCloudflareLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// since api only supports replace, update looks a lot like create...
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	enabled := d.Get("enabled").(bool)
-	loadBalancer := cloudflare.LoadBalancer{
-		ID:             d.Id(),
-		Name:           d.Get("name").(string),
-		FallbackPool:   d.Get("fallback_pool_id").(string),
-		DefaultPools:   expandInterfaceToStringList(d.Get("default_pool_ids")),
-		Proxied:        d.Get("proxied").(bool),
-		Enabled:        &enabled,
-		TTL:            d.Get("ttl").(int),
-		SteeringPolicy: d.Get("steering_policy").(string),
-		Persistence:    d.Get("session_affinity").(string),
+	loadBalancer := loadBalancer{
+		LoadBalancer: cloudflare.LoadBalancer{
+			ID:             d.Id(),
+			Name:           d.Get("name").(string),
+			FallbackPool:   d.Get("fallback_pool_id").(string),
+			DefaultPools:   expandInterfaceToStringList(d.Get("default_pool_ids")),
+			Proxied:        d.Get("proxied").(bool),
+			Enabled:        &enabled,
+			TTL:            d.Get("ttl").(int),
+			SteeringPolicy: d.Get("steering_policy").(string),
+			Persistence:    d.Get("session_affinity").(string),
+		},
+		AdaptiveRouting:  expandLoadBalancerAdaptiveRouting(d.Get("adaptive_routing")),
+		LocationStrategy: expandLoadBalancerLocationStrategy(d.Get("location_strategy")),
 	}
+	loadBalancer.RandomSteering = expandLoadBalancerRandomSteering(d.Get("random_steering"))
 
 	if description, ok := d.GetOk("description"); ok {
 		loadBalancer.Description = description.(string)
@@ -351,7 +388,7 @@ func resourceCloudflareLoadBalancerUpdate(ctx context.Context, d *schema.Resourc
 
 	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare Load Balancer from struct: %+v", loadBalancer))
 
-	_, err := client.ModifyLoadBalancer(ctx, zoneID, loadBalancer)
+	_, err := loadBalancerRequest(ctx, client, http.MethodPut, loadBalancerURI(zoneID, d.Id()), loadBalancer)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating load balancer for zone"))
 	}
@@ -376,13 +413,13 @@ func expandGeoPools(pool interface{}, geoType string) (map[string][]string, erro
 }
 
 func resourceCloudflareLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	loadBalancerID := d.Id()
 
-	loadBalancer, err := client.LoadBalancerDetails(ctx, zoneID, loadBalancerID)
+	loadBalancer, err := loadBalancerRequest(ctx, client, http.MethodGet, loadBalancerURI(zoneID, loadBalancerID), nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFoundError(err) {
 			tflog.Info(ctx, fmt.Sprintf("Load balancer %s in zone %s not found", loadBalancerID, zoneID))
 			d.SetId("")
 			return nil
@@ -403,12 +440,24 @@ func resourceCloudflareLoadBalancerRead(ctx context.Context, d *schema.ResourceD
 	d.Set("created_on", loadBalancer.CreatedOn.Format(time.RFC3339Nano))
 	d.Set("modified_on", loadBalancer.ModifiedOn.Format(time.RFC3339Nano))
 
-	if _, sessionAffinityAttrsOk := d.GetOk("session_affinity_attributes"); sessionAffinityAttrsOk {
-		if err := d.Set("session_affinity_attributes", flattenSessionAffinityAttrs(loadBalancer.SessionAffinityAttributes)); err != nil {
+	if sessionAffinityAttrs, sessionAffinityAttrsOk := d.GetOk("session_affinity_attributes"); sessionAffinityAttrsOk {
+		if err := d.Set("session_affinity_attributes", flattenSessionAffinityAttrs(loadBalancer.SessionAffinityAttributes, sessionAffinityAttrs.(map[string]interface{}))); err != nil {
 			return diag.FromErr(fmt.Errorf("failed to set session_affinity_attributes: %w", err))
 		}
 	}
 
+	if err := d.Set("adaptive_routing", flattenLoadBalancerAdaptiveRouting(loadBalancer.AdaptiveRouting)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set adaptive_routing: %w", err))
+	}
+
+	if err := d.Set("location_strategy", flattenLoadBalancerLocationStrategy(loadBalancer.LocationStrategy)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set location_strategy: %w", err))
+	}
+
+	if err := d.Set("random_steering", flattenLoadBalancerRandomSteering(loadBalancer.RandomSteering)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set random_steering: %w", err))
+	}
+
 	if len(loadBalancer.Rules) > 0 {
 		fr, err := flattenRules(d, loadBalancer.Rules)
 		if err != nil {
@@ -450,16 +499,103 @@ func flattenGeoPools(pools map[string][]string, geoType string) *schema.Set {
 	return schema.NewSet(schema.HashResource(localPoolElems[geoType]), flattened)
 }
 
-func flattenSessionAffinityAttrs(attrs *cloudflare.SessionAffinityAttributes) map[string]interface{} {
-	return map[string]interface{}{
+func flattenSessionAffinityAttrs(attrs *loadBalancerSessionAffinityAttributes, configured map[string]interface{}) map[string]interface{} {
+	if attrs == nil {
+		return nil
+	}
+
+	flattened := map[string]interface{}{
 		"drain_duration": strconv.Itoa(attrs.DrainDuration),
 		"samesite":       attrs.SameSite,
 		"secure":         attrs.Secure,
 	}
+
+	// The remaining attributes are only read back when configured, as the
+	// API returns defaults for them.
+	if _, ok := configured["zero_downtime_failover"]; ok {
+		flattened["zero_downtime_failover"] = attrs.ZeroDowntimeFailover
+	}
+	if _, ok := configured["headers"]; ok {
+		flattened["headers"] = strings.Join(attrs.Headers, ",")
+	}
+	if _, ok := configured["require_all_headers"]; ok {
+		flattened["require_all_headers"] = strconv.FormatBool(attrs.RequireAllHeaders)
+	}
+
+	return flattened
+}
+
+func expandLoadBalancerAdaptiveRouting(v interface{}) *loadBalancerAdaptiveRouting {
+	for _, item := range v.([]interface{}) {
+		m := item.(map[string]interface{})
+		return &loadBalancerAdaptiveRouting{
+			FailoverAcrossPools: m["failover_across_pools"].(bool),
+		}
+	}
+	return nil
+}
+
+func flattenLoadBalancerAdaptiveRouting(adaptiveRouting *loadBalancerAdaptiveRouting) []interface{} {
+	if adaptiveRouting == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"failover_across_pools": adaptiveRouting.FailoverAcrossPools,
+	}}
+}
+
+func expandLoadBalancerLocationStrategy(v interface{}) *loadBalancerLocationStrategy {
+	for _, item := range v.([]interface{}) {
+		m := item.(map[string]interface{})
+		return &loadBalancerLocationStrategy{
+			PreferECS: m["prefer_ecs"].(string),
+			Mode:      m["mode"].(string),
+		}
+	}
+	return nil
+}
+
+func flattenLoadBalancerLocationStrategy(locationStrategy *loadBalancerLocationStrategy) []interface{} {
+	if locationStrategy == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"prefer_ecs": locationStrategy.PreferECS,
+		"mode":       locationStrategy.Mode,
+	}}
+}
+
+func expandLoadBalancerRandomSteering(v interface{}) *cloudflare.RandomSteering {
+	for _, item := range v.([]interface{}) {
+		m := item.(map[string]interface{})
+		randomSteering := &cloudflare.RandomSteering{
+			DefaultWeight: m["default_weight"].(float64),
+			PoolWeights:   make(map[string]float64),
+		}
+		for pool, weight := range m["pool_weights"].(map[string]interface{}) {
+			randomSteering.PoolWeights[pool] = weight.(float64)
+		}
+		return randomSteering
+	}
+	return nil
+}
+
+func flattenLoadBalancerRandomSteering(randomSteering *cloudflare.RandomSteering) []interface{} {
+	if randomSteering == nil {
+		return nil
+	}
+	weights := make(map[string]interface{})
+	for pool, weight := range randomSteering.PoolWeights {
+		weights[pool] = weight
+	}
+	return []interface{}{map[string]interface{}{
+		"default_weight": randomSteering.DefaultWeight,
+		"pool_weights":   weights,
+	}}
 }
 
 func resourceCloudflareLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	loadBalancerID := d.Id()
 
@@ -699,8 +835,8 @@ func expandRules(rdata interface{}) ([]*cloudflare.LoadBalancerRule, error) {
 	return rules, nil
 }
 
-func expandSessionAffinityAttrs(attrs interface{}) (*cloudflare.SessionAffinityAttributes, error) {
-	var cfSessionAffinityAttrs cloudflare.SessionAffinityAttributes
+func expandSessionAffinityAttrs(attrs interface{}) (*loadBalancerSessionAffinityAttributes, error) {
+	var cfSessionAffinityAttrs loadBalancerSessionAffinityAttributes
 
 	for k, v := range attrs.(map[string]interface{}) {
 		switch k {
@@ -713,8 +849,40 @@ func expandSessionAffinityAttrs(attrs interface{}) (*cloudflare.SessionAffinityA
 			if cfSessionAffinityAttrs.DrainDuration, err = strconv.Atoi(v.(string)); err != nil {
 				return nil, err
 			}
+		case "zero_downtime_failover":
+			cfSessionAffinityAttrs.ZeroDowntimeFailover = v.(string)
+		case "headers":
+			for _, header := range strings.Split(v.(string), ",") {
+				if header = strings.TrimSpace(header); header != "" {
+					cfSessionAffinityAttrs.Headers = append(cfSessionAffinityAttrs.Headers, header)
+				}
+			}
+		case "require_all_headers":
+			var err error
+			if cfSessionAffinityAttrs.RequireAllHeaders, err = strconv.ParseBool(v.(string)); err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	return &cfSessionAffinityAttrs, nil
 }
+
+func loadBalancerURI(zoneID, loadBalancerID string) string {
+	return fmt.Sprintf("/zones/%s/load_balancers/%s", zoneID, loadBalancerID)
+}
+
+func loadBalancerRequest(ctx context.Context, client *apiClient, method, uri string, body interface{}) (loadBalancer, error) {
+	var lb loadBalancer
+
+	res, err := rawRequest(ctx, client, method, uri, body)
+	if err != nil {
+		return lb, err
+	}
+
+	if err := json.Unmarshal(res, &lb); err != nil {
+		return lb, fmt.Errorf("error unmarshalling load balancer: %w", err)
+	}
+
+	return lb, nil
+}
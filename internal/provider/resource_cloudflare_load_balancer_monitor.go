@@ -24,12 +24,11 @@ func resourceCloudflareLoadBalancerMonitor() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceCloudflareLoadBalancerMonitorDiff,
 	}
 }
 
-func resourceCloudflareLoadBalancerPoolMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
-
+func buildLoadBalancerMonitor(d *schema.ResourceData) cloudflare.LoadBalancerMonitor {
 	loadBalancerMonitor := cloudflare.LoadBalancerMonitor{
 		Timeout:  d.Get("timeout").(int),
 		Type:     d.Get("type").(string),
@@ -57,14 +56,8 @@ func resourceCloudflareLoadBalancerPoolMonitorCreate(ctx context.Context, d *sch
 			loadBalancerMonitor.AllowInsecure = allowInsecure.(bool)
 		}
 
-		expectedBody := d.Get("expected_body")
-		loadBalancerMonitor.ExpectedBody = expectedBody.(string)
-
-		if expectedCodes, ok := d.GetOk("expected_codes"); ok {
-			loadBalancerMonitor.ExpectedCodes = expectedCodes.(string)
-		} else {
-			return diag.FromErr(fmt.Errorf("expected_codes must be set"))
-		}
+		loadBalancerMonitor.ExpectedBody = d.Get("expected_body").(string)
+		loadBalancerMonitor.ExpectedCodes = d.Get("expected_codes").(string)
 
 		if followRedirects, ok := d.GetOk("follow_redirects"); ok {
 			loadBalancerMonitor.FollowRedirects = followRedirects.(bool)
@@ -86,13 +79,17 @@ func resourceCloudflareLoadBalancerPoolMonitorCreate(ctx context.Context, d *sch
 			loadBalancerMonitor.Path = "/"
 		}
 
-		if probeZone, ok := d.GetOk("probe_zone"); ok {
-			loadBalancerMonitor.ProbeZone = probeZone.(string)
-		} else {
-			loadBalancerMonitor.ProbeZone = ""
-		}
+		loadBalancerMonitor.ProbeZone = d.Get("probe_zone").(string)
 	}
 
+	return loadBalancerMonitor
+}
+
+func resourceCloudflareLoadBalancerPoolMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	loadBalancerMonitor := buildLoadBalancerMonitor(d)
+
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Load Balancer Monitor from struct: %+v", loadBalancerMonitor))
 
 	r, err := client.CreateLoadBalancerMonitor(ctx, loadBalancerMonitor)
@@ -112,74 +109,10 @@ func resourceCloudflareLoadBalancerPoolMonitorCreate(ctx context.Context, d *sch
 }
 
 func resourceCloudflareLoadBalancerPoolMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
-	loadBalancerMonitor := cloudflare.LoadBalancerMonitor{
-		ID:       d.Id(),
-		Timeout:  d.Get("timeout").(int),
-		Type:     d.Get("type").(string),
-		Interval: d.Get("interval").(int),
-		Retries:  d.Get("retries").(int),
-	}
-
-	if description, ok := d.GetOk("description"); ok {
-		loadBalancerMonitor.Description = description.(string)
-	}
-
-	if port, ok := d.GetOk("port"); ok {
-		loadBalancerMonitor.Port = uint16(port.(int))
-	}
-
-	switch loadBalancerMonitor.Type {
-	case "tcp":
-		if method, ok := d.GetOk("method"); ok {
-			loadBalancerMonitor.Method = method.(string)
-		} else {
-			loadBalancerMonitor.Method = "connection_established"
-		}
-	case "http", "https":
-		if allowInsecure, ok := d.GetOk("allow_insecure"); ok {
-			loadBalancerMonitor.AllowInsecure = allowInsecure.(bool)
-		}
-
-		if expectedBody, ok := d.GetOk("expected_body"); ok {
-			loadBalancerMonitor.ExpectedBody = expectedBody.(string)
-		} else {
-			loadBalancerMonitor.ExpectedBody = ""
-		}
-
-		if expectedCodes, ok := d.GetOk("expected_codes"); ok {
-			loadBalancerMonitor.ExpectedCodes = expectedCodes.(string)
-		} else {
-			return diag.FromErr(fmt.Errorf("expected_codes must be set"))
-		}
-
-		if header, ok := d.GetOk("header"); ok {
-			loadBalancerMonitor.Header = expandLoadBalancerMonitorHeader(header)
-		}
-
-		if followRedirects, ok := d.GetOk("follow_redirects"); ok {
-			loadBalancerMonitor.FollowRedirects = followRedirects.(bool)
-		}
-
-		if method, ok := d.GetOk("method"); ok {
-			loadBalancerMonitor.Method = method.(string)
-		} else {
-			loadBalancerMonitor.Method = "GET"
-		}
-
-		if path, ok := d.GetOk("path"); ok {
-			loadBalancerMonitor.Path = path.(string)
-		} else {
-			loadBalancerMonitor.Path = "/"
-		}
-
-		if probeZone, ok := d.GetOk("probe_zone"); ok {
-			loadBalancerMonitor.ProbeZone = probeZone.(string)
-		} else {
-			loadBalancerMonitor.ProbeZone = ""
-		}
-	}
+	loadBalancerMonitor := buildLoadBalancerMonitor(d)
+	loadBalancerMonitor.ID = d.Id()
 
 	tflog.Debug(ctx, fmt.Sprintf("Update Cloudflare Load Balancer Monitor from struct: %+v", loadBalancerMonitor))
 
@@ -204,7 +137,7 @@ func expandLoadBalancerMonitorHeader(cfgSet interface{}) map[string][]string {
 }
 
 func resourceCloudflareLoadBalancerPoolMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	loadBalancerMonitor, err := client.LoadBalancerMonitorDetails(ctx, d.Id())
 	if err != nil {
@@ -258,7 +191,7 @@ func flattenLoadBalancerMonitorHeader(header map[string][]string) *schema.Set {
 }
 
 func resourceCloudflareLoadBalancerPoolMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare Load Balancer Monitor: %s ", d.Id()))
 
@@ -274,3 +207,66 @@ func resourceCloudflareLoadBalancerPoolMonitorDelete(ctx context.Context, d *sch
 
 	return nil
 }
+
+// loadBalancerMonitorHTTPAttributes are only supported by "http" and "https"
+// monitors.
+var loadBalancerMonitorHTTPAttributes = []string{"allow_insecure", "expected_body", "expected_codes", "follow_redirects", "header", "path", "probe_zone"}
+
+func resourceCloudflareLoadBalancerMonitorDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// method and path are computed, use the configuration so that values
+	// read back from the API aren't mistaken for configured ones.
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.GetAttr("type").IsKnown() || !config.GetAttr("method").IsKnown() {
+		return nil
+	}
+
+	var configured []string
+	for _, key := range append([]string{"port"}, loadBalancerMonitorHTTPAttributes...) {
+		if !config.GetAttr(key).IsNull() {
+			configured = append(configured, key)
+		}
+	}
+
+	method := ""
+	if value := config.GetAttr("method"); !value.IsNull() {
+		method = value.AsString()
+	}
+
+	return validateLoadBalancerMonitorConfig(d.Get("type").(string), method, configured)
+}
+
+// validateLoadBalancerMonitorConfig ensures only the attributes supported by
+// the monitor type are configured, as the API otherwise either rejects the
+// monitor or silently drops them and reports a diff on every plan.
+func validateLoadBalancerMonitorConfig(monitorType, method string, configured []string) error {
+	if monitorType == "http" || monitorType == "https" {
+		if !contains(configured, "expected_codes") {
+			return fmt.Errorf("expected_codes must be set for %q monitors", monitorType)
+		}
+		return nil
+	}
+
+	for _, key := range loadBalancerMonitorHTTPAttributes {
+		if contains(configured, key) {
+			return fmt.Errorf("%s is only supported for \"http\" and \"https\" monitors", key)
+		}
+	}
+
+	switch monitorType {
+	case "tcp":
+		if method != "" && method != "connection_established" {
+			return fmt.Errorf("method must be \"connection_established\" for \"tcp\" monitors")
+		}
+	case "icmp_ping":
+		if contains(configured, "port") {
+			return fmt.Errorf("port is not supported for \"icmp_ping\" monitors")
+		}
+		fallthrough
+	default:
+		if method != "" {
+			return fmt.Errorf("method is not supported for %q monitors", monitorType)
+		}
+	}
+
+	return nil
+}
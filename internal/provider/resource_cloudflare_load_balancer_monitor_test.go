@@ -203,6 +203,54 @@ func TestAccCloudflareLoadBalancerMonitor_NoRequired(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareLoadBalancerMonitor_UnsupportedAttributes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareLoadBalancerMonitorConfigICMPPingWithPath(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("path is only supported for \"http\" and \"https\" monitors")),
+			},
+		},
+	})
+}
+
+func TestValidateLoadBalancerMonitorConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		monitorType string
+		method      string
+		configured  []string
+		valid       bool
+	}{
+		{"http", "http", "GET", []string{"expected_codes", "path", "header", "probe_zone"}, true},
+		{"https without expected codes", "https", "", []string{"path"}, false},
+		{"tcp", "tcp", "connection_established", []string{"port"}, true},
+		{"tcp with http method", "tcp", "GET", []string{"port"}, false},
+		{"tcp with probe zone", "tcp", "", []string{"port", "probe_zone"}, false},
+		{"udp icmp", "udp_icmp", "", []string{"port"}, true},
+		{"udp icmp with method", "udp_icmp", "GET", []string{"port"}, false},
+		{"icmp ping", "icmp_ping", "", nil, true},
+		{"icmp ping with port", "icmp_ping", "", []string{"port"}, false},
+		{"icmp ping with expected codes", "icmp_ping", "", []string{"expected_codes"}, false},
+		{"smtp", "smtp", "", []string{"port"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateLoadBalancerMonitorConfig(tc.monitorType, tc.method, tc.configured)
+			if tc.valid && err != nil {
+				t.Errorf("expected no error, got %s", err)
+			}
+			if !tc.valid && err == nil {
+				t.Error("expected an error, got none")
+			}
+		})
+	}
+}
+
 func TestAccCloudflareLoadBalancerMonitor_Update(t *testing.T) {
 	var loadBalancerMonitor cloudflare.LoadBalancerMonitor
 	var initialId string
@@ -274,7 +322,7 @@ func TestAccCloudflareLoadBalancerMonitor_CreateAfterManualDestroy(t *testing.T)
 }
 
 func testAccCheckCloudflareLoadBalancerMonitorDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_load_balancer_monitor" {
@@ -301,7 +349,7 @@ func testAccCheckCloudflareLoadBalancerMonitorExists(n string, load *cloudflare.
 			return fmt.Errorf("No Load Balancer Monitor ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundLoadBalancerMonitor, err := client.LoadBalancerMonitorDetails(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
@@ -340,7 +388,7 @@ func testAccCheckCloudflareLoadBalancerMonitorDates(n string, loadBalancerMonito
 
 func testAccManuallyDeleteLoadBalancerMonitor(name string, loadBalancerMonitor *cloudflare.LoadBalancerMonitor, initialId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		*initialId = loadBalancerMonitor.ID
 		err := client.DeleteLoadBalancerMonitor(context.Background(), loadBalancerMonitor.ID)
 		if err != nil {
@@ -442,3 +490,12 @@ resource "cloudflare_load_balancer_monitor" "test" {
   description = "this is a wrong config"
 }`
 }
+
+func testAccCheckCloudflareLoadBalancerMonitorConfigICMPPingWithPath() string {
+	return `
+resource "cloudflare_load_balancer_monitor" "test" {
+  type = "icmp_ping"
+  path = "/health"
+  description = "this is a wrong config"
+}`
+}
@@ -2,10 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
-	"strings"
-
+	"net/http"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -28,14 +28,28 @@ func resourceCloudflareLoadBalancerPool() *schema.Resource {
 	}
 }
 
+// loadBalancerPool extends cloudflare.LoadBalancerPool with the origin
+// settings not yet exposed by cloudflare-go.
+type loadBalancerPool struct {
+	cloudflare.LoadBalancerPool
+	Origins []loadBalancerOrigin `json:"origins"`
+}
+
+type loadBalancerOrigin struct {
+	cloudflare.LoadBalancerOrigin
+	VirtualNetworkID string `json:"virtual_network_id,omitempty"`
+}
+
 func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
-	loadBalancerPool := cloudflare.LoadBalancerPool{
-		Name:           d.Get("name").(string),
-		Origins:        expandLoadBalancerOrigins(d.Get("origins").(*schema.Set)),
-		Enabled:        d.Get("enabled").(bool),
-		MinimumOrigins: d.Get("minimum_origins").(int),
+	loadBalancerPool := loadBalancerPool{
+		LoadBalancerPool: cloudflare.LoadBalancerPool{
+			Name:           d.Get("name").(string),
+			Enabled:        d.Get("enabled").(bool),
+			MinimumOrigins: d.Get("minimum_origins").(int),
+		},
+		Origins: expandLoadBalancerOrigins(d.Get("origins").(*schema.Set)),
 	}
 
 	if lat, ok := d.GetOk("latitude"); ok {
@@ -73,7 +87,7 @@ func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.Res
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Load Balancer Pool from struct: %+v", loadBalancerPool))
 
-	r, err := client.CreateLoadBalancerPool(ctx, loadBalancerPool)
+	r, err := loadBalancerPoolRequest(ctx, client, http.MethodPost, loadBalancerPoolsURI(client), loadBalancerPool)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating load balancer pool"))
 	}
@@ -90,14 +104,16 @@ func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudflareLoadBalancerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
-
-	loadBalancerPool := cloudflare.LoadBalancerPool{
-		ID:             d.Id(),
-		Name:           d.Get("name").(string),
-		Origins:        expandLoadBalancerOrigins(d.Get("origins").(*schema.Set)),
-		Enabled:        d.Get("enabled").(bool),
-		MinimumOrigins: d.Get("minimum_origins").(int),
+	client := meta.(*apiClient)
+
+	loadBalancerPool := loadBalancerPool{
+		LoadBalancerPool: cloudflare.LoadBalancerPool{
+			ID:             d.Id(),
+			Name:           d.Get("name").(string),
+			Enabled:        d.Get("enabled").(bool),
+			MinimumOrigins: d.Get("minimum_origins").(int),
+		},
+		Origins: expandLoadBalancerOrigins(d.Get("origins").(*schema.Set)),
 	}
 
 	if lat, ok := d.GetOk("latitude"); ok {
@@ -135,7 +151,7 @@ func resourceCloudflareLoadBalancerPoolUpdate(ctx context.Context, d *schema.Res
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Load Balancer Pool from struct: %+v", loadBalancerPool))
 
-	_, err := client.ModifyLoadBalancerPool(ctx, loadBalancerPool)
+	_, err := loadBalancerPoolRequest(ctx, client, http.MethodPut, loadBalancerPoolsURI(client)+"/"+d.Id(), loadBalancerPool)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error updating load balancer pool"))
 	}
@@ -194,14 +210,17 @@ func expandLoadBalancerOriginSteering(s *schema.Set) *cloudflare.LoadBalancerOri
 	return nil
 }
 
-func expandLoadBalancerOrigins(originSet *schema.Set) (origins []cloudflare.LoadBalancerOrigin) {
+func expandLoadBalancerOrigins(originSet *schema.Set) (origins []loadBalancerOrigin) {
 	for _, iface := range originSet.List() {
 		o := iface.(map[string]interface{})
-		origin := cloudflare.LoadBalancerOrigin{
-			Name:    o["name"].(string),
-			Address: o["address"].(string),
-			Enabled: o["enabled"].(bool),
-			Weight:  o["weight"].(float64),
+		origin := loadBalancerOrigin{
+			LoadBalancerOrigin: cloudflare.LoadBalancerOrigin{
+				Name:    o["name"].(string),
+				Address: o["address"].(string),
+				Enabled: o["enabled"].(bool),
+				Weight:  o["weight"].(float64),
+			},
+			VirtualNetworkID: o["virtual_network_id"].(string),
 		}
 
 		if header, ok := o["header"]; ok {
@@ -214,11 +233,11 @@ func expandLoadBalancerOrigins(originSet *schema.Set) (origins []cloudflare.Load
 }
 
 func resourceCloudflareLoadBalancerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
-	loadBalancerPool, err := client.LoadBalancerPoolDetails(ctx, d.Id())
+	loadBalancerPool, err := loadBalancerPoolRequest(ctx, client, http.MethodGet, loadBalancerPoolsURI(client)+"/"+d.Id(), nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFoundError(err) {
 			tflog.Info(ctx, fmt.Sprintf("Load balancer pool %s no longer exists", d.Id()))
 			d.SetId("")
 			return nil
@@ -287,15 +306,16 @@ func flattenLoadBalancerOriginSteering(os *cloudflare.LoadBalancerOriginSteering
 	}})
 }
 
-func flattenLoadBalancerOrigins(d *schema.ResourceData, origins []cloudflare.LoadBalancerOrigin) *schema.Set {
+func flattenLoadBalancerOrigins(d *schema.ResourceData, origins []loadBalancerOrigin) *schema.Set {
 	flattened := make([]interface{}, 0)
 	for _, o := range origins {
 		cfg := map[string]interface{}{
-			"name":    o.Name,
-			"address": o.Address,
-			"enabled": o.Enabled,
-			"weight":  o.Weight,
-			"header":  flattenLoadBalancerPoolHeader(o.Header),
+			"name":               o.Name,
+			"address":            o.Address,
+			"enabled":            o.Enabled,
+			"weight":             o.Weight,
+			"virtual_network_id": o.VirtualNetworkID,
+			"header":             flattenLoadBalancerPoolHeader(o.Header),
 		}
 
 		flattened = append(flattened, cfg)
@@ -304,7 +324,7 @@ func flattenLoadBalancerOrigins(d *schema.ResourceData, origins []cloudflare.Loa
 }
 
 func resourceCloudflareLoadBalancerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare Load Balancer Pool: %s ", d.Id()))
 
@@ -315,3 +335,27 @@ func resourceCloudflareLoadBalancerPoolDelete(ctx context.Context, d *schema.Res
 
 	return nil
 }
+
+// loadBalancerPoolsURI mirrors cloudflare-go, which manages pools on the
+// account when the client has one and on the user otherwise.
+func loadBalancerPoolsURI(client *apiClient) string {
+	if client.AccountID != "" {
+		return fmt.Sprintf("/accounts/%s/load_balancers/pools", client.AccountID)
+	}
+	return "/user/load_balancers/pools"
+}
+
+func loadBalancerPoolRequest(ctx context.Context, client *apiClient, method, uri string, body interface{}) (loadBalancerPool, error) {
+	var pool loadBalancerPool
+
+	res, err := rawRequest(ctx, client, method, uri, body)
+	if err != nil {
+		return pool, err
+	}
+
+	if err := json.Unmarshal(res, &pool); err != nil {
+		return pool, fmt.Errorf("error unmarshalling load balancer pool: %w", err)
+	}
+
+	return pool, nil
+}
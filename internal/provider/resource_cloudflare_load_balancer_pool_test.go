@@ -138,6 +138,44 @@ func TestAccCloudflareLoadBalancerPool_FullySpecified(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareLoadBalancerPool_OriginSteeringAndVirtualNetwork(t *testing.T) {
+	t.Parallel()
+	var loadBalancerPool cloudflare.LoadBalancerPool
+	rnd := generateRandomResourceName()
+	name := "cloudflare_load_balancer_pool." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareLoadBalancerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareLoadBalancerPoolConfigOriginSteering(rnd, accountID, "least_outstanding_requests"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareLoadBalancerPoolExists(name, &loadBalancerPool),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "origin_steering.*", map[string]string{
+						"policy": "least_outstanding_requests",
+					}),
+					resource.TestCheckResourceAttr(name, "origins.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(name, "origins.*.virtual_network_id", "cloudflare_tunnel_virtual_network."+rnd, "id"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareLoadBalancerPoolConfigOriginSteering(rnd, accountID, "least_connections"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(name, "origin_steering.*", map[string]string{
+						"policy": "least_connections",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareLoadBalancerPool_CreateAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 	var loadBalancerPool cloudflare.LoadBalancerPool
@@ -175,7 +213,7 @@ func TestAccCloudflareLoadBalancerPool_CreateAfterManualDestroy(t *testing.T) {
 }
 
 func testAccCheckCloudflareLoadBalancerPoolDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_load_balancer_pool" {
@@ -202,7 +240,7 @@ func testAccCheckCloudflareLoadBalancerPoolExists(n string, loadBalancerPool *cl
 			return fmt.Errorf("No Load Balancer ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundLoadBalancerPool, err := client.LoadBalancerPoolDetails(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
@@ -241,7 +279,7 @@ func testAccCheckCloudflareLoadBalancerPoolDates(n string, loadBalancerPool *clo
 
 func testAccManuallyDeleteLoadBalancerPool(name string, loadBalancerPool *cloudflare.LoadBalancerPool, initialId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		*initialId = loadBalancerPool.ID
 		err := client.DeleteLoadBalancerPool(context.Background(), loadBalancerPool.ID)
 		if err != nil {
@@ -266,6 +304,38 @@ resource "cloudflare_load_balancer_pool" "%[1]s" {
 }`, id)
 }
 
+func testAccCheckCloudflareLoadBalancerPoolConfigOriginSteering(id, accountID, policy string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_tunnel_virtual_network" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+
+resource "cloudflare_load_balancer_pool" "%[1]s" {
+  name = "my-tf-pool-steering-%[1]s"
+
+  origins {
+    name = "example-1"
+    address = "10.0.0.1"
+    virtual_network_id = cloudflare_tunnel_virtual_network.%[1]s.id
+  }
+
+  origins {
+    name = "example-2"
+    address = "10.0.0.2"
+    virtual_network_id = cloudflare_tunnel_virtual_network.%[1]s.id
+    header {
+      header = "Host"
+      values = ["example-2.example.com"]
+    }
+  }
+
+  origin_steering {
+    policy = "%[3]s"
+  }
+}`, id, accountID, policy)
+}
+
 func testAccCheckCloudflareLoadBalancerPoolConfigFullySpecified(id string, headerValue string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_load_balancer_pool" "%[1]s" {
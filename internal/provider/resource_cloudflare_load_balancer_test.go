@@ -10,6 +10,7 @@ import (
 
 	"os"
 
+	"reflect"
 	"regexp"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -210,6 +211,67 @@ func TestAccCloudflareLoadBalancer_ProximityBalanced(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareLoadBalancer_AdvancedSteering(t *testing.T) {
+	t.Parallel()
+	var loadBalancer cloudflare.LoadBalancer
+	zone := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_load_balancer." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareLoadBalancerConfigAdvancedSteering(zoneID, zone, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareLoadBalancerExists(name, &loadBalancer),
+					resource.TestCheckResourceAttr(name, "steering_policy", "random"),
+					resource.TestCheckResourceAttr(name, "random_steering.0.default_weight", "0.2"),
+					resource.TestCheckResourceAttrPair(name, "random_steering.0.pool_weights.%", name, "default_pool_ids.#"),
+					resource.TestCheckResourceAttr(name, "adaptive_routing.0.failover_across_pools", "true"),
+					resource.TestCheckResourceAttr(name, "location_strategy.0.prefer_ecs", "always"),
+					resource.TestCheckResourceAttr(name, "location_strategy.0.mode", "resolver_ip"),
+					resource.TestCheckResourceAttr(name, "session_affinity", "header"),
+					resource.TestCheckResourceAttr(name, "session_affinity_attributes.headers", "x-session-id,x-user"),
+					resource.TestCheckResourceAttr(name, "session_affinity_attributes.require_all_headers", "true"),
+					resource.TestCheckResourceAttr(name, "session_affinity_attributes.zero_downtime_failover", "sticky"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandSessionAffinityAttrs(t *testing.T) {
+	attrs, err := expandSessionAffinityAttrs(map[string]interface{}{
+		"drain_duration":         "60",
+		"zero_downtime_failover": "temporary",
+		"headers":                "x-session-id, x-user,",
+		"require_all_headers":    "true",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &loadBalancerSessionAffinityAttributes{
+		SessionAffinityAttributes: cloudflare.SessionAffinityAttributes{
+			DrainDuration:        60,
+			ZeroDowntimeFailover: "temporary",
+		},
+		Headers:           []string{"x-session-id", "x-user"},
+		RequireAllHeaders: true,
+	}
+	if !reflect.DeepEqual(attrs, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, attrs)
+	}
+
+	if _, err := expandSessionAffinityAttrs(map[string]interface{}{"require_all_headers": "maybe"}); err == nil {
+		t.Fatal("expected an error for an invalid require_all_headers value")
+	}
+}
+
 func TestAccCloudflareLoadBalancer_Rules(t *testing.T) {
 	t.Parallel()
 	var loadBalancer cloudflare.LoadBalancer
@@ -351,7 +413,7 @@ func TestAccCloudflareLoadBalancer_CreateAfterManualDestroy(t *testing.T) {
 }
 
 func testAccCheckCloudflareLoadBalancerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_load_balancer" {
@@ -378,7 +440,7 @@ func testAccCheckCloudflareLoadBalancerExists(n string, loadBalancer *cloudflare
 			return fmt.Errorf("No Load Balancer ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundLoadBalancer, err := client.LoadBalancerDetails(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
 		if err != nil {
 			return err
@@ -441,7 +503,7 @@ func testAccCheckCloudflareLoadBalancerDates(n string, loadBalancer *cloudflare.
 func testAccManuallyDeleteLoadBalancer(name string, loadBalancer *cloudflare.LoadBalancer, initialId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, _ := s.RootModule().Resources[name]
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		*initialId = loadBalancer.ID
 		err := client.DeleteLoadBalancer(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
 		if err != nil {
@@ -490,6 +552,41 @@ resource "cloudflare_load_balancer" "%[3]s" {
 }`, zoneID, zone, id)
 }
 
+func testAccCheckCloudflareLoadBalancerConfigAdvancedSteering(zoneID, zone, id string) string {
+	return testAccCheckCloudflareLoadBalancerPoolConfigBasic(id) + fmt.Sprintf(`
+resource "cloudflare_load_balancer" "%[3]s" {
+  zone_id          = "%[1]s"
+  name             = "tf-testacc-lb-advanced-%[3]s.%[2]s"
+  fallback_pool_id = cloudflare_load_balancer_pool.%[3]s.id
+  default_pool_ids = [cloudflare_load_balancer_pool.%[3]s.id]
+  steering_policy  = "random"
+  proxied          = true
+  session_affinity = "header"
+
+  session_affinity_attributes = {
+    headers                = "x-session-id,x-user"
+    require_all_headers    = "true"
+    zero_downtime_failover = "sticky"
+  }
+
+  random_steering {
+    default_weight = 0.2
+    pool_weights = {
+      (cloudflare_load_balancer_pool.%[3]s.id) = 0.8
+    }
+  }
+
+  adaptive_routing {
+    failover_across_pools = true
+  }
+
+  location_strategy {
+    prefer_ecs = "always"
+    mode       = "resolver_ip"
+  }
+}`, zoneID, zone, id)
+}
+
 func testAccCheckCloudflareLoadBalancerConfigGeoBalanced(zoneID, zone, id string) string {
 	return testAccCheckCloudflareLoadBalancerPoolConfigBasic(id) + fmt.Sprintf(`
 resource "cloudflare_load_balancer" "%[3]s" {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,7 +23,7 @@ func resourceCloudflareLogpullRetention() *schema.Resource {
 }
 
 func resourceCloudflareLogpullRetentionSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	status := d.Get("enabled").(bool)
 
@@ -39,7 +38,7 @@ func resourceCloudflareLogpullRetentionSet(ctx context.Context, d *schema.Resour
 }
 
 func resourceCloudflareLogpullRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	logpullConf, err := client.GetLogpullRetentionFlag(ctx, zoneID)
@@ -53,7 +52,7 @@ func resourceCloudflareLogpullRetentionRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceCloudflareLogpullRetentionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	_, err := client.SetLogpullRetentionFlag(ctx, zoneID, false)
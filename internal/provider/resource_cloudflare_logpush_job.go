@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -24,6 +26,7 @@ func resourceCloudflareLogpushJob() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareLogpushJobImport,
 		},
+		CustomizeDiff: resourceCloudflareLogpushJobValidateDestinationDiff,
 		Description: `
 		Provides a resource which manages Cloudflare Logpush jobs. For Logpush jobs pushing to Amazon S3, Google Cloud Storage,
 Microsoft Azure or Sumo Logic, this resource cannot be automatically created. In order to have this automated, you must
@@ -72,8 +75,13 @@ func getJobFromResource(d *schema.ResourceData) (cloudflare.LogpushJob, *AccessI
 		Frequency:          d.Get("frequency").(string),
 	}
 
-	filter := d.Get("filter")
-	if filter != "" {
+	if builder, ok := d.GetOk("filter_builder"); ok {
+		jobFilter, err := expandLogpushJobFilterBuilder(builder.([]interface{}))
+		if err != nil {
+			return job, identifier, fmt.Errorf("invalid filter_builder: %w", err)
+		}
+		job.Filter = jobFilter
+	} else if filter := d.Get("filter"); filter != "" {
 		var jobFilter cloudflare.LogpushJobFilters
 		if err := json.Unmarshal([]byte(filter.(string)), &jobFilter); err != nil {
 			return cloudflare.LogpushJob{}, identifier, err
@@ -89,7 +97,7 @@ func getJobFromResource(d *schema.ResourceData) (cloudflare.LogpushJob, *AccessI
 }
 
 func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	jobID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("could not extract Logpush job from resource - invalid identifier (%s): %w", d.Id(), err))
@@ -137,19 +145,31 @@ func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("destination_conf", job.DestinationConf)
 	d.Set("ownership_challenge", d.Get("ownership_challenge"))
 	d.Set("frequency", job.Frequency)
-	d.Set("filter", filter)
+
+	// When the filter is managed through filter_builder the compiled JSON is
+	// not exposed as `filter` to avoid a perpetual diff on the conflicting
+	// attribute.
+	if _, ok := d.GetOk("filter_builder"); !ok {
+		d.Set("filter", filter)
+	}
 
 	return nil
 }
 
 func resourceCloudflareLogpushJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	job, identifier, err := getJobFromResource(d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing logpush job from resource: %w", err))
 	}
 
+	if _, ok := d.GetOk("filter_builder"); ok && job.Filter != nil {
+		if err := validateLogpushJobFilterKeys(ctx, client, identifier, job.Dataset, job.Filter.Where); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Logpush job for %s from struct: %+v", identifier, job))
 
 	var j *cloudflare.LogpushJob
@@ -173,13 +193,19 @@ func resourceCloudflareLogpushJobCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareLogpushJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	job, identifier, err := getJobFromResource(d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing logpush job from resource: %w", err))
 	}
 
+	if _, ok := d.GetOk("filter_builder"); ok && job.Filter != nil {
+		if err := validateLogpushJobFilterKeys(ctx, client, identifier, job.Dataset, job.Filter.Where); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare Logpush job for %s from struct: %+v", identifier, job))
 
 	if identifier.Type == AccountType {
@@ -196,7 +222,7 @@ func resourceCloudflareLogpushJobUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareLogpushJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	job, identifier, err := getJobFromResource(d)
 	if err != nil {
@@ -255,3 +281,250 @@ func resourceCloudflareLogpushJobImport(ctx context.Context, d *schema.ResourceD
 
 	return []*schema.ResourceData{d}, nil
 }
+
+var logpushJobFilterOperators = []string{
+	string(cloudflare.Equal),
+	string(cloudflare.NotEqual),
+	string(cloudflare.LessThan),
+	string(cloudflare.LessThanOrEqual),
+	string(cloudflare.GreaterThan),
+	string(cloudflare.GreaterThanOrEqual),
+	string(cloudflare.StartsWith),
+	string(cloudflare.EndsWith),
+	string(cloudflare.NotStartsWith),
+	string(cloudflare.NotEndsWith),
+	string(cloudflare.Contains),
+	string(cloudflare.NotContains),
+	string(cloudflare.ValueIsIn),
+	string(cloudflare.ValueIsNotIn),
+}
+
+// expandLogpushJobFilterBuilder compiles the `filter_builder` block into the
+// filter structure accepted by the API.
+func expandLogpushJobFilterBuilder(builder []interface{}) (*cloudflare.LogpushJobFilters, error) {
+	if len(builder) == 0 || builder[0] == nil {
+		return nil, nil
+	}
+
+	b := builder[0].(map[string]interface{})
+
+	elements, err := expandLogpushJobFilterConditions(b["condition"].([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, g := range b["group"].([]interface{}) {
+		group := g.(map[string]interface{})
+		conditions, err := expandLogpushJobFilterConditions(group["condition"].([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("group %d: %w", i, err)
+		}
+		elements = append(elements, logpushJobFilterGroup(group["match"].(string), conditions))
+	}
+
+	if len(elements) == 0 {
+		return nil, fmt.Errorf("at least one condition or group is required")
+	}
+
+	filter := &cloudflare.LogpushJobFilters{Where: logpushJobFilterGroup(b["match"].(string), elements)}
+	if err := filter.Where.Validate(); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+func logpushJobFilterGroup(match string, elements []cloudflare.LogpushJobFilter) cloudflare.LogpushJobFilter {
+	if match == "any" {
+		return cloudflare.LogpushJobFilter{Or: elements}
+	}
+	return cloudflare.LogpushJobFilter{And: elements}
+}
+
+func expandLogpushJobFilterConditions(conditions []interface{}) ([]cloudflare.LogpushJobFilter, error) {
+	filters := make([]cloudflare.LogpushJobFilter, 0, len(conditions))
+
+	for _, c := range conditions {
+		condition := c.(map[string]interface{})
+		key := condition["key"].(string)
+		operator := cloudflare.Operator(condition["operator"].(string))
+		value := condition["value"].(string)
+		values := expandInterfaceToStringList(condition["values"])
+
+		filter := cloudflare.LogpushJobFilter{Key: key, Operator: operator}
+
+		if operator == cloudflare.ValueIsIn || operator == cloudflare.ValueIsNotIn {
+			if len(values) == 0 || value != "" {
+				return nil, fmt.Errorf("condition on %q: operator %q requires `values` and not `value`", key, operator)
+			}
+			typed := make([]interface{}, 0, len(values))
+			for _, v := range values {
+				typed = append(typed, logpushJobFilterValue(v))
+			}
+			filter.Value = typed
+		} else {
+			if len(values) > 0 {
+				return nil, fmt.Errorf("condition on %q: operator %q requires `value` and not `values`", key, operator)
+			}
+			filter.Value = logpushJobFilterValue(value)
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// logpushJobFilterValue converts a string from the configuration into the
+// JSON type the filter expects as dataset fields may be numeric or boolean.
+func logpushJobFilterValue(v string) interface{} {
+	if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(v, 64); err == nil {
+		return f
+	}
+	if v == "true" || v == "false" {
+		return v == "true"
+	}
+	return v
+}
+
+// validateLogpushJobFilterKeys ensures every key referenced by the filter is a
+// field of the dataset, suggesting the closest field name when it is not.
+func validateLogpushJobFilterKeys(ctx context.Context, client *apiClient, identifier *AccessIdentifier, dataset string, filter cloudflare.LogpushJobFilter) error {
+	var fields cloudflare.LogpushFields
+	var err error
+	if identifier.Type == AccountType {
+		fields, err = client.GetAccountLogpushFields(ctx, identifier.Value, dataset)
+	} else {
+		fields, err = client.GetZoneLogpushFields(ctx, identifier.Value, dataset)
+	}
+	if err != nil {
+		return fmt.Errorf("error fetching logpush fields for dataset %q: %w", dataset, err)
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return checkLogpushJobFilterKeys(filter, dataset, names)
+}
+
+func checkLogpushJobFilterKeys(filter cloudflare.LogpushJobFilter, dataset string, fields []string) error {
+	for _, group := range [][]cloudflare.LogpushJobFilter{filter.And, filter.Or} {
+		for _, element := range group {
+			if err := checkLogpushJobFilterKeys(element, dataset, fields); err != nil {
+				return err
+			}
+		}
+	}
+
+	if filter.Key == "" || contains(fields, filter.Key) {
+		return nil
+	}
+
+	err := fmt.Errorf("filter key %q is not a field of dataset %q", filter.Key, dataset)
+	if suggestion := closestEnumValue(filter.Key, fields); suggestion != "" {
+		err = fmt.Errorf("%s. Did you mean %q?", err, suggestion)
+	}
+
+	return err
+}
+
+type logpushValidationResult struct {
+	Valid   bool   `json:"valid"`
+	Message string `json:"message"`
+}
+
+// resourceCloudflareLogpushJobValidateDestinationDiff runs the Logpush
+// validation endpoints at plan time when `validate_destination` is set, as
+// the API otherwise rejects invalid destinations on apply with an opaque
+// error.
+func resourceCloudflareLogpushJobValidateDestinationDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("validate_destination").(bool) {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges("destination_conf", "ownership_challenge", "logpull_options", "validate_destination") {
+		return nil
+	}
+
+	for _, key := range []string{"account_id", "zone_id", "destination_conf", "ownership_challenge", "logpull_options"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	identifier := &AccessIdentifier{Type: ZoneType, Value: d.Get("zone_id").(string)}
+	if accountID := d.Get("account_id").(string); accountID != "" {
+		identifier = &AccessIdentifier{Type: AccountType, Value: accountID}
+	}
+
+	return validateLogpushJobDestination(ctx, meta.(*apiClient), identifier,
+		d.Get("destination_conf").(string), d.Get("ownership_challenge").(string), d.Get("logpull_options").(string))
+}
+
+func validateLogpushJobDestination(ctx context.Context, client *apiClient, identifier *AccessIdentifier, destinationConf, ownershipChallenge, logpullOptions string) error {
+	tflog.Debug(ctx, fmt.Sprintf("Validating Logpush destination for %s: %s", identifier, destinationConf))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, logpushValidateURI(identifier, "validate/destination"), map[string]string{"destination_conf": destinationConf})
+	if err != nil {
+		return fmt.Errorf("error validating logpush destination_conf: %w", err)
+	}
+	if err := checkLogpushValidationResult(res, "destination_conf"); err != nil {
+		return err
+	}
+
+	if ownershipChallenge != "" {
+		var valid bool
+		if identifier.Type == AccountType {
+			valid, err = client.ValidateAccountLogpushOwnershipChallenge(ctx, identifier.Value, destinationConf, ownershipChallenge)
+		} else {
+			valid, err = client.ValidateZoneLogpushOwnershipChallenge(ctx, identifier.Value, destinationConf, ownershipChallenge)
+		}
+		if err != nil {
+			return fmt.Errorf("error validating logpush ownership_challenge: %w", err)
+		}
+		if !valid {
+			return fmt.Errorf("ownership_challenge is not valid for destination_conf %q, it may have expired or belong to a different destination", destinationConf)
+		}
+	}
+
+	if logpullOptions != "" {
+		res, err := rawRequest(ctx, client, http.MethodPost, logpushValidateURI(identifier, "validate/origin"), map[string]string{"logpull_options": logpullOptions})
+		if err != nil {
+			return fmt.Errorf("error validating logpush logpull_options: %w", err)
+		}
+		if err := checkLogpushValidationResult(res, "logpull_options"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkLogpushValidationResult converts an invalid validation result into an
+// error naming the attribute and including the reason returned by the API.
+func checkLogpushValidationResult(res json.RawMessage, attribute string) error {
+	var result logpushValidationResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		return fmt.Errorf("error unmarshalling logpush validation result: %w", err)
+	}
+
+	if result.Valid {
+		return nil
+	}
+
+	if result.Message == "" {
+		return fmt.Errorf("%s was rejected by the Logpush validation API", attribute)
+	}
+
+	return fmt.Errorf("%s was rejected by the Logpush validation API: %s", attribute, result.Message)
+}
+
+func logpushValidateURI(identifier *AccessIdentifier, endpoint string) string {
+	return fmt.Sprintf("/%ss/%s/logpush/%s", identifier.Type, identifier.Value, endpoint)
+}
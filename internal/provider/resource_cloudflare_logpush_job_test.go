@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestExpandLogpushJobFilterBuilder(t *testing.T) {
+	builder := []interface{}{
+		map[string]interface{}{
+			"match": "all",
+			"condition": []interface{}{
+				map[string]interface{}{"key": "ClientRequestHost", "operator": "eq", "value": "example.com", "values": []interface{}{}},
+			},
+			"group": []interface{}{
+				map[string]interface{}{
+					"match": "any",
+					"condition": []interface{}{
+						map[string]interface{}{"key": "EdgeResponseStatus", "operator": "geq", "value": "500", "values": []interface{}{}},
+						map[string]interface{}{"key": "ClientCountry", "operator": "in", "value": "", "values": []interface{}{"us", "ca"}},
+					},
+				},
+			},
+		},
+	}
+
+	filter, err := expandLogpushJobFilterBuilder(builder)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := json.Marshal(filter)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"where":{"and":[{"key":"ClientRequestHost","operator":"eq","value":"example.com"},{"or":[{"key":"EdgeResponseStatus","operator":"geq","value":500},{"key":"ClientCountry","operator":"in","value":["us","ca"]}]}]}}`
+	if string(got) != want {
+		t.Errorf("expandLogpushJobFilterBuilder() = %s, want %s", got, want)
+	}
+
+	if err := checkLogpushJobFilterKeys(filter.Where, "http_requests", []string{"ClientCountry", "ClientRequestHost", "EdgeResponseStatus"}); err != nil {
+		t.Errorf("unexpected error validating known keys: %s", err)
+	}
+
+	err = checkLogpushJobFilterKeys(filter.Where, "http_requests", []string{"ClientCountry", "ClientRequestHost", "EdgeResponseStatuses"})
+	if err == nil {
+		t.Fatal("expected an error for an unknown key")
+	}
+	if want := `filter key "EdgeResponseStatus" is not a field of dataset "http_requests". Did you mean "EdgeResponseStatuses"?`; err.Error() != want {
+		t.Errorf("got error %q, want %q", err, want)
+	}
+}
+
+func TestExpandLogpushJobFilterBuilderInvalidValues(t *testing.T) {
+	builder := []interface{}{
+		map[string]interface{}{
+			"match": "all",
+			"condition": []interface{}{
+				map[string]interface{}{"key": "ClientCountry", "operator": "in", "value": "us", "values": []interface{}{}},
+			},
+			"group": []interface{}{},
+		},
+	}
+
+	if _, err := expandLogpushJobFilterBuilder(builder); err == nil {
+		t.Error("expected an error when `in` is used without `values`")
+	}
+}
+
+func TestCheckLogpushValidationResult(t *testing.T) {
+	if err := checkLogpushValidationResult(json.RawMessage(`{"valid":true,"message":""}`), "destination_conf"); err != nil {
+		t.Errorf("expected valid result to pass, got %s", err)
+	}
+
+	err := checkLogpushValidationResult(json.RawMessage(`{"valid":false,"message":"bucket does not exist"}`), "destination_conf")
+	if err == nil || err.Error() != "destination_conf was rejected by the Logpush validation API: bucket does not exist" {
+		t.Errorf("unexpected error for invalid result: %v", err)
+	}
+
+	err = checkLogpushValidationResult(json.RawMessage(`{"valid":false}`), "logpull_options")
+	if err == nil || err.Error() != "logpull_options was rejected by the Logpush validation API" {
+		t.Errorf("unexpected error for invalid result without message: %v", err)
+	}
+}
+
+func TestLogpushValidateURI(t *testing.T) {
+	got := logpushValidateURI(&AccessIdentifier{Type: AccountType, Value: "abc"}, "validate/destination")
+	if want := "/accounts/abc/logpush/validate/destination"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	got = logpushValidateURI(&AccessIdentifier{Type: ZoneType, Value: "def"}, "validate/origin")
+	if want := "/zones/def/logpush/validate/origin"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -25,7 +25,7 @@ func resourceCloudflareLogpushOwnershipChallengeNoop(ctx context.Context, d *sch
 }
 
 func resourceCloudflareLogpushOwnershipChallengeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	destinationConf := d.Get("destination_conf").(string)
 	identifier, err := initIdentifier(d)
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type magicNetworkMonitoringRule struct {
+	ID                     string   `json:"id,omitempty"`
+	Name                   string   `json:"name"`
+	Prefixes               []string `json:"prefixes"`
+	AutomaticAdvertisement bool     `json:"automatic_advertisement"`
+	BandwidthThreshold     *int     `json:"bandwidth_threshold,omitempty"`
+	PacketThreshold        *int     `json:"packet_threshold,omitempty"`
+	Duration               string   `json:"duration,omitempty"`
+}
+
+func resourceCloudflareMagicNetworkMonitoringRule() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMagicNetworkMonitoringRuleSchema(),
+		CreateContext: resourceCloudflareMagicNetworkMonitoringRuleCreate,
+		ReadContext:   resourceCloudflareMagicNetworkMonitoringRuleRead,
+		UpdateContext: resourceCloudflareMagicNetworkMonitoringRuleUpdate,
+		DeleteContext: resourceCloudflareMagicNetworkMonitoringRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMagicNetworkMonitoringRuleImport,
+		},
+		Description: "Provides a Cloudflare Magic Network Monitoring rule. Rules alert when traffic to the " +
+			"monitored prefixes exceeds a bandwidth or packet threshold and can automatically advertise " +
+			"the prefixes through Magic Transit when an attack is detected.",
+	}
+}
+
+func resourceCloudflareMagicNetworkMonitoringRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	rule := buildMagicNetworkMonitoringRule(d)
+	if err := validateMagicNetworkMonitoringRulePrefixes(ctx, client, accountID, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Magic Network Monitoring rule from struct: %+v", rule))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/mnm/rules", accountID), rule)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Magic Network Monitoring rule %q: %w", rule.Name, err))
+	}
+
+	var created magicNetworkMonitoringRule
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Magic Network Monitoring rule: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareMagicNetworkMonitoringRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicNetworkMonitoringRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, magicNetworkMonitoringRuleURI(accountID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Magic Network Monitoring rule %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Magic Network Monitoring rule %q: %w", d.Id(), err))
+	}
+
+	var rule magicNetworkMonitoringRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Magic Network Monitoring rule: %w", err))
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("prefixes", rule.Prefixes)
+	d.Set("automatic_advertisement", rule.AutomaticAdvertisement)
+	d.Set("bandwidth_threshold", rule.BandwidthThreshold)
+	d.Set("packet_threshold", rule.PacketThreshold)
+	d.Set("duration", rule.Duration)
+
+	return nil
+}
+
+func resourceCloudflareMagicNetworkMonitoringRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	rule := buildMagicNetworkMonitoringRule(d)
+	if err := validateMagicNetworkMonitoringRulePrefixes(ctx, client, accountID, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Magic Network Monitoring rule %s from struct: %+v", d.Id(), rule))
+
+	_, err := rawRequest(ctx, client, http.MethodPatch, magicNetworkMonitoringRuleURI(accountID, d.Id()), rule)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Magic Network Monitoring rule %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareMagicNetworkMonitoringRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicNetworkMonitoringRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting Magic Network Monitoring rule %s", d.Id()))
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, magicNetworkMonitoringRuleURI(accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Magic Network Monitoring rule %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMagicNetworkMonitoringRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/ruleID"`, d.Id())
+	}
+
+	accountID, ruleID := attributes[0], attributes[1]
+
+	d.SetId(ruleID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareMagicNetworkMonitoringRuleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildMagicNetworkMonitoringRule(d *schema.ResourceData) magicNetworkMonitoringRule {
+	rule := magicNetworkMonitoringRule{
+		Name:                   d.Get("name").(string),
+		Prefixes:               expandInterfaceToStringList(d.Get("prefixes").(*schema.Set).List()),
+		AutomaticAdvertisement: d.Get("automatic_advertisement").(bool),
+		Duration:               d.Get("duration").(string),
+	}
+
+	if v, ok := d.GetOk("bandwidth_threshold"); ok {
+		threshold := v.(int)
+		rule.BandwidthThreshold = &threshold
+	}
+
+	if v, ok := d.GetOk("packet_threshold"); ok {
+		threshold := v.(int)
+		rule.PacketThreshold = &threshold
+	}
+
+	return rule
+}
+
+// validateMagicNetworkMonitoringRulePrefixes ensures that every prefix of a
+// rule with automatic advertisement is covered by an IP prefix onboarded to
+// the account, as only those can be advertised when an attack is detected.
+func validateMagicNetworkMonitoringRulePrefixes(ctx context.Context, client *apiClient, accountID string, rule magicNetworkMonitoringRule) error {
+	if !rule.AutomaticAdvertisement {
+		return nil
+	}
+
+	accountPrefixes, err := client.ListPrefixes(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("error listing IP prefixes for account %q: %w", accountID, err)
+	}
+
+	var cidrs []string
+	for _, prefix := range accountPrefixes {
+		cidrs = append(cidrs, prefix.CIDR)
+	}
+
+	var missing []string
+	for _, prefix := range rule.Prefixes {
+		if !cidrListContainsPrefix(cidrs, prefix) {
+			missing = append(missing, prefix)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("automatic_advertisement requires every prefix to be onboarded to the account, but %s not found in the account IP prefixes", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// cidrListContainsPrefix returns whether prefix is equal to or a subnet of
+// any of the CIDRs.
+func cidrListContainsPrefix(cidrs []string, prefix string) bool {
+	_, prefixNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return false
+	}
+	prefixOnes, prefixBits := prefixNet.Mask.Size()
+
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+
+		ones, bits := network.Mask.Size()
+		if bits == prefixBits && ones <= prefixOnes && network.Contains(prefixNet.IP) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func magicNetworkMonitoringRuleURI(accountID, ruleID string) string {
+	return fmt.Sprintf("/accounts/%s/mnm/rules/%s", accountID, ruleID)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareMagicNetworkMonitoringRule_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_magic_network_monitoring_rule.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMagicNetworkMonitoringRuleConfig(rnd, accountID, "bandwidth_threshold = 1000000000", "1m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "prefixes.#", "1"),
+					resource.TestCheckResourceAttr(name, "automatic_advertisement", "false"),
+					resource.TestCheckResourceAttr(name, "bandwidth_threshold", "1000000000"),
+					resource.TestCheckResourceAttr(name, "duration", "1m"),
+				),
+			},
+			{
+				Config: testAccCloudflareMagicNetworkMonitoringRuleConfig(rnd, accountID, "packet_threshold = 10000", "5m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "packet_threshold", "10000"),
+					resource.TestCheckResourceAttr(name, "bandwidth_threshold", "0"),
+					resource.TestCheckResourceAttr(name, "duration", "5m"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func TestCidrListContainsPrefix(t *testing.T) {
+	cidrs := []string{"192.0.2.0/24", "2001:db8::/32"}
+
+	cases := map[string]bool{
+		"192.0.2.0/24":     true,
+		"192.0.2.128/25":   true,
+		"192.0.0.0/16":     false,
+		"198.51.100.0/24":  false,
+		"2001:db8:1::/48":  true,
+		"2001:db9::/32":    false,
+		"not-a-cidr":       false,
+		"::ffff:c000:0/96": false,
+	}
+
+	for prefix, expected := range cases {
+		if got := cidrListContainsPrefix(cidrs, prefix); got != expected {
+			t.Errorf("cidrListContainsPrefix(%q) = %t, expected %t", prefix, got, expected)
+		}
+	}
+}
+
+func testAccCloudflareMagicNetworkMonitoringRuleConfig(name, accountID, threshold, duration string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_magic_network_monitoring_rule" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  prefixes   = ["192.0.2.0/24"]
+  %[3]s
+  duration   = "%[4]s"
+}
+`, name, accountID, threshold, duration)
+}
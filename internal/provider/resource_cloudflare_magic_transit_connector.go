@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type magicTransitConnector struct {
+	ID                           string `json:"id,omitempty"`
+	Activated                    bool   `json:"activated"`
+	InterruptWindowDurationHours int    `json:"interrupt_window_duration_hours,omitempty"`
+	InterruptWindowHourOfDay     *int   `json:"interrupt_window_hour_of_day,omitempty"`
+	Timezone                     string `json:"timezone,omitempty"`
+	Notes                        string `json:"notes"`
+	LastHeartbeat                string `json:"last_heartbeat,omitempty"`
+	LastSeenVersion              string `json:"last_seen_version,omitempty"`
+	Device                       *struct {
+		ID           string `json:"id"`
+		SerialNumber string `json:"serial_number"`
+	} `json:"device,omitempty"`
+}
+
+func resourceCloudflareMagicTransitConnector() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMagicTransitConnectorSchema(),
+		CreateContext: resourceCloudflareMagicTransitConnectorCreate,
+		ReadContext:   resourceCloudflareMagicTransitConnectorRead,
+		UpdateContext: resourceCloudflareMagicTransitConnectorUpdate,
+		DeleteContext: resourceCloudflareMagicTransitConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMagicTransitConnectorImport,
+		},
+		Description: "Provides a resource for onboarding and activating Magic Transit connector devices. " +
+			"Connectors are provisioned by Cloudflare when shipped, so creating this resource adopts an " +
+			"existing connector and destroying it deactivates the connector.",
+	}
+}
+
+func resourceCloudflareMagicTransitConnectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	connectorID := d.Get("connector_id").(string)
+	if serial, ok := d.GetOk("serial_number"); ok {
+		id, err := findMagicTransitConnectorBySerialNumber(ctx, client, accountID, serial.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		connectorID = id
+	}
+
+	d.SetId(connectorID)
+
+	return resourceCloudflareMagicTransitConnectorUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareMagicTransitConnectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, magicTransitConnectorURI(accountID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Magic Transit connector %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Magic Transit connector %q: %w", d.Id(), err))
+	}
+
+	var connector magicTransitConnector
+	if err := json.Unmarshal(res, &connector); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Magic Transit connector: %w", err))
+	}
+
+	d.Set("connector_id", connector.ID)
+	if connector.Device != nil {
+		d.Set("serial_number", connector.Device.SerialNumber)
+	}
+	d.Set("activated", connector.Activated)
+	d.Set("interrupt_window_duration_hours", connector.InterruptWindowDurationHours)
+	d.Set("interrupt_window_hour_of_day", connector.InterruptWindowHourOfDay)
+	d.Set("timezone", connector.Timezone)
+	d.Set("notes", connector.Notes)
+	d.Set("last_heartbeat", connector.LastHeartbeat)
+	d.Set("last_seen_version", connector.LastSeenVersion)
+
+	return nil
+}
+
+func resourceCloudflareMagicTransitConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	connector := magicTransitConnector{
+		Activated:                    d.Get("activated").(bool),
+		InterruptWindowDurationHours: d.Get("interrupt_window_duration_hours").(int),
+		Timezone:                     d.Get("timezone").(string),
+		Notes:                        d.Get("notes").(string),
+	}
+
+	if v, ok := d.GetOk("interrupt_window_hour_of_day"); ok || d.HasChange("interrupt_window_hour_of_day") {
+		hour := v.(int)
+		connector.InterruptWindowHourOfDay = &hour
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Magic Transit connector %s from struct: %+v", d.Id(), connector))
+
+	_, err := rawRequest(ctx, client, http.MethodPatch, magicTransitConnectorURI(accountID, d.Id()), connector)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Magic Transit connector %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareMagicTransitConnectorRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicTransitConnectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Deactivating Magic Transit connector %s", d.Id()))
+
+	_, err := rawRequest(ctx, client, http.MethodPatch, magicTransitConnectorURI(accountID, d.Id()), map[string]bool{"activated": false})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deactivating Magic Transit connector %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMagicTransitConnectorImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/connectorID"`, d.Id())
+	}
+
+	accountID, connectorID := attributes[0], attributes[1]
+
+	d.SetId(connectorID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareMagicTransitConnectorRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func findMagicTransitConnectorBySerialNumber(ctx context.Context, client *apiClient, accountID, serialNumber string) (string, error) {
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/magic/connectors", accountID), nil)
+	if err != nil {
+		return "", fmt.Errorf("error listing Magic Transit connectors: %w", err)
+	}
+
+	var connectors []magicTransitConnector
+	if err := json.Unmarshal(res, &connectors); err != nil {
+		return "", fmt.Errorf("error unmarshalling Magic Transit connectors: %w", err)
+	}
+
+	for _, connector := range connectors {
+		if connector.Device != nil && connector.Device.SerialNumber == serialNumber {
+			return connector.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("no Magic Transit connector with serial number %q found in account %q", serialNumber, accountID)
+}
+
+func magicTransitConnectorURI(accountID, connectorID string) string {
+	return fmt.Sprintf("/accounts/%s/magic/connectors/%s", accountID, connectorID)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareMagicTransitConnector_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_magic_transit_connector.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	connectorID := os.Getenv("CLOUDFLARE_MAGIC_TRANSIT_CONNECTOR_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckMagicTransitConnector(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMagicTransitConnectorConfig(rnd, accountID, connectorID, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "connector_id", connectorID),
+					resource.TestCheckResourceAttr(name, "activated", "true"),
+					resource.TestCheckResourceAttr(name, "interrupt_window_hour_of_day", "2"),
+					resource.TestCheckResourceAttr(name, "timezone", "Europe/London"),
+					resource.TestCheckResourceAttr(name, "notes", rnd),
+					resource.TestCheckResourceAttrSet(name, "serial_number"),
+				),
+			},
+			{
+				Config: testAccCloudflareMagicTransitConnectorConfig(rnd, accountID, connectorID, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "interrupt_window_hour_of_day", "0"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareMagicTransitConnectorConfig(rnd, accountID, connectorID string, hour int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_magic_transit_connector" "%[1]s" {
+  account_id                   = "%[2]s"
+  connector_id                 = "%[3]s"
+  interrupt_window_hour_of_day = %[4]d
+  timezone                     = "Europe/London"
+  notes                        = "%[1]s"
+}
+`, rnd, accountID, connectorID, hour)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,10 +18,14 @@ func resourceCloudflareManagedHeaders() *schema.Resource {
 		ReadContext:   resourceCloudflareManagedHeadersRead,
 		UpdateContext: resourceCloudflareManagedHeadersUpdate,
 		DeleteContext: resourceCloudflareManagedHeadersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareManagedHeadersImport,
+		},
 		SchemaVersion: 0,
 		Description: `
 The [Cloudflare Managed Headers](https://developers.cloudflare.com/rules/transform/managed-transforms/)
-allows you to add or remove some predefined headers to one's requests or origin responses.`,
+allows you to add or remove some predefined headers to one's requests or origin responses.
+Only the headers listed in the resource are managed, other managed headers of the zone are left untouched.`,
 	}
 }
 
@@ -31,7 +36,7 @@ func resourceCloudflareManagedHeadersCreate(ctx context.Context, d *schema.Resou
 }
 
 func resourceCloudflareManagedHeadersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	headers, err := client.ListZoneManagedHeaders(ctx, cloudflare.ListManagedHeadersParams{
 		ZoneID: zoneID,
@@ -40,32 +45,38 @@ func resourceCloudflareManagedHeadersRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf("error reading managed headers: %w", err))
 	}
 
-	// Filter out headers that are not enabled. This will eventually move into
-	// the API endpoint or the SDK.
-	var enabledRequestHeaders []cloudflare.ManagedHeader
-	var enabledResponseHeaders []cloudflare.ManagedHeader
-
-	for _, header := range headers.ManagedRequestHeaders {
-		if header.Enabled {
-			enabledRequestHeaders = append(enabledRequestHeaders, header)
-		}
-	}
-
-	for _, header := range headers.ManagedResponseHeaders {
-		if header.Enabled {
-			enabledResponseHeaders = append(enabledResponseHeaders, header)
-		}
-	}
+	// Only the headers in state are managed by the resource, the others are
+	// left to other tools and resources.
+	requestHeaders := filterManagedHeaders(headers.ManagedRequestHeaders, d.Get("managed_request_headers").(*schema.Set))
+	responseHeaders := filterManagedHeaders(headers.ManagedResponseHeaders, d.Get("managed_response_headers").(*schema.Set))
 
-	if err := d.Set("managed_request_headers", buildResourceFromManagedHeaders(enabledRequestHeaders)); err != nil {
+	if err := d.Set("managed_request_headers", buildResourceFromManagedHeaders(requestHeaders)); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("managed_response_headers", buildResourceFromManagedHeaders(enabledResponseHeaders)); err != nil {
+	if err := d.Set("managed_response_headers", buildResourceFromManagedHeaders(responseHeaders)); err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
+// filterManagedHeaders returns the headers whose ID is in the resource set,
+// with their current state.
+func filterManagedHeaders(headers []cloudflare.ManagedHeader, resource *schema.Set) []cloudflare.ManagedHeader {
+	managed := make(map[string]bool)
+	for _, header := range resource.List() {
+		managed[header.(map[string]interface{})["id"].(string)] = true
+	}
+
+	var result []cloudflare.ManagedHeader
+	for _, header := range headers {
+		if managed[header.ID] {
+			result = append(result, header)
+		}
+	}
+
+	return result
+}
+
 func buildResourceFromManagedHeaders(headers []cloudflare.ManagedHeader) interface{} {
 	headersState := []map[string]interface{}{}
 	for _, header := range headers {
@@ -79,13 +90,27 @@ func buildResourceFromManagedHeaders(headers []cloudflare.ManagedHeader) interfa
 }
 
 func resourceCloudflareManagedHeadersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	mh, err := buildManagedHeadersFromResource(d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error building managed headers from resource: %w", err))
 	}
+
+	current, err := client.ListZoneManagedHeaders(ctx, cloudflare.ListManagedHeadersParams{
+		ZoneID: zoneID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading managed headers: %w", err))
+	}
+	if err := checkManagedHeadersConflicts(current.ManagedRequestHeaders, mh.ManagedRequestHeaders); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkManagedHeadersConflicts(current.ManagedResponseHeaders, mh.ManagedResponseHeaders); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if _, err := client.UpdateZoneManagedHeaders(ctx, cloudflare.UpdateManagedHeadersParams{
 		ManagedHeaders: mh,
 		ZoneID:         zoneID,
@@ -95,22 +120,46 @@ func resourceCloudflareManagedHeadersUpdate(ctx context.Context, d *schema.Resou
 	return resourceCloudflareManagedHeadersRead(ctx, d, meta)
 }
 
-// receives the resource config and builds a managed headers struct.
-func buildManagedHeadersFromResource(d *schema.ResourceData) (cloudflare.ManagedHeaders, error) {
-	requestHeaders, ok := d.Get("managed_request_headers").(*schema.Set)
-	if !ok {
-		return cloudflare.ManagedHeaders{}, errors.New("unable to create interface array type assertion")
+// checkManagedHeadersConflicts returns an error when a header being enabled
+// conflicts with a header that stays enabled, which the API rejects with a
+// less helpful message.
+func checkManagedHeadersConflicts(current, changes []cloudflare.ManagedHeader) error {
+	enabled := make(map[string]bool)
+	for _, header := range current {
+		enabled[header.ID] = header.Enabled
+	}
+	for _, header := range changes {
+		enabled[header.ID] = header.Enabled
+	}
+
+	for _, header := range current {
+		if !enabled[header.ID] {
+			continue
+		}
+
+		var conflicts []string
+		for _, id := range header.ConflictsWith {
+			if enabled[id] {
+				conflicts = append(conflicts, id)
+			}
+		}
+		if len(conflicts) > 0 {
+			return fmt.Errorf("managed header %q cannot be enabled together with %s", header.ID, strings.Join(conflicts, ", "))
+		}
 	}
-	reqHeaders, err := buildManagedHeadersListFromResource(requestHeaders)
+
+	return nil
+}
+
+// receives the resource config and builds a managed headers struct with the
+// headers to change. Headers removed from the resource are disabled.
+func buildManagedHeadersFromResource(d *schema.ResourceData) (cloudflare.ManagedHeaders, error) {
+	reqHeaders, err := buildManagedHeadersChangesFromResource(d, "managed_request_headers")
 	if err != nil {
 		return cloudflare.ManagedHeaders{}, err
 	}
 
-	responseHeaders, ok := d.Get("managed_response_headers").(*schema.Set)
-	if !ok {
-		return cloudflare.ManagedHeaders{}, errors.New("unable to create interface array type assertion")
-	}
-	respHeaders, err := buildManagedHeadersListFromResource(responseHeaders)
+	respHeaders, err := buildManagedHeadersChangesFromResource(d, "managed_response_headers")
 	if err != nil {
 		return cloudflare.ManagedHeaders{}, err
 	}
@@ -121,6 +170,39 @@ func buildManagedHeadersFromResource(d *schema.ResourceData) (cloudflare.Managed
 	}, nil
 }
 
+func buildManagedHeadersChangesFromResource(d *schema.ResourceData, key string) ([]cloudflare.ManagedHeader, error) {
+	o, n := d.GetChange(key)
+	oldHeaders, ok := o.(*schema.Set)
+	if !ok {
+		return nil, errors.New("unable to create interface array type assertion")
+	}
+	newHeaders, ok := n.(*schema.Set)
+	if !ok {
+		return nil, errors.New("unable to create interface array type assertion")
+	}
+
+	headers, err := buildManagedHeadersListFromResource(newHeaders)
+	if err != nil {
+		return nil, err
+	}
+	removed, err := buildManagedHeadersListFromResource(oldHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	configured := make(map[string]bool, len(headers))
+	for _, header := range headers {
+		configured[header.ID] = true
+	}
+	for _, header := range removed {
+		if !configured[header.ID] {
+			headers = append(headers, cloudflare.ManagedHeader{ID: header.ID, Enabled: false})
+		}
+	}
+
+	return headers, nil
+}
+
 func buildManagedHeadersListFromResource(resource *schema.Set) ([]cloudflare.ManagedHeader, error) {
 	headers := make([]cloudflare.ManagedHeader, 0, len(resource.List()))
 	for _, header := range resource.List() {
@@ -137,36 +219,32 @@ func buildManagedHeadersListFromResource(resource *schema.Set) ([]cloudflare.Man
 			return nil, errors.New("unable to create bool type assertion for managed header enabled")
 		}
 
-		if enabled {
-			headers = append(headers, cloudflare.ManagedHeader{
-				ID:      id,
-				Enabled: enabled,
-			})
-		}
+		headers = append(headers, cloudflare.ManagedHeader{
+			ID:      id,
+			Enabled: enabled,
+		})
 	}
 	return headers, nil
 }
 
 func resourceCloudflareManagedHeadersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
-	headers, err := client.ListZoneManagedHeaders(ctx, cloudflare.ListManagedHeadersParams{
-		ZoneID: zoneID,
-	})
+	requestHeaders, err := buildManagedHeadersListFromResource(d.Get("managed_request_headers").(*schema.Set))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error reading managed headers: %w", err))
+		return diag.FromErr(err)
+	}
+	responseHeaders, err := buildManagedHeadersListFromResource(d.Get("managed_response_headers").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	requestHeaders := make([]cloudflare.ManagedHeader, 0, len(headers.ManagedRequestHeaders))
-	for _, header := range headers.ManagedRequestHeaders {
-		header.Enabled = false
-		requestHeaders = append(requestHeaders, header)
+	for i := range requestHeaders {
+		requestHeaders[i].Enabled = false
 	}
-	responseHeaders := make([]cloudflare.ManagedHeader, 0, len(headers.ManagedResponseHeaders))
-	for _, header := range headers.ManagedResponseHeaders {
-		header.Enabled = false
-		responseHeaders = append(responseHeaders, header)
+	for i := range responseHeaders {
+		responseHeaders[i].Enabled = false
 	}
 
 	if _, err := client.UpdateZoneManagedHeaders(ctx, cloudflare.UpdateManagedHeadersParams{
@@ -181,3 +259,35 @@ func resourceCloudflareManagedHeadersDelete(ctx context.Context, d *schema.Resou
 
 	return nil
 }
+
+// resourceCloudflareManagedHeadersImport adopts every header enabled in the
+// zone, as there is no state to tell which ones should be managed.
+func resourceCloudflareManagedHeadersImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*apiClient)
+	zoneID := d.Id()
+
+	headers, err := client.ListZoneManagedHeaders(ctx, cloudflare.ListManagedHeadersParams{
+		ZoneID: zoneID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading managed headers: %w", err)
+	}
+
+	var requestHeaders, responseHeaders []cloudflare.ManagedHeader
+	for _, header := range headers.ManagedRequestHeaders {
+		if header.Enabled {
+			requestHeaders = append(requestHeaders, header)
+		}
+	}
+	for _, header := range headers.ManagedResponseHeaders {
+		if header.Enabled {
+			responseHeaders = append(responseHeaders, header)
+		}
+	}
+
+	d.Set("zone_id", zoneID)
+	d.Set("managed_request_headers", buildResourceFromManagedHeaders(requestHeaders))
+	d.Set("managed_response_headers", buildResourceFromManagedHeaders(responseHeaders))
+
+	return []*schema.ResourceData{d}, nil
+}
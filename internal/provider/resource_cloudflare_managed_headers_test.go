@@ -9,6 +9,7 @@ import (
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
 
@@ -93,10 +94,68 @@ func TestAccCloudflareManagedHeaders(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "managed_response_headers.0.enabled", "true"),
 				),
 			},
+			{
+				Config: testAccCheckCloudflareManagedHeadersUpdated(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "managed_request_headers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_request_headers.0.id", "add_true_client_ip_headers"),
+					resource.TestCheckResourceAttr(resourceName, "managed_request_headers.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "managed_response_headers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_response_headers.0.id", "add_security_headers"),
+					resource.TestCheckResourceAttr(resourceName, "managed_response_headers.0.enabled", "false"),
+					testAccCheckCloudflareManagedHeaderEnabled(zoneID, "add_visitor_location_headers", false),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareManagedHeadersUpdated(rnd, zoneID),
+				PreConfig: func() {
+					setCloudflareManagedRequestHeader(t, zoneID, "add_visitor_location_headers", true)
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "managed_request_headers.#", "1"),
+					testAccCheckCloudflareManagedHeaderEnabled(zoneID, "add_visitor_location_headers", true),
+				),
+			},
 		},
 	})
 }
 
+func testAccCheckCloudflareManagedHeaderEnabled(zoneID, id string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*apiClient)
+		headers, err := client.ListZoneManagedHeaders(context.Background(), cloudflare.ListManagedHeadersParams{
+			ZoneID: zoneID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, h := range append(headers.ManagedRequestHeaders, headers.ManagedResponseHeaders...) {
+			if h.ID == id {
+				if h.Enabled != enabled {
+					return fmt.Errorf("expected managed header %q enabled to be %t", id, enabled)
+				}
+				return nil
+			}
+		}
+
+		return fmt.Errorf("managed header %q not found", id)
+	}
+}
+
+func setCloudflareManagedRequestHeader(t *testing.T, zoneID, id string, enabled bool) {
+	client := testAccProvider.Meta().(*apiClient)
+	_, err := client.UpdateZoneManagedHeaders(context.Background(), cloudflare.UpdateManagedHeadersParams{
+		ManagedHeaders: cloudflare.ManagedHeaders{
+			ManagedRequestHeaders: []cloudflare.ManagedHeader{{ID: id, Enabled: enabled}},
+		},
+		ZoneID: zoneID,
+	})
+	if err != nil {
+		t.Fatalf("failed to update managed header %q: %s", id, err)
+	}
+}
+
 func testAccCheckCloudflareManagedHeaders(rnd, zoneID string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_managed_headers" "%[1]s" {
@@ -117,3 +176,19 @@ func testAccCheckCloudflareManagedHeaders(rnd, zoneID string) string {
 	}
   }`, rnd, zoneID)
 }
+
+func testAccCheckCloudflareManagedHeadersUpdated(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_managed_headers" "%[1]s" {
+	zone_id  = "%[2]s"
+	managed_request_headers {
+		id = "add_true_client_ip_headers"
+		enabled = true
+	}
+
+	managed_response_headers {
+		id = "add_security_headers"
+		enabled = false
+	}
+  }`, rnd, zoneID)
+}
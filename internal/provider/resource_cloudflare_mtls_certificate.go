@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type mtlsCertificate struct {
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Certificates string `json:"certificates"`
+	PrivateKey   string `json:"private_key,omitempty"`
+	CA           bool   `json:"ca"`
+	Issuer       string `json:"issuer,omitempty"`
+	SerialNumber string `json:"serial_number,omitempty"`
+	Signature    string `json:"signature,omitempty"`
+	UploadedOn   string `json:"uploaded_on,omitempty"`
+	ExpiresOn    string `json:"expires_on,omitempty"`
+}
+
+type mtlsCertificateAssociation struct {
+	Service string `json:"service"`
+	Status  string `json:"status"`
+}
+
+func resourceCloudflareMTLSCertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceCloudflareMTLSCertificateSchema(),
+		// mTLS certificates cannot be updated, only uploaded again.
+		CreateContext: resourceCloudflareMTLSCertificateCreate,
+		ReadContext:   resourceCloudflareMTLSCertificateRead,
+		DeleteContext: resourceCloudflareMTLSCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMTLSCertificateImport,
+		},
+		Description: "Provides a resource to upload account mTLS certificates and CA bundles, for use by services such as API Shield mTLS. " +
+			"Certificates cannot be updated in place and are replaced on changes.",
+	}
+}
+
+func resourceCloudflareMTLSCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	certificate := mtlsCertificate{
+		Name:         d.Get("name").(string),
+		Certificates: d.Get("certificates").(string),
+		PrivateKey:   d.Get("private_key").(string),
+		CA:           d.Get("ca").(bool),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Uploading mTLS certificate %q", certificate.Name))
+
+	cert, err := mtlsCertificateRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/mtls_certificates", accountID), certificate)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error uploading mTLS certificate: %w", err))
+	}
+
+	d.SetId(cert.ID)
+
+	return resourceCloudflareMTLSCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareMTLSCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	cert, err := mtlsCertificateRequest(ctx, client, http.MethodGet, mtlsCertificateURI(accountID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("mTLS certificate %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", cert.Name)
+	d.Set("ca", cert.CA)
+	d.Set("issuer", cert.Issuer)
+	d.Set("serial_number", cert.SerialNumber)
+	d.Set("signature", cert.Signature)
+	d.Set("uploaded_on", cert.UploadedOn)
+	d.Set("expires_on", cert.ExpiresOn)
+
+	// The API may reformat the PEM, keep the configured one unless importing.
+	if d.Get("certificates").(string) == "" {
+		d.Set("certificates", cert.Certificates)
+	}
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("%s/associations", mtlsCertificateURI(accountID, d.Id())), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing associations of mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	var associations []mtlsCertificateAssociation
+	if err := json.Unmarshal(res, &associations); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling mTLS certificate associations: %w", err))
+	}
+
+	result := make([]map[string]interface{}, 0, len(associations))
+	for _, association := range associations {
+		result = append(result, map[string]interface{}{
+			"service": association.Service,
+			"status":  association.Status,
+		})
+	}
+	if err := d.Set("associations", result); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting associations: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, mtlsCertificateURI(accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/certificateID"`, d.Id())
+	}
+
+	accountID, certificateID := attributes[0], attributes[1]
+
+	d.SetId(certificateID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareMTLSCertificateRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func mtlsCertificateURI(accountID, certificateID string) string {
+	return fmt.Sprintf("/accounts/%s/mtls_certificates/%s", accountID, certificateID)
+}
+
+func mtlsCertificateRequest(ctx context.Context, client *apiClient, method, uri string, body interface{}) (mtlsCertificate, error) {
+	var cert mtlsCertificate
+
+	res, err := rawRequest(ctx, client, method, uri, body)
+	if err != nil {
+		return cert, err
+	}
+
+	if err := json.Unmarshal(res, &cert); err != nil {
+		return cert, fmt.Errorf("error unmarshalling mTLS certificate: %w", err)
+	}
+
+	return cert, nil
+}
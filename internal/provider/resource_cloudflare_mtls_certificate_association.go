@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type mtlsCertificateHostnameAssociation struct {
+	Hostnames         []string `json:"hostnames"`
+	MTLSCertificateID string   `json:"mtls_certificate_id,omitempty"`
+}
+
+func resourceCloudflareMTLSCertificateAssociation() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMTLSCertificateAssociationSchema(),
+		CreateContext: resourceCloudflareMTLSCertificateAssociationCreate,
+		ReadContext:   resourceCloudflareMTLSCertificateAssociationRead,
+		UpdateContext: resourceCloudflareMTLSCertificateAssociationUpdate,
+		DeleteContext: resourceCloudflareMTLSCertificateAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMTLSCertificateAssociationImport,
+		},
+		Description: "Provides a resource to associate hostnames of a zone with an account mTLS CA bundle, " +
+			"so that API Shield mTLS validates the client certificates of these hostnames against it instead of the Cloudflare managed CA.",
+	}
+}
+
+func resourceCloudflareMTLSCertificateAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("mtls_certificate_id").(string))
+
+	return resourceCloudflareMTLSCertificateAssociationUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareMTLSCertificateAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	uri := fmt.Sprintf("%s?mtls_certificate_id=%s", mtlsCertificateAssociationURI(zoneID), url.QueryEscape(d.Id()))
+	res, err := rawRequest(ctx, client, http.MethodGet, uri, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching hostname associations of mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	var association mtlsCertificateHostnameAssociation
+	if err := json.Unmarshal(res, &association); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling mTLS certificate hostname associations: %w", err))
+	}
+
+	if len(association.Hostnames) == 0 {
+		tflog.Info(ctx, fmt.Sprintf("mTLS certificate %s is no longer associated with hostnames of zone %s", d.Id(), zoneID))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("mtls_certificate_id", d.Id())
+	d.Set("hostnames", association.Hostnames)
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	association := mtlsCertificateHostnameAssociation{
+		Hostnames:         expandInterfaceToStringList(d.Get("hostnames").(*schema.Set).List()),
+		MTLSCertificateID: d.Id(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Associating mTLS certificate %s with hostnames %v of zone %s", d.Id(), association.Hostnames, zoneID))
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, mtlsCertificateAssociationURI(zoneID), association); err != nil {
+		return diag.FromErr(fmt.Errorf("error associating mTLS certificate %q with hostnames: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareMTLSCertificateAssociationRead(ctx, d, meta)
+}
+
+func resourceCloudflareMTLSCertificateAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	association := mtlsCertificateHostnameAssociation{
+		Hostnames:         []string{},
+		MTLSCertificateID: d.Id(),
+	}
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, mtlsCertificateAssociationURI(zoneID), association); err != nil {
+		return diag.FromErr(fmt.Errorf("error removing hostname associations of mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateAssociationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/mtlsCertificateID"`, d.Id())
+	}
+
+	zoneID, certificateID := attributes[0], attributes[1]
+
+	d.SetId(certificateID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareMTLSCertificateAssociationRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func mtlsCertificateAssociationURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/certificate_authorities/hostname_associations", zoneID)
+}
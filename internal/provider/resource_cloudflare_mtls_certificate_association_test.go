@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareMTLSCertificateAssociation_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_mtls_certificate_association.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	ca, err := generateCACertificate(rnd)
+	if err != nil {
+		t.Fatalf("failed to generate CA certificate: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMTLSCertificateAssociationConfig(rnd, accountID, zoneID, ca, fmt.Sprintf(`"%s.%s"`, rnd, domain)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "mtls_certificate_id", "cloudflare_mtls_certificate."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "hostnames.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "hostnames.*", fmt.Sprintf("%s.%s", rnd, domain)),
+				),
+			},
+			{
+				Config: testAccCloudflareMTLSCertificateAssociationConfig(rnd, accountID, zoneID, ca, fmt.Sprintf(`"%[1]s.%[2]s", "api.%[1]s.%[2]s"`, rnd, domain)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostnames.#", "2"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareMTLSCertificateAssociationConfig(rnd, accountID, zoneID, ca, hostnames string) string {
+	return testAccCloudflareMTLSCertificateConfig(rnd, accountID, ca) + fmt.Sprintf(`
+resource "cloudflare_mtls_certificate_association" "%[1]s" {
+  zone_id             = "%[2]s"
+  mtls_certificate_id = cloudflare_mtls_certificate.%[1]s.id
+  hostnames           = [%[3]s]
+}
+`, rnd, zoneID, hostnames)
+}
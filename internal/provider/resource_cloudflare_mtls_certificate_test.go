@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareMTLSCertificate_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_mtls_certificate.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	ca, err := generateCACertificate(rnd)
+	if err != nil {
+		t.Fatalf("failed to generate CA certificate: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMTLSCertificateConfig(rnd, accountID, ca),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "ca", "true"),
+					resource.TestCheckResourceAttrSet(name, "issuer"),
+					resource.TestCheckResourceAttrSet(name, "serial_number"),
+					resource.TestCheckResourceAttrSet(name, "expires_on"),
+					resource.TestCheckResourceAttr(name, "associations.#", "0"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", accountID),
+				ImportStateVerifyIgnore: []string{"certificates"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareMTLSCertificateConfig(rnd, accountID, ca string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_mtls_certificate" "%[1]s" {
+  account_id   = "%[2]s"
+  name         = "%[1]s"
+  ca           = true
+  certificates = <<EOT
+%[3]sEOT
+}
+`, rnd, accountID, ca)
+}
+
+// generateCACertificate returns a PEM encoded self-signed CA certificate.
+func generateCACertificate(commonName string) (string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", err
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})), nil
+}
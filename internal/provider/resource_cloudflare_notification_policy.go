@@ -26,7 +26,7 @@ func resourceCloudflareNotificationPolicy() *schema.Resource {
 }
 
 func resourceCloudflareNotificationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	notificationPolicy := buildNotificationPolicy(d)
@@ -42,7 +42,7 @@ func resourceCloudflareNotificationPolicyCreate(ctx context.Context, d *schema.R
 }
 
 func resourceCloudflareNotificationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	policyID := d.Id()
 	accountID := d.Get("account_id").(string)
 
@@ -82,7 +82,7 @@ func resourceCloudflareNotificationPolicyRead(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudflareNotificationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	policyID := d.Id()
 	accountID := d.Get("account_id").(string)
 
@@ -99,7 +99,7 @@ func resourceCloudflareNotificationPolicyUpdate(ctx context.Context, d *schema.R
 }
 
 func resourceCloudflareNotificationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	policyID := d.Id()
 	accountID := d.Get("account_id").(string)
 
@@ -26,7 +26,7 @@ func resourceCloudflareNotificationPolicyWebhooks() *schema.Resource {
 }
 
 func resourceCloudflareNotificationPolicyWebhooksCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	notificationWebhooks := buildNotificationPolicyWebhooks(d)
@@ -47,7 +47,7 @@ func resourceCloudflareNotificationPolicyWebhooksCreate(ctx context.Context, d *
 }
 
 func resourceCloudflareNotificationPolicyWebhooksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	webhooksDestinationID := d.Id()
 	accountID := d.Get("account_id").(string)
 
@@ -74,7 +74,7 @@ func resourceCloudflareNotificationPolicyWebhooksRead(ctx context.Context, d *sc
 }
 
 func resourceCloudflareNotificationPolicyWebhooksUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	webhooksID := d.Id()
 	accountID := d.Get("account_id").(string)
 
@@ -90,7 +90,7 @@ func resourceCloudflareNotificationPolicyWebhooksUpdate(ctx context.Context, d *
 }
 
 func resourceCloudflareNotificationPolicyWebhooksDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	webhooksID := d.Id()
 	accountID := d.Get("account_id").(string)
 
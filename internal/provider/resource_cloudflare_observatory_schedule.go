@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	observatoryRegions = []string{
+		"asia-east1", "asia-northeast1", "asia-northeast2", "asia-south1", "asia-southeast1",
+		"australia-southeast1", "europe-north1", "europe-southwest1", "europe-west1", "europe-west2",
+		"europe-west3", "europe-west4", "europe-west8", "europe-west9", "me-west1",
+		"southamerica-east1", "us-central1", "us-east1", "us-east4", "us-south1", "us-west1",
+	}
+	observatoryFrequencies = []string{"DAILY", "WEEKLY"}
+)
+
+type observatorySchedule struct {
+	URL       string `json:"url"`
+	Region    string `json:"region"`
+	Frequency string `json:"frequency"`
+}
+
+type observatoryTest struct {
+	ID            string                `json:"id"`
+	Date          string                `json:"date"`
+	DesktopReport observatoryTestReport `json:"desktopReport"`
+	MobileReport  observatoryTestReport `json:"mobileReport"`
+}
+
+type observatoryTestReport struct {
+	PerformanceScore int `json:"performanceScore"`
+}
+
+func resourceCloudflareObservatorySchedule() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareObservatoryScheduleSchema(),
+		CreateContext: resourceCloudflareObservatoryScheduleCreate,
+		ReadContext:   resourceCloudflareObservatoryScheduleRead,
+		DeleteContext: resourceCloudflareObservatoryScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareObservatoryScheduleImport,
+		},
+		Description: "Provides a resource for scheduling recurring Observatory speed tests of a page. " +
+			"The scores of the most recent test are exposed so they can be used in preconditions.",
+	}
+}
+
+func resourceCloudflareObservatoryScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	pageURL := d.Get("url").(string)
+	region := d.Get("region").(string)
+
+	body := map[string]string{"frequency": d.Get("frequency").(string)}
+
+	tflog.Debug(ctx, fmt.Sprintf("Scheduling Observatory test of %s from %s", pageURL, region))
+
+	_, err := rawRequest(ctx, client, http.MethodPost, observatoryScheduleURI(zoneID, pageURL, region), body)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error scheduling Observatory test of %q: %w", pageURL, err))
+	}
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s", region, pageURL)))
+
+	return resourceCloudflareObservatoryScheduleRead(ctx, d, meta)
+}
+
+func resourceCloudflareObservatoryScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	pageURL := d.Get("url").(string)
+	region := d.Get("region").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, observatoryScheduleURI(zoneID, pageURL, region), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Observatory schedule for %s from %s no longer exists", pageURL, region))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Observatory schedule for %q: %w", pageURL, err))
+	}
+
+	var schedule observatorySchedule
+	if err := json.Unmarshal(res, &schedule); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Observatory schedule: %w", err))
+	}
+
+	d.Set("frequency", schedule.Frequency)
+
+	params := url.Values{}
+	params.Set("region", region)
+	params.Set("per_page", "1")
+
+	res, err = rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/zones/%s/speed_api/pages/%s/tests?%s", zoneID, url.PathEscape(pageURL), params.Encode()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching Observatory tests for %q: %w", pageURL, err))
+	}
+
+	var tests []observatoryTest
+	if err := json.Unmarshal(res, &tests); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Observatory tests: %w", err))
+	}
+
+	if len(tests) > 0 {
+		d.Set("last_test_date", tests[0].Date)
+		d.Set("desktop_performance_score", tests[0].DesktopReport.PerformanceScore)
+		d.Set("mobile_performance_score", tests[0].MobileReport.PerformanceScore)
+	}
+
+	return nil
+}
+
+func resourceCloudflareObservatoryScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	pageURL := d.Get("url").(string)
+	region := d.Get("region").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, observatoryScheduleURI(zoneID, pageURL, region), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Observatory schedule for %q: %w", pageURL, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareObservatoryScheduleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/region/url"`, d.Id())
+	}
+
+	zoneID, region, pageURL := attributes[0], attributes[1], attributes[2]
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s", region, pageURL)))
+	d.Set("zone_id", zoneID)
+	d.Set("region", region)
+	d.Set("url", pageURL)
+
+	resourceCloudflareObservatoryScheduleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func observatoryScheduleURI(zoneID, pageURL, region string) string {
+	return fmt.Sprintf("/zones/%s/speed_api/schedule/%s?region=%s", zoneID, url.PathEscape(pageURL), url.QueryEscape(region))
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareObservatorySchedule_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_observatory_schedule.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "cloudflare_observatory_schedule" "%[1]s" {
+  zone_id   = "%[2]s"
+  url       = "%[3]s/%[1]s"
+  region    = "us-central1"
+  frequency = "WEEKLY"
+}
+`, rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "url", fmt.Sprintf("%s/%s", domain, rnd)),
+					resource.TestCheckResourceAttr(name, "region", "us-central1"),
+					resource.TestCheckResourceAttr(name, "frequency", "WEEKLY"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/us-central1/%s/%s", zoneID, domain, rnd),
+			},
+		},
+	})
+}
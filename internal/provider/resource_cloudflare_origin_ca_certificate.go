@@ -19,9 +19,10 @@ func resourceCloudflareOriginCACertificate() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareOriginCACertificateSchema(),
 		CreateContext: resourceCloudflareOriginCACertificateCreate,
-		UpdateContext: resourceCloudflareOriginCACertificateCreate,
+		UpdateContext: resourceCloudflareOriginCACertificateUpdate,
 		ReadContext:   resourceCloudflareOriginCACertificateRead,
 		DeleteContext: resourceCloudflareOriginCACertificateDelete,
+		CustomizeDiff: resourceCloudflareOriginCACertificateDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -29,7 +30,7 @@ func resourceCloudflareOriginCACertificate() *schema.Resource {
 }
 
 func resourceCloudflareOriginCACertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	hostnames := []string{}
 	hostnamesRaw := d.Get("hostnames").(*schema.Set)
@@ -62,8 +63,17 @@ func resourceCloudflareOriginCACertificateCreate(ctx context.Context, d *schema.
 	return resourceCloudflareOriginCACertificateRead(ctx, d, meta)
 }
 
+func resourceCloudflareOriginCACertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// min_days_remaining only affects when the certificate is replaced.
+	if d.HasChangeExcept("min_days_remaining") {
+		return resourceCloudflareOriginCACertificateCreate(ctx, d, meta)
+	}
+
+	return resourceCloudflareOriginCACertificateRead(ctx, d, meta)
+}
+
 func resourceCloudflareOriginCACertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	certID := d.Id()
 	cert, err := client.OriginCertificate(ctx, certID)
 
@@ -109,7 +119,7 @@ func resourceCloudflareOriginCACertificateRead(ctx context.Context, d *schema.Re
 }
 
 func resourceCloudflareOriginCACertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	certID := d.Id()
 
 	tflog.Info(ctx, fmt.Sprintf("Revoking Cloudflare OriginCACertificate: id %s", certID))
@@ -124,6 +134,35 @@ func resourceCloudflareOriginCACertificateDelete(ctx context.Context, d *schema.
 	return nil
 }
 
+// resourceCloudflareOriginCACertificateDiff replaces the certificate once it
+// is within min_days_remaining days of expiring.
+func resourceCloudflareOriginCACertificateDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	minDaysRemaining := d.Get("min_days_remaining").(int)
+	if d.Id() == "" || minDaysRemaining == 0 {
+		return nil
+	}
+
+	expiresOn, err := time.Parse(time.RFC3339, d.Get("expires_on").(string))
+	if err != nil {
+		return nil
+	}
+
+	if !originCACertificateNeedsRenewal(expiresOn, minDaysRemaining, time.Now()) {
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("OriginCACertificate %s expires on %s, re-issuing", d.Id(), expiresOn.Format(time.RFC3339)))
+
+	if err := d.SetNewComputed("expires_on"); err != nil {
+		return err
+	}
+	return d.ForceNew("expires_on")
+}
+
+func originCACertificateNeedsRenewal(expiresOn time.Time, minDaysRemaining int, now time.Time) bool {
+	return expiresOn.Sub(now) < time.Duration(minDaysRemaining)*24*time.Hour
+}
+
 func validateCSR(v interface{}, k string) (ws []string, errors []error) {
 	block, _ := pem.Decode([]byte(v.(string)))
 	if block == nil {
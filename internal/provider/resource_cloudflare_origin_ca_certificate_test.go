@@ -105,7 +105,7 @@ func TestCalculateRequestedValidityFromCertificate(t *testing.T) {
 }
 
 func testAccCheckCloudflareOriginCACertificateDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_origin_ca_certificate" {
@@ -132,7 +132,7 @@ func testAccCheckCloudflareOriginCACertificateExists(name string, cert *cloudfla
 			return fmt.Errorf("No Origin CA Certificate ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundOriginCACertificate, err := client.OriginCertificate(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
@@ -205,3 +205,26 @@ resource "cloudflare_origin_ca_certificate" "%[1]s" {
 }
 `, name, zoneName, csr)
 }
+
+func TestOriginCACertificateNeedsRenewal(t *testing.T) {
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		expiresOn        time.Time
+		minDaysRemaining int
+		expected         bool
+	}{
+		"outside window": {expiresOn: now.AddDate(0, 0, 60), minDaysRemaining: 30, expected: false},
+		"on boundary":    {expiresOn: now.AddDate(0, 0, 30), minDaysRemaining: 30, expected: false},
+		"inside window":  {expiresOn: now.AddDate(0, 0, 29), minDaysRemaining: 30, expected: true},
+		"expired":        {expiresOn: now.AddDate(0, 0, -1), minDaysRemaining: 30, expected: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := originCACertificateNeedsRenewal(tc.expiresOn, tc.minDaysRemaining, now); got != tc.expected {
+				t.Fatalf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type pageShieldPolicy struct {
+	ID          string `json:"id,omitempty"`
+	Action      string `json:"action"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	Expression  string `json:"expression"`
+	Value       string `json:"value"`
+}
+
+func resourceCloudflarePageShieldPolicy() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflarePageShieldPolicySchema(),
+		CreateContext: resourceCloudflarePageShieldPolicyCreate,
+		ReadContext:   resourceCloudflarePageShieldPolicyRead,
+		UpdateContext: resourceCloudflarePageShieldPolicyUpdate,
+		DeleteContext: resourceCloudflarePageShieldPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflarePageShieldPolicyImport,
+		},
+		Description: "Provides a resource to manage Page Shield policies, which apply Content Security Policy directives to the pages matching an expression.",
+	}
+}
+
+func resourceCloudflarePageShieldPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	policy := buildPageShieldPolicy(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Page Shield policy from struct: %+v", policy))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/page_shield/policies", zoneID), policy)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Page Shield policy: %w", err))
+	}
+
+	var created pageShieldPolicy
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Page Shield policy: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflarePageShieldPolicyRead(ctx, d, meta)
+}
+
+func resourceCloudflarePageShieldPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, pageShieldPolicyURI(zoneID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Page Shield policy %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error fetching Page Shield policy %q: %w", d.Id(), err))
+	}
+
+	var policy pageShieldPolicy
+	if err := json.Unmarshal(res, &policy); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Page Shield policy %q: %w", d.Id(), err))
+	}
+
+	d.Set("action", policy.Action)
+	d.Set("description", policy.Description)
+	d.Set("enabled", policy.Enabled)
+	d.Set("expression", policy.Expression)
+	d.Set("value", policy.Value)
+
+	return nil
+}
+
+func resourceCloudflarePageShieldPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	policy := buildPageShieldPolicy(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Page Shield policy %s from struct: %+v", d.Id(), policy))
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, pageShieldPolicyURI(zoneID, d.Id()), policy); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Page Shield policy %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflarePageShieldPolicyRead(ctx, d, meta)
+}
+
+func resourceCloudflarePageShieldPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodDelete, pageShieldPolicyURI(zoneID, d.Id()), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Page Shield policy %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflarePageShieldPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/policyID"`, d.Id())
+	}
+
+	zoneID, policyID := attributes[0], attributes[1]
+
+	d.SetId(policyID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflarePageShieldPolicyRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildPageShieldPolicy(d *schema.ResourceData) pageShieldPolicy {
+	return pageShieldPolicy{
+		Action:      d.Get("action").(string),
+		Description: d.Get("description").(string),
+		Enabled:     d.Get("enabled").(bool),
+		Expression:  d.Get("expression").(string),
+		Value:       d.Get("value").(string),
+	}
+}
+
+func pageShieldPolicyURI(zoneID, policyID string) string {
+	return fmt.Sprintf("/zones/%s/page_shield/policies/%s", zoneID, policyID)
+}
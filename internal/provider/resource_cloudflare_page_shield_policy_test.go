@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflarePageShieldPolicy_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_page_shield_policy.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflarePageShieldPolicyConfig(rnd, zoneID, "log", "script-src 'self'"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "action", "log"),
+					resource.TestCheckResourceAttr(name, "description", rnd),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "expression", fmt.Sprintf(`ends_with(http.request.uri.path, "/%s")`, rnd)),
+					resource.TestCheckResourceAttr(name, "value", "script-src 'self'"),
+				),
+			},
+			{
+				Config: testAccCloudflarePageShieldPolicyConfig(rnd, zoneID, "allow", "script-src 'self' https://cdnjs.cloudflare.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "action", "allow"),
+					resource.TestCheckResourceAttr(name, "value", "script-src 'self' https://cdnjs.cloudflare.com"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func testAccCloudflarePageShieldPolicyConfig(rnd, zoneID, action, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_page_shield_policy" "%[1]s" {
+  zone_id     = "%[2]s"
+  action      = "%[3]s"
+  description = "%[1]s"
+  expression  = "ends_with(http.request.uri.path, \"/%[1]s\")"
+  value       = "%[4]s"
+}
+`, rnd, zoneID, action, value)
+}
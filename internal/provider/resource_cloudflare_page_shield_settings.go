@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type pageShieldSettings struct {
+	Enabled                        bool   `json:"enabled"`
+	UseCloudflareReportingEndpoint bool   `json:"use_cloudflare_reporting_endpoint"`
+	UseConnectionURLPath           bool   `json:"use_connection_url_path"`
+	UpdatedAt                      string `json:"updated_at,omitempty"`
+}
+
+func resourceCloudflarePageShieldSettings() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflarePageShieldSettingsSchema(),
+		CreateContext: resourceCloudflarePageShieldSettingsCreate,
+		ReadContext:   resourceCloudflarePageShieldSettingsRead,
+		UpdateContext: resourceCloudflarePageShieldSettingsUpdate,
+		DeleteContext: resourceCloudflarePageShieldSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflarePageShieldSettingsImport,
+		},
+		Description: "Provides a resource to manage the Page Shield settings of a zone. Deleting the resource disables Page Shield.",
+	}
+}
+
+func resourceCloudflarePageShieldSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+	return resourceCloudflarePageShieldSettingsUpdate(ctx, d, meta)
+}
+
+func resourceCloudflarePageShieldSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, pageShieldURI(zoneID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Page Shield settings for zone %q: %w", zoneID, err))
+	}
+
+	var settings pageShieldSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Page Shield settings: %w", err))
+	}
+
+	d.Set("enabled", settings.Enabled)
+	d.Set("use_cloudflare_reporting_endpoint", settings.UseCloudflareReportingEndpoint)
+	d.Set("use_connection_url_path", settings.UseConnectionURLPath)
+	d.Set("updated_at", settings.UpdatedAt)
+
+	return nil
+}
+
+func resourceCloudflarePageShieldSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	settings := pageShieldSettings{
+		Enabled:                        d.Get("enabled").(bool),
+		UseCloudflareReportingEndpoint: d.Get("use_cloudflare_reporting_endpoint").(bool),
+		UseConnectionURLPath:           d.Get("use_connection_url_path").(bool),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Page Shield settings for zone %s: %+v", zoneID, settings))
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, pageShieldURI(zoneID), settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Page Shield settings for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflarePageShieldSettingsRead(ctx, d, meta)
+}
+
+func resourceCloudflarePageShieldSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Disabling Page Shield for zone %s", zoneID))
+
+	settings := pageShieldSettings{UseCloudflareReportingEndpoint: true}
+	if _, err := rawRequest(ctx, client, http.MethodPut, pageShieldURI(zoneID), settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling Page Shield for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflarePageShieldSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflarePageShieldSettingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func pageShieldURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/page_shield", zoneID)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflarePageShieldSettings_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_page_shield_settings.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflarePageShieldSettingsConfig(rnd, zoneID, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "use_cloudflare_reporting_endpoint", "true"),
+					resource.TestCheckResourceAttr(name, "use_connection_url_path", "false"),
+				),
+			},
+			{
+				Config: testAccCloudflarePageShieldSettingsConfig(rnd, zoneID, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+					resource.TestCheckResourceAttr(name, "use_connection_url_path", "true"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
+		},
+	})
+}
+
+func testAccCloudflarePageShieldSettingsConfig(rnd, zoneID string, enabled, useConnectionURLPath bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_page_shield_settings" "%[1]s" {
+  zone_id                 = "%[2]s"
+  enabled                 = %[3]t
+  use_connection_url_path = %[4]t
+}
+`, rnd, zoneID, enabled, useConnectionURLPath)
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type pagesDomain struct {
+	ID               string                  `json:"id,omitempty"`
+	Name             string                  `json:"name"`
+	Status           string                  `json:"status,omitempty"`
+	ValidationData   *pagesDomainStatusField `json:"validation_data,omitempty"`
+	VerificationData *pagesDomainStatusField `json:"verification_data,omitempty"`
+}
+
+type pagesDomainStatusField struct {
+	Status       string `json:"status"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+func resourceCloudflarePagesDomain() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflarePagesDomainSchema(),
+		CreateContext: resourceCloudflarePagesDomainCreate,
+		ReadContext:   resourceCloudflarePagesDomainRead,
+		DeleteContext: resourceCloudflarePagesDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflarePagesDomainImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Description: "Provides a resource for managing Cloudflare Pages domains.",
+	}
+}
+
+func resourceCloudflarePagesDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	projectName := d.Get("project_name").(string)
+	domain := d.Get("domain").(string)
+
+	_, err := pagesDomainRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/pages/projects/%s/domains", accountID, projectName), pagesDomain{Name: domain})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Pages domain %q for project %q: %w", domain, projectName, err))
+	}
+
+	d.SetId(domain)
+
+	if d.Get("wait_for_active_status").(bool) {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *resource.RetryError {
+			pd, err := pagesDomainRequest(ctx, client, http.MethodGet, pagesDomainURI(accountID, projectName, domain), nil)
+			if err != nil {
+				return resource.NonRetryableError(fmt.Errorf("failed to fetch Pages domain: %w", err))
+			}
+
+			switch pd.Status {
+			case "active":
+				return nil
+			case "blocked", "error":
+				return resource.NonRetryableError(fmt.Errorf("Pages domain %q entered %s state: %s", domain, pd.Status, pagesDomainErrorMessage(pd)))
+			default:
+				return resource.RetryableError(fmt.Errorf("expected Pages domain %q to be active but was in state %s", domain, pd.Status))
+			}
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflarePagesDomainRead(ctx, d, meta)
+}
+
+func resourceCloudflarePagesDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	projectName := d.Get("project_name").(string)
+
+	pd, err := pagesDomainRequest(ctx, client, http.MethodGet, pagesDomainURI(accountID, projectName, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Pages domain %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Pages domain %q: %w", d.Id(), err))
+	}
+
+	d.Set("domain", pd.Name)
+	d.Set("status", pd.Status)
+
+	if pd.ValidationData != nil {
+		d.Set("validation_status", pd.ValidationData.Status)
+	}
+
+	if pd.VerificationData != nil {
+		d.Set("verification_status", pd.VerificationData.Status)
+	}
+
+	return nil
+}
+
+func resourceCloudflarePagesDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	projectName := d.Get("project_name").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, pagesDomainURI(accountID, projectName, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Pages domain %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflarePagesDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/projectName/domain"`, d.Id())
+	}
+
+	accountID, projectName, domain := attributes[0], attributes[1], attributes[2]
+
+	d.SetId(domain)
+	d.Set("account_id", accountID)
+	d.Set("project_name", projectName)
+	d.Set("wait_for_active_status", false)
+
+	resourceCloudflarePagesDomainRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func pagesDomainURI(accountID, projectName, domain string) string {
+	return fmt.Sprintf("/accounts/%s/pages/projects/%s/domains/%s", accountID, projectName, domain)
+}
+
+func pagesDomainRequest(ctx context.Context, client *apiClient, method, uri string, body interface{}) (pagesDomain, error) {
+	var pd pagesDomain
+
+	res, err := rawRequest(ctx, client, method, uri, body)
+	if err != nil {
+		return pd, err
+	}
+
+	if err := json.Unmarshal(res, &pd); err != nil {
+		return pd, fmt.Errorf("error unmarshalling Pages domain: %w", err)
+	}
+
+	return pd, nil
+}
+
+func pagesDomainErrorMessage(pd pagesDomain) string {
+	messages := []string{}
+	for _, field := range []*pagesDomainStatusField{pd.ValidationData, pd.VerificationData} {
+		if field != nil && field.ErrorMessage != "" {
+			messages = append(messages, field.ErrorMessage)
+		}
+	}
+
+	if len(messages) == 0 {
+		return "no error message returned"
+	}
+
+	return strings.Join(messages, ", ")
+}
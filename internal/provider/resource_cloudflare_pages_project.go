@@ -0,0 +1,523 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var pagesProjectSourceTypes = []string{"github", "gitlab"}
+
+var pagesProjectPreviewDeploymentSettings = []string{"all", "none", "custom"}
+
+const (
+	pagesProjectEnvVarTypePlainText  = "plain_text"
+	pagesProjectEnvVarTypeSecretText = "secret_text"
+)
+
+type pagesProject struct {
+	ID                string                         `json:"id,omitempty"`
+	Name              string                         `json:"name,omitempty"`
+	SubDomain         string                         `json:"subdomain,omitempty"`
+	Domains           []string                       `json:"domains,omitempty"`
+	CreatedOn         *time.Time                     `json:"created_on,omitempty"`
+	ProductionBranch  string                         `json:"production_branch,omitempty"`
+	Source            *pagesProjectSource            `json:"source,omitempty"`
+	BuildConfig       *pagesProjectBuildConfig       `json:"build_config,omitempty"`
+	DeploymentConfigs *pagesProjectDeploymentConfigs `json:"deployment_configs,omitempty"`
+}
+
+type pagesProjectSource struct {
+	Type   string                    `json:"type"`
+	Config *pagesProjectSourceConfig `json:"config"`
+}
+
+type pagesProjectSourceConfig struct {
+	Owner                        string   `json:"owner"`
+	RepoName                     string   `json:"repo_name"`
+	ProductionBranch             string   `json:"production_branch"`
+	PRCommentsEnabled            bool     `json:"pr_comments_enabled"`
+	DeploymentsEnabled           bool     `json:"deployments_enabled"`
+	ProductionDeploymentsEnabled bool     `json:"production_deployments_enabled"`
+	PreviewDeploymentSetting     string   `json:"preview_deployment_setting,omitempty"`
+	PreviewBranchIncludes        []string `json:"preview_branch_includes"`
+	PreviewBranchExcludes        []string `json:"preview_branch_excludes"`
+}
+
+type pagesProjectBuildConfig struct {
+	BuildCommand      string `json:"build_command"`
+	DestinationDir    string `json:"destination_dir"`
+	RootDir           string `json:"root_dir"`
+	WebAnalyticsTag   string `json:"web_analytics_tag"`
+	WebAnalyticsToken string `json:"web_analytics_token"`
+}
+
+type pagesProjectDeploymentConfigs struct {
+	Preview    *pagesProjectDeploymentConfigEnvironment `json:"preview,omitempty"`
+	Production *pagesProjectDeploymentConfigEnvironment `json:"production,omitempty"`
+}
+
+// pagesProjectDeploymentConfigEnvironment uses maps of pointers as the API
+// expects removed variables and bindings to be sent as explicit nulls.
+type pagesProjectDeploymentConfigEnvironment struct {
+	EnvVars                 map[string]*pagesProjectEnvVar           `json:"env_vars,omitempty"`
+	KVNamespaces            map[string]*pagesProjectNamespaceBinding `json:"kv_namespaces,omitempty"`
+	DurableObjectNamespaces map[string]*pagesProjectNamespaceBinding `json:"durable_object_namespaces,omitempty"`
+	D1Databases             map[string]*pagesProjectD1Binding        `json:"d1_databases,omitempty"`
+	R2Buckets               map[string]*pagesProjectR2Binding        `json:"r2_buckets,omitempty"`
+	CompatibilityDate       string                                   `json:"compatibility_date,omitempty"`
+	CompatibilityFlags      []string                                 `json:"compatibility_flags"`
+}
+
+type pagesProjectEnvVar struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"`
+}
+
+type pagesProjectNamespaceBinding struct {
+	NamespaceID string `json:"namespace_id"`
+}
+
+type pagesProjectD1Binding struct {
+	ID string `json:"id"`
+}
+
+type pagesProjectR2Binding struct {
+	Name string `json:"name"`
+}
+
+func resourceCloudflarePagesProject() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflarePagesProjectSchema(),
+		CreateContext: resourceCloudflarePagesProjectCreate,
+		ReadContext:   resourceCloudflarePagesProjectRead,
+		UpdateContext: resourceCloudflarePagesProjectUpdate,
+		DeleteContext: resourceCloudflarePagesProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflarePagesProjectImport,
+		},
+		CustomizeDiff: resourceCloudflarePagesProjectDiff,
+		Description:   "Provides a resource which manages Cloudflare Pages projects.",
+	}
+}
+
+func resourceCloudflarePagesProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	project := buildPagesProject(d)
+	project.Name = name
+
+	_, err := pagesProjectRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/pages/projects", accountID), project)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Pages project %q: %w", name, err))
+	}
+
+	d.SetId(name)
+
+	return resourceCloudflarePagesProjectRead(ctx, d, meta)
+}
+
+func resourceCloudflarePagesProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	project, err := pagesProjectRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/pages/projects/%s", accountID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Pages project %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Pages project %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", project.Name)
+	d.Set("production_branch", project.ProductionBranch)
+	d.Set("subdomain", project.SubDomain)
+	d.Set("domains", project.Domains)
+
+	if project.CreatedOn != nil {
+		d.Set("created_on", project.CreatedOn.Format(time.RFC3339))
+	}
+
+	if err := d.Set("build_config", flattenPagesProjectBuildConfig(project.BuildConfig, d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting build_config: %w", err))
+	}
+
+	if err := d.Set("source", flattenPagesProjectSource(project.Source)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting source: %w", err))
+	}
+
+	if err := d.Set("deployment_configs", flattenPagesProjectDeploymentConfigs(project.DeploymentConfigs, d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting deployment_configs: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflarePagesProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	project := buildPagesProject(d)
+
+	_, err := pagesProjectRequest(ctx, client, http.MethodPatch, fmt.Sprintf("/accounts/%s/pages/projects/%s", accountID, d.Id()), project)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Pages project %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflarePagesProjectRead(ctx, d, meta)
+}
+
+func resourceCloudflarePagesProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, fmt.Sprintf("/accounts/%s/pages/projects/%s", accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Pages project %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflarePagesProjectImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/projectName"`, d.Id())
+	}
+
+	accountID, projectName := attributes[0], attributes[1]
+
+	d.SetId(projectName)
+	d.Set("account_id", accountID)
+
+	resourceCloudflarePagesProjectRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflarePagesProjectDiff rejects source configurations the API
+// would silently ignore or overwrite.
+func resourceCloudflarePagesProjectDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	sources, ok := d.Get("source").([]interface{})
+	if !ok || len(sources) == 0 || sources[0] == nil {
+		return nil
+	}
+
+	configs, ok := sources[0].(map[string]interface{})["config"].([]interface{})
+	if !ok || len(configs) == 0 || configs[0] == nil {
+		return nil
+	}
+
+	return validatePagesProjectSourceConfig(configs[0].(map[string]interface{}), d.Get("production_branch").(string))
+}
+
+// validatePagesProjectSourceConfig ensures preview branch filters are only
+// set with the custom preview deployment setting, which is the only one that
+// uses them, and that the repository production branch matches the project's
+// as the API keeps the two in sync.
+func validatePagesProjectSourceConfig(cfg map[string]interface{}, productionBranch string) error {
+	setting := cfg["preview_deployment_setting"].(string)
+	includes := expandInterfaceToStringList(cfg["preview_branch_includes"])
+	excludes := expandInterfaceToStringList(cfg["preview_branch_excludes"])
+
+	if setting != "custom" && (len(includes) > 0 || len(excludes) > 0) {
+		return fmt.Errorf("preview_branch_includes and preview_branch_excludes require preview_deployment_setting to be \"custom\", got %q", setting)
+	}
+
+	if branch := cfg["production_branch"].(string); branch != "" && productionBranch != "" && branch != productionBranch {
+		return fmt.Errorf("source production_branch %q must match the project production_branch %q", branch, productionBranch)
+	}
+
+	return nil
+}
+
+func pagesProjectRequest(ctx context.Context, client *apiClient, method, uri string, body interface{}) (pagesProject, error) {
+	var project pagesProject
+
+	res, err := rawRequest(ctx, client, method, uri, body)
+	if err != nil {
+		return project, err
+	}
+
+	if err := json.Unmarshal(res, &project); err != nil {
+		return project, fmt.Errorf("error unmarshalling Pages project: %w", err)
+	}
+
+	return project, nil
+}
+
+func buildPagesProject(d *schema.ResourceData) pagesProject {
+	project := pagesProject{
+		ProductionBranch: d.Get("production_branch").(string),
+		BuildConfig:      &pagesProjectBuildConfig{},
+	}
+
+	if v, ok := d.GetOk("build_config"); ok {
+		cfg := v.([]interface{})[0].(map[string]interface{})
+		project.BuildConfig = &pagesProjectBuildConfig{
+			BuildCommand:      cfg["build_command"].(string),
+			DestinationDir:    cfg["destination_dir"].(string),
+			RootDir:           cfg["root_dir"].(string),
+			WebAnalyticsTag:   cfg["web_analytics_tag"].(string),
+			WebAnalyticsToken: cfg["web_analytics_token"].(string),
+		}
+	}
+
+	if v, ok := d.GetOk("source"); ok {
+		source := v.([]interface{})[0].(map[string]interface{})
+		project.Source = &pagesProjectSource{Type: source["type"].(string)}
+
+		if cfgs := source["config"].([]interface{}); len(cfgs) > 0 {
+			cfg := cfgs[0].(map[string]interface{})
+			project.Source.Config = &pagesProjectSourceConfig{
+				Owner:                        cfg["owner"].(string),
+				RepoName:                     cfg["repo_name"].(string),
+				ProductionBranch:             cfg["production_branch"].(string),
+				PRCommentsEnabled:            cfg["pr_comments_enabled"].(bool),
+				DeploymentsEnabled:           cfg["deployments_enabled"].(bool),
+				ProductionDeploymentsEnabled: cfg["production_deployment_enabled"].(bool),
+				PreviewDeploymentSetting:     cfg["preview_deployment_setting"].(string),
+				PreviewBranchIncludes:        expandInterfaceToStringList(cfg["preview_branch_includes"]),
+				PreviewBranchExcludes:        expandInterfaceToStringList(cfg["preview_branch_excludes"]),
+			}
+		}
+	}
+
+	oldConfigs, newConfigs := d.GetChange("deployment_configs")
+	project.DeploymentConfigs = &pagesProjectDeploymentConfigs{
+		Preview:    expandPagesProjectDeploymentConfigEnvironment(pagesProjectEnvironmentConfig(newConfigs, "preview"), pagesProjectEnvironmentConfig(oldConfigs, "preview")),
+		Production: expandPagesProjectDeploymentConfigEnvironment(pagesProjectEnvironmentConfig(newConfigs, "production"), pagesProjectEnvironmentConfig(oldConfigs, "production")),
+	}
+
+	return project
+}
+
+// pagesProjectEnvironmentConfig returns the configuration map for the named
+// deployment environment or an empty map if it has not been configured.
+func pagesProjectEnvironmentConfig(configs interface{}, environment string) map[string]interface{} {
+	list, ok := configs.([]interface{})
+	if !ok || len(list) == 0 || list[0] == nil {
+		return map[string]interface{}{}
+	}
+
+	env, ok := list[0].(map[string]interface{})[environment].([]interface{})
+	if !ok || len(env) == 0 || env[0] == nil {
+		return map[string]interface{}{}
+	}
+
+	return env[0].(map[string]interface{})
+}
+
+// expandPagesProjectDeploymentConfigEnvironment builds the API payload for a
+// deployment environment. Keys which were previously set but have since been
+// removed from the configuration are sent as nulls to remove them remotely.
+func expandPagesProjectDeploymentConfigEnvironment(cfg, old map[string]interface{}) *pagesProjectDeploymentConfigEnvironment {
+	env := &pagesProjectDeploymentConfigEnvironment{
+		EnvVars:                 map[string]*pagesProjectEnvVar{},
+		KVNamespaces:            map[string]*pagesProjectNamespaceBinding{},
+		DurableObjectNamespaces: map[string]*pagesProjectNamespaceBinding{},
+		D1Databases:             map[string]*pagesProjectD1Binding{},
+		R2Buckets:               map[string]*pagesProjectR2Binding{},
+		CompatibilityFlags:      []string{},
+	}
+
+	for _, key := range []string{"environment_variables", "secrets"} {
+		for name := range pagesProjectStringMap(old[key]) {
+			env.EnvVars[name] = nil
+		}
+	}
+	for name, value := range pagesProjectStringMap(cfg["environment_variables"]) {
+		env.EnvVars[name] = &pagesProjectEnvVar{Type: pagesProjectEnvVarTypePlainText, Value: value}
+	}
+	for name, value := range pagesProjectStringMap(cfg["secrets"]) {
+		env.EnvVars[name] = &pagesProjectEnvVar{Type: pagesProjectEnvVarTypeSecretText, Value: value}
+	}
+
+	for name := range pagesProjectStringMap(old["kv_namespaces"]) {
+		env.KVNamespaces[name] = nil
+	}
+	for name, id := range pagesProjectStringMap(cfg["kv_namespaces"]) {
+		env.KVNamespaces[name] = &pagesProjectNamespaceBinding{NamespaceID: id}
+	}
+
+	for name := range pagesProjectStringMap(old["durable_object_namespaces"]) {
+		env.DurableObjectNamespaces[name] = nil
+	}
+	for name, id := range pagesProjectStringMap(cfg["durable_object_namespaces"]) {
+		env.DurableObjectNamespaces[name] = &pagesProjectNamespaceBinding{NamespaceID: id}
+	}
+
+	for name := range pagesProjectStringMap(old["d1_databases"]) {
+		env.D1Databases[name] = nil
+	}
+	for name, id := range pagesProjectStringMap(cfg["d1_databases"]) {
+		env.D1Databases[name] = &pagesProjectD1Binding{ID: id}
+	}
+
+	for name := range pagesProjectStringMap(old["r2_buckets"]) {
+		env.R2Buckets[name] = nil
+	}
+	for name, bucket := range pagesProjectStringMap(cfg["r2_buckets"]) {
+		env.R2Buckets[name] = &pagesProjectR2Binding{Name: bucket}
+	}
+
+	if v, ok := cfg["compatibility_date"].(string); ok {
+		env.CompatibilityDate = v
+	}
+
+	if v, ok := cfg["compatibility_flags"]; ok {
+		env.CompatibilityFlags = expandInterfaceToStringList(v)
+	}
+
+	return env
+}
+
+func pagesProjectStringMap(v interface{}) map[string]string {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return map[string]string{}
+	}
+
+	out := make(map[string]string, len(m))
+	for k, val := range m {
+		out[k] = val.(string)
+	}
+
+	return out
+}
+
+func flattenPagesProjectBuildConfig(cfg *pagesProjectBuildConfig, d *schema.ResourceData) []interface{} {
+	if cfg == nil || (*cfg == pagesProjectBuildConfig{}) {
+		return []interface{}{}
+	}
+
+	// The analytics token is not always returned by the API so the value
+	// from state is preserved where it is missing.
+	token := cfg.WebAnalyticsToken
+	if token == "" {
+		token = d.Get("build_config.0.web_analytics_token").(string)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"build_command":       cfg.BuildCommand,
+		"destination_dir":     cfg.DestinationDir,
+		"root_dir":            cfg.RootDir,
+		"web_analytics_tag":   cfg.WebAnalyticsTag,
+		"web_analytics_token": token,
+	}}
+}
+
+func flattenPagesProjectSource(source *pagesProjectSource) []interface{} {
+	if source == nil || source.Type == "" {
+		return []interface{}{}
+	}
+
+	config := []interface{}{}
+	if source.Config != nil {
+		config = append(config, map[string]interface{}{
+			"owner":                         source.Config.Owner,
+			"repo_name":                     source.Config.RepoName,
+			"production_branch":             source.Config.ProductionBranch,
+			"pr_comments_enabled":           source.Config.PRCommentsEnabled,
+			"deployments_enabled":           source.Config.DeploymentsEnabled,
+			"production_deployment_enabled": source.Config.ProductionDeploymentsEnabled,
+			"preview_deployment_setting":    source.Config.PreviewDeploymentSetting,
+			"preview_branch_includes":       flattenStringList(source.Config.PreviewBranchIncludes),
+			"preview_branch_excludes":       flattenStringList(source.Config.PreviewBranchExcludes),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"type":   source.Type,
+		"config": config,
+	}}
+}
+
+func flattenPagesProjectDeploymentConfigs(configs *pagesProjectDeploymentConfigs, d *schema.ResourceData) []interface{} {
+	if configs == nil {
+		return []interface{}{}
+	}
+
+	current := d.Get("deployment_configs")
+
+	return []interface{}{map[string]interface{}{
+		"preview":    flattenPagesProjectDeploymentConfigEnvironment(configs.Preview, pagesProjectEnvironmentConfig(current, "preview")),
+		"production": flattenPagesProjectDeploymentConfigEnvironment(configs.Production, pagesProjectEnvironmentConfig(current, "production")),
+	}}
+}
+
+// flattenPagesProjectDeploymentConfigEnvironment converts the API response
+// into state. Secret values are never returned by the API so the values
+// already held in state are retained for any secret which still exists.
+func flattenPagesProjectDeploymentConfigEnvironment(env *pagesProjectDeploymentConfigEnvironment, current map[string]interface{}) []interface{} {
+	if env == nil {
+		return []interface{}{}
+	}
+
+	currentSecrets := pagesProjectStringMap(current["secrets"])
+	envVars := map[string]interface{}{}
+	secrets := map[string]interface{}{}
+	for name, v := range env.EnvVars {
+		if v == nil {
+			continue
+		}
+
+		if v.Type == pagesProjectEnvVarTypeSecretText {
+			secrets[name] = currentSecrets[name]
+			continue
+		}
+
+		envVars[name] = v.Value
+	}
+
+	kvNamespaces := map[string]interface{}{}
+	for name, v := range env.KVNamespaces {
+		if v != nil {
+			kvNamespaces[name] = v.NamespaceID
+		}
+	}
+
+	durableObjectNamespaces := map[string]interface{}{}
+	for name, v := range env.DurableObjectNamespaces {
+		if v != nil {
+			durableObjectNamespaces[name] = v.NamespaceID
+		}
+	}
+
+	d1Databases := map[string]interface{}{}
+	for name, v := range env.D1Databases {
+		if v != nil {
+			d1Databases[name] = v.ID
+		}
+	}
+
+	r2Buckets := map[string]interface{}{}
+	for name, v := range env.R2Buckets {
+		if v != nil {
+			r2Buckets[name] = v.Name
+		}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"environment_variables":     envVars,
+		"secrets":                   secrets,
+		"kv_namespaces":             kvNamespaces,
+		"durable_object_namespaces": durableObjectNamespaces,
+		"d1_databases":              d1Databases,
+		"r2_buckets":                r2Buckets,
+		"compatibility_date":        env.CompatibilityDate,
+		"compatibility_flags":       flattenStringList(env.CompatibilityFlags),
+	}}
+}
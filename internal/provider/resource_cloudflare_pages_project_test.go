@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("cloudflare_pages_project", &resource.Sweeper{
+		Name: "cloudflare_pages_project",
+		F:    testSweepCloudflarePagesProjects,
+	})
+}
+
+func testSweepCloudflarePagesProjects(r string) error {
+	client, err := sharedClient()
+	if err != nil {
+		return err
+	}
+
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	projects, _, err := client.ListPagesProjects(context.Background(), accountID, cloudflare.PaginationOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to fetch Pages projects: %w", err)
+	}
+
+	for _, project := range projects {
+		//nolint:errcheck
+		client.DeletePagesProject(context.Background(), accountID, project.Name)
+	}
+
+	return nil
+}
+
+func TestExpandPagesProjectDeploymentConfigEnvironment(t *testing.T) {
+	old := map[string]interface{}{
+		"environment_variables": map[string]interface{}{"KEEP": "a", "REMOVE": "b"},
+		"secrets":               map[string]interface{}{"OLD_SECRET": "c"},
+		"kv_namespaces":         map[string]interface{}{"OLD_KV": "1234"},
+	}
+	cfg := map[string]interface{}{
+		"environment_variables": map[string]interface{}{"KEEP": "updated"},
+		"secrets":               map[string]interface{}{"NEW_SECRET": "d"},
+		"kv_namespaces":         map[string]interface{}{"KV": "5678"},
+		"compatibility_date":    "2022-08-15",
+		"compatibility_flags":   []interface{}{"nodejs_compat"},
+	}
+
+	env := expandPagesProjectDeploymentConfigEnvironment(cfg, old)
+
+	if v := env.EnvVars["KEEP"]; v == nil || v.Value != "updated" || v.Type != pagesProjectEnvVarTypePlainText {
+		t.Fatalf("expected KEEP to be updated plain text variable, got %+v", v)
+	}
+
+	if v := env.EnvVars["NEW_SECRET"]; v == nil || v.Type != pagesProjectEnvVarTypeSecretText {
+		t.Fatalf("expected NEW_SECRET to be a secret, got %+v", v)
+	}
+
+	for _, removed := range []string{"REMOVE", "OLD_SECRET"} {
+		if v, ok := env.EnvVars[removed]; !ok || v != nil {
+			t.Fatalf("expected %s to be sent as null, got %+v", removed, v)
+		}
+	}
+
+	if v, ok := env.KVNamespaces["OLD_KV"]; !ok || v != nil {
+		t.Fatalf("expected OLD_KV binding to be sent as null, got %+v", v)
+	}
+
+	if v := env.KVNamespaces["KV"]; v == nil || v.NamespaceID != "5678" {
+		t.Fatalf("expected KV binding to namespace 5678, got %+v", v)
+	}
+
+	if env.CompatibilityDate != "2022-08-15" || len(env.CompatibilityFlags) != 1 {
+		t.Fatalf("unexpected compatibility settings: %q %v", env.CompatibilityDate, env.CompatibilityFlags)
+	}
+}
+
+func TestValidatePagesProjectSourceConfig(t *testing.T) {
+	config := func(setting string, includes []interface{}, branch string) map[string]interface{} {
+		return map[string]interface{}{
+			"preview_deployment_setting": setting,
+			"preview_branch_includes":    includes,
+			"preview_branch_excludes":    []interface{}{},
+			"production_branch":          branch,
+		}
+	}
+
+	if err := validatePagesProjectSourceConfig(config("custom", []interface{}{"dev"}, "main"), "main"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := validatePagesProjectSourceConfig(config("all", nil, "main"), "main"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := validatePagesProjectSourceConfig(config("all", []interface{}{"dev"}, "main"), "main"); err == nil {
+		t.Fatal("expected an error for branch includes without the custom setting")
+	}
+
+	if err := validatePagesProjectSourceConfig(config("none", nil, "main"), "production"); err == nil {
+		t.Fatal("expected an error for mismatched production branches")
+	}
+}
+
+func TestAccCloudflarePagesProject_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_pages_project.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflarePagesProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflarePagesProjectConfig(rnd, accountID, "main"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "production_branch", "main"),
+					resource.TestCheckResourceAttr(name, "subdomain", fmt.Sprintf("%s.pages.dev", rnd)),
+					resource.TestCheckResourceAttr(name, "build_config.0.build_command", "npm run build"),
+					resource.TestCheckResourceAttr(name, "build_config.0.destination_dir", "build"),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.environment_variables.ENVIRONMENT", "preview"),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.environment_variables.ENVIRONMENT", "production"),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.compatibility_date", "2022-08-15"),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.compatibility_flags.0", "nodejs_compat"),
+				),
+			},
+			{
+				Config: testAccCloudflarePagesProjectConfig(rnd, accountID, "production"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "production_branch", "production"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", accountID),
+				ImportStateVerifyIgnore: []string{"deployment_configs.0.production.0.secrets"},
+			},
+		},
+	})
+}
+
+func TestAccCloudflarePagesProject_Source(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_pages_project.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	owner := os.Getenv("CLOUDFLARE_PAGES_OWNER")
+	repo := os.Getenv("CLOUDFLARE_PAGES_REPO")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckPagesRepository(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflarePagesProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflarePagesProjectSourceConfig(rnd, accountID, owner, repo, "all", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "source.0.type", "github"),
+					resource.TestCheckResourceAttr(name, "source.0.config.0.owner", owner),
+					resource.TestCheckResourceAttr(name, "source.0.config.0.repo_name", repo),
+					resource.TestCheckResourceAttr(name, "source.0.config.0.production_deployment_enabled", "true"),
+					resource.TestCheckResourceAttr(name, "source.0.config.0.preview_deployment_setting", "all"),
+				),
+			},
+			{
+				Config:      testAccCloudflarePagesProjectSourceConfig(rnd, accountID, owner, repo, "all", `preview_branch_includes = ["dev"]`),
+				ExpectError: regexp.MustCompile(`require preview_deployment_setting to be "custom"`),
+			},
+			{
+				Config: testAccCloudflarePagesProjectSourceConfig(rnd, accountID, owner, repo, "custom", `preview_branch_includes = ["dev"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "source.0.config.0.preview_deployment_setting", "custom"),
+					resource.TestCheckResourceAttr(name, "source.0.config.0.preview_branch_includes.0", "dev"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflarePagesProjectSourceConfig(rnd, accountID, owner, repo, previewSetting, extra string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_pages_project" "%[1]s" {
+  account_id        = "%[2]s"
+  name              = "%[1]s"
+  production_branch = "main"
+
+  source {
+    type = "github"
+    config {
+      owner                      = "%[3]s"
+      repo_name                  = "%[4]s"
+      production_branch          = "main"
+      preview_deployment_setting = "%[5]s"
+      %[6]s
+    }
+  }
+}
+`, rnd, accountID, owner, repo, previewSetting, extra)
+}
+
+func testAccCheckCloudflarePagesProjectDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_pages_project" {
+			continue
+		}
+
+		_, err := rawRequest(context.Background(), client, http.MethodGet, fmt.Sprintf("/accounts/%s/pages/projects/%s", rs.Primary.Attributes["account_id"], rs.Primary.ID), nil)
+		if err == nil {
+			return fmt.Errorf("Pages project still exists")
+		}
+
+		if !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCloudflarePagesProjectConfig(rnd, accountID, productionBranch string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_pages_project" "%[1]s" {
+  account_id        = "%[2]s"
+  name              = "%[1]s"
+  production_branch = "%[3]s"
+
+  build_config {
+    build_command   = "npm run build"
+    destination_dir = "build"
+    root_dir        = "/"
+  }
+
+  deployment_configs {
+    preview {
+      environment_variables = {
+        ENVIRONMENT = "preview"
+      }
+    }
+
+    production {
+      environment_variables = {
+        ENVIRONMENT = "production"
+      }
+      secrets = {
+        API_KEY = "%[1]s"
+      }
+      compatibility_date  = "2022-08-15"
+      compatibility_flags = ["nodejs_compat"]
+    }
+  }
+}
+`, rnd, accountID, productionBranch)
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type queue struct {
+	ID         string `json:"queue_id,omitempty"`
+	Name       string `json:"queue_name"`
+	CreatedOn  string `json:"created_on,omitempty"`
+	ModifiedOn string `json:"modified_on,omitempty"`
+}
+
+func resourceCloudflareQueue() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareQueueSchema(),
+		CreateContext: resourceCloudflareQueueCreate,
+		ReadContext:   resourceCloudflareQueueRead,
+		UpdateContext: resourceCloudflareQueueUpdate,
+		DeleteContext: resourceCloudflareQueueDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareQueueImport,
+		},
+		Description: "Provides a resource for managing Queues, which deliver messages between Workers.",
+	}
+}
+
+func resourceCloudflareQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	q := queue{Name: d.Get("name").(string)}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Queue from struct: %+v", q))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/queues", accountID), q)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating queue %q: %w", q.Name, err))
+	}
+
+	if err := json.Unmarshal(res, &q); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling queue: %w", err))
+	}
+
+	d.SetId(q.ID)
+
+	return resourceCloudflareQueueRead(ctx, d, meta)
+}
+
+func resourceCloudflareQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	q, err := fetchQueue(ctx, client, accountID, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Queue %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding queue %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", q.Name)
+	d.Set("created_on", q.CreatedOn)
+	d.Set("modified_on", q.ModifiedOn)
+
+	return nil
+}
+
+func resourceCloudflareQueueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	q := queue{Name: d.Get("name").(string)}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Queue %s from struct: %+v", d.Id(), q))
+
+	_, err := rawRequest(ctx, client, http.MethodPut, queueURI(accountID, d.Id()), q)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating queue %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareQueueRead(ctx, d, meta)
+}
+
+func resourceCloudflareQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, queueURI(accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting queue %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareQueueImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/queueID"`, d.Id())
+	}
+
+	accountID, queueID := attributes[0], attributes[1]
+
+	d.SetId(queueID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareQueueRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func fetchQueue(ctx context.Context, client *apiClient, accountID, queueID string) (queue, error) {
+	var q queue
+
+	res, err := rawRequest(ctx, client, http.MethodGet, queueURI(accountID, queueID), nil)
+	if err != nil {
+		return q, err
+	}
+
+	if err := json.Unmarshal(res, &q); err != nil {
+		return q, fmt.Errorf("error unmarshalling queue: %w", err)
+	}
+
+	return q, nil
+}
+
+// listQueues returns every queue in the account.
+func listQueues(ctx context.Context, client *apiClient, accountID string) ([]queue, error) {
+	var queues []queue
+
+	for page := 1; ; page++ {
+		uri := fmt.Sprintf("/accounts/%s/queues?page=%d&per_page=100", accountID, page)
+
+		res, resultInfo, err := rawRequestWithResultInfo(ctx, client, http.MethodGet, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		var results []queue
+		if err := json.Unmarshal(res, &results); err != nil {
+			return nil, fmt.Errorf("error unmarshalling queues: %w", err)
+		}
+		queues = append(queues, results...)
+
+		if len(results) == 0 || page >= resultInfo.TotalPages {
+			return queues, nil
+		}
+	}
+}
+
+func queueURI(accountID, queueID string) string {
+	return fmt.Sprintf("/accounts/%s/queues/%s", accountID, queueID)
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type queueConsumer struct {
+	ID              string                `json:"consumer_id,omitempty"`
+	Script          string                `json:"script,omitempty"`
+	ScriptName      string                `json:"script_name,omitempty"`
+	Type            string                `json:"type,omitempty"`
+	DeadLetterQueue string                `json:"dead_letter_queue,omitempty"`
+	Settings        queueConsumerSettings `json:"settings"`
+	CreatedOn       string                `json:"created_on,omitempty"`
+}
+
+type queueConsumerSettings struct {
+	BatchSize      int  `json:"batch_size"`
+	MaxRetries     int  `json:"max_retries"`
+	MaxWaitTimeMs  int  `json:"max_wait_time_ms"`
+	MaxConcurrency *int `json:"max_concurrency,omitempty"`
+	RetryDelay     int  `json:"retry_delay"`
+}
+
+func resourceCloudflareQueueConsumer() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareQueueConsumerSchema(),
+		CreateContext: resourceCloudflareQueueConsumerCreate,
+		ReadContext:   resourceCloudflareQueueConsumerRead,
+		UpdateContext: resourceCloudflareQueueConsumerUpdate,
+		DeleteContext: resourceCloudflareQueueConsumerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareQueueConsumerImport,
+		},
+		CustomizeDiff: resourceCloudflareQueueConsumerDeadLetterQueueDiff,
+		Description:   "Provides a resource for managing the Worker consumer of a Queue, including its dead letter queue.",
+	}
+}
+
+func resourceCloudflareQueueConsumerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	queueID := d.Get("queue_id").(string)
+
+	consumer, err := buildQueueConsumer(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Queue consumer from struct: %+v", consumer))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("%s/consumers", queueURI(accountID, queueID)), consumer)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating consumer for queue %q: %w", queueID, err))
+	}
+
+	if err := json.Unmarshal(res, &consumer); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling queue consumer: %w", err))
+	}
+
+	d.SetId(consumer.ID)
+
+	return resourceCloudflareQueueConsumerRead(ctx, d, meta)
+}
+
+func resourceCloudflareQueueConsumerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	queueID := d.Get("queue_id").(string)
+
+	consumer, ok, err := fetchQueueConsumer(ctx, client, accountID, queueID, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			ok = false
+		} else {
+			return diag.FromErr(fmt.Errorf("error finding queue consumer %q: %w", d.Id(), err))
+		}
+	}
+
+	if !ok {
+		tflog.Info(ctx, fmt.Sprintf("Queue consumer %s no longer exists", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	deadLetterQueueID := ""
+	if consumer.DeadLetterQueue != "" {
+		queues, err := listQueues(ctx, client, accountID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing queues: %w", err))
+		}
+		for _, q := range queues {
+			if q.Name == consumer.DeadLetterQueue {
+				deadLetterQueueID = q.ID
+				break
+			}
+		}
+	}
+
+	scriptName := consumer.Script
+	if scriptName == "" {
+		scriptName = consumer.ScriptName
+	}
+
+	d.Set("script_name", scriptName)
+	d.Set("dead_letter_queue_id", deadLetterQueueID)
+	d.Set("batch_size", consumer.Settings.BatchSize)
+	d.Set("max_retries", consumer.Settings.MaxRetries)
+	d.Set("max_wait_time_ms", consumer.Settings.MaxWaitTimeMs)
+	d.Set("max_concurrency", consumer.Settings.MaxConcurrency)
+	d.Set("retry_delay", consumer.Settings.RetryDelay)
+	d.Set("created_on", consumer.CreatedOn)
+
+	return nil
+}
+
+func resourceCloudflareQueueConsumerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	queueID := d.Get("queue_id").(string)
+
+	consumer, err := buildQueueConsumer(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Queue consumer %s from struct: %+v", d.Id(), consumer))
+
+	_, err = rawRequest(ctx, client, http.MethodPut, queueConsumerURI(accountID, queueID, d.Id()), consumer)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating queue consumer %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareQueueConsumerRead(ctx, d, meta)
+}
+
+func resourceCloudflareQueueConsumerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	queueID := d.Get("queue_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, queueConsumerURI(accountID, queueID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting queue consumer %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareQueueConsumerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/queueID/consumerID"`, d.Id())
+	}
+
+	accountID, queueID, consumerID := attributes[0], attributes[1], attributes[2]
+
+	d.SetId(consumerID)
+	d.Set("account_id", accountID)
+	d.Set("queue_id", queueID)
+
+	resourceCloudflareQueueConsumerRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareQueueConsumerDeadLetterQueueDiff catches dead letter
+// queue misconfigurations at plan time, as the API only rejects them with a
+// generic error on apply. IDs of queues created in the same plan are unknown
+// and are checked again on apply by buildQueueConsumer.
+func resourceCloudflareQueueConsumerDeadLetterQueueDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	deadLetterQueueID := d.Get("dead_letter_queue_id").(string)
+	if !d.NewValueKnown("dead_letter_queue_id") || deadLetterQueueID == "" {
+		return nil
+	}
+
+	if d.NewValueKnown("queue_id") {
+		if err := validateQueueConsumerDeadLetterQueue(d.Get("queue_id").(string), deadLetterQueueID); err != nil {
+			return err
+		}
+	}
+
+	if !d.HasChange("dead_letter_queue_id") || !d.NewValueKnown("account_id") {
+		return nil
+	}
+
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := fetchQueue(ctx, client, accountID, deadLetterQueueID); err != nil {
+		if isNotFoundError(err) {
+			return fmt.Errorf("dead letter queue %q does not exist in account %q", deadLetterQueueID, accountID)
+		}
+		return fmt.Errorf("error finding dead letter queue %q: %w", deadLetterQueueID, err)
+	}
+
+	return nil
+}
+
+func validateQueueConsumerDeadLetterQueue(queueID, deadLetterQueueID string) error {
+	if deadLetterQueueID != "" && queueID == deadLetterQueueID {
+		return fmt.Errorf("queue %q cannot be its own dead letter queue", queueID)
+	}
+
+	return nil
+}
+
+func buildQueueConsumer(ctx context.Context, client *apiClient, d *schema.ResourceData) (queueConsumer, error) {
+	consumer := queueConsumer{
+		ScriptName: d.Get("script_name").(string),
+		Type:       "worker",
+		Settings: queueConsumerSettings{
+			BatchSize:     d.Get("batch_size").(int),
+			MaxRetries:    d.Get("max_retries").(int),
+			MaxWaitTimeMs: d.Get("max_wait_time_ms").(int),
+			RetryDelay:    d.Get("retry_delay").(int),
+		},
+	}
+
+	if v, ok := d.GetOk("max_concurrency"); ok {
+		concurrency := v.(int)
+		consumer.Settings.MaxConcurrency = &concurrency
+	}
+
+	// The API references dead letter queues by name.
+	if deadLetterQueueID := d.Get("dead_letter_queue_id").(string); deadLetterQueueID != "" {
+		accountID := d.Get("account_id").(string)
+
+		if err := validateQueueConsumerDeadLetterQueue(d.Get("queue_id").(string), deadLetterQueueID); err != nil {
+			return consumer, err
+		}
+
+		q, err := fetchQueue(ctx, client, accountID, deadLetterQueueID)
+		if err != nil {
+			return consumer, fmt.Errorf("error finding dead letter queue %q: %w", deadLetterQueueID, err)
+		}
+		consumer.DeadLetterQueue = q.Name
+	}
+
+	return consumer, nil
+}
+
+// fetchQueueConsumer looks the consumer up in the consumers of its queue.
+func fetchQueueConsumer(ctx context.Context, client *apiClient, accountID, queueID, consumerID string) (queueConsumer, bool, error) {
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("%s/consumers", queueURI(accountID, queueID)), nil)
+	if err != nil {
+		return queueConsumer{}, false, err
+	}
+
+	var consumers []queueConsumer
+	if err := json.Unmarshal(res, &consumers); err != nil {
+		return queueConsumer{}, false, fmt.Errorf("error unmarshalling queue consumers: %w", err)
+	}
+
+	for _, consumer := range consumers {
+		if consumer.ID == consumerID {
+			return consumer, true, nil
+		}
+	}
+
+	return queueConsumer{}, false, nil
+}
+
+func queueConsumerURI(accountID, queueID, consumerID string) string {
+	return fmt.Sprintf("%s/consumers/%s", queueURI(accountID, queueID), consumerID)
+}
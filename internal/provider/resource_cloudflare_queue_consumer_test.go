@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareQueueConsumer_DeadLetterQueue(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_queue_consumer.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareQueueConsumerConfig(rnd, accountID, fmt.Sprintf("cloudflare_queue.%s_dlq.id", rnd)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "script_name", rnd),
+					resource.TestCheckResourceAttrPair(name, "queue_id", fmt.Sprintf("cloudflare_queue.%s", rnd), "id"),
+					resource.TestCheckResourceAttrPair(name, "dead_letter_queue_id", fmt.Sprintf("cloudflare_queue.%s_dlq", rnd), "id"),
+					resource.TestCheckResourceAttr(name, "batch_size", "50"),
+					resource.TestCheckResourceAttr(name, "max_retries", "5"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccCloudflareQueueConsumerImportStateIdFunc(name, accountID),
+			},
+			{
+				Config:      testAccCloudflareQueueConsumerConfig(rnd, accountID, fmt.Sprintf("cloudflare_queue.%s.id", rnd)),
+				ExpectError: regexp.MustCompile("cannot be its own dead letter queue"),
+			},
+			{
+				Config:      testAccCloudflareQueueConsumerConfig(rnd, accountID, `"00000000000000000000000000000000"`),
+				ExpectError: regexp.MustCompile("does not exist in account"),
+			},
+		},
+	})
+}
+
+func TestValidateQueueConsumerDeadLetterQueue(t *testing.T) {
+	if err := validateQueueConsumerDeadLetterQueue("a", "b"); err != nil {
+		t.Errorf("expected no error for a different queue, got %s", err)
+	}
+
+	if err := validateQueueConsumerDeadLetterQueue("a", ""); err != nil {
+		t.Errorf("expected no error without a dead letter queue, got %s", err)
+	}
+
+	if err := validateQueueConsumerDeadLetterQueue("a", "a"); err == nil {
+		t.Error("expected an error when the queue is its own dead letter queue")
+	}
+}
+
+func testAccCloudflareQueueConsumerImportStateIdFunc(name, accountID string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", accountID, rs.Primary.Attributes["queue_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCloudflareQueueConsumerConfig(rnd, accountID, deadLetterQueueID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s" {
+  name    = "%[1]s"
+  content = "export default { async queue(batch, env) {} };"
+}
+
+resource "cloudflare_queue" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+
+resource "cloudflare_queue" "%[1]s_dlq" {
+  account_id = "%[2]s"
+  name       = "%[1]s-dlq"
+}
+
+resource "cloudflare_queue_consumer" "%[1]s" {
+  account_id           = "%[2]s"
+  queue_id             = cloudflare_queue.%[1]s.id
+  script_name          = cloudflare_worker_script.%[1]s.name
+  dead_letter_queue_id = %[3]s
+  batch_size           = 50
+  max_retries          = 5
+}
+`, rnd, accountID, deadLetterQueueID)
+}
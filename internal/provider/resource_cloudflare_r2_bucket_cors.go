@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var r2BucketCORSMethods = []string{"GET", "PUT", "POST", "DELETE", "HEAD"}
+
+type r2BucketCORS struct {
+	Rules []r2BucketCORSRule `json:"rules"`
+}
+
+type r2BucketCORSRule struct {
+	ID            string              `json:"id,omitempty"`
+	Allowed       r2BucketCORSAllowed `json:"allowed"`
+	ExposeHeaders []string            `json:"exposeHeaders,omitempty"`
+	MaxAgeSeconds int                 `json:"maxAgeSeconds,omitempty"`
+}
+
+type r2BucketCORSAllowed struct {
+	Methods []string `json:"methods"`
+	Origins []string `json:"origins"`
+	Headers []string `json:"headers,omitempty"`
+}
+
+func resourceCloudflareR2BucketCORS() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareR2BucketCORSSchema(),
+		CreateContext: resourceCloudflareR2BucketCORSUpdate,
+		ReadContext:   resourceCloudflareR2BucketCORSRead,
+		UpdateContext: resourceCloudflareR2BucketCORSUpdate,
+		DeleteContext: resourceCloudflareR2BucketCORSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareR2BucketCORSImport,
+		},
+		Description: "Provides a resource for managing the CORS policy of an R2 bucket.",
+	}
+}
+
+func resourceCloudflareR2BucketCORSUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	bucket := d.Get("bucket").(string)
+
+	cors := r2BucketCORS{Rules: expandR2BucketCORSRules(d.Get("rule").([]interface{}))}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting R2 bucket CORS policy for %s from struct: %+v", bucket, cors))
+
+	_, err := rawRequest(ctx, client, http.MethodPut, r2BucketURI(accountID, bucket, "cors"), cors)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting CORS policy for R2 bucket %q: %w", bucket, err))
+	}
+
+	d.SetId(bucket)
+
+	return resourceCloudflareR2BucketCORSRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2BucketCORSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, r2BucketURI(accountID, d.Id(), "cors"), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("CORS policy for R2 bucket %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding CORS policy for R2 bucket %q: %w", d.Id(), err))
+	}
+
+	var cors r2BucketCORS
+	if err := json.Unmarshal(res, &cors); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling R2 bucket CORS policy: %w", err))
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("rule", flattenR2BucketCORSRules(cors.Rules)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting rule: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketCORSDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, r2BucketURI(accountID, d.Id(), "cors"), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting CORS policy for R2 bucket %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketCORSImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/bucketName"`, d.Id())
+	}
+
+	accountID, bucket := attributes[0], attributes[1]
+
+	d.SetId(bucket)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareR2BucketCORSRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// r2BucketURI builds the URI of a configuration endpoint for an R2 bucket.
+func r2BucketURI(accountID, bucket, setting string) string {
+	return fmt.Sprintf("/accounts/%s/r2/buckets/%s/%s", accountID, bucket, setting)
+}
+
+func expandR2BucketCORSRules(rules []interface{}) []r2BucketCORSRule {
+	expanded := make([]r2BucketCORSRule, 0, len(rules))
+
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		expanded = append(expanded, r2BucketCORSRule{
+			ID: rule["id"].(string),
+			Allowed: r2BucketCORSAllowed{
+				Methods: expandInterfaceToStringList(rule["allowed_methods"].(*schema.Set).List()),
+				Origins: expandInterfaceToStringList(rule["allowed_origins"]),
+				Headers: expandInterfaceToStringList(rule["allowed_headers"]),
+			},
+			ExposeHeaders: expandInterfaceToStringList(rule["expose_headers"]),
+			MaxAgeSeconds: rule["max_age_seconds"].(int),
+		})
+	}
+
+	return expanded
+}
+
+func flattenR2BucketCORSRules(rules []r2BucketCORSRule) []interface{} {
+	flattened := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		flattened = append(flattened, map[string]interface{}{
+			"id":              rule.ID,
+			"allowed_methods": rule.Allowed.Methods,
+			"allowed_origins": rule.Allowed.Origins,
+			"allowed_headers": rule.Allowed.Headers,
+			"expose_headers":  rule.ExposeHeaders,
+			"max_age_seconds": rule.MaxAgeSeconds,
+		})
+	}
+
+	return flattened
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareR2BucketCORS_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_r2_bucket_cors.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	bucket := os.Getenv("CLOUDFLARE_R2_BUCKET_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckR2Bucket(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2BucketCORSConfig(rnd, accountID, bucket, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "bucket", bucket),
+					resource.TestCheckResourceAttr(name, "rule.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule.0.id", rnd),
+					resource.TestCheckResourceAttr(name, "rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(name, "rule.0.allowed_origins.0", "https://example.com"),
+					resource.TestCheckResourceAttr(name, "rule.0.max_age_seconds", "3600"),
+				),
+			},
+			{
+				Config: testAccCloudflareR2BucketCORSConfig(rnd, accountID, bucket, 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rule.0.max_age_seconds", "600"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareR2BucketCORSConfig(rnd, accountID, bucket string, maxAge int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_r2_bucket_cors" "%[1]s" {
+  account_id = "%[2]s"
+  bucket     = "%[3]s"
+
+  rule {
+    id              = "%[1]s"
+    allowed_methods = ["GET", "PUT"]
+    allowed_origins = ["https://example.com"]
+    allowed_headers = ["Content-Type"]
+    max_age_seconds = %[4]d
+  }
+}
+`, rnd, accountID, bucket, maxAge)
+}
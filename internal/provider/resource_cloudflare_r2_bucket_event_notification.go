@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var r2BucketEventNotificationActions = []string{
+	"PutObject",
+	"CopyObject",
+	"DeleteObject",
+	"CompleteMultipartUpload",
+	"LifecycleDeletion",
+}
+
+type r2BucketEventNotificationConfig struct {
+	BucketName string                           `json:"bucketName"`
+	Queues     []r2BucketEventNotificationQueue `json:"queues"`
+}
+
+type r2BucketEventNotificationQueue struct {
+	QueueID   string                          `json:"queueId"`
+	QueueName string                          `json:"queueName"`
+	Rules     []r2BucketEventNotificationRule `json:"rules"`
+}
+
+type r2BucketEventNotificationRule struct {
+	Actions     []string `json:"actions"`
+	Prefix      string   `json:"prefix,omitempty"`
+	Suffix      string   `json:"suffix,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+func resourceCloudflareR2BucketEventNotification() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareR2BucketEventNotificationSchema(),
+		CreateContext: resourceCloudflareR2BucketEventNotificationUpdate,
+		ReadContext:   resourceCloudflareR2BucketEventNotificationRead,
+		UpdateContext: resourceCloudflareR2BucketEventNotificationUpdate,
+		DeleteContext: resourceCloudflareR2BucketEventNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareR2BucketEventNotificationImport,
+		},
+		Description: "Provides a resource for delivering R2 bucket event notifications to a Queue.",
+	}
+}
+
+func resourceCloudflareR2BucketEventNotificationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	bucket := d.Get("bucket").(string)
+	queueID := d.Get("queue_id").(string)
+
+	body := struct {
+		Rules []r2BucketEventNotificationRule `json:"rules"`
+	}{
+		Rules: expandR2BucketEventNotificationRules(d.Get("rule").([]interface{})),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting R2 bucket event notification for %s to queue %s from struct: %+v", bucket, queueID, body))
+
+	_, err := rawRequest(ctx, client, http.MethodPut, r2BucketEventNotificationURI(accountID, bucket, queueID), body)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting event notification for R2 bucket %q: %w", bucket, err))
+	}
+
+	d.SetId(queueID)
+
+	return resourceCloudflareR2BucketEventNotificationRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2BucketEventNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	bucket := d.Get("bucket").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/event_notifications/r2/%s/configuration", accountID, bucket), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Event notification configuration for R2 bucket %s no longer exists", bucket))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding event notifications for R2 bucket %q: %w", bucket, err))
+	}
+
+	var config r2BucketEventNotificationConfig
+	if err := json.Unmarshal(res, &config); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling R2 bucket event notifications: %w", err))
+	}
+
+	var queue *r2BucketEventNotificationQueue
+	for i := range config.Queues {
+		if config.Queues[i].QueueID == d.Id() {
+			queue = &config.Queues[i]
+			break
+		}
+	}
+
+	if queue == nil {
+		tflog.Info(ctx, fmt.Sprintf("Event notification for R2 bucket %s to queue %s no longer exists", bucket, d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("queue_id", queue.QueueID)
+	d.Set("queue_name", queue.QueueName)
+
+	if err := d.Set("rule", flattenR2BucketEventNotificationRules(queue.Rules)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting rule: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketEventNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	bucket := d.Get("bucket").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, r2BucketEventNotificationURI(accountID, bucket, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting event notification for R2 bucket %q: %w", bucket, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketEventNotificationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/bucketName/queueID"`, d.Id())
+	}
+
+	accountID, bucket, queueID := attributes[0], attributes[1], attributes[2]
+
+	d.SetId(queueID)
+	d.Set("account_id", accountID)
+	d.Set("bucket", bucket)
+
+	resourceCloudflareR2BucketEventNotificationRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func r2BucketEventNotificationURI(accountID, bucket, queueID string) string {
+	return fmt.Sprintf("/accounts/%s/event_notifications/r2/%s/configuration/queues/%s", accountID, bucket, queueID)
+}
+
+func expandR2BucketEventNotificationRules(rules []interface{}) []r2BucketEventNotificationRule {
+	expanded := make([]r2BucketEventNotificationRule, 0, len(rules))
+
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		expanded = append(expanded, r2BucketEventNotificationRule{
+			Actions:     expandInterfaceToStringList(rule["actions"].(*schema.Set).List()),
+			Prefix:      rule["prefix"].(string),
+			Suffix:      rule["suffix"].(string),
+			Description: rule["description"].(string),
+		})
+	}
+
+	return expanded
+}
+
+func flattenR2BucketEventNotificationRules(rules []r2BucketEventNotificationRule) []interface{} {
+	flattened := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		flattened = append(flattened, map[string]interface{}{
+			"actions":     rule.Actions,
+			"prefix":      rule.Prefix,
+			"suffix":      rule.Suffix,
+			"description": rule.Description,
+		})
+	}
+
+	return flattened
+}
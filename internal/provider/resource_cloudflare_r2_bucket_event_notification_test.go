@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareR2BucketEventNotification_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_r2_bucket_event_notification.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	bucket := os.Getenv("CLOUDFLARE_R2_BUCKET_NAME")
+	queueID := os.Getenv("CLOUDFLARE_QUEUE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckR2Bucket(t)
+			testAccPreCheckQueue(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2BucketEventNotificationConfig(rnd, accountID, bucket, queueID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "bucket", bucket),
+					resource.TestCheckResourceAttr(name, "queue_id", queueID),
+					resource.TestCheckResourceAttrSet(name, "queue_name"),
+					resource.TestCheckResourceAttr(name, "rule.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule.0.actions.#", "2"),
+					resource.TestCheckResourceAttr(name, "rule.0.prefix", "uploads/"),
+					resource.TestCheckResourceAttr(name, "rule.0.suffix", ".jpg"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/%s/", accountID, bucket),
+			},
+		},
+	})
+}
+
+func testAccCloudflareR2BucketEventNotificationConfig(rnd, accountID, bucket, queueID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_r2_bucket_event_notification" "%[1]s" {
+  account_id = "%[2]s"
+  bucket     = "%[3]s"
+  queue_id   = "%[4]s"
+
+  rule {
+    actions     = ["PutObject", "CompleteMultipartUpload"]
+    prefix      = "uploads/"
+    suffix      = ".jpg"
+    description = "%[1]s"
+  }
+}
+`, rnd, accountID, bucket, queueID)
+}
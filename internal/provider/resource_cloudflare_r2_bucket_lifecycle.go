@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var r2BucketStorageClasses = []string{"InfrequentAccess"}
+
+// r2LifecycleSecondsPerDay converts the day based schema values to the
+// second based ages used by the API.
+const r2LifecycleSecondsPerDay = 86400
+
+type r2BucketLifecycle struct {
+	Rules []r2BucketLifecycleRule `json:"rules"`
+}
+
+type r2BucketLifecycleRule struct {
+	ID                              string                               `json:"id"`
+	Enabled                         bool                                 `json:"enabled"`
+	Conditions                      r2BucketLifecycleRuleConditions      `json:"conditions"`
+	DeleteObjectsTransition         *r2BucketLifecycleTransition         `json:"deleteObjectsTransition,omitempty"`
+	AbortMultipartUploadsTransition *r2BucketLifecycleTransition         `json:"abortMultipartUploadsTransition,omitempty"`
+	StorageClassTransitions         []r2BucketLifecycleStorageTransition `json:"storageClassTransitions,omitempty"`
+}
+
+type r2BucketLifecycleRuleConditions struct {
+	Prefix string `json:"prefix"`
+}
+
+type r2BucketLifecycleTransition struct {
+	Condition r2BucketLifecycleCondition `json:"condition"`
+}
+
+type r2BucketLifecycleStorageTransition struct {
+	Condition    r2BucketLifecycleCondition `json:"condition"`
+	StorageClass string                     `json:"storageClass"`
+}
+
+type r2BucketLifecycleCondition struct {
+	Type   string `json:"type"`
+	MaxAge int    `json:"maxAge,omitempty"`
+	Date   string `json:"date,omitempty"`
+}
+
+func resourceCloudflareR2BucketLifecycle() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareR2BucketLifecycleSchema(),
+		CreateContext: resourceCloudflareR2BucketLifecycleUpdate,
+		ReadContext:   resourceCloudflareR2BucketLifecycleRead,
+		UpdateContext: resourceCloudflareR2BucketLifecycleUpdate,
+		DeleteContext: resourceCloudflareR2BucketLifecycleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareR2BucketLifecycleImport,
+		},
+		Description: "Provides a resource for managing the object lifecycle rules of an R2 bucket.",
+	}
+}
+
+func resourceCloudflareR2BucketLifecycleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	bucket := d.Get("bucket").(string)
+
+	rules, err := expandR2BucketLifecycleRules(d.Get("rule").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lifecycle := r2BucketLifecycle{Rules: rules}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting R2 bucket lifecycle rules for %s from struct: %+v", bucket, lifecycle))
+
+	_, err = rawRequest(ctx, client, http.MethodPut, r2BucketURI(accountID, bucket, "lifecycle"), lifecycle)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting lifecycle rules for R2 bucket %q: %w", bucket, err))
+	}
+
+	d.SetId(bucket)
+
+	return resourceCloudflareR2BucketLifecycleRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2BucketLifecycleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, r2BucketURI(accountID, d.Id(), "lifecycle"), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("R2 bucket %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding lifecycle rules for R2 bucket %q: %w", d.Id(), err))
+	}
+
+	var lifecycle r2BucketLifecycle
+	if err := json.Unmarshal(res, &lifecycle); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling R2 bucket lifecycle rules: %w", err))
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("rule", flattenR2BucketLifecycleRules(lifecycle.Rules)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting rule: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketLifecycleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	// Lifecycle rules have no delete endpoint; an empty rule set removes them.
+	_, err := rawRequest(ctx, client, http.MethodPut, r2BucketURI(accountID, d.Id(), "lifecycle"), r2BucketLifecycle{Rules: []r2BucketLifecycleRule{}})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error removing lifecycle rules for R2 bucket %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketLifecycleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/bucketName"`, d.Id())
+	}
+
+	accountID, bucket := attributes[0], attributes[1]
+
+	d.SetId(bucket)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareR2BucketLifecycleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandR2BucketLifecycleRules(rules []interface{}) ([]r2BucketLifecycleRule, error) {
+	expanded := make([]r2BucketLifecycleRule, 0, len(rules))
+
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		id := rule["id"].(string)
+
+		lr := r2BucketLifecycleRule{
+			ID:         id,
+			Enabled:    rule["enabled"].(bool),
+			Conditions: r2BucketLifecycleRuleConditions{Prefix: rule["prefix"].(string)},
+		}
+
+		if expiration := rule["expiration"].([]interface{}); len(expiration) > 0 && expiration[0] != nil {
+			condition, err := expandR2BucketLifecycleCondition(expiration[0].(map[string]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("rule %q expiration: %w", id, err)
+			}
+			lr.DeleteObjectsTransition = &r2BucketLifecycleTransition{Condition: condition}
+		}
+
+		if days := rule["abort_multipart_uploads_after_days"].(int); days > 0 {
+			lr.AbortMultipartUploadsTransition = &r2BucketLifecycleTransition{
+				Condition: r2BucketLifecycleCondition{Type: "Age", MaxAge: days * r2LifecycleSecondsPerDay},
+			}
+		}
+
+		for _, t := range rule["transition"].([]interface{}) {
+			transition := t.(map[string]interface{})
+			condition, err := expandR2BucketLifecycleCondition(transition)
+			if err != nil {
+				return nil, fmt.Errorf("rule %q transition: %w", id, err)
+			}
+			lr.StorageClassTransitions = append(lr.StorageClassTransitions, r2BucketLifecycleStorageTransition{
+				Condition:    condition,
+				StorageClass: transition["storage_class"].(string),
+			})
+		}
+
+		expanded = append(expanded, lr)
+	}
+
+	return expanded, nil
+}
+
+func expandR2BucketLifecycleCondition(c map[string]interface{}) (r2BucketLifecycleCondition, error) {
+	days, date := c["max_age_days"].(int), c["date"].(string)
+
+	switch {
+	case days > 0 && date != "":
+		return r2BucketLifecycleCondition{}, errors.New("only one of max_age_days or date may be set")
+	case days > 0:
+		return r2BucketLifecycleCondition{Type: "Age", MaxAge: days * r2LifecycleSecondsPerDay}, nil
+	case date != "":
+		return r2BucketLifecycleCondition{Type: "Date", Date: date}, nil
+	default:
+		return r2BucketLifecycleCondition{}, errors.New("one of max_age_days or date must be set")
+	}
+}
+
+func flattenR2BucketLifecycleRules(rules []r2BucketLifecycleRule) []interface{} {
+	flattened := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		r := map[string]interface{}{
+			"id":      rule.ID,
+			"enabled": rule.Enabled,
+			"prefix":  rule.Conditions.Prefix,
+		}
+
+		if rule.DeleteObjectsTransition != nil {
+			r["expiration"] = []interface{}{flattenR2BucketLifecycleCondition(rule.DeleteObjectsTransition.Condition)}
+		}
+
+		if rule.AbortMultipartUploadsTransition != nil {
+			r["abort_multipart_uploads_after_days"] = rule.AbortMultipartUploadsTransition.Condition.MaxAge / r2LifecycleSecondsPerDay
+		}
+
+		transitions := make([]interface{}, 0, len(rule.StorageClassTransitions))
+		for _, t := range rule.StorageClassTransitions {
+			transition := flattenR2BucketLifecycleCondition(t.Condition)
+			transition["storage_class"] = t.StorageClass
+			transitions = append(transitions, transition)
+		}
+		r["transition"] = transitions
+
+		flattened = append(flattened, r)
+	}
+
+	return flattened
+}
+
+func flattenR2BucketLifecycleCondition(c r2BucketLifecycleCondition) map[string]interface{} {
+	condition := map[string]interface{}{}
+
+	if c.Type == "Date" {
+		condition["date"] = c.Date
+	} else {
+		condition["max_age_days"] = c.MaxAge / r2LifecycleSecondsPerDay
+	}
+
+	return condition
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestExpandR2BucketLifecycleRules(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"id":                                 "archive",
+			"enabled":                            true,
+			"prefix":                             "logs/",
+			"expiration":                         []interface{}{map[string]interface{}{"max_age_days": 365, "date": ""}},
+			"abort_multipart_uploads_after_days": 7,
+			"transition": []interface{}{
+				map[string]interface{}{"max_age_days": 0, "date": "2030-01-01T00:00:00Z", "storage_class": "InfrequentAccess"},
+			},
+		},
+	}
+
+	expanded, err := expandR2BucketLifecycleRules(rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []r2BucketLifecycleRule{{
+		ID:                              "archive",
+		Enabled:                         true,
+		Conditions:                      r2BucketLifecycleRuleConditions{Prefix: "logs/"},
+		DeleteObjectsTransition:         &r2BucketLifecycleTransition{Condition: r2BucketLifecycleCondition{Type: "Age", MaxAge: 365 * 86400}},
+		AbortMultipartUploadsTransition: &r2BucketLifecycleTransition{Condition: r2BucketLifecycleCondition{Type: "Age", MaxAge: 7 * 86400}},
+		StorageClassTransitions: []r2BucketLifecycleStorageTransition{{
+			Condition:    r2BucketLifecycleCondition{Type: "Date", Date: "2030-01-01T00:00:00Z"},
+			StorageClass: "InfrequentAccess",
+		}},
+	}}
+
+	if !reflect.DeepEqual(expanded, want) {
+		t.Errorf("expandR2BucketLifecycleRules() = %+v, want %+v", expanded, want)
+	}
+
+	flattened := flattenR2BucketLifecycleRules(expanded)[0].(map[string]interface{})
+	if got := flattened["abort_multipart_uploads_after_days"]; got != 7 {
+		t.Errorf("abort_multipart_uploads_after_days = %v, want 7", got)
+	}
+	if got := flattened["expiration"].([]interface{})[0].(map[string]interface{})["max_age_days"]; got != 365 {
+		t.Errorf("expiration.max_age_days = %v, want 365", got)
+	}
+
+	invalid := []interface{}{
+		map[string]interface{}{
+			"id":                                 "invalid",
+			"enabled":                            true,
+			"prefix":                             "",
+			"expiration":                         []interface{}{map[string]interface{}{"max_age_days": 0, "date": ""}},
+			"abort_multipart_uploads_after_days": 0,
+			"transition":                         []interface{}{},
+		},
+	}
+
+	if _, err := expandR2BucketLifecycleRules(invalid); err == nil {
+		t.Error("expected an error for an expiration without a condition")
+	}
+}
+
+func TestAccCloudflareR2BucketLifecycle_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_r2_bucket_lifecycle.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	bucket := os.Getenv("CLOUDFLARE_R2_BUCKET_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckR2Bucket(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2BucketLifecycleConfig(rnd, accountID, bucket),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "bucket", bucket),
+					resource.TestCheckResourceAttr(name, "rule.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule.0.id", rnd),
+					resource.TestCheckResourceAttr(name, "rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(name, "rule.0.expiration.0.max_age_days", "365"),
+					resource.TestCheckResourceAttr(name, "rule.0.abort_multipart_uploads_after_days", "7"),
+					resource.TestCheckResourceAttr(name, "rule.0.transition.0.max_age_days", "30"),
+					resource.TestCheckResourceAttr(name, "rule.0.transition.0.storage_class", "InfrequentAccess"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareR2BucketLifecycleConfig(rnd, accountID, bucket string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_r2_bucket_lifecycle" "%[1]s" {
+  account_id = "%[2]s"
+  bucket     = "%[3]s"
+
+  rule {
+    id                                 = "%[1]s"
+    prefix                             = "logs/"
+    abort_multipart_uploads_after_days = 7
+
+    transition {
+      max_age_days = 30
+    }
+
+    expiration {
+      max_age_days = 365
+    }
+  }
+}
+`, rnd, accountID, bucket)
+}
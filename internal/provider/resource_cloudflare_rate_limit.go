@@ -26,7 +26,7 @@ func resourceCloudflareRateLimit() *schema.Resource {
 }
 
 func resourceCloudflareRateLimitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	zoneID := d.Get("zone_id").(string)
 
@@ -87,7 +87,7 @@ func resourceCloudflareRateLimitCreate(ctx context.Context, d *schema.ResourceDa
 
 func resourceCloudflareRateLimitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// since api only supports replace, update looks a lot like create...
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	rateLimitId := d.Id()
 
@@ -258,7 +258,7 @@ func expandRateLimitBypass(bypassUrlPatterns *schema.Set) []cloudflare.RateLimit
 }
 
 func resourceCloudflareRateLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	rateLimitId := d.Id()
 
@@ -382,7 +382,7 @@ func flattenRateLimitCorrelate(cfg cloudflare.RateLimitCorrelate) []map[string]i
 }
 
 func resourceCloudflareRateLimitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	rateLimitId := d.Id()
 
@@ -2,12 +2,16 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -40,8 +44,16 @@ func resourceCloudflareRecord() *schema.Resource {
 	}
 }
 
+// dnsRecordErrorPaths attributes DNS record API errors to the argument that
+// caused them.
+var dnsRecordErrorPaths = map[int]cty.Path{
+	1004:  cty.GetAttrPath("value"),
+	81053: cty.GetAttrPath("name"),
+	81057: cty.GetAttrPath("name"),
+}
+
 func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	newRecord := cloudflare.DNSRecord{
 		Type:   d.Get("type").(string),
@@ -67,6 +79,9 @@ func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData,
 	if dataOk {
 		dataMap := data.([]interface{})[0]
 		for id, value := range dataMap.(map[string]interface{}) {
+			if !dnsRecordDataFieldAllowed(newRecord.Type, id) {
+				continue
+			}
 			newData, err := transformToCloudflareDNSData(newRecord.Type, id, value)
 			if err != nil {
 				return diag.FromErr(err)
@@ -153,7 +168,7 @@ func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData,
 					return nil
 				}
 
-				return resource.RetryableError(fmt.Errorf("expected DNS record to not already be present but already exists"))
+				return resource.RetryableError(fmt.Errorf("expected DNS record to not already be present but already exists: %w", err))
 			}
 
 			return resource.NonRetryableError(fmt.Errorf("failed to create DNS record: %w", err))
@@ -167,23 +182,29 @@ func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData,
 
 		d.SetId(r.Result.ID)
 
+		if hasDNSRecordAnnotations(d) {
+			if err := updateDNSRecordAnnotations(ctx, client, newRecord.ZoneID, r.Result.ID, d); err != nil {
+				return resource.NonRetryableError(err)
+			}
+		}
+
 		resourceCloudflareRecordRead(ctx, d, meta)
 
 		return nil
 	})
 
 	if retry != nil {
-		return diag.FromErr(retry)
+		return cloudflareErrorDiagnostics("error creating DNS record", retry, dnsRecordErrorPaths)
 	}
 
 	return nil
 }
 
 func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
-	record, err := client.DNSRecord(ctx, zoneID, d.Id())
+	record, err := fetchDNSRecordWithAnnotations(ctx, client, zoneID, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "Invalid dns record identifier") ||
 			strings.Contains(err.Error(), "HTTP status 404") {
@@ -199,7 +220,9 @@ func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, m
 
 	readDataMap := make(map[string]interface{})
 
-	if dataOk {
+	if _, ok := dnsRecordDataFields[record.Type]; ok {
+		record.Data = flattenDNSRecordData(record.Type, record.Data)
+	} else if dataOk {
 		dataMap := data.([]interface{})[0]
 		if dataMap != nil {
 			for id, value := range dataMap.(map[string]interface{}) {
@@ -229,6 +252,8 @@ func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, m
 		tflog.Warn(ctx, fmt.Sprintf("Error setting metadata: %s", err))
 	}
 	d.Set("proxiable", record.Proxiable)
+	d.Set("comment", record.Comment)
+	d.Set("tags", record.Tags)
 
 	if record.Priority != nil {
 		priority := record.Priority
@@ -240,7 +265,7 @@ func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	updateRecord := cloudflare.DNSRecord{
@@ -259,6 +284,9 @@ func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData,
 	if dataOk {
 		dataMap := data.([]interface{})[0]
 		for id, value := range dataMap.(map[string]interface{}) {
+			if !dnsRecordDataFieldAllowed(updateRecord.Type, id) {
+				continue
+			}
 			newData, err := transformToCloudflareDNSData(updateRecord.Type, id, value)
 			if err != nil {
 				return diag.FromErr(err)
@@ -295,32 +323,84 @@ func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData,
 		err := client.UpdateDNSRecord(ctx, zoneID, d.Id(), updateRecord)
 		if err != nil {
 			if strings.Contains(err.Error(), "already exist") {
-				return resource.RetryableError(fmt.Errorf("expected DNS record to not already be present but already exists"))
+				return resource.RetryableError(fmt.Errorf("expected DNS record to not already be present but already exists: %w", err))
 			}
 
 			return resource.NonRetryableError(fmt.Errorf("failed to create DNS record: %w", err))
 		}
 
+		if d.HasChanges("comment", "tags") {
+			if err := updateDNSRecordAnnotations(ctx, client, zoneID, d.Id(), d); err != nil {
+				return resource.NonRetryableError(err)
+			}
+		}
+
 		resourceCloudflareRecordRead(ctx, d, meta)
 		return nil
 	})
 
 	if retry != nil {
-		return diag.FromErr(retry)
+		return cloudflareErrorDiagnostics("error updating DNS record", retry, dnsRecordErrorPaths)
 	}
 
 	return nil
 }
 
 func resourceCloudflareRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare Record: %s, %s", zoneID, d.Id()))
 
 	err := client.DeleteDNSRecord(ctx, zoneID, d.Id())
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Cloudflare Record: %w", err))
+		return cloudflareErrorDiagnostics("error deleting DNS record", err, nil)
+	}
+
+	return nil
+}
+
+// dnsRecordWithAnnotations adds the comment and tags of a DNS record, which
+// cloudflare-go does not support yet.
+type dnsRecordWithAnnotations struct {
+	cloudflare.DNSRecord
+	Comment string   `json:"comment"`
+	Tags    []string `json:"tags"`
+}
+
+func fetchDNSRecordWithAnnotations(ctx context.Context, client *apiClient, zoneID, recordID string) (dnsRecordWithAnnotations, error) {
+	var record dnsRecordWithAnnotations
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/zones/%s/dns_records/%s", zoneID, recordID), nil)
+	if err != nil {
+		return record, err
+	}
+
+	if err := json.Unmarshal(res, &record); err != nil {
+		return record, fmt.Errorf("error unmarshalling DNS record: %w", err)
+	}
+
+	return record, nil
+}
+
+func hasDNSRecordAnnotations(d *schema.ResourceData) bool {
+	return d.Get("comment").(string) != "" || d.Get("tags").(*schema.Set).Len() > 0
+}
+
+// updateDNSRecordAnnotations sets the comment and tags of a DNS record. Both
+// are always sent so that removing them from the configuration clears them.
+func updateDNSRecordAnnotations(ctx context.Context, client *apiClient, zoneID, recordID string, d *schema.ResourceData) error {
+	payload := struct {
+		Comment string   `json:"comment"`
+		Tags    []string `json:"tags"`
+	}{
+		Comment: d.Get("comment").(string),
+		Tags:    expandInterfaceToStringList(d.Get("tags").(*schema.Set).List()),
+	}
+
+	_, err := rawRequest(ctx, client, http.MethodPatch, fmt.Sprintf("/zones/%s/dns_records/%s", zoneID, recordID), payload)
+	if err != nil {
+		return fmt.Errorf("error updating comment and tags of DNS record %q: %w", recordID, err)
 	}
 
 	return nil
@@ -341,7 +421,7 @@ func expandStringMap(inVal interface{}) map[string]string {
 }
 
 func resourceCloudflareRecordImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -405,9 +485,13 @@ func transformToCloudflareDNSData(recordType string, id string, value interface{
 	case id == "flags":
 		switch {
 		case strings.ToUpper(recordType) == "SRV",
-			strings.ToUpper(recordType) == "CAA",
-			strings.ToUpper(recordType) == "DNSKEY":
+			strings.ToUpper(recordType) == "CAA":
 			newValue, err = value.(string), nil
+		case strings.ToUpper(recordType) == "DNSKEY":
+			// The API only accepts DNSKEY flags as a number.
+			if value.(string) != "" {
+				newValue, err = strconv.Atoi(value.(string))
+			}
 		case strings.ToUpper(recordType) == "NAPTR":
 			newValue, err = value.(string), nil
 		}
@@ -422,6 +506,85 @@ func transformToCloudflareDNSData(recordType string, id string, value interface{
 	return
 }
 
+// dnsRecordDataFields holds the `data` properties the API returns for record
+// types which are read back from the API rather than from the configuration.
+var dnsRecordDataFields = map[string][]string{
+	"DNSKEY": {"flags", "protocol", "algorithm", "public_key"},
+	"DS":     {"key_tag", "algorithm", "digest_type", "digest"},
+	"HTTPS":  {"priority", "target", "value"},
+	"LOC": {
+		"lat_degrees", "lat_minutes", "lat_seconds", "lat_direction",
+		"long_degrees", "long_minutes", "long_seconds", "long_direction",
+		"altitude", "size", "precision_horz", "precision_vert",
+	},
+	"NAPTR":  {"order", "preference", "flags", "service", "regex", "replacement"},
+	"SMIMEA": {"usage", "selector", "matching_type", "certificate"},
+	"SSHFP":  {"algorithm", "type", "fingerprint"},
+	"SVCB":   {"priority", "target", "value"},
+	"TLSA":   {"usage", "selector", "matching_type", "certificate"},
+}
+
+// dnsRecordDataFieldAllowed reports whether a `data` property is sent for
+// the record type. Every property is sent for types without a known set.
+func dnsRecordDataFieldAllowed(recordType, id string) bool {
+	fields, ok := dnsRecordDataFields[strings.ToUpper(recordType)]
+	if !ok {
+		return true
+	}
+	return contains(fields, id)
+}
+
+// flattenDNSRecordData converts the `data` returned by the API into the
+// shape of the `data` block. JSON numbers are decoded as floats so integer
+// properties are converted back, and flags are always a string.
+func flattenDNSRecordData(recordType string, data interface{}) []interface{} {
+	apiData, ok := data.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	flattened := make(map[string]interface{})
+	for _, id := range dnsRecordDataFields[recordType] {
+		value, ok := apiData[id]
+		if !ok || value == nil {
+			continue
+		}
+
+		switch {
+		case id == "flags":
+			flattened[id] = fmt.Sprintf("%v", value)
+		case contains(dnsTypeIntFields, id):
+			if f, ok := value.(float64); ok {
+				flattened[id] = int(f)
+			}
+		case contains(dnsTypeFloatFields, id):
+			flattened[id] = value
+		default:
+			flattened[id] = fmt.Sprintf("%v", value)
+		}
+	}
+
+	return []interface{}{flattened}
+}
+
+// suppressRecordDataDiff ignores formatting differences in `data`
+// properties the API normalizes: whitespace in keys and certificates, the
+// case of hex digests and a trailing dot on targets.
+func suppressRecordDataDiff(k, old, new string, d *schema.ResourceData) bool {
+	stripSpaces := func(s string) string {
+		return strings.Join(strings.Fields(s), "")
+	}
+
+	switch {
+	case strings.HasSuffix(k, ".target"):
+		return strings.EqualFold(strings.TrimSuffix(old, "."), strings.TrimSuffix(new, "."))
+	case strings.HasSuffix(k, ".public_key"):
+		return stripSpaces(old) == stripSpaces(new)
+	default:
+		return strings.EqualFold(stripSpaces(old), stripSpaces(new))
+	}
+}
+
 func suppressPriority(k, old, new string, d *schema.ResourceData) bool {
 	recordType := d.Get("type").(string)
 	if recordType != "MX" && recordType != "URI" {
@@ -89,6 +89,39 @@ func TestAccCloudflareRecord_Basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecord_CommentAndTags(t *testing.T) {
+	t.Parallel()
+	var record cloudflare.DNSRecord
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigCommentAndTags(zoneID, rnd, "owned by the platform team", `"team:platform", "env:production"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "comment", "owned by the platform team"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "team:platform"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareRecordConfigCommentAndTags(zoneID, rnd, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRecord_CaseInsensitive(t *testing.T) {
 	t.Parallel()
 	var record cloudflare.DNSRecord
@@ -253,6 +286,108 @@ func TestAccCloudflareRecord_CAA(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecord_HTTPS(t *testing.T) {
+	t.Parallel()
+	var record cloudflare.DNSRecord
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigHTTPS(rnd, zoneID, fmt.Sprintf("%s.%s", rnd, domain)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "data.0.priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.0.target", "."),
+					resource.TestCheckResourceAttr(resourceName, "data.0.value", `alpn="h3,h2"`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_DS(t *testing.T) {
+	t.Parallel()
+	var record cloudflare.DNSRecord
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigDS(rnd, zoneID, fmt.Sprintf("%s.%s", rnd, domain)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "data.0.key_tag", "2371"),
+					resource.TestCheckResourceAttr(resourceName, "data.0.algorithm", "13"),
+					resource.TestCheckResourceAttr(resourceName, "data.0.digest_type", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "data.0.digest"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenDNSRecordData(t *testing.T) {
+	data := map[string]interface{}{
+		"flags":      float64(257),
+		"protocol":   float64(3),
+		"algorithm":  float64(13),
+		"public_key": "mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ==",
+		"key_tag":    float64(2371),
+	}
+
+	flattened := flattenDNSRecordData("DNSKEY", data)
+	if len(flattened) != 1 {
+		t.Fatalf("expected a single data block, got %d", len(flattened))
+	}
+
+	block := flattened[0].(map[string]interface{})
+	if block["flags"] != "257" {
+		t.Errorf("expected flags to be %q, got %#v", "257", block["flags"])
+	}
+	if block["protocol"] != 3 || block["algorithm"] != 13 {
+		t.Errorf("expected integer protocol and algorithm, got %#v and %#v", block["protocol"], block["algorithm"])
+	}
+	if _, ok := block["key_tag"]; ok {
+		t.Errorf("expected key_tag to be dropped for DNSKEY records")
+	}
+
+	if flattenDNSRecordData("TLSA", nil) != nil {
+		t.Errorf("expected no data block without data")
+	}
+}
+
+func TestSuppressRecordDataDiff(t *testing.T) {
+	cases := []struct {
+		key, old, new string
+		suppress      bool
+	}{
+		{"data.0.target", "example.com.", "example.com", true},
+		{"data.0.digest", "ABCDEF", "abc def", true},
+		{"data.0.fingerprint", "abcdef", "abcdee", false},
+		{"data.0.public_key", "AbC dEf", "AbCdEf", true},
+		{"data.0.public_key", "AbCdEf", "abcdef", false},
+	}
+
+	for _, c := range cases {
+		if got := suppressRecordDataDiff(c.key, c.old, c.new, nil); got != c.suppress {
+			t.Errorf("%s: expected suppress %t for %q and %q, got %t", c.key, c.suppress, c.old, c.new, got)
+		}
+	}
+}
+
 func TestAccCloudflareRecord_Proxied(t *testing.T) {
 	t.Parallel()
 	var record cloudflare.DNSRecord
@@ -520,7 +655,7 @@ func testAccCheckCloudflareRecordRecreated(before, after *cloudflare.DNSRecord)
 }
 
 func testAccCheckCloudflareRecordDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_record" {
@@ -538,7 +673,7 @@ func testAccCheckCloudflareRecordDestroy(s *terraform.State) error {
 
 func testAccManuallyDeleteRecord(record *cloudflare.DNSRecord) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		err := client.DeleteDNSRecord(context.Background(), record.ZoneID, record.ID)
 		if err != nil {
 			return err
@@ -603,7 +738,7 @@ func testAccCheckCloudflareRecordExists(n string, record *cloudflare.DNSRecord)
 			return fmt.Errorf("No Record ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundRecord, err := client.DNSRecord(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
 		if err != nil {
 			return err
@@ -630,6 +765,19 @@ resource "cloudflare_record" "%[3]s" {
 }`, zoneID, name, rnd)
 }
 
+func testAccCheckCloudflareRecordConfigCommentAndTags(zoneID, rnd, comment, tags string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+	zone_id = "%[1]s"
+	name = "tf-acctest-%[2]s"
+	value = "192.168.0.10"
+	type = "A"
+	ttl = 3600
+	comment = "%[3]s"
+	tags = [%[4]s]
+}`, zoneID, rnd, comment, tags)
+}
+
 func testAccCheckCloudflareRecordConfigApex(zoneID, rnd string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "%[2]s" {
@@ -699,6 +847,37 @@ resource "cloudflare_record" "%[1]s" {
 }`, resourceName, zoneID, name, ttl)
 }
 
+func testAccCheckCloudflareRecordConfigHTTPS(resourceName, zoneID, name string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[1]s" {
+  zone_id = "%[2]s"
+  name = "%[3]s"
+  data {
+    priority = 1
+    target   = "."
+    value    = "alpn=\"h3,h2\""
+  }
+  type = "HTTPS"
+  ttl = 3600
+}`, resourceName, zoneID, name)
+}
+
+func testAccCheckCloudflareRecordConfigDS(resourceName, zoneID, name string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[1]s" {
+  zone_id = "%[2]s"
+  name = "%[3]s"
+  data {
+    key_tag     = 2371
+    algorithm   = 13
+    digest_type = 2
+    digest      = "1F987CC6583E92DF0890718C42B2B2E4D1 F7B5FA7E3D0F3A9C4B2D4E5F6A7B8C9"
+  }
+  type = "DS"
+  ttl = 3600
+}`, resourceName, zoneID, name)
+}
+
 func testAccCheckCloudflareRecordConfigProxied(zoneID, domain, name, rnd string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "%[4]s" {
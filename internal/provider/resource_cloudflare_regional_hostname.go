@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type regionalHostname struct {
+	Hostname  string `json:"hostname,omitempty"`
+	RegionKey string `json:"region_key"`
+	CreatedOn string `json:"created_on,omitempty"`
+}
+
+func resourceCloudflareRegionalHostname() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareRegionalHostnameSchema(),
+		CreateContext: resourceCloudflareRegionalHostnameCreate,
+		ReadContext:   resourceCloudflareRegionalHostnameRead,
+		UpdateContext: resourceCloudflareRegionalHostnameUpdate,
+		DeleteContext: resourceCloudflareRegionalHostnameDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareRegionalHostnameImport,
+		},
+		Description: "Provides a resource to restrict the processing of a hostname to a region with Regional Services, part of the Data Localization Suite.",
+	}
+}
+
+func resourceCloudflareRegionalHostnameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	hostname := regionalHostname{
+		Hostname:  d.Get("hostname").(string),
+		RegionKey: d.Get("region_key").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating regional hostname from struct: %+v", hostname))
+
+	_, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/addressing/regional_hostnames", zoneID), hostname)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating regional hostname %q: %w", hostname.Hostname, err))
+	}
+
+	d.SetId(hostname.Hostname)
+
+	return resourceCloudflareRegionalHostnameRead(ctx, d, meta)
+}
+
+func resourceCloudflareRegionalHostnameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, regionalHostnameURI(zoneID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Regional hostname %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error fetching regional hostname %q: %w", d.Id(), err))
+	}
+
+	var hostname regionalHostname
+	if err := json.Unmarshal(res, &hostname); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling regional hostname %q: %w", d.Id(), err))
+	}
+
+	d.Set("hostname", hostname.Hostname)
+	d.Set("region_key", hostname.RegionKey)
+	d.Set("created_on", hostname.CreatedOn)
+
+	return nil
+}
+
+func resourceCloudflareRegionalHostnameUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	hostname := regionalHostname{
+		RegionKey: d.Get("region_key").(string),
+	}
+
+	_, err := rawRequest(ctx, client, http.MethodPatch, regionalHostnameURI(zoneID, d.Id()), hostname)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating regional hostname %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareRegionalHostnameRead(ctx, d, meta)
+}
+
+func resourceCloudflareRegionalHostnameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, regionalHostnameURI(zoneID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting regional hostname %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareRegionalHostnameImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/hostname"`, d.Id())
+	}
+
+	zoneID, hostname := attributes[0], attributes[1]
+
+	d.SetId(hostname)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareRegionalHostnameRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func regionalHostnameURI(zoneID, hostname string) string {
+	return fmt.Sprintf("/zones/%s/addressing/regional_hostnames/%s", zoneID, hostname)
+}
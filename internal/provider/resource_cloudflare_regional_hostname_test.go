@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRegionalHostname_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_regional_hostname.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	hostname := fmt.Sprintf("%s.%s", rnd, domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRegionalHostnameConfig(rnd, zoneID, hostname, "eu"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "hostname", hostname),
+					resource.TestCheckResourceAttr(name, "region_key", "eu"),
+					resource.TestCheckResourceAttrSet(name, "created_on"),
+				),
+			},
+			{
+				Config: testAccCloudflareRegionalHostnameConfig(rnd, zoneID, hostname, "us"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostname", hostname),
+					resource.TestCheckResourceAttr(name, "region_key", "us"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRegionalHostnameConfig(rnd, zoneID, hostname, regionKey string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_regional_hostname" "%[1]s" {
+  zone_id    = "%[2]s"
+  hostname   = "%[3]s"
+  region_key = "%[4]s"
+}
+`, rnd, zoneID, hostname, regionKey)
+}
@@ -2,14 +2,17 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"reflect"
 	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -28,6 +31,7 @@ func resourceCloudflareRuleset() *schema.Resource {
 		ReadContext:   resourceCloudflareRulesetRead,
 		UpdateContext: resourceCloudflareRulesetUpdate,
 		DeleteContext: resourceCloudflareRulesetDelete,
+		CustomizeDiff: resourceCloudflareRulesetDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareRulesetImport,
 		},
@@ -51,7 +55,7 @@ basic syntax.
 }
 
 func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
 	rulesetPhase := d.Get("phase").(string)
@@ -75,14 +79,16 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 	rulesetName := d.Get("name").(string)
 	rulesetDescription := d.Get("description").(string)
 	rulesetKind := d.Get("kind").(string)
-	rs := cloudflare.Ruleset{
-		Name:        rulesetName,
-		Description: rulesetDescription,
-		Kind:        rulesetKind,
-		Phase:       rulesetPhase,
+	rs := rulesetWithConfig{
+		Ruleset: cloudflare.Ruleset{
+			Name:        rulesetName,
+			Description: rulesetDescription,
+			Kind:        rulesetKind,
+			Phase:       rulesetPhase,
+		},
 	}
 
-	rules, err := buildRulesetRulesFromResource(d)
+	rules, err := buildRulesetRulesWithConfigFromResource(d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error building ruleset rules from resource: %w", err))
 	}
@@ -105,37 +111,29 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	var rulesetCreateErr error
-	if accountID != "" {
-		ruleset, rulesetCreateErr = client.CreateAccountRuleset(ctx, accountID, rs)
-	} else {
-		ruleset, rulesetCreateErr = client.CreateZoneRuleset(ctx, zoneID, rs)
-	}
-
-	if rulesetCreateErr != nil {
-		return diag.FromErr(fmt.Errorf("error creating ruleset %s: %w", rulesetName, rulesetCreateErr))
+	routeRoot, identifier := rulesetRouteRoot(d)
+	createdRuleset, err := createRulesetWithConfig(ctx, client, routeRoot, identifier, rs)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating ruleset %s: %w", rulesetName, err))
 	}
 
-	rulesetEntryPoint := cloudflare.Ruleset{
-		Description: rulesetDescription,
-		Rules:       rules,
+	rulesetEntryPoint := rulesetWithConfig{
+		Ruleset: cloudflare.Ruleset{
+			Description: rulesetDescription,
+		},
+		Rules: rules,
 	}
 
 	// For "custom" rulesets, we don't send a follow up PUT it to the entrypoint
 	// endpoint.
 	if rulesetKind != string(cloudflare.RulesetKindCustom) {
-		if accountID != "" {
-			_, err = client.UpdateAccountRulesetPhase(ctx, accountID, rulesetPhase, rulesetEntryPoint)
-		} else {
-			_, err = client.UpdateZoneRulesetPhase(ctx, zoneID, rulesetPhase, rulesetEntryPoint)
-		}
-
+		_, err = updateRulesetPhaseWithConfig(ctx, client, routeRoot, identifier, rulesetPhase, rulesetEntryPoint)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error updating ruleset phase entrypoint %s: %w", rulesetName, err))
 		}
 	}
 
-	d.SetId(ruleset.ID)
+	d.SetId(createdRuleset.ID)
 
 	return resourceCloudflareRulesetRead(ctx, d, meta)
 }
@@ -145,21 +143,12 @@ func resourceCloudflareRulesetImport(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceCloudflareRulesetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
-	accountID := d.Get("account_id").(string)
-	zoneID := d.Get("zone_id").(string)
-
-	var ruleset cloudflare.Ruleset
-	var err error
-
-	if accountID != "" {
-		ruleset, err = client.GetAccountRuleset(ctx, accountID, d.Id())
-	} else {
-		ruleset, err = client.GetZoneRuleset(ctx, zoneID, d.Id())
-	}
+	client := meta.(*apiClient)
 
+	routeRoot, identifier := rulesetRouteRoot(d)
+	ruleset, err := getRulesetWithConfig(ctx, client, routeRoot, identifier, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "could not find ruleset") {
+		if isNotFoundError(err) || strings.Contains(err.Error(), "could not find ruleset") {
 			log.Printf("[INFO] Ruleset %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -170,7 +159,7 @@ func resourceCloudflareRulesetRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("name", ruleset.Name)
 	d.Set("description", ruleset.Description)
 
-	if err := d.Set("rules", buildStateFromRulesetRules(ruleset.Rules)); err != nil {
+	if err := d.Set("rules", buildStateFromRulesetRulesWithConfig(ruleset.Rules)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -178,22 +167,16 @@ func resourceCloudflareRulesetRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCloudflareRulesetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
-	accountID := d.Get("account_id").(string)
-	zoneID := d.Get("zone_id").(string)
+	client := meta.(*apiClient)
 
-	rules, err := buildRulesetRulesFromResource(d)
+	rules, err := buildRulesetRulesWithConfigFromResource(d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error building ruleset from resource: %w", err))
 	}
 
 	description := d.Get("description").(string)
-	if accountID != "" {
-		_, err = client.UpdateAccountRuleset(ctx, accountID, d.Id(), description, rules)
-	} else {
-		_, err = client.UpdateZoneRuleset(ctx, zoneID, d.Id(), description, rules)
-	}
-
+	routeRoot, identifier := rulesetRouteRoot(d)
+	_, err = updateRulesetWithConfig(ctx, client, routeRoot, identifier, d.Id(), description, rules)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating ruleset with ID %q: %w", d.Id(), err))
 	}
@@ -202,7 +185,7 @@ func resourceCloudflareRulesetUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceCloudflareRulesetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
 	var err error
@@ -220,6 +203,91 @@ func resourceCloudflareRulesetDelete(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
+// rulesetPhaseOverrideActions are the actions the managed rulesets of the
+// DDoS phases can be overridden with. Other actions are only rejected by the
+// API once the ruleset is applied.
+var rulesetPhaseOverrideActions = map[string][]string{
+	string(cloudflare.RulesetPhaseDDoSL7): {"block", "managed_challenge", "challenge", "js_challenge", "log", "ddos_dynamic"},
+	string(cloudflare.RulesetPhaseDDoSL4): {"block", "log", "ddos_dynamic"},
+}
+
+// resourceCloudflareRulesetDiff validates the rule and override actions
+// against the phase and the rule logging settings so that invalid DDoS
+// overrides and logging on non-skip rules fail at plan time.
+func resourceCloudflareRulesetDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	phase := d.Get("phase").(string)
+
+	rules, _ := d.Get("rules").([]interface{})
+	for i, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var overrideActions []string
+		if actionParameters, ok := rule["action_parameters"].([]interface{}); ok && len(actionParameters) > 0 && actionParameters[0] != nil {
+			if overrides, ok := actionParameters[0].(map[string]interface{})["overrides"].([]interface{}); ok && len(overrides) > 0 && overrides[0] != nil {
+				override := overrides[0].(map[string]interface{})
+				overrideActions = append(overrideActions, override["action"].(string))
+				for _, c := range override["categories"].([]interface{}) {
+					if c != nil {
+						overrideActions = append(overrideActions, c.(map[string]interface{})["action"].(string))
+					}
+				}
+				for _, o := range override["rules"].([]interface{}) {
+					if o != nil {
+						overrideActions = append(overrideActions, o.(map[string]interface{})["action"].(string))
+					}
+				}
+			}
+		}
+
+		if err := validateRulesetPhaseActions(phase, rule["action"].(string), overrideActions); err != nil {
+			return fmt.Errorf("rules.%d: %w", i, err)
+		}
+
+		logging, _ := rule["logging"].([]interface{})
+		if err := validateRulesetRuleLogging(rule["action"].(string), logging); err != nil {
+			return fmt.Errorf("rules.%d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateRulesetRuleLogging checks that logging is only configured for skip
+// rules, the only rules the API supports it on. An empty action is not yet
+// known and is skipped.
+func validateRulesetRuleLogging(action string, logging []interface{}) error {
+	if len(logging) == 0 || action == "" || action == string(cloudflare.RulesetRuleActionSkip) {
+		return nil
+	}
+
+	return fmt.Errorf("logging can only be configured for skip rules, got %q", action)
+}
+
+// validateRulesetPhaseActions checks the action of a rule and the actions of
+// its overrides against the phase. Empty actions are not yet known or not
+// set and are skipped.
+func validateRulesetPhaseActions(phase, action string, overrideActions []string) error {
+	allowed, ok := rulesetPhaseOverrideActions[phase]
+	if !ok {
+		return nil
+	}
+
+	if action != "" && action != string(cloudflare.RulesetRuleActionExecute) {
+		return fmt.Errorf("rules in the %s phase can only use the %q action, got %q", phase, cloudflare.RulesetRuleActionExecute, action)
+	}
+
+	for _, a := range overrideActions {
+		if a != "" && !contains(allowed, a) {
+			return fmt.Errorf("overrides in the %s phase can only use the actions %s, got %q", phase, strings.Join(allowed, ", "), a)
+		}
+	}
+
+	return nil
+}
+
 // buildStateFromRulesetRules receives the current ruleset rules and returns an
 // interface for the state file.
 func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
@@ -227,6 +295,7 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 	for _, r := range rules {
 		rule := map[string]interface{}{
 			"id":         r.ID,
+			"ref":        r.Ref,
 			"expression": r.Expression,
 			"action":     r.Action,
 			"enabled":    r.Enabled,
@@ -774,7 +843,9 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 							for pKey, pValue := range pValue.([]interface{})[i].(map[string]interface{}) {
 								switch pKey {
 								case "default":
-									rule.ActionParameters.EdgeTTL.Default = cloudflare.UintPtr(uint(pValue.(int)))
+									if pValue.(int) > 0 {
+										rule.ActionParameters.EdgeTTL.Default = cloudflare.UintPtr(uint(pValue.(int)))
+									}
 								case "mode":
 									rule.ActionParameters.EdgeTTL.Mode = pValue.(string)
 								case "status_code_ttl":
@@ -816,6 +887,10 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 									}
 								}
 							}
+
+							if rule.ActionParameters.EdgeTTL.Mode == "override_origin" && rule.ActionParameters.EdgeTTL.Default == nil {
+								return nil, fmt.Errorf("edge_ttl.default must be set when edge_ttl.mode is \"override_origin\"")
+							}
 						}
 
 					case "browser_ttl":
@@ -1038,6 +1113,15 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 						}
 						rule.ActionParameters.CookieFields = fields
 
+					case "automatic_https_rewrites", "autominify", "bic", "disable_apps", "disable_railgun",
+						"disable_zaraz", "email_obfuscation", "hotlink_protection", "mirage", "opportunistic_encryption",
+						"polish", "rocket_loader", "security_level", "server_side_excludes", "ssl", "sxg":
+						// Configuration Rules settings are handled by
+						// buildRulesetConfigSettingsFromResource.
+
+					case "from_value":
+						// Handled by buildRulesetFromValueFromResource.
+
 					default:
 						log.Printf("[DEBUG] unknown key encountered in buildRulesetRulesFromResource for action parameters: %s", pKey)
 					}
@@ -1116,6 +1200,10 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 			rule.Description = resourceRule["description"].(string)
 		}
 
+		if resourceRule["ref"] != nil {
+			rule.Ref = resourceRule["ref"].(string)
+		}
+
 		rulesetRules = append(rulesetRules, rule)
 	}
 
@@ -1149,3 +1237,442 @@ func apiEnabledToStatusFieldConversion(s *bool) string {
 		return ""
 	}
 }
+
+// rulesetConfigSettings holds the `set_config` action parameters used by
+// Configuration Rules in the `http_config_settings` phase. These are not
+// modelled by cloudflare-go yet so they are merged into the action parameters
+// sent to the API.
+type rulesetConfigSettings struct {
+	AutomaticHTTPSRewrites  *bool                    `json:"automatic_https_rewrites,omitempty"`
+	Autominify              *rulesetConfigAutominify `json:"autominify,omitempty"`
+	BIC                     *bool                    `json:"bic,omitempty"`
+	DisableApps             *bool                    `json:"disable_apps,omitempty"`
+	DisableRailgun          *bool                    `json:"disable_railgun,omitempty"`
+	DisableZaraz            *bool                    `json:"disable_zaraz,omitempty"`
+	EmailObfuscation        *bool                    `json:"email_obfuscation,omitempty"`
+	HotlinkProtection       *bool                    `json:"hotlink_protection,omitempty"`
+	Mirage                  *bool                    `json:"mirage,omitempty"`
+	OpportunisticEncryption *bool                    `json:"opportunistic_encryption,omitempty"`
+	Polish                  string                   `json:"polish,omitempty"`
+	RocketLoader            *bool                    `json:"rocket_loader,omitempty"`
+	SecurityLevel           string                   `json:"security_level,omitempty"`
+	ServerSideExcludes      *bool                    `json:"server_side_excludes,omitempty"`
+	SSL                     string                   `json:"ssl,omitempty"`
+	SXG                     *bool                    `json:"sxg,omitempty"`
+}
+
+type rulesetConfigAutominify struct {
+	HTML bool `json:"html"`
+	CSS  bool `json:"css"`
+	JS   bool `json:"js"`
+}
+
+// boolSettings maps the schema keys of the boolean settings to their fields.
+func (s *rulesetConfigSettings) boolSettings() map[string]**bool {
+	return map[string]**bool{
+		"automatic_https_rewrites": &s.AutomaticHTTPSRewrites,
+		"bic":                      &s.BIC,
+		"disable_apps":             &s.DisableApps,
+		"disable_railgun":          &s.DisableRailgun,
+		"disable_zaraz":            &s.DisableZaraz,
+		"email_obfuscation":        &s.EmailObfuscation,
+		"hotlink_protection":       &s.HotlinkProtection,
+		"mirage":                   &s.Mirage,
+		"opportunistic_encryption": &s.OpportunisticEncryption,
+		"rocket_loader":            &s.RocketLoader,
+		"server_side_excludes":     &s.ServerSideExcludes,
+		"sxg":                      &s.SXG,
+	}
+}
+
+// rulesetRuleActionParametersFromValue holds the `redirect` action parameters
+// used by Single Redirects in the `http_request_dynamic_redirect` phase.
+type rulesetRuleActionParametersFromValue struct {
+	StatusCode          int                                  `json:"status_code,omitempty"`
+	TargetURL           rulesetRuleActionParametersTargetURL `json:"target_url"`
+	PreserveQueryString bool                                 `json:"preserve_query_string"`
+}
+
+type rulesetRuleActionParametersTargetURL struct {
+	Value      string `json:"value,omitempty"`
+	Expression string `json:"expression,omitempty"`
+}
+
+// rulesetRuleActionParametersOverrides adds the ruleset-level sensitivity
+// override for managed rulesets which cloudflare-go does not expose.
+type rulesetRuleActionParametersOverrides struct {
+	*cloudflare.RulesetRuleActionParametersOverrides
+	SensitivityLevel string `json:"sensitivity_level,omitempty"`
+}
+
+type rulesetRuleActionParametersWithConfig struct {
+	*cloudflare.RulesetRuleActionParameters
+	rulesetConfigSettings
+	FromValue *rulesetRuleActionParametersFromValue `json:"from_value,omitempty"`
+	Overrides *rulesetRuleActionParametersOverrides `json:"overrides,omitempty"`
+}
+
+// rulesetRuleRateLimit mirrors cloudflare.RulesetRuleRateLimit but always
+// sends `mitigation_timeout` and `requests_to_origin` as a zero mitigation
+// timeout is meaningful (throttling) and cannot be omitted.
+type rulesetRuleRateLimit struct {
+	Characteristics    []string `json:"characteristics,omitempty"`
+	RequestsPerPeriod  int      `json:"requests_per_period,omitempty"`
+	Period             int      `json:"period,omitempty"`
+	MitigationTimeout  int      `json:"mitigation_timeout"`
+	CountingExpression string   `json:"counting_expression,omitempty"`
+	RequestsToOrigin   bool     `json:"requests_to_origin"`
+}
+
+type rulesetRuleWithConfig struct {
+	cloudflare.RulesetRule
+	ActionParameters *rulesetRuleActionParametersWithConfig `json:"action_parameters,omitempty"`
+	RateLimit        *rulesetRuleRateLimit                  `json:"ratelimit,omitempty"`
+}
+
+type rulesetWithConfig struct {
+	cloudflare.Ruleset
+	Rules []rulesetRuleWithConfig `json:"rules"`
+}
+
+// rulesetRouteRoot returns the route root and identifier the ruleset is
+// scoped to.
+func rulesetRouteRoot(d *schema.ResourceData) (cloudflare.RouteRoot, string) {
+	if accountID := d.Get("account_id").(string); accountID != "" {
+		return cloudflare.AccountRouteRoot, accountID
+	}
+	return cloudflare.ZoneRouteRoot, d.Get("zone_id").(string)
+}
+
+func createRulesetWithConfig(ctx context.Context, client *apiClient, routeRoot cloudflare.RouteRoot, identifier string, rs rulesetWithConfig) (rulesetWithConfig, error) {
+	uri := fmt.Sprintf("/%s/%s/rulesets", routeRoot, identifier)
+	return rawRulesetWithConfig(ctx, client, http.MethodPost, uri, rs)
+}
+
+func getRulesetWithConfig(ctx context.Context, client *apiClient, routeRoot cloudflare.RouteRoot, identifier, rulesetID string) (rulesetWithConfig, error) {
+	uri := fmt.Sprintf("/%s/%s/rulesets/%s", routeRoot, identifier, rulesetID)
+	return rawRulesetWithConfig(ctx, client, http.MethodGet, uri, nil)
+}
+
+func updateRulesetWithConfig(ctx context.Context, client *apiClient, routeRoot cloudflare.RouteRoot, identifier, rulesetID, description string, rules []rulesetRuleWithConfig) (rulesetWithConfig, error) {
+	uri := fmt.Sprintf("/%s/%s/rulesets/%s", routeRoot, identifier, rulesetID)
+	payload := struct {
+		Description string                  `json:"description"`
+		Rules       []rulesetRuleWithConfig `json:"rules"`
+	}{
+		Description: description,
+		Rules:       rules,
+	}
+	return rawRulesetWithConfig(ctx, client, http.MethodPut, uri, payload)
+}
+
+func updateRulesetPhaseWithConfig(ctx context.Context, client *apiClient, routeRoot cloudflare.RouteRoot, identifier, phase string, rs rulesetWithConfig) (rulesetWithConfig, error) {
+	uri := fmt.Sprintf("/%s/%s/rulesets/phases/%s/entrypoint", routeRoot, identifier, phase)
+	return rawRulesetWithConfig(ctx, client, http.MethodPut, uri, rs)
+}
+
+func rawRulesetWithConfig(ctx context.Context, client *apiClient, method, uri string, payload interface{}) (rulesetWithConfig, error) {
+	var rs rulesetWithConfig
+
+	res, err := rawRequest(ctx, client, method, uri, payload)
+	if err != nil {
+		return rs, err
+	}
+
+	if err := json.Unmarshal(res, &rs); err != nil {
+		return rs, fmt.Errorf("error unmarshalling ruleset: %w", err)
+	}
+
+	return rs, nil
+}
+
+// buildRulesetRulesWithConfigFromResource builds the ruleset rules from the
+// resource config including any Configuration Rules settings.
+func buildRulesetRulesWithConfigFromResource(d *schema.ResourceData) ([]rulesetRuleWithConfig, error) {
+	rules, err := buildRulesetRulesFromResource(d)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := rulesetRuleRefs(rules, rulesetConfiguredRefs(d, len(rules)), rulesetPriorRules(d))
+
+	var rulesWithConfig []rulesetRuleWithConfig
+	for i, r := range rules {
+		r.Ref = refs[i]
+		rule := rulesetRuleWithConfig{RulesetRule: r}
+		if r.ActionParameters != nil {
+			fromValue, err := buildRulesetFromValueFromResource(d, i)
+			if err != nil {
+				return nil, err
+			}
+
+			rule.ActionParameters = &rulesetRuleActionParametersWithConfig{
+				RulesetRuleActionParameters: r.ActionParameters,
+				rulesetConfigSettings:       buildRulesetConfigSettingsFromResource(d, i),
+				FromValue:                   fromValue,
+				Overrides:                   buildRulesetOverridesFromResource(d, i, r.ActionParameters.Overrides),
+			}
+		}
+		if r.RateLimit != nil {
+			rateLimit := rulesetRuleRateLimit(*r.RateLimit)
+			rule.RateLimit = &rateLimit
+		}
+		rulesWithConfig = append(rulesWithConfig, rule)
+	}
+
+	return rulesWithConfig, nil
+}
+
+// buildRulesetConfigSettingsFromResource builds the Configuration Rules
+// settings for a single rule. Boolean settings are read from the raw config
+// so that an explicit `false` (used to turn a feature off) is distinguishable
+// from an unset value.
+func buildRulesetConfigSettingsFromResource(d *schema.ResourceData, ruleIndex int) rulesetConfigSettings {
+	var settings rulesetConfigSettings
+	prefix := fmt.Sprintf("rules.%d.action_parameters.0", ruleIndex)
+
+	if rawActionParameters, ok := rulesetRawActionParameters(d, ruleIndex); ok {
+		for key, field := range settings.boolSettings() {
+			value := rawActionParameters.GetAttr(key)
+			if value.IsKnown() && !value.IsNull() {
+				*field = cloudflare.BoolPtr(value.True())
+			}
+		}
+	}
+
+	if _, ok := d.GetOk(prefix + ".autominify"); ok {
+		settings.Autominify = &rulesetConfigAutominify{
+			HTML: d.Get(prefix + ".autominify.0.html").(bool),
+			CSS:  d.Get(prefix + ".autominify.0.css").(bool),
+			JS:   d.Get(prefix + ".autominify.0.js").(bool),
+		}
+	}
+
+	settings.Polish = d.Get(prefix + ".polish").(string)
+	settings.SecurityLevel = d.Get(prefix + ".security_level").(string)
+	settings.SSL = d.Get(prefix + ".ssl").(string)
+
+	return settings
+}
+
+// buildRulesetOverridesFromResource combines the overrides built by
+// buildRulesetRulesFromResource with the ruleset-level sensitivity.
+func buildRulesetOverridesFromResource(d *schema.ResourceData, ruleIndex int, overrides *cloudflare.RulesetRuleActionParametersOverrides) *rulesetRuleActionParametersOverrides {
+	sensitivityLevel := d.Get(fmt.Sprintf("rules.%d.action_parameters.0.overrides.0.sensitivity_level", ruleIndex)).(string)
+	if overrides == nil && sensitivityLevel == "" {
+		return nil
+	}
+
+	return &rulesetRuleActionParametersOverrides{
+		RulesetRuleActionParametersOverrides: overrides,
+		SensitivityLevel:                     sensitivityLevel,
+	}
+}
+
+// buildRulesetFromValueFromResource builds the Single Redirects parameters for
+// a single rule.
+func buildRulesetFromValueFromResource(d *schema.ResourceData, ruleIndex int) (*rulesetRuleActionParametersFromValue, error) {
+	prefix := fmt.Sprintf("rules.%d.action_parameters.0.from_value", ruleIndex)
+	if _, ok := d.GetOk(prefix); !ok {
+		return nil, nil
+	}
+
+	fromValue := &rulesetRuleActionParametersFromValue{
+		StatusCode:          d.Get(prefix + ".0.status_code").(int),
+		PreserveQueryString: d.Get(prefix + ".0.preserve_query_string").(bool),
+		TargetURL: rulesetRuleActionParametersTargetURL{
+			Value:      d.Get(prefix + ".0.target_url.0.value").(string),
+			Expression: d.Get(prefix + ".0.target_url.0.expression").(string),
+		},
+	}
+
+	if (fromValue.TargetURL.Value == "") == (fromValue.TargetURL.Expression == "") {
+		return nil, fmt.Errorf("exactly one of from_value.target_url.value or from_value.target_url.expression must be set")
+	}
+
+	return fromValue, nil
+}
+
+// rulesetRawActionParameters returns the raw configuration of the action
+// parameters block for the rule at ruleIndex.
+func rulesetRawActionParameters(d *schema.ResourceData, ruleIndex int) (cty.Value, bool) {
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return cty.NilVal, false
+	}
+
+	rules := config.GetAttr("rules")
+	if !rules.IsKnown() || rules.IsNull() || rules.LengthInt() <= ruleIndex {
+		return cty.NilVal, false
+	}
+
+	actionParameters := rules.Index(cty.NumberIntVal(int64(ruleIndex))).GetAttr("action_parameters")
+	if !actionParameters.IsKnown() || actionParameters.IsNull() || actionParameters.LengthInt() == 0 {
+		return cty.NilVal, false
+	}
+
+	return actionParameters.Index(cty.NumberIntVal(0)), true
+}
+
+// rulesetConfiguredRefs reports, for each rule, whether its ref is set in
+// the configuration rather than carried over from the state.
+func rulesetConfiguredRefs(d *schema.ResourceData, count int) []bool {
+	configured := make([]bool, count)
+
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return configured
+	}
+
+	rules := config.GetAttr("rules")
+	if !rules.IsKnown() || rules.IsNull() {
+		return configured
+	}
+
+	for i := 0; i < count && i < rules.LengthInt(); i++ {
+		ref := rules.Index(cty.NumberIntVal(int64(i))).GetAttr("ref")
+		configured[i] = ref.IsKnown() && !ref.IsNull() && ref.AsString() != ""
+	}
+
+	return configured
+}
+
+// rulesetPriorRules returns the rules of the ruleset as they were last
+// applied.
+func rulesetPriorRules(d *schema.ResourceData) []cloudflare.RulesetRule {
+	old, _ := d.GetChange("rules")
+	oldRules, _ := old.([]interface{})
+
+	var prior []cloudflare.RulesetRule
+	for _, r := range oldRules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		prior = append(prior, cloudflare.RulesetRule{
+			Ref:         rule["ref"].(string),
+			Expression:  rule["expression"].(string),
+			Description: rule["description"].(string),
+		})
+	}
+
+	return prior
+}
+
+// rulesetRuleRefs returns the refs to send for the rules. A rule without a
+// configured ref takes the ref of the previously applied rule with the same
+// expression and description, so that inserting or reordering rules does not
+// move refs between them. The rule ID cannot be used for this as, like an
+// unset ref, it is carried over by list position. Rules without a match, or
+// whose match clashes with a configured ref, are sent without a ref and the
+// API assigns a new one.
+func rulesetRuleRefs(rules []cloudflare.RulesetRule, configured []bool, prior []cloudflare.RulesetRule) []string {
+	taken := make(map[string]bool, len(rules))
+	for i, rule := range rules {
+		if configured[i] {
+			taken[rule.Ref] = true
+		}
+	}
+
+	used := make([]bool, len(prior))
+	result := make([]string, len(rules))
+	for i, rule := range rules {
+		if configured[i] {
+			result[i] = rule.Ref
+			continue
+		}
+
+		for j, p := range prior {
+			if used[j] || p.Ref == "" || taken[p.Ref] || p.Expression != rule.Expression || p.Description != rule.Description {
+				continue
+			}
+
+			used[j] = true
+			taken[p.Ref] = true
+			result[i] = p.Ref
+			break
+		}
+	}
+
+	return result
+}
+
+// buildStateFromRulesetRulesWithConfig builds the state for the ruleset rules
+// and merges in any Configuration Rules settings returned by the API.
+func buildStateFromRulesetRulesWithConfig(rulesWithConfig []rulesetRuleWithConfig) interface{} {
+	var rules []cloudflare.RulesetRule
+	for _, r := range rulesWithConfig {
+		rule := r.RulesetRule
+		if r.ActionParameters != nil {
+			if r.ActionParameters.RulesetRuleActionParameters == nil {
+				r.ActionParameters.RulesetRuleActionParameters = &cloudflare.RulesetRuleActionParameters{}
+			}
+			if overrides := r.ActionParameters.Overrides; overrides != nil {
+				if overrides.RulesetRuleActionParametersOverrides == nil {
+					overrides.RulesetRuleActionParametersOverrides = &cloudflare.RulesetRuleActionParametersOverrides{}
+				}
+				r.ActionParameters.RulesetRuleActionParameters.Overrides = overrides.RulesetRuleActionParametersOverrides
+			}
+			rule.ActionParameters = r.ActionParameters.RulesetRuleActionParameters
+		}
+		if r.RateLimit != nil {
+			rateLimit := cloudflare.RulesetRuleRateLimit(*r.RateLimit)
+			rule.RateLimit = &rateLimit
+		}
+		rules = append(rules, rule)
+	}
+
+	state := buildStateFromRulesetRules(rules)
+	rulesData, ok := state.([]map[string]interface{})
+	if !ok {
+		return state
+	}
+
+	for i, r := range rulesWithConfig {
+		if r.ActionParameters == nil {
+			continue
+		}
+
+		actionParameters, ok := rulesData[i]["action_parameters"].([]map[string]interface{})
+		if !ok || len(actionParameters) == 0 {
+			continue
+		}
+
+		settings := r.ActionParameters.rulesetConfigSettings
+		for key, field := range settings.boolSettings() {
+			if *field != nil {
+				actionParameters[0][key] = **field
+			}
+		}
+
+		if settings.Autominify != nil {
+			actionParameters[0]["autominify"] = []map[string]interface{}{{
+				"html": settings.Autominify.HTML,
+				"css":  settings.Autominify.CSS,
+				"js":   settings.Autominify.JS,
+			}}
+		}
+
+		if fromValue := r.ActionParameters.FromValue; fromValue != nil {
+			actionParameters[0]["from_value"] = []map[string]interface{}{{
+				"status_code":           fromValue.StatusCode,
+				"preserve_query_string": fromValue.PreserveQueryString,
+				"target_url": []map[string]interface{}{{
+					"value":      fromValue.TargetURL.Value,
+					"expression": fromValue.TargetURL.Expression,
+				}},
+			}}
+		}
+
+		if overrides, ok := actionParameters[0]["overrides"].([]map[string]interface{}); ok && len(overrides) > 0 && r.ActionParameters.Overrides != nil {
+			overrides[0]["sensitivity_level"] = r.ActionParameters.Overrides.SensitivityLevel
+		}
+
+		actionParameters[0]["polish"] = settings.Polish
+		actionParameters[0]["security_level"] = settings.SecurityLevel
+		actionParameters[0]["ssl"] = settings.SSL
+	}
+
+	return rulesData
+}
@@ -5,11 +5,15 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -776,6 +780,49 @@ func TestAccCloudflareRuleset_RateLimit(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRuleset_RateLimitAdvancedCharacteristics(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetRateLimitAdvancedCharacteristics(rnd, "example HTTP rate limit throttling by API key", zoneID, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_ratelimit"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "block"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.characteristics.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rules.0.ratelimit.0.characteristics.*", "cf.colo.id"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rules.0.ratelimit.0.characteristics.*", "cf.bot_management.ja3_hash"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rules.0.ratelimit.0.characteristics.*", "http.request.headers[\"x-api-key\"]"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.period", "10"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.requests_per_period", "50"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.mitigation_timeout", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.counting_expression", "(http.request.uri.path matches \"^/api/\") and (http.response.code eq 429)"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.requests_to_origin", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRuleset_RequestOrigin(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
 	// service does not yet support the API tokens and it results in
@@ -1224,6 +1271,151 @@ func TestAccCloudflareRuleset_ActionParametersHTTPDDoSOverride(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRuleset_ActionParametersHTTPDDosRulesetOverride(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetActionParametersHTTPDDosRulesetOverride(rnd, "override HTTP DDoS ruleset sensitivity", zoneID, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "override HTTP DDoS ruleset sensitivity"),
+					resource.TestCheckResourceAttr(resourceName, "phase", "ddos_l7"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "execute"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.id", "4d21379b4f9f4bb088e0729962c8b3cf"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.action", "log"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.sensitivity_level", "medium"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRuleset_ActionParametersNetworkDDoSOverride(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareRulesetActionParametersNetworkDDoSOverride(rnd, accountID, "managed_challenge"),
+				ExpectError: regexp.MustCompile(`overrides in the ddos_l4 phase can only use the actions block, log, ddos_dynamic, got "managed_challenge"`),
+			},
+			{
+				Config: testAccCheckCloudflareRulesetActionParametersNetworkDDoSOverride(rnd, accountID, "log"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "ddos_l4"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "execute"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.id", "3b64149bfa6e4220bbbc2bd6db589552"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.sensitivity_level", "low"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.categories.0.action", "log"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareRulesetActionParametersNetworkDDoSOverride(rnd, accountID, action string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    account_id  = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "root"
+    phase       = "ddos_l4"
+
+    rules {
+      action = "execute"
+      action_parameters {
+        id = "3b64149bfa6e4220bbbc2bd6db589552"
+        overrides {
+          sensitivity_level = "low"
+          categories {
+            category = "udp"
+            action   = "%[3]s"
+          }
+        }
+      }
+      expression  = "true"
+      description = "override network-layer DDoS ruleset"
+      enabled     = true
+    }
+  }`, rnd, accountID, action)
+}
+
+func TestAccCloudflareRuleset_WAFManagedRulesetOWASPLogWithScoreThreshold(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetManagedWAFOWASPLogWithScoreThreshold(rnd, "Cloudflare OWASP managed ruleset logging anomaly scores over 40", zoneID, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Cloudflare OWASP managed ruleset logging anomaly scores over 40"),
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_request_firewall_managed"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.id", "4814384a9e5d4991b9815dcfc25d2f1f"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.action", "log"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.categories.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.categories.0.category", "paranoia-level-2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.categories.0.action", "log"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.categories.0.status", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.rules.0.id", "6179ae15870a4bb7b2d480d4843b323c"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.rules.0.action", "log"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.rules.0.score_threshold", "40"),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareRulesetManagedWAFOWASPLogWithScoreThreshold(rnd, "Cloudflare OWASP managed ruleset logging anomaly scores over 40", zoneID, zoneName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRuleset_AccountLevelCustomWAFRule(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
 	// service does not yet support the API tokens and it results in
@@ -1551,6 +1743,208 @@ func TestAccCloudflareRuleset_ActionParametersOverridesThrashingStatus(t *testin
 	})
 }
 
+func TestAccCloudflareRuleset_CacheSettingsRespectOrigin(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRulesetCacheSettingsEdgeTTLMode(rnd, "my respect origin cache settings ruleset", zoneID, "respect_origin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_request_cache_settings"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "set_cache_settings"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.edge_ttl.0.mode", "respect_origin"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.edge_ttl.0.default", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.edge_ttl.0.status_code_ttl.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.edge_ttl.0.status_code_ttl.0.status_code_range.0.from", "500"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.edge_ttl.0.status_code_ttl.0.value", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.browser_ttl.0.mode", "bypass"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_key.0.custom_key.0.query_string.0.include.0", "*"),
+				),
+			},
+			{
+				Config:      testAccCloudflareRulesetCacheSettingsEdgeTTLMode(rnd, "my respect origin cache settings ruleset", zoneID, "override_origin"),
+				ExpectError: regexp.MustCompile(`edge_ttl.default must be set`),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRuleset_ConfigSettings(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRulesetConfigSettings(rnd, "my config settings ruleset", zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_config_settings"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "set_config"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.email_obfuscation", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.rocket_loader", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.bic", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.polish", "lossless"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.security_level", "high"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.ssl", "strict"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.autominify.0.html", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.autominify.0.css", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.autominify.0.js", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRuleset_RuleRefs(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	var ref string
+	captureRef := func(value string) error {
+		if ref != "" && value != ref {
+			return fmt.Errorf("expected ref %q to be kept, got %q", ref, value)
+		}
+		ref = value
+		return nil
+	}
+
+	insertedRule := `
+    rules {
+      action      = "block"
+      expression  = "(http.request.uri.path contains \"/login/\")"
+      description = "block requests to /login/"
+      enabled     = true
+    }
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRulesetRuleRefs(rnd, zoneID, "", "/api/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ref", "skip_"+rnd),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.logging.0.status", "disabled"),
+					resource.TestCheckResourceAttrWith(resourceName, "rules.1.ref", captureRef),
+				),
+			},
+			{
+				Config: testAccCloudflareRulesetRuleRefs(rnd, zoneID, insertedRule, "/api/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.0.description", "block requests to /login/"),
+					resource.TestCheckResourceAttrWith(resourceName, "rules.0.ref", func(value string) error {
+						if value == "" || value == ref || value == "skip_"+rnd {
+							return fmt.Errorf("expected the inserted rule to get a new ref, got %q", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.ref", "skip_"+rnd),
+					resource.TestCheckResourceAttrWith(resourceName, "rules.2.ref", captureRef),
+				),
+			},
+			{
+				Config:      testAccCloudflareRulesetRuleRefsLoggingConfig(rnd, zoneID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`logging can only be configured for skip rules, got "block"`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRulesetRuleRefs(rnd, zoneID, leadingRules, path string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_request_firewall_custom"
+%[3]s
+    rules {
+      ref         = "skip_%[1]s"
+      action      = "skip"
+      action_parameters {
+        ruleset = "current"
+      }
+      expression  = "(cf.client.bot)"
+      description = "skip the remaining rules for verified bots"
+      enabled     = true
+      logging {
+        status = "disabled"
+      }
+    }
+
+    rules {
+      action      = "block"
+      expression  = "(http.request.uri.path contains \"%[4]s\")"
+      description = "block requests to %[4]s"
+      enabled     = true
+    }
+  }`, rnd, zoneID, leadingRules, path)
+}
+
+func testAccCloudflareRulesetRuleRefsLoggingConfig(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_request_firewall_custom"
+
+    rules {
+      action      = "block"
+      expression  = "(http.request.uri.path contains \"/api/\")"
+      description = "block requests to /api/"
+      enabled     = true
+      logging {
+        status = "disabled"
+      }
+    }
+  }`, rnd, zoneID)
+}
+
+func TestAccCloudflareRuleset_DynamicRedirect(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRulesetDynamicRedirect(rnd, "my dynamic redirect ruleset", zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_request_dynamic_redirect"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "redirect"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.from_value.0.status_code", "301"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.from_value.0.target_url.0.value", "https://example.com/new"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.from_value.0.preserve_query_string", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.action_parameters.0.from_value.0.status_code", "302"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.action_parameters.0.from_value.0.target_url.0.expression", `concat("https://example.com", http.request.uri.path)`),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.action_parameters.0.from_value.0.preserve_query_string", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRuleset_CacheSettings(t *testing.T) {
 	t.Parallel()
 	rnd := generateRandomResourceName()
@@ -2372,6 +2766,180 @@ func testAccCheckCloudflareRulesetRateLimit(rnd, name, zoneID, zoneName string)
   }`, rnd, name, zoneID, zoneName)
 }
 
+func testAccCheckCloudflareRulesetRateLimitAdvancedCharacteristics(rnd, name, zoneID, zoneName string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id  = "%[3]s"
+    name        = "%[2]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_ratelimit"
+
+    rules {
+      action = "block"
+      ratelimit {
+        characteristics = [
+          "cf.colo.id",
+          "cf.bot_management.ja3_hash",
+          "http.request.headers[\"x-api-key\"]"
+        ]
+        period = 10
+        requests_per_period = 50
+        mitigation_timeout = 0
+        counting_expression = "(http.request.uri.path matches \"^/api/\") and (http.response.code eq 429)"
+        requests_to_origin = true
+      }
+      expression = "(http.request.uri.path matches \"^/api/\")"
+      description = "throttle API clients by API key"
+      enabled = true
+    }
+  }`, rnd, name, zoneID, zoneName)
+}
+
+func TestValidateRulesetRateLimitCharacteristic(t *testing.T) {
+	testCases := map[string]bool{
+		"cf.colo.id":                                        true,
+		"ip.src":                                            true,
+		"cf.bot_management.ja3_hash":                        true,
+		"cf.bot_management.ja4":                             true,
+		`http.request.headers["x-api-key"]`:                 true,
+		`http.request.cookies["session"]`:                   true,
+		`http.request.uri.args["page"]`:                     true,
+		`http.request.body.form["user"]`:                    true,
+		`lookup_json_string(http.request.body.raw, "user")`: true,
+		"ip.dst":                          false,
+		`http.request.headers[x-api-key]`: false,
+		`http.request.headers["x-api-key"] or ip.src eq 1.1.1.1`: false,
+	}
+
+	for value, valid := range testCases {
+		_, errs := validateRulesetRateLimitCharacteristic(value, "characteristics")
+		if valid && len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got: %v", value, errs)
+		}
+		if !valid && len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", value)
+		}
+	}
+}
+
+func TestRulesetRuleRefs(t *testing.T) {
+	rule := func(ref, expression string) cloudflare.RulesetRule {
+		return cloudflare.RulesetRule{Ref: ref, Expression: expression, Description: "rule " + expression}
+	}
+
+	testCases := []struct {
+		name       string
+		rules      []cloudflare.RulesetRule
+		configured []bool
+		prior      []cloudflare.RulesetRule
+		expected   []string
+	}{
+		{
+			name:       "new ruleset",
+			rules:      []cloudflare.RulesetRule{rule("", "a"), rule("b", "b")},
+			configured: []bool{false, true},
+			expected:   []string{"", "b"},
+		},
+		{
+			name:       "refs of unchanged rules are kept",
+			rules:      []cloudflare.RulesetRule{rule("1", "a"), rule("2", "b")},
+			configured: []bool{false, false},
+			prior:      []cloudflare.RulesetRule{rule("1", "a"), rule("2", "b")},
+			expected:   []string{"1", "2"},
+		},
+		{
+			name:       "rule inserted at the start",
+			rules:      []cloudflare.RulesetRule{rule("1", "new"), rule("2", "a"), rule("", "b")},
+			configured: []bool{false, false, false},
+			prior:      []cloudflare.RulesetRule{rule("1", "a"), rule("2", "b")},
+			expected:   []string{"", "1", "2"},
+		},
+		{
+			name:       "reordered rules",
+			rules:      []cloudflare.RulesetRule{rule("1", "b"), rule("2", "a")},
+			configured: []bool{false, false},
+			prior:      []cloudflare.RulesetRule{rule("1", "a"), rule("2", "b")},
+			expected:   []string{"2", "1"},
+		},
+		{
+			name:       "changed rule gets a new ref",
+			rules:      []cloudflare.RulesetRule{rule("1", "changed")},
+			configured: []bool{false},
+			prior:      []cloudflare.RulesetRule{rule("1", "a")},
+			expected:   []string{""},
+		},
+		{
+			name:       "configured ref wins over a carried over one",
+			rules:      []cloudflare.RulesetRule{rule("", "a"), rule("1", "b")},
+			configured: []bool{false, true},
+			prior:      []cloudflare.RulesetRule{rule("1", "a")},
+			expected:   []string{"", "1"},
+		},
+		{
+			name:       "identical rules each keep their ref",
+			rules:      []cloudflare.RulesetRule{rule("", "a"), rule("", "a"), rule("", "a")},
+			configured: []bool{false, false, false},
+			prior:      []cloudflare.RulesetRule{rule("1", "a"), rule("2", "a")},
+			expected:   []string{"1", "2", ""},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, rulesetRuleRefs(tc.rules, tc.configured, tc.prior))
+		})
+	}
+}
+
+func TestValidateRulesetRuleLogging(t *testing.T) {
+	logging := []interface{}{map[string]interface{}{"status": "disabled"}}
+
+	testCases := []struct {
+		name    string
+		action  string
+		logging []interface{}
+		wantErr bool
+	}{
+		{name: "skip rule", action: "skip", logging: logging},
+		{name: "block rule without logging", action: "block"},
+		{name: "block rule", action: "block", logging: logging, wantErr: true},
+		{name: "unknown action", action: "", logging: logging},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateRulesetRuleLogging(tc.action, tc.logging)
+			assert.Equal(t, tc.wantErr, err != nil, "error: %v", err)
+		})
+	}
+}
+
+func TestValidateRulesetPhaseActions(t *testing.T) {
+	testCases := []struct {
+		name            string
+		phase           string
+		action          string
+		overrideActions []string
+		wantErr         bool
+	}{
+		{name: "other phases are not checked", phase: "http_request_firewall_managed", action: "skip", overrideActions: []string{"managed_challenge"}},
+		{name: "HTTP DDoS challenge", phase: "ddos_l7", action: "execute", overrideActions: []string{"managed_challenge", "", "log"}},
+		{name: "HTTP DDoS skip rule", phase: "ddos_l7", action: "skip", wantErr: true},
+		{name: "HTTP DDoS invalid override", phase: "ddos_l7", action: "execute", overrideActions: []string{"rewrite"}, wantErr: true},
+		{name: "network DDoS block", phase: "ddos_l4", action: "execute", overrideActions: []string{"block", "ddos_dynamic"}},
+		{name: "network DDoS challenge", phase: "ddos_l4", action: "execute", overrideActions: []string{"managed_challenge"}, wantErr: true},
+		{name: "unknown action", phase: "ddos_l4", action: "", overrideActions: []string{""}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateRulesetPhaseActions(tc.phase, tc.action, tc.overrideActions)
+			assert.Equal(t, tc.wantErr, err != nil, "error: %v", err)
+		})
+	}
+}
+
 func testAccCheckCloudflareRulesetActionParametersOverridesActionEnabled(rnd, name, zoneID, zoneName string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_ruleset" "%[1]s" {
@@ -2493,6 +3061,67 @@ func testAccCheckCloudflareRulesetActionParametersHTTPDDosOverride(rnd, name, zo
   }`, rnd, name, zoneID, zoneName)
 }
 
+func testAccCheckCloudflareRulesetActionParametersHTTPDDosRulesetOverride(rnd, name, zoneID, zoneName string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id  = "%[3]s"
+    name        = "%[2]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "ddos_l7"
+
+    rules {
+      action = "execute"
+      action_parameters {
+        id = "4d21379b4f9f4bb088e0729962c8b3cf"
+        overrides {
+          action            = "log"
+          sensitivity_level = "medium"
+        }
+      }
+      expression = "true"
+      description = "override HTTP DDoS ruleset"
+      enabled = true
+    }
+  }`, rnd, name, zoneID, zoneName)
+}
+
+func testAccCheckCloudflareRulesetManagedWAFOWASPLogWithScoreThreshold(rnd, name, zoneID, zoneName string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id  = "%[3]s"
+    name        = "%[2]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_request_firewall_managed"
+
+    rules {
+      action = "execute"
+      action_parameters {
+        id = "4814384a9e5d4991b9815dcfc25d2f1f"
+        overrides {
+          action = "log"
+
+          categories {
+            category = "paranoia-level-2"
+            action = "log"
+            status = "enabled"
+          }
+
+          rules {
+            id = "6179ae15870a4bb7b2d480d4843b323c"
+            action = "log"
+            score_threshold = 40
+          }
+        }
+      }
+      expression = "true"
+      description = "zone"
+      enabled = true
+    }
+  }`, rnd, name, zoneID, zoneName)
+}
+
 func testAccCheckCloudflareRulesetAccountLevelCustomWAFRule(rnd, name, accountID, zoneName string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_ruleset" "%[1]s_account_custom_firewall" {
@@ -2932,3 +3561,127 @@ func testAccCloudflareRulesetCacheSettingsCustomKeyEmpty(rnd, accountID, zoneID
     }
   }`, rnd, accountID, zoneID)
 }
+
+func testAccCloudflareRulesetCacheSettingsEdgeTTLMode(rnd, name, zoneID, mode string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[3]s"
+    name        = "%[2]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_request_cache_settings"
+
+    rules {
+      action = "set_cache_settings"
+      action_parameters {
+        edge_ttl {
+          mode = "%[4]s"
+          status_code_ttl {
+            status_code_range {
+              from = 500
+            }
+            value = -1
+          }
+        }
+        browser_ttl {
+          mode = "bypass"
+        }
+        cache_key {
+          custom_key {
+            query_string {
+              include = ["*"]
+            }
+          }
+        }
+      }
+      expression  = "true"
+      description = "%[1]s set cache settings rule"
+      enabled     = true
+    }
+  }`, rnd, name, zoneID, mode)
+}
+
+func testAccCloudflareRulesetConfigSettings(rnd, name, zoneID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[3]s"
+    name        = "%[2]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_config_settings"
+
+    rules {
+      action = "set_config"
+      action_parameters {
+        email_obfuscation = false
+        rocket_loader     = true
+        bic               = true
+        polish            = "lossless"
+        security_level    = "high"
+        ssl               = "strict"
+        autominify {
+          html = true
+          css  = false
+          js   = true
+        }
+      }
+      expression  = "true"
+      description = "%[1]s set config rule"
+      enabled     = true
+    }
+  }`, rnd, name, zoneID)
+}
+
+func testAccCloudflareRulesetDynamicRedirect(rnd, name, zoneID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[3]s"
+    name        = "%[2]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_request_dynamic_redirect"
+
+    rules {
+      action = "redirect"
+      action_parameters {
+        from_value {
+          status_code = 301
+          target_url {
+            value = "https://example.com/new"
+          }
+          preserve_query_string = true
+        }
+      }
+      expression  = "(http.request.uri.path eq \"/old\")"
+      description = "%[1]s static redirect rule"
+      enabled     = true
+    }
+
+    rules {
+      action = "redirect"
+      action_parameters {
+        from_value {
+          status_code = 302
+          target_url {
+            expression = "concat(\"https://example.com\", http.request.uri.path)"
+          }
+        }
+      }
+      expression  = "(http.host eq \"old.example.com\")"
+      description = "%[1]s dynamic redirect rule"
+      enabled     = true
+    }
+  }`, rnd, name, zoneID)
+}
+
+func TestRulesetActionParameterProductsValidation(t *testing.T) {
+	validate := resourceCloudflareRulesetSchema()["rules"].Elem.(*schema.Resource).Schema["action_parameters"].Elem.(*schema.Resource).Schema["products"].Elem.(*schema.Schema).ValidateFunc
+
+	for _, product := range []string{"zoneLockdown", "uaBlock", "rateLimit", "securityLevel", "bic", "hot", "waf"} {
+		_, errs := validate(product, "products")
+		assert.Empty(t, errs, "expected %q to be valid", product)
+	}
+
+	_, errs := validate("zonelockdown", "products")
+	assert.Len(t, errs, 1)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type secondaryDNSIncoming struct {
+	Name               string   `json:"name"`
+	Peers              []string `json:"peers"`
+	AutoRefreshSeconds int      `json:"auto_refresh_seconds"`
+	SOASerial          int      `json:"soa_serial,omitempty"`
+	CheckedTime        string   `json:"checked_time,omitempty"`
+	ModifiedTime       string   `json:"modified_time,omitempty"`
+}
+
+func resourceCloudflareSecondaryDNSIncoming() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareSecondaryDNSIncomingSchema(),
+		CreateContext: resourceCloudflareSecondaryDNSIncomingCreate,
+		ReadContext:   resourceCloudflareSecondaryDNSIncomingRead,
+		UpdateContext: resourceCloudflareSecondaryDNSIncomingUpdate,
+		DeleteContext: resourceCloudflareSecondaryDNSIncomingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareSecondaryDNSIncomingImport,
+		},
+		Description: "Provides a resource to manage incoming zone transfers for a secondary zone, where Cloudflare transfers the zone from your primary nameservers.",
+	}
+}
+
+func resourceCloudflareSecondaryDNSIncomingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	incoming := buildSecondaryDNSIncoming(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Secondary DNS incoming configuration for zone %s: %+v", zoneID, incoming))
+
+	if _, err := rawRequest(ctx, client, http.MethodPost, secondaryDNSIncomingURI(zoneID), incoming); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Secondary DNS incoming configuration for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareSecondaryDNSIncomingRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSIncomingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, secondaryDNSIncomingURI(zoneID), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Secondary DNS incoming configuration for zone %s no longer exists", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Secondary DNS incoming configuration for zone %q: %w", zoneID, err))
+	}
+
+	var incoming secondaryDNSIncoming
+	if err := json.Unmarshal(res, &incoming); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Secondary DNS incoming configuration: %w", err))
+	}
+
+	d.Set("name", incoming.Name)
+	d.Set("peers", incoming.Peers)
+	d.Set("auto_refresh_seconds", incoming.AutoRefreshSeconds)
+	d.Set("soa_serial", incoming.SOASerial)
+	d.Set("checked_time", incoming.CheckedTime)
+	d.Set("modified_time", incoming.ModifiedTime)
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSIncomingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	incoming := buildSecondaryDNSIncoming(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Secondary DNS incoming configuration for zone %s: %+v", zoneID, incoming))
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, secondaryDNSIncomingURI(zoneID), incoming); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Secondary DNS incoming configuration for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareSecondaryDNSIncomingRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSIncomingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodDelete, secondaryDNSIncomingURI(zoneID), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Secondary DNS incoming configuration for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSIncomingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareSecondaryDNSIncomingRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildSecondaryDNSIncoming(d *schema.ResourceData) secondaryDNSIncoming {
+	return secondaryDNSIncoming{
+		Name:               d.Get("name").(string),
+		Peers:              expandInterfaceToStringList(d.Get("peers").(*schema.Set).List()),
+		AutoRefreshSeconds: d.Get("auto_refresh_seconds").(int),
+	}
+}
+
+func secondaryDNSIncomingURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/secondary_dns/incoming", zoneID)
+}
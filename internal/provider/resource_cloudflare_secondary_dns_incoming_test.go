@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSecondaryDNSIncoming_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_secondary_dns_incoming.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_SECONDARY_DNS_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckSecondaryDNSZone(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSecondaryDNSIncomingConfig(rnd, accountID, zoneID, domain, 86400),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "peers.#", "1"),
+					resource.TestCheckResourceAttr(name, "auto_refresh_seconds", "86400"),
+				),
+			},
+			{
+				Config: testAccCloudflareSecondaryDNSIncomingConfig(rnd, accountID, zoneID, domain, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "auto_refresh_seconds", "3600"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"checked_time", "modified_time", "soa_serial"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareSecondaryDNSIncomingConfig(rnd, accountID, zoneID, domain string, autoRefresh int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_secondary_dns_peer" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  ip         = "192.0.2.53"
+}
+
+resource "cloudflare_secondary_dns_incoming" "%[1]s" {
+  zone_id              = "%[3]s"
+  name                 = "%[4]s"
+  peers                = [cloudflare_secondary_dns_peer.%[1]s.id]
+  auto_refresh_seconds = %[5]d
+}
+`, rnd, accountID, zoneID, domain, autoRefresh)
+}
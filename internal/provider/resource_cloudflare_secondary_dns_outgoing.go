@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const secondaryDNSOutgoingStatusEnabled = "Enabled"
+
+type secondaryDNSOutgoing struct {
+	Name                string   `json:"name"`
+	Peers               []string `json:"peers"`
+	SOASerial           int      `json:"soa_serial,omitempty"`
+	LastTransferredTime string   `json:"last_transferred_time,omitempty"`
+}
+
+func resourceCloudflareSecondaryDNSOutgoing() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareSecondaryDNSOutgoingSchema(),
+		CreateContext: resourceCloudflareSecondaryDNSOutgoingCreate,
+		ReadContext:   resourceCloudflareSecondaryDNSOutgoingRead,
+		UpdateContext: resourceCloudflareSecondaryDNSOutgoingUpdate,
+		DeleteContext: resourceCloudflareSecondaryDNSOutgoingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareSecondaryDNSOutgoingImport,
+		},
+		Description: "Provides a resource to manage outgoing zone transfers, where Cloudflare is the primary and transfers the zone to your secondary nameservers.",
+	}
+}
+
+func resourceCloudflareSecondaryDNSOutgoingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	outgoing := buildSecondaryDNSOutgoing(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Secondary DNS outgoing configuration for zone %s: %+v", zoneID, outgoing))
+
+	if _, err := rawRequest(ctx, client, http.MethodPost, secondaryDNSOutgoingURI(zoneID), outgoing); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Secondary DNS outgoing configuration for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	if err := setSecondaryDNSOutgoingEnabled(ctx, client, zoneID, d.Get("enabled").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareSecondaryDNSOutgoingRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSOutgoingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, secondaryDNSOutgoingURI(zoneID), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Secondary DNS outgoing configuration for zone %s no longer exists", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Secondary DNS outgoing configuration for zone %q: %w", zoneID, err))
+	}
+
+	var outgoing secondaryDNSOutgoing
+	if err := json.Unmarshal(res, &outgoing); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Secondary DNS outgoing configuration: %w", err))
+	}
+
+	res, err = rawRequest(ctx, client, http.MethodGet, secondaryDNSOutgoingURI(zoneID)+"/status", nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Secondary DNS outgoing status for zone %q: %w", zoneID, err))
+	}
+
+	var status string
+	if err := json.Unmarshal(res, &status); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Secondary DNS outgoing status: %w", err))
+	}
+
+	d.Set("name", outgoing.Name)
+	d.Set("peers", outgoing.Peers)
+	d.Set("enabled", status == secondaryDNSOutgoingStatusEnabled)
+	d.Set("soa_serial", outgoing.SOASerial)
+	d.Set("last_transferred_time", outgoing.LastTransferredTime)
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSOutgoingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	if d.HasChanges("name", "peers") {
+		outgoing := buildSecondaryDNSOutgoing(d)
+
+		tflog.Debug(ctx, fmt.Sprintf("Updating Secondary DNS outgoing configuration for zone %s: %+v", zoneID, outgoing))
+
+		if _, err := rawRequest(ctx, client, http.MethodPut, secondaryDNSOutgoingURI(zoneID), outgoing); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Secondary DNS outgoing configuration for zone %q: %w", zoneID, err))
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := setSecondaryDNSOutgoingEnabled(ctx, client, zoneID, d.Get("enabled").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("force_notify_triggers") && d.Get("enabled").(bool) {
+		tflog.Debug(ctx, fmt.Sprintf("Forcing Secondary DNS NOTIFY for zone %s", zoneID))
+
+		if _, err := rawRequest(ctx, client, http.MethodPost, secondaryDNSOutgoingURI(zoneID)+"/force_notify", nil); err != nil {
+			return diag.FromErr(fmt.Errorf("error forcing Secondary DNS NOTIFY for zone %q: %w", zoneID, err))
+		}
+	}
+
+	return resourceCloudflareSecondaryDNSOutgoingRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSOutgoingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodDelete, secondaryDNSOutgoingURI(zoneID), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Secondary DNS outgoing configuration for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSOutgoingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareSecondaryDNSOutgoingRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setSecondaryDNSOutgoingEnabled toggles outgoing zone transfers, which are
+// managed through dedicated endpoints rather than the configuration itself.
+func setSecondaryDNSOutgoingEnabled(ctx context.Context, client *apiClient, zoneID string, enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+
+	if _, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("%s/%s", secondaryDNSOutgoingURI(zoneID), action), nil); err != nil {
+		return fmt.Errorf("failed to %s outgoing zone transfers for zone %q: %w", action, zoneID, err)
+	}
+
+	return nil
+}
+
+func buildSecondaryDNSOutgoing(d *schema.ResourceData) secondaryDNSOutgoing {
+	return secondaryDNSOutgoing{
+		Name:  d.Get("name").(string),
+		Peers: expandInterfaceToStringList(d.Get("peers").(*schema.Set).List()),
+	}
+}
+
+func secondaryDNSOutgoingURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/secondary_dns/outgoing", zoneID)
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSecondaryDNSOutgoing_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_secondary_dns_outgoing.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSecondaryDNSOutgoingConfig(rnd, accountID, zoneID, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "peers.#", "1"),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareSecondaryDNSOutgoingConfig(rnd, accountID, zoneID, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_transferred_time", "soa_serial"},
+			},
+		},
+	})
+}
+
+func TestAccCloudflareSecondaryDNSOutgoing_ForceNotify(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_secondary_dns_outgoing.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSecondaryDNSOutgoingConfigForceNotify(rnd, accountID, zoneID, domain, "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "force_notify_triggers.record", "192.0.2.1"),
+				),
+			},
+			{
+				Config: testAccCloudflareSecondaryDNSOutgoingConfigForceNotify(rnd, accountID, zoneID, domain, "192.0.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "force_notify_triggers.record", "192.0.2.2"),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareSecondaryDNSOutgoingConfigForceNotify(rnd, accountID, zoneID, domain, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_secondary_dns_peer" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  ip         = "192.0.2.53"
+}
+
+resource "cloudflare_record" "%[1]s" {
+  zone_id = "%[3]s"
+  name    = "%[1]s"
+  type    = "A"
+  value   = "%[5]s"
+}
+
+resource "cloudflare_secondary_dns_outgoing" "%[1]s" {
+  zone_id = "%[3]s"
+  name    = "%[4]s"
+  peers   = [cloudflare_secondary_dns_peer.%[1]s.id]
+
+  force_notify_triggers = {
+    record = cloudflare_record.%[1]s.value
+  }
+}
+`, rnd, accountID, zoneID, domain, value)
+}
+
+func testAccCloudflareSecondaryDNSOutgoingConfig(rnd, accountID, zoneID, domain string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_secondary_dns_peer" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  ip         = "192.0.2.53"
+}
+
+resource "cloudflare_secondary_dns_outgoing" "%[1]s" {
+  zone_id = "%[3]s"
+  name    = "%[4]s"
+  peers   = [cloudflare_secondary_dns_peer.%[1]s.id]
+  enabled = %[5]t
+}
+`, rnd, accountID, zoneID, domain, enabled)
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type secondaryDNSPeer struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name"`
+	IP         string `json:"ip,omitempty"`
+	Port       int    `json:"port,omitempty"`
+	IxfrEnable bool   `json:"ixfr_enable"`
+	TSIGID     string `json:"tsig_id,omitempty"`
+}
+
+func resourceCloudflareSecondaryDNSPeer() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareSecondaryDNSPeerSchema(),
+		CreateContext: resourceCloudflareSecondaryDNSPeerCreate,
+		ReadContext:   resourceCloudflareSecondaryDNSPeerRead,
+		UpdateContext: resourceCloudflareSecondaryDNSPeerUpdate,
+		DeleteContext: resourceCloudflareSecondaryDNSPeerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareSecondaryDNSPeerImport,
+		},
+		Description: "Provides a resource for managing Secondary DNS peers, the nameservers zones are transferred from or to.",
+	}
+}
+
+func resourceCloudflareSecondaryDNSPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Secondary DNS peer %q", name))
+
+	// Peers are created with a name only, the remaining settings are applied
+	// by the update below.
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/secondary_dns/peers", accountID), secondaryDNSPeer{Name: name})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Secondary DNS peer %q: %w", name, err))
+	}
+
+	var peer secondaryDNSPeer
+	if err := json.Unmarshal(res, &peer); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Secondary DNS peer: %w", err))
+	}
+
+	d.SetId(peer.ID)
+
+	return resourceCloudflareSecondaryDNSPeerUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSPeerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, secondaryDNSPeerURI(accountID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Secondary DNS peer %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Secondary DNS peer %q: %w", d.Id(), err))
+	}
+
+	var peer secondaryDNSPeer
+	if err := json.Unmarshal(res, &peer); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Secondary DNS peer: %w", err))
+	}
+
+	d.Set("name", peer.Name)
+	d.Set("ip", peer.IP)
+	d.Set("port", peer.Port)
+	d.Set("ixfr_enable", peer.IxfrEnable)
+	d.Set("tsig_id", peer.TSIGID)
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSPeerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	peer := secondaryDNSPeer{
+		ID:         d.Id(),
+		Name:       d.Get("name").(string),
+		IP:         d.Get("ip").(string),
+		Port:       d.Get("port").(int),
+		IxfrEnable: d.Get("ixfr_enable").(bool),
+		TSIGID:     d.Get("tsig_id").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Secondary DNS peer %s from struct: %+v", d.Id(), peer))
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, secondaryDNSPeerURI(accountID, d.Id()), peer); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Secondary DNS peer %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareSecondaryDNSPeerRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSPeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodDelete, secondaryDNSPeerURI(accountID, d.Id()), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Secondary DNS peer %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSPeerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/peerID"`, d.Id())
+	}
+
+	accountID, peerID := attributes[0], attributes[1]
+
+	d.SetId(peerID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareSecondaryDNSPeerRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func secondaryDNSPeerURI(accountID, peerID string) string {
+	return fmt.Sprintf("/accounts/%s/secondary_dns/peers/%s", accountID, peerID)
+}
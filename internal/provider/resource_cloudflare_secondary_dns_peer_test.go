@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSecondaryDNSPeer_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_secondary_dns_peer.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSecondaryDNSPeerConfig(rnd, accountID, "192.0.2.53", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "ip", "192.0.2.53"),
+					resource.TestCheckResourceAttr(name, "port", "53"),
+					resource.TestCheckResourceAttr(name, "ixfr_enable", "false"),
+					resource.TestCheckResourceAttrPair(name, "tsig_id", fmt.Sprintf("cloudflare_secondary_dns_tsig.%s", rnd), "id"),
+				),
+			},
+			{
+				Config: testAccCloudflareSecondaryDNSPeerConfig(rnd, accountID, "192.0.2.54", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ip", "192.0.2.54"),
+					resource.TestCheckResourceAttr(name, "ixfr_enable", "true"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareSecondaryDNSPeerConfig(rnd, accountID, ip string, ixfr bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_secondary_dns_tsig" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  secret     = "caf79a7804b04337c9c66ccd7bef9190a1e1679b5dd03d8aa10f7ad45e1a9dab92b417896c15d4d007c7c14194538d2a5d0feffdecc5a7f0e1c570cfa700837c"
+  algo       = "hmac-sha512."
+}
+
+resource "cloudflare_secondary_dns_peer" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  ip          = "%[3]s"
+  ixfr_enable = %[4]t
+  tsig_id     = cloudflare_secondary_dns_tsig.%[1]s.id
+}
+`, rnd, accountID, ip, ixfr)
+}
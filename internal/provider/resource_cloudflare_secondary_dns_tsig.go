@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareSecondaryDNSTSIG() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareSecondaryDNSTSIGSchema(),
+		CreateContext: resourceCloudflareSecondaryDNSTSIGCreate,
+		ReadContext:   resourceCloudflareSecondaryDNSTSIGRead,
+		UpdateContext: resourceCloudflareSecondaryDNSTSIGUpdate,
+		DeleteContext: resourceCloudflareSecondaryDNSTSIGDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareSecondaryDNSTSIGImport,
+		},
+		Description: "Provides a resource for managing TSIG keys used to authenticate zone transfers with Secondary DNS peers.",
+	}
+}
+
+func resourceCloudflareSecondaryDNSTSIGCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	tsig := buildSecondaryDNSTSIG(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Secondary DNS TSIG %q", tsig.Name))
+
+	tsig, err := client.CreateSecondaryDNSTSIG(ctx, accountID, tsig)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Secondary DNS TSIG %q: %w", d.Get("name").(string), err))
+	}
+
+	d.SetId(tsig.ID)
+
+	return resourceCloudflareSecondaryDNSTSIGRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSTSIGRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	tsig, err := client.GetSecondaryDNSTSIG(ctx, accountID, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Secondary DNS TSIG %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Secondary DNS TSIG %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", tsig.Name)
+	d.Set("secret", tsig.Secret)
+	d.Set("algo", tsig.Algo)
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSTSIGUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	tsig := buildSecondaryDNSTSIG(d)
+	tsig.ID = d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Secondary DNS TSIG %s", d.Id()))
+
+	if _, err := client.UpdateSecondaryDNSTSIG(ctx, accountID, tsig); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Secondary DNS TSIG %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareSecondaryDNSTSIGRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSTSIGDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if err := client.DeleteSecondaryDNSTSIG(ctx, accountID, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Secondary DNS TSIG %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSTSIGImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/tsigID"`, d.Id())
+	}
+
+	accountID, tsigID := attributes[0], attributes[1]
+
+	d.SetId(tsigID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareSecondaryDNSTSIGRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildSecondaryDNSTSIG(d *schema.ResourceData) cloudflare.SecondaryDNSTSIG {
+	return cloudflare.SecondaryDNSTSIG{
+		Name:   d.Get("name").(string),
+		Secret: d.Get("secret").(string),
+		Algo:   d.Get("algo").(string),
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSecondaryDNSTSIG_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_secondary_dns_tsig.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSecondaryDNSTSIGConfig(rnd, accountID, "hmac-sha512."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "algo", "hmac-sha512."),
+				),
+			},
+			{
+				Config: testAccCloudflareSecondaryDNSTSIGConfig(rnd, accountID, "hmac-sha256."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "algo", "hmac-sha256."),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareSecondaryDNSTSIGConfig(rnd, accountID, algo string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_secondary_dns_tsig" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  secret     = "caf79a7804b04337c9c66ccd7bef9190a1e1679b5dd03d8aa10f7ad45e1a9dab92b417896c15d4d007c7c14194538d2a5d0feffdecc5a7f0e1c570cfa700837c"
+  algo       = "%[3]s"
+}
+`, rnd, accountID, algo)
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type snippet struct {
+	Name       string `json:"snippet_name"`
+	CreatedOn  string `json:"created_on"`
+	ModifiedOn string `json:"modified_on"`
+}
+
+func resourceCloudflareSnippet() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareSnippetSchema(),
+		CreateContext: resourceCloudflareSnippetCreate,
+		ReadContext:   resourceCloudflareSnippetRead,
+		UpdateContext: resourceCloudflareSnippetUpdate,
+		DeleteContext: resourceCloudflareSnippetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareSnippetImport,
+		},
+		CustomizeDiff: resourceCloudflareSnippetDiff,
+		Description:   "Provides a resource to upload the code of a Cloudflare Snippet. Use `cloudflare_snippet_rules` to choose the requests it runs on.",
+	}
+}
+
+func resourceCloudflareSnippetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("name").(string))
+
+	return resourceCloudflareSnippetUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareSnippetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, snippetURI(zoneID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Snippet %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error fetching snippet %q: %w", d.Id(), err))
+	}
+
+	var s snippet
+	if err := json.Unmarshal(res, &s); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling snippet %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", s.Name)
+	d.Set("created_on", s.CreatedOn)
+	d.Set("modified_on", s.ModifiedOn)
+
+	return nil
+}
+
+func resourceCloudflareSnippetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	metadata, err := json.Marshal(map[string]interface{}{
+		"main_module": d.Get("main_module").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := writeWorkerScriptPart(writer, "metadata", "", "application/json", metadata); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, f := range d.Get("files").([]interface{}) {
+		file := f.(map[string]interface{})
+		name := file["name"].(string)
+		if err := writeWorkerScriptPart(writer, name, name, "application/javascript+module", []byte(file["content"].(string))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Uploading snippet %s", d.Id()))
+
+	_, err = rawMultipartRequest(ctx, client, http.MethodPut, snippetURI(zoneID, d.Id()), "", writer.FormDataContentType(), body.Bytes())
+	if err != nil {
+		return cloudflareErrorDiagnostics(fmt.Sprintf("error uploading snippet %q", d.Id()), err, nil)
+	}
+
+	return resourceCloudflareSnippetRead(ctx, d, meta)
+}
+
+func resourceCloudflareSnippetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, snippetURI(zoneID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting snippet %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSnippetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/snippetName"`, d.Id())
+	}
+
+	zoneID, name := attributes[0], attributes[1]
+
+	d.SetId(name)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareSnippetRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareSnippetDiff ensures main_module refers to one of the
+// uploaded files, which the API otherwise only reports when the snippet runs.
+func resourceCloudflareSnippetDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	mainModule := d.Get("main_module").(string)
+	if mainModule == "" {
+		return nil
+	}
+
+	var names []string
+	for _, f := range d.Get("files").([]interface{}) {
+		file, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := file["name"].(string)
+		if name == "" {
+			// Not known until apply.
+			return nil
+		}
+		if contains(names, name) {
+			return fmt.Errorf("file %q is declared more than once", name)
+		}
+		names = append(names, name)
+	}
+
+	if !contains(names, mainModule) {
+		return fmt.Errorf("main_module %q must be the name of one of the files", mainModule)
+	}
+
+	return nil
+}
+
+func snippetURI(zoneID, name string) string {
+	return fmt.Sprintf("/zones/%s/snippets/%s", zoneID, name)
+}
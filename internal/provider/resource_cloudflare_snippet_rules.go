@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type snippetRule struct {
+	Expression  string `json:"expression"`
+	SnippetName string `json:"snippet_name"`
+	Enabled     bool   `json:"enabled"`
+	Description string `json:"description,omitempty"`
+}
+
+func resourceCloudflareSnippetRules() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareSnippetRulesSchema(),
+		CreateContext: resourceCloudflareSnippetRulesCreate,
+		ReadContext:   resourceCloudflareSnippetRulesRead,
+		UpdateContext: resourceCloudflareSnippetRulesUpdate,
+		DeleteContext: resourceCloudflareSnippetRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareSnippetRulesImport,
+		},
+		Description: "Provides a resource to manage the rules that decide which requests of a zone run a Cloudflare Snippet. The zone only has one list of snippet rules, so only one of these resources should be declared for each zone.",
+	}
+}
+
+func resourceCloudflareSnippetRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+
+	return resourceCloudflareSnippetRulesUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareSnippetRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/zones/%s/snippets/snippet_rules", zoneID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching snippet rules for zone %q: %w", zoneID, err))
+	}
+
+	var rules []snippetRule
+	if err := json.Unmarshal(res, &rules); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling snippet rules for zone %q: %w", zoneID, err))
+	}
+
+	if err := d.Set("rules", flattenSnippetRules(rules)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting rules: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSnippetRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	rules := expandSnippetRules(d.Get("rules").([]interface{}))
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating snippet rules for zone %s: %+v", zoneID, rules))
+
+	_, err := rawRequest(ctx, client, http.MethodPut, fmt.Sprintf("/zones/%s/snippets/snippet_rules", zoneID), map[string]interface{}{"rules": rules})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating snippet rules for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareSnippetRulesRead(ctx, d, meta)
+}
+
+func resourceCloudflareSnippetRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodPut, fmt.Sprintf("/zones/%s/snippets/snippet_rules", zoneID), map[string]interface{}{"rules": []snippetRule{}})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting snippet rules for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSnippetRulesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareSnippetRulesRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandSnippetRules(rules []interface{}) []snippetRule {
+	result := make([]snippetRule, 0, len(rules))
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		result = append(result, snippetRule{
+			Expression:  rule["expression"].(string),
+			SnippetName: rule["snippet_name"].(string),
+			Enabled:     rule["enabled"].(bool),
+			Description: rule["description"].(string),
+		})
+	}
+
+	return result
+}
+
+func flattenSnippetRules(rules []snippetRule) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"expression":   rule.Expression,
+			"snippet_name": rule.SnippetName,
+			"enabled":      rule.Enabled,
+			"description":  rule.Description,
+		})
+	}
+
+	return result
+}
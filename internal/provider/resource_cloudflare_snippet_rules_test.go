@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSnippetRules_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_snippet_rules.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSnippetRulesConfig(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", zoneID),
+					resource.TestCheckResourceAttr(name, "rules.#", "1"),
+					resource.TestCheckResourceAttr(name, "rules.0.snippet_name", rnd),
+					resource.TestCheckResourceAttr(name, "rules.0.expression", `http.request.uri.path eq "/snippet"`),
+					resource.TestCheckResourceAttr(name, "rules.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareSnippetRulesConfig(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rules.0.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareSnippetRulesConfig(rnd, zoneID string, enabled bool) string {
+	return testAccCloudflareSnippetConfig(rnd, zoneID, "main.js", "x-snippet") + fmt.Sprintf(`
+resource "cloudflare_snippet_rules" "%[1]s" {
+  zone_id = "%[2]s"
+
+  rules {
+    expression   = "http.request.uri.path eq \"/snippet\""
+    snippet_name = cloudflare_snippet.%[1]s.name
+    enabled      = %[3]t
+    description  = "Add a header to /snippet"
+  }
+}
+`, rnd, zoneID, enabled)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSnippet_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_snippet.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSnippetConfig(rnd, zoneID, "main.js", "x-snippet"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", rnd),
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "main_module", "main.js"),
+					resource.TestCheckResourceAttr(name, "files.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "created_on"),
+				),
+			},
+			{
+				Config: testAccCloudflareSnippetConfig(rnd, zoneID, "main.js", "x-snippet-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "files.0.content", testAccCloudflareSnippetContent("x-snippet-updated")+"\n"),
+				),
+			},
+			{
+				Config:      testAccCloudflareSnippetConfig(rnd, zoneID, "missing.js", "x-snippet-updated"),
+				ExpectError: regexp.MustCompile(`must be the name of one of the files`),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"main_module", "files"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareSnippetContent(header string) string {
+	return fmt.Sprintf(`export default { async fetch(request) { const response = await fetch(request); const r = new Response(response.body, response); r.headers.set("%s", "1"); return r; } }`, header)
+}
+
+func testAccCloudflareSnippetConfig(rnd, zoneID, mainModule, header string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_snippet" "%[1]s" {
+  zone_id     = "%[2]s"
+  name        = "%[1]s"
+  main_module = "%[3]s"
+
+  files {
+    name    = "main.js"
+    content = <<EOT
+%[4]s
+EOT
+  }
+}
+`, rnd, zoneID, mainModule, testAccCloudflareSnippetContent(header))
+}
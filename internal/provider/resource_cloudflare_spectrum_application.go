@@ -27,7 +27,7 @@ func resourceCloudflareSpectrumApplication() *schema.Resource {
 }
 
 func resourceCloudflareSpectrumApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	newSpectrumApp := applicationFromResource(d)
 	zoneID := d.Get("zone_id").(string)
@@ -51,7 +51,7 @@ func resourceCloudflareSpectrumApplicationCreate(ctx context.Context, d *schema.
 }
 
 func resourceCloudflareSpectrumApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	application := applicationFromResource(d)
@@ -67,7 +67,7 @@ func resourceCloudflareSpectrumApplicationUpdate(ctx context.Context, d *schema.
 }
 
 func resourceCloudflareSpectrumApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	applicationID := d.Id()
 
@@ -132,7 +132,7 @@ func resourceCloudflareSpectrumApplicationRead(ctx context.Context, d *schema.Re
 }
 
 func resourceCloudflareSpectrumApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	applicationID := d.Id()
 
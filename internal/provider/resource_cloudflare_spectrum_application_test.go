@@ -187,7 +187,7 @@ func TestAccCloudflareSpectrumApplication_Update(t *testing.T) {
 }
 
 func testAccCheckCloudflareSpectrumApplicationDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_spectrum_application" {
@@ -261,7 +261,7 @@ func testAccCheckCloudflareSpectrumApplicationExists(n string, spectrumApp *clou
 			return fmt.Errorf("No Load Balancer ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundSpectrumApplication, err := client.SpectrumApplication(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
 		if err != nil {
 			return err
@@ -298,7 +298,7 @@ func testAccCheckCloudflareSpectrumApplicationIDIsValid(n string) resource.TestC
 func testAccManuallyDeleteSpectrumApplication(name string, spectrumApp *cloudflare.SpectrumApplication, initialID *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, _ := s.RootModule().Resources[name]
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		*initialID = spectrumApp.ID
 		err := client.DeleteSpectrumApplication(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
 		if err != nil {
@@ -25,7 +25,7 @@ func resourceCloudflareSplitTunnel() *schema.Resource {
 }
 
 func resourceCloudflareSplitTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	mode := d.Get("mode").(string)
 
@@ -42,7 +42,7 @@ func resourceCloudflareSplitTunnelRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareSplitTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	mode := d.Get("mode").(string)
 
@@ -66,7 +66,7 @@ func resourceCloudflareSplitTunnelUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareSplitTunnelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	mode := d.Get("mode").(string)
 
@@ -26,7 +26,7 @@ func resourceCloudflareStaticRoute() *schema.Resource {
 }
 
 func resourceCloudflareStaticRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	newStaticRoute, err := client.CreateMagicTransitStaticRoute(ctx, accountID, staticRouteFromResource(d))
@@ -57,7 +57,7 @@ func resourceCloudflareStaticRouteImport(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareStaticRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	staticRoute, err := client.GetMagicTransitStaticRoute(ctx, accountID, d.Id())
@@ -91,7 +91,7 @@ func resourceCloudflareStaticRouteRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareStaticRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	_, err := client.UpdateMagicTransitStaticRoute(ctx, accountID, d.Id(), staticRouteFromResource(d))
@@ -103,7 +103,7 @@ func resourceCloudflareStaticRouteUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareStaticRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Static Route:  %s", d.Id()))
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type streamVideo struct {
+	UID                   string                 `json:"uid,omitempty"`
+	URL                   string                 `json:"url,omitempty"`
+	Meta                  map[string]interface{} `json:"meta,omitempty"`
+	AllowedOrigins        []string               `json:"allowedOrigins"`
+	RequireSignedURLs     bool                   `json:"requireSignedURLs"`
+	ThumbnailTimestampPct float64                `json:"thumbnailTimestampPct"`
+	ReadyToStream         bool                   `json:"readyToStream,omitempty"`
+	Duration              float64                `json:"duration,omitempty"`
+	Preview               string                 `json:"preview,omitempty"`
+	Thumbnail             string                 `json:"thumbnail,omitempty"`
+	Status                *streamVideoState      `json:"status,omitempty"`
+}
+
+type streamVideoState struct {
+	State string `json:"state"`
+}
+
+func resourceCloudflareStream() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareStreamSchema(),
+		CreateContext: resourceCloudflareStreamCreate,
+		ReadContext:   resourceCloudflareStreamRead,
+		UpdateContext: resourceCloudflareStreamUpdate,
+		DeleteContext: resourceCloudflareStreamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareStreamImport,
+		},
+		Description: "Provides a resource for managing a Cloudflare Stream video which is copied from a URL.",
+	}
+}
+
+func resourceCloudflareStreamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	video := buildStreamVideo(d)
+	video.URL = d.Get("url").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Copying Stream video from struct: %+v", video))
+
+	v, err := streamVideoRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/stream/copy", accountID), video)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Stream video from %q: %w", video.URL, err))
+	}
+
+	d.SetId(v.UID)
+
+	return resourceCloudflareStreamRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	v, err := streamVideoRequest(ctx, client, http.MethodGet, streamVideoURI(accountID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Stream video %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Stream video %q: %w", d.Id(), err))
+	}
+
+	name, _ := v.Meta["name"].(string)
+	d.Set("name", name)
+	d.Set("allowed_origins", v.AllowedOrigins)
+	d.Set("require_signed_urls", v.RequireSignedURLs)
+	d.Set("thumbnail_timestamp_pct", v.ThumbnailTimestampPct)
+	d.Set("ready_to_stream", v.ReadyToStream)
+	d.Set("duration", v.Duration)
+	d.Set("preview", v.Preview)
+	d.Set("thumbnail", v.Thumbnail)
+
+	if v.Status != nil {
+		d.Set("status", v.Status.State)
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	video := buildStreamVideo(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Stream video %s from struct: %+v", d.Id(), video))
+
+	_, err := streamVideoRequest(ctx, client, http.MethodPost, streamVideoURI(accountID, d.Id()), video)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Stream video %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareStreamRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, streamVideoURI(accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Stream video %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/videoID"`, d.Id())
+	}
+
+	accountID, videoID := attributes[0], attributes[1]
+
+	d.SetId(videoID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareStreamRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildStreamVideo(d *schema.ResourceData) streamVideo {
+	video := streamVideo{
+		AllowedOrigins:        expandInterfaceToStringList(d.Get("allowed_origins").(*schema.Set).List()),
+		RequireSignedURLs:     d.Get("require_signed_urls").(bool),
+		ThumbnailTimestampPct: d.Get("thumbnail_timestamp_pct").(float64),
+	}
+
+	if name, ok := d.GetOk("name"); ok {
+		video.Meta = map[string]interface{}{"name": name.(string)}
+	}
+
+	return video
+}
+
+func streamVideoURI(accountID, videoID string) string {
+	return fmt.Sprintf("/accounts/%s/stream/%s", accountID, videoID)
+}
+
+func streamVideoRequest(ctx context.Context, client *apiClient, method, uri string, body interface{}) (streamVideo, error) {
+	var v streamVideo
+
+	res, err := rawRequest(ctx, client, method, uri, body)
+	if err != nil {
+		return v, err
+	}
+
+	if err := json.Unmarshal(res, &v); err != nil {
+		return v, fmt.Errorf("error unmarshalling Stream video: %w", err)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type streamAudioTrack struct {
+	UID     string `json:"uid,omitempty"`
+	URL     string `json:"url,omitempty"`
+	Label   string `json:"label"`
+	Default *bool  `json:"default,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
+
+func resourceCloudflareStreamAudioTrack() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareStreamAudioTrackSchema(),
+		CreateContext: resourceCloudflareStreamAudioTrackCreate,
+		ReadContext:   resourceCloudflareStreamAudioTrackRead,
+		UpdateContext: resourceCloudflareStreamAudioTrackUpdate,
+		DeleteContext: resourceCloudflareStreamAudioTrackDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareStreamAudioTrackImport,
+		},
+		Description: "Provides a resource for managing an additional audio track of a Cloudflare Stream video, copied from a URL.",
+	}
+}
+
+func resourceCloudflareStreamAudioTrackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	videoID := d.Get("video_id").(string)
+
+	track := streamAudioTrack{
+		URL:   d.Get("url").(string),
+		Label: d.Get("label").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Copying Stream audio track from struct: %+v", track))
+
+	t, err := streamAudioTrackRequest(ctx, client, http.MethodPost, fmt.Sprintf("%s/audio/copy", streamVideoURI(accountID, videoID)), track)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Stream audio track from %q: %w", track.URL, err))
+	}
+
+	d.SetId(t.UID)
+
+	// Tracks cannot be copied as the default one, only updated to be.
+	if d.Get("default").(bool) {
+		return resourceCloudflareStreamAudioTrackUpdate(ctx, d, meta)
+	}
+
+	return resourceCloudflareStreamAudioTrackRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamAudioTrackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	videoID := d.Get("video_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("%s/audio", streamVideoURI(accountID, videoID)), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Stream video %s no longer exists", videoID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error listing audio tracks of Stream video %q: %w", videoID, err))
+	}
+
+	var tracks []streamAudioTrack
+	if err := json.Unmarshal(res, &tracks); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Stream audio tracks: %w", err))
+	}
+
+	for _, t := range tracks {
+		if t.UID != d.Id() {
+			continue
+		}
+
+		d.Set("label", t.Label)
+		d.Set("default", t.Default != nil && *t.Default)
+		d.Set("status", t.Status)
+
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Stream audio track %s no longer exists", d.Id()))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareStreamAudioTrackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	videoID := d.Get("video_id").(string)
+
+	track := streamAudioTrack{
+		Label:   d.Get("label").(string),
+		Default: cloudflare.BoolPtr(d.Get("default").(bool)),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Stream audio track %s from struct: %+v", d.Id(), track))
+
+	_, err := streamAudioTrackRequest(ctx, client, http.MethodPatch, streamAudioTrackURI(accountID, videoID, d.Id()), track)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Stream audio track %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareStreamAudioTrackRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamAudioTrackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	videoID := d.Get("video_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, streamAudioTrackURI(accountID, videoID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting Stream audio track %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamAudioTrackImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/videoID/audioTrackID"`, d.Id())
+	}
+
+	accountID, videoID, trackID := attributes[0], attributes[1], attributes[2]
+
+	d.SetId(trackID)
+	d.Set("account_id", accountID)
+	d.Set("video_id", videoID)
+
+	resourceCloudflareStreamAudioTrackRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func streamAudioTrackURI(accountID, videoID, trackID string) string {
+	return fmt.Sprintf("%s/audio/%s", streamVideoURI(accountID, videoID), trackID)
+}
+
+func streamAudioTrackRequest(ctx context.Context, client *apiClient, method, uri string, body interface{}) (streamAudioTrack, error) {
+	var t streamAudioTrack
+
+	res, err := rawRequest(ctx, client, method, uri, body)
+	if err != nil {
+		return t, err
+	}
+
+	if err := json.Unmarshal(res, &t); err != nil {
+		return t, fmt.Errorf("error unmarshalling Stream audio track: %w", err)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareStreamAudioTrack_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_stream_audio_track.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareStreamAudioTrackConfig(rnd, accountID, "Français", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "label", "Français"),
+					resource.TestCheckResourceAttr(name, "default", "false"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+				),
+			},
+			{
+				Config: testAccCloudflareStreamAudioTrackConfig(rnd, accountID, "French", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "label", "French"),
+					resource.TestCheckResourceAttr(name, "default", "true"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccCloudflareStreamChildImportStateIdFunc(name, accountID),
+				ImportStateVerifyIgnore: []string{"url"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareStreamAudioTrackConfig(rnd, accountID, label string, isDefault bool) string {
+	return testAccCloudflareStreamConfig(rnd, accountID, false) + fmt.Sprintf(`
+resource "cloudflare_stream_audio_track" "%[1]s" {
+  account_id = "%[2]s"
+  video_id   = cloudflare_stream.%[1]s.id
+  url        = "https://storage.googleapis.com/stream-example-bucket/audio.mp3"
+  label      = "%[3]s"
+  default    = %[4]t
+}
+`, rnd, accountID, label, isDefault)
+}
+
+// testAccCloudflareStreamChildImportStateIdFunc returns the import ID of a
+// resource that belongs to a Stream video.
+func testAccCloudflareStreamChildImportStateIdFunc(name, accountID string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", accountID, rs.Primary.Attributes["video_id"], rs.Primary.ID), nil
+	}
+}
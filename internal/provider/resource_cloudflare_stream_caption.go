@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type streamCaption struct {
+	Label    string `json:"label"`
+	Language string `json:"language"`
+}
+
+func resourceCloudflareStreamCaption() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareStreamCaptionSchema(),
+		CreateContext: resourceCloudflareStreamCaptionCreate,
+		ReadContext:   resourceCloudflareStreamCaptionRead,
+		UpdateContext: resourceCloudflareStreamCaptionUpdate,
+		DeleteContext: resourceCloudflareStreamCaptionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareStreamCaptionImport,
+		},
+		Description: "Provides a resource for managing the captions of a Cloudflare Stream video in one language.",
+	}
+}
+
+func resourceCloudflareStreamCaptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("language").(string))
+
+	return resourceCloudflareStreamCaptionUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareStreamCaptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	videoID := d.Get("video_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("%s/captions", streamVideoURI(accountID, videoID)), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Stream video %s no longer exists", videoID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error listing captions of Stream video %q: %w", videoID, err))
+	}
+
+	var captions []streamCaption
+	if err := json.Unmarshal(res, &captions); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Stream captions: %w", err))
+	}
+
+	for _, caption := range captions {
+		if caption.Language == d.Id() {
+			d.Set("language", caption.Language)
+			d.Set("label", caption.Label)
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Captions %s of Stream video %s no longer exist", d.Id(), videoID))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareStreamCaptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	videoID := d.Get("video_id").(string)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writeWorkerScriptPart(writer, "file", d.Id()+".vtt", "text/vtt", []byte(d.Get("content").(string))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := writer.Close(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Uploading captions %s of Stream video %s", d.Id(), videoID))
+
+	_, err := rawMultipartRequest(ctx, client, http.MethodPut, streamCaptionURI(accountID, videoID, d.Id()), "", writer.FormDataContentType(), body.Bytes())
+	if err != nil {
+		return cloudflareErrorDiagnostics(fmt.Sprintf("error uploading captions %q of Stream video %q", d.Id(), videoID), err, nil)
+	}
+
+	return resourceCloudflareStreamCaptionRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamCaptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+	videoID := d.Get("video_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, streamCaptionURI(accountID, videoID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting captions %q of Stream video %q: %w", d.Id(), videoID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamCaptionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/videoID/language"`, d.Id())
+	}
+
+	accountID, videoID, language := attributes[0], attributes[1], attributes[2]
+
+	d.SetId(language)
+	d.Set("account_id", accountID)
+	d.Set("video_id", videoID)
+
+	resourceCloudflareStreamCaptionRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func streamCaptionURI(accountID, videoID, language string) string {
+	return fmt.Sprintf("%s/captions/%s", streamVideoURI(accountID, videoID), language)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareStreamCaption_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_stream_caption.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareStreamCaptionConfig(rnd, accountID, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "language", "en"),
+					resource.TestCheckResourceAttrSet(name, "label"),
+					resource.TestCheckResourceAttrPair(name, "video_id", "cloudflare_stream."+rnd, "id"),
+				),
+			},
+			{
+				Config: testAccCloudflareStreamCaptionConfig(rnd, accountID, "Hello again"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "content", "WEBVTT\n\n00:00.000 --> 00:02.000\nHello again\n"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccCloudflareStreamChildImportStateIdFunc(name, accountID),
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareStreamCaptionConfig(rnd, accountID, text string) string {
+	return testAccCloudflareStreamConfig(rnd, accountID, false) + fmt.Sprintf(`
+resource "cloudflare_stream_caption" "%[1]s" {
+  account_id = "%[2]s"
+  video_id   = cloudflare_stream.%[1]s.id
+  language   = "en"
+  content    = <<EOT
+WEBVTT
+
+00:00.000 --> 00:02.000
+%[3]s
+EOT
+}
+`, rnd, accountID, text)
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type streamKey struct {
+	ID      string `json:"id"`
+	PEM     string `json:"pem,omitempty"`
+	JWK     string `json:"jwk,omitempty"`
+	Created string `json:"created"`
+}
+
+func resourceCloudflareStreamKey() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareStreamKeySchema(),
+		CreateContext: resourceCloudflareStreamKeyCreate,
+		ReadContext:   resourceCloudflareStreamKeyRead,
+		DeleteContext: resourceCloudflareStreamKeyDelete,
+		Description: "Provides a resource for managing signing keys used to generate signed URLs for Cloudflare Stream. " +
+			"The private key material is only returned when the key is created and is stored in the Terraform state.",
+	}
+}
+
+func resourceCloudflareStreamKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/stream/keys", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Stream signing key: %w", err))
+	}
+
+	var key streamKey
+	if err := json.Unmarshal(res, &key); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Stream signing key: %w", err))
+	}
+
+	d.SetId(key.ID)
+	d.Set("pem", key.PEM)
+	d.Set("jwk", key.JWK)
+
+	return resourceCloudflareStreamKeyRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/stream/keys", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Stream signing keys: %w", err))
+	}
+
+	var keys []streamKey
+	if err := json.Unmarshal(res, &keys); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Stream signing keys: %w", err))
+	}
+
+	for _, key := range keys {
+		if key.ID == d.Id() {
+			d.Set("created", key.Created)
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Stream signing key %s no longer exists", d.Id()))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareStreamKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, fmt.Sprintf("/accounts/%s/stream/keys/%s", accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Stream signing key %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var streamLiveInputRecordingModes = []string{"off", "automatic"}
+
+type streamLiveInput struct {
+	UID                      string                   `json:"uid,omitempty"`
+	Meta                     map[string]interface{}   `json:"meta,omitempty"`
+	Recording                streamLiveInputRecording `json:"recording"`
+	DeleteRecordingAfterDays *int                     `json:"deleteRecordingAfterDays"`
+	RTMPS                    *streamLiveInputRTMPS    `json:"rtmps,omitempty"`
+	SRT                      *streamLiveInputSRT      `json:"srt,omitempty"`
+	WebRTC                   *streamLiveInputWebRTC   `json:"webRTC,omitempty"`
+}
+
+type streamLiveInputRecording struct {
+	Mode              string   `json:"mode"`
+	TimeoutSeconds    int      `json:"timeoutSeconds"`
+	RequireSignedURLs bool     `json:"requireSignedURLs"`
+	AllowedOrigins    []string `json:"allowedOrigins"`
+}
+
+type streamLiveInputRTMPS struct {
+	URL       string `json:"url"`
+	StreamKey string `json:"streamKey"`
+}
+
+type streamLiveInputSRT struct {
+	URL        string `json:"url"`
+	StreamID   string `json:"streamId"`
+	Passphrase string `json:"passphrase"`
+}
+
+type streamLiveInputWebRTC struct {
+	URL string `json:"url"`
+}
+
+func resourceCloudflareStreamLiveInput() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareStreamLiveInputSchema(),
+		CreateContext: resourceCloudflareStreamLiveInputCreate,
+		ReadContext:   resourceCloudflareStreamLiveInputRead,
+		UpdateContext: resourceCloudflareStreamLiveInputUpdate,
+		DeleteContext: resourceCloudflareStreamLiveInputDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareStreamLiveInputImport,
+		},
+		Description: "Provides a resource for managing Cloudflare Stream live inputs.",
+	}
+}
+
+func resourceCloudflareStreamLiveInputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	input := buildStreamLiveInput(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Stream live input from struct: %+v", input))
+
+	li, err := streamLiveInputRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/stream/live_inputs", accountID), input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Stream live input: %w", err))
+	}
+
+	d.SetId(li.UID)
+
+	return resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamLiveInputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	li, err := streamLiveInputRequest(ctx, client, http.MethodGet, streamLiveInputURI(accountID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Stream live input %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Stream live input %q: %w", d.Id(), err))
+	}
+
+	name, _ := li.Meta["name"].(string)
+	d.Set("name", name)
+	d.Set("recording_mode", li.Recording.Mode)
+	d.Set("recording_timeout_seconds", li.Recording.TimeoutSeconds)
+	d.Set("recording_require_signed_urls", li.Recording.RequireSignedURLs)
+	d.Set("recording_allowed_origins", li.Recording.AllowedOrigins)
+
+	if li.DeleteRecordingAfterDays != nil {
+		d.Set("delete_recording_after_days", *li.DeleteRecordingAfterDays)
+	} else {
+		d.Set("delete_recording_after_days", nil)
+	}
+
+	if li.RTMPS != nil {
+		d.Set("rtmps_url", li.RTMPS.URL)
+		d.Set("rtmps_stream_key", li.RTMPS.StreamKey)
+	}
+
+	if li.SRT != nil {
+		d.Set("srt_url", li.SRT.URL)
+		d.Set("srt_stream_id", li.SRT.StreamID)
+		d.Set("srt_passphrase", li.SRT.Passphrase)
+	}
+
+	if li.WebRTC != nil {
+		d.Set("webrtc_url", li.WebRTC.URL)
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamLiveInputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	input := buildStreamLiveInput(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Stream live input %s from struct: %+v", d.Id(), input))
+
+	_, err := streamLiveInputRequest(ctx, client, http.MethodPut, streamLiveInputURI(accountID, d.Id()), input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Stream live input %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamLiveInputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, streamLiveInputURI(accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Stream live input %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamLiveInputImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/liveInputID"`, d.Id())
+	}
+
+	accountID, liveInputID := attributes[0], attributes[1]
+
+	d.SetId(liveInputID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildStreamLiveInput(d *schema.ResourceData) streamLiveInput {
+	input := streamLiveInput{
+		Recording: streamLiveInputRecording{
+			Mode:              d.Get("recording_mode").(string),
+			TimeoutSeconds:    d.Get("recording_timeout_seconds").(int),
+			RequireSignedURLs: d.Get("recording_require_signed_urls").(bool),
+			AllowedOrigins:    expandInterfaceToStringList(d.Get("recording_allowed_origins").(*schema.Set).List()),
+		},
+	}
+
+	if name, ok := d.GetOk("name"); ok {
+		input.Meta = map[string]interface{}{"name": name.(string)}
+	}
+
+	if days, ok := d.GetOk("delete_recording_after_days"); ok {
+		input.DeleteRecordingAfterDays = cloudflare.IntPtr(days.(int))
+	}
+
+	return input
+}
+
+func streamLiveInputURI(accountID, liveInputID string) string {
+	return fmt.Sprintf("/accounts/%s/stream/live_inputs/%s", accountID, liveInputID)
+}
+
+func streamLiveInputRequest(ctx context.Context, client *apiClient, method, uri string, body interface{}) (streamLiveInput, error) {
+	var li streamLiveInput
+
+	res, err := rawRequest(ctx, client, method, uri, body)
+	if err != nil {
+		return li, err
+	}
+
+	if err := json.Unmarshal(res, &li); err != nil {
+		return li, fmt.Errorf("error unmarshalling Stream live input: %w", err)
+	}
+
+	return li, nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareStreamLiveInput_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_stream_live_input.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareStreamLiveInputConfig(rnd, accountID, "off", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "recording_mode", "off"),
+					resource.TestCheckResourceAttrSet(name, "rtmps_url"),
+					resource.TestCheckResourceAttrSet(name, "rtmps_stream_key"),
+				),
+			},
+			{
+				Config: testAccCloudflareStreamLiveInputConfig(rnd, accountID, "automatic", 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "recording_mode", "automatic"),
+					resource.TestCheckResourceAttr(name, "recording_timeout_seconds", "30"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareStreamLiveInputConfig(rnd, accountID, mode string, timeout int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_stream_live_input" "%[1]s" {
+  account_id                = "%[2]s"
+  name                      = "%[1]s"
+  recording_mode            = "%[3]s"
+  recording_timeout_seconds = %[4]d
+}
+`, rnd, accountID, mode, timeout)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareStream_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_stream.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareStreamConfig(rnd, accountID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "allowed_origins.#", "1"),
+					resource.TestCheckResourceAttr(name, "require_signed_urls", "false"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+				),
+			},
+			{
+				Config: testAccCloudflareStreamConfig(rnd, accountID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "require_signed_urls", "true"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", accountID),
+				ImportStateVerifyIgnore: []string{"url"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareStreamConfig(rnd, accountID string, signed bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_stream" "%[1]s" {
+  account_id          = "%[2]s"
+  url                 = "https://storage.googleapis.com/stream-example-bucket/video.mp4"
+  name                = "%[1]s"
+  allowed_origins     = ["example.com"]
+  require_signed_urls = %[3]t
+}
+`, rnd, accountID, signed)
+}
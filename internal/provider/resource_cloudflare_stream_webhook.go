@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type streamWebhook struct {
+	NotificationURL string `json:"notificationUrl"`
+	Secret          string `json:"secret,omitempty"`
+}
+
+func resourceCloudflareStreamWebhook() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareStreamWebhookSchema(),
+		CreateContext: resourceCloudflareStreamWebhookUpdate,
+		ReadContext:   resourceCloudflareStreamWebhookRead,
+		UpdateContext: resourceCloudflareStreamWebhookUpdate,
+		DeleteContext: resourceCloudflareStreamWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareStreamWebhookImport,
+		},
+		Description: "Provides a resource for managing the Cloudflare Stream webhook of an account. An account has a single webhook.",
+	}
+}
+
+func resourceCloudflareStreamWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodPut, streamWebhookURI(accountID), streamWebhook{NotificationURL: d.Get("notification_url").(string)})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting Stream webhook: %w", err))
+	}
+
+	d.SetId(accountID)
+
+	return resourceCloudflareStreamWebhookRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, streamWebhookURI(d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Stream webhook for account %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Stream webhook: %w", err))
+	}
+
+	var webhook streamWebhook
+	if err := json.Unmarshal(res, &webhook); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Stream webhook: %w", err))
+	}
+
+	d.Set("account_id", d.Id())
+	d.Set("notification_url", webhook.NotificationURL)
+	d.Set("secret", webhook.Secret)
+
+	return nil
+}
+
+func resourceCloudflareStreamWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, streamWebhookURI(d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Stream webhook: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamWebhookImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceCloudflareStreamWebhookRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func streamWebhookURI(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/stream/webhook", accountID)
+}
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -26,7 +28,7 @@ func resourceCloudflareTeamsAccount() *schema.Resource {
 }
 
 func resourceCloudflareTeamsAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	configuration, err := client.TeamsAccountConfiguration(ctx, accountID)
@@ -73,6 +75,17 @@ func resourceCloudflareTeamsAccountRead(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	extendedEmailMatching, err := teamsAccountExtendedEmailMatching(ctx, client, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Teams Account extended email matching %q: %w", d.Id(), err))
+	}
+
+	if extendedEmailMatching != nil {
+		if err := d.Set("extended_email_matching_enabled", *extendedEmailMatching); err != nil {
+			return diag.FromErr(fmt.Errorf("error parsing account extended email matching enablement: %w", err))
+		}
+	}
+
 	logSettings, err := client.TeamsAccountLoggingConfiguration(ctx, accountID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error finding Teams Account log settings %q: %w", d.Id(), err))
@@ -97,7 +110,7 @@ func resourceCloudflareTeamsAccountRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareTeamsAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	blockPageConfig := inflateBlockPageConfig(d.Get("block_page"))
 	fipsConfig := inflateFIPSConfig(d.Get("fips"))
@@ -136,6 +149,21 @@ func resourceCloudflareTeamsAccountUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf("error updating Teams Account configuration for account %q: %w", accountID, err))
 	}
 
+	// The library has no support for extended email matching, so it is
+	// patched in after the configuration update which would otherwise reset it.
+	//nolint:staticcheck
+	extendedEmailMatching, ok := d.GetOkExists("extended_email_matching_enabled")
+	if ok {
+		settings := map[string]interface{}{
+			"settings": map[string]interface{}{
+				"extended_email_matching": map[string]interface{}{"enabled": extendedEmailMatching.(bool)},
+			},
+		}
+		if _, err := rawRequest(ctx, client, http.MethodPatch, fmt.Sprintf("/accounts/%s/gateway/configuration", accountID), settings); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Teams Account extended email matching for account %q: %w", accountID, err))
+		}
+	}
+
 	if loggingConfig != nil {
 		if _, err := client.TeamsAccountUpdateLoggingConfiguration(ctx, accountID, *loggingConfig); err != nil {
 			return diag.FromErr(fmt.Errorf("error updating Teams Account logging settings for account %q: %w", accountID, err))
@@ -161,6 +189,32 @@ func resourceCloudflareTeamsAccountImport(ctx context.Context, d *schema.Resourc
 	return []*schema.ResourceData{d}, nil
 }
 
+// teamsAccountExtendedEmailMatching returns whether extended email matching
+// is enabled for the account, or nil when the account has no such setting.
+func teamsAccountExtendedEmailMatching(ctx context.Context, client *apiClient, accountID string) (*bool, error) {
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/gateway/configuration", accountID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var configuration struct {
+		Settings struct {
+			ExtendedEmailMatching *struct {
+				Enabled bool `json:"enabled"`
+			} `json:"extended_email_matching"`
+		} `json:"settings"`
+	}
+	if err := json.Unmarshal(res, &configuration); err != nil {
+		return nil, fmt.Errorf("error unmarshalling Teams Account configuration: %w", err)
+	}
+
+	if configuration.Settings.ExtendedEmailMatching == nil {
+		return nil, nil
+	}
+
+	return &configuration.Settings.ExtendedEmailMatching.Enabled, nil
+}
+
 func flattenBlockPageConfig(blockPage *cloudflare.TeamsBlockPage) []interface{} {
 	return []interface{}{map[string]interface{}{
 		"enabled":          *blockPage.Enabled,
@@ -34,6 +34,7 @@ func TestAccCloudflareTeamsAccountConfigurationBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "account_id", accountID),
 					resource.TestCheckResourceAttr(name, "tls_decrypt_enabled", "true"),
 					resource.TestCheckResourceAttr(name, "activity_log_enabled", "true"),
+					resource.TestCheckResourceAttr(name, "extended_email_matching_enabled", "true"),
 					resource.TestCheckResourceAttr(name, "fips.0.tls", "true"),
 					resource.TestCheckResourceAttr(name, "block_page.0.name", rnd),
 					resource.TestCheckResourceAttr(name, "block_page.0.enabled", "true"),
@@ -62,6 +63,7 @@ resource "cloudflare_teams_account" "%[1]s" {
   account_id = "%[2]s"
   tls_decrypt_enabled = true
   activity_log_enabled = true
+  extended_email_matching_enabled = true
   block_page {
     name = "%[1]s"
     enabled = true
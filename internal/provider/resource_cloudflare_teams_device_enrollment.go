@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// teamsDeviceEnrollmentAppName is the name the dashboard uses for the Access
+// application that holds the device enrollment permissions.
+const teamsDeviceEnrollmentAppName = "Warp Login App"
+
+func resourceCloudflareTeamsDeviceEnrollment() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareTeamsDeviceEnrollmentSchema(),
+		CreateContext: resourceCloudflareTeamsDeviceEnrollmentCreate,
+		ReadContext:   resourceCloudflareTeamsDeviceEnrollmentRead,
+		UpdateContext: resourceCloudflareTeamsDeviceEnrollmentUpdate,
+		DeleteContext: resourceCloudflareTeamsDeviceEnrollmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTeamsDeviceEnrollmentImport,
+		},
+		Description: "Provides a Cloudflare Teams resource for managing the device enrollment permissions of an account. " +
+			"Enrollment permissions are stored in a dedicated Access application; rules controlling who may enroll " +
+			"devices are defined with `cloudflare_access_policy` resources referencing `application_id`. " +
+			"An existing enrollment application is adopted on create and removed on destroy.",
+	}
+}
+
+func resourceCloudflareTeamsDeviceEnrollmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	app, err := findTeamsDeviceEnrollmentApplication(ctx, client, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if app.ID != "" {
+		tflog.Info(ctx, fmt.Sprintf("Adopting existing device enrollment application %s", app.ID))
+		d.SetId(app.ID)
+		return resourceCloudflareTeamsDeviceEnrollmentUpdate(ctx, d, meta)
+	}
+
+	org, _, err := client.AccessOrganization(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching Access organization for account %q: %w", accountID, err))
+	}
+
+	newApp := buildTeamsDeviceEnrollmentApplication(d)
+	newApp.Domain = fmt.Sprintf("%s/warp", org.AuthDomain)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating device enrollment application from struct: %+v", newApp))
+
+	app, err = client.CreateAccessApplication(ctx, accountID, newApp)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating device enrollment application for account %q: %w", accountID, err))
+	}
+
+	d.SetId(app.ID)
+
+	return resourceCloudflareTeamsDeviceEnrollmentRead(ctx, d, meta)
+}
+
+func resourceCloudflareTeamsDeviceEnrollmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	app, err := client.AccessApplication(ctx, accountID, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Device enrollment application %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding device enrollment application %q: %w", d.Id(), err))
+	}
+
+	d.Set("allowed_idps", app.AllowedIdps)
+	d.Set("auto_redirect_to_identity", app.AutoRedirectToIdentity)
+	d.Set("session_duration", app.SessionDuration)
+	d.Set("application_id", app.ID)
+	d.Set("aud", app.AUD)
+	d.Set("domain", app.Domain)
+
+	return nil
+}
+
+func resourceCloudflareTeamsDeviceEnrollmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	current, err := client.AccessApplication(ctx, accountID, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding device enrollment application %q: %w", d.Id(), err))
+	}
+
+	app := buildTeamsDeviceEnrollmentApplication(d)
+	app.ID = d.Id()
+	app.Domain = current.Domain
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating device enrollment application from struct: %+v", app))
+
+	if _, err := client.UpdateAccessApplication(ctx, accountID, app); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating device enrollment application %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareTeamsDeviceEnrollmentRead(ctx, d, meta)
+}
+
+func resourceCloudflareTeamsDeviceEnrollmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if err := client.DeleteAccessApplication(ctx, accountID, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting device enrollment application %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareTeamsDeviceEnrollmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*apiClient)
+	accountID := d.Id()
+
+	app, err := findTeamsDeviceEnrollmentApplication(ctx, client, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if app.ID == "" {
+		return nil, fmt.Errorf("no device enrollment application found in account %q", accountID)
+	}
+
+	d.SetId(app.ID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareTeamsDeviceEnrollmentRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findTeamsDeviceEnrollmentApplication returns the account's device enrollment
+// Access application or an empty application when one hasn't been created yet.
+func findTeamsDeviceEnrollmentApplication(ctx context.Context, client *apiClient, accountID string) (cloudflare.AccessApplication, error) {
+	pageOpts := cloudflare.PaginationOptions{PerPage: 50, Page: 1}
+
+	for {
+		apps, info, err := client.AccessApplications(ctx, accountID, pageOpts)
+		if err != nil {
+			return cloudflare.AccessApplication{}, fmt.Errorf("error listing Access applications for account %q: %w", accountID, err)
+		}
+
+		for _, app := range apps {
+			if app.Type == cloudflare.Warp {
+				return app, nil
+			}
+		}
+
+		if pageOpts.Page >= info.TotalPages {
+			return cloudflare.AccessApplication{}, nil
+		}
+		pageOpts.Page++
+	}
+}
+
+func buildTeamsDeviceEnrollmentApplication(d *schema.ResourceData) cloudflare.AccessApplication {
+	return cloudflare.AccessApplication{
+		Name:                   teamsDeviceEnrollmentAppName,
+		Type:                   cloudflare.Warp,
+		AllowedIdps:            expandInterfaceToStringList(d.Get("allowed_idps").(*schema.Set).List()),
+		AutoRedirectToIdentity: d.Get("auto_redirect_to_identity").(bool),
+		SessionDuration:        d.Get("session_duration").(string),
+	}
+}
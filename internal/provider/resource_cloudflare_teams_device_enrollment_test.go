@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareTeamsDeviceEnrollment_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_device_enrollment.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsDeviceEnrollmentConfig(rnd, accountID, "24h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "session_duration", "24h"),
+					resource.TestCheckResourceAttrSet(name, "application_id"),
+					resource.TestCheckResourceAttrSet(name, "aud"),
+					resource.TestMatchResourceAttr(name, "domain", regexp.MustCompile(`/warp$`)),
+					resource.TestCheckResourceAttrPair(fmt.Sprintf("cloudflare_access_policy.%s", rnd), "application_id", name, "application_id"),
+				),
+			},
+			{
+				Config: testAccCloudflareTeamsDeviceEnrollmentConfig(rnd, accountID, "12h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "session_duration", "12h"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateId:     accountID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareTeamsDeviceEnrollmentConfig(rnd, accountID, sessionDuration string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_device_enrollment" "%[1]s" {
+  account_id       = "%[2]s"
+  session_duration = "%[3]s"
+}
+
+resource "cloudflare_access_policy" "%[1]s" {
+  application_id = cloudflare_teams_device_enrollment.%[1]s.application_id
+  account_id     = "%[2]s"
+  name           = "%[1]s"
+  precedence     = 1
+  decision       = "allow"
+
+  include {
+    email_domain = ["example.com"]
+  }
+}
+`, rnd, accountID, sessionDuration)
+}
@@ -25,7 +25,7 @@ func resourceCloudflareTeamsList() *schema.Resource {
 }
 
 func resourceCloudflareTeamsListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	newTeamsList := cloudflare.TeamsList{
 		Name:        d.Get("name").(string),
@@ -53,7 +53,7 @@ func resourceCloudflareTeamsListCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareTeamsListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	list, err := client.TeamsList(ctx, accountID, d.Id())
@@ -83,7 +83,7 @@ func resourceCloudflareTeamsListRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceCloudflareTeamsListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	updatedTeamsList := cloudflare.TeamsList{
 		ID:          d.Id(),
@@ -122,7 +122,7 @@ func resourceCloudflareTeamsListUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareTeamsListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	appID := d.Id()
 	accountID := d.Get("account_id").(string)
 
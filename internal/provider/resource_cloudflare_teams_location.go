@@ -25,7 +25,7 @@ func resourceCloudflareTeamsLocation() *schema.Resource {
 }
 
 func resourceCloudflareTeamsLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	location, err := client.TeamsLocation(ctx, accountID, d.Id())
@@ -66,7 +66,7 @@ func resourceCloudflareTeamsLocationRead(ctx context.Context, d *schema.Resource
 	return nil
 }
 func resourceCloudflareTeamsLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	accountID := d.Get("account_id").(string)
 	networks, err := inflateTeamsLocationNetworks(d.Get("networks"))
@@ -91,7 +91,7 @@ func resourceCloudflareTeamsLocationCreate(ctx context.Context, d *schema.Resour
 	return resourceCloudflareTeamsLocationRead(ctx, d, meta)
 }
 func resourceCloudflareTeamsLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	networks, err := inflateTeamsLocationNetworks(d.Get("networks"))
 	if err != nil {
@@ -116,7 +116,7 @@ func resourceCloudflareTeamsLocationUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceCloudflareTeamsLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	id := d.Id()
 	accountID := d.Get("account_id").(string)
 
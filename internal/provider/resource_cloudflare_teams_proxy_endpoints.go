@@ -25,7 +25,7 @@ func resourceCloudflareTeamsProxyEndpoint() *schema.Resource {
 }
 
 func resourceCloudflareTeamsProxyEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	endpoint, err := client.TeamsProxyEndpoint(ctx, accountID, d.Id())
@@ -54,7 +54,7 @@ func resourceCloudflareTeamsProxyEndpointRead(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudflareTeamsProxyEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	accountID := d.Get("account_id").(string)
 	newProxyEndpoint := cloudflare.TeamsProxyEndpoint{
@@ -74,7 +74,7 @@ func resourceCloudflareTeamsProxyEndpointCreate(ctx context.Context, d *schema.R
 }
 
 func resourceCloudflareTeamsProxyEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	updatedProxyEndpoint := cloudflare.TeamsProxyEndpoint{
 		ID:   d.Id(),
@@ -97,7 +97,7 @@ func resourceCloudflareTeamsProxyEndpointUpdate(ctx context.Context, d *schema.R
 }
 
 func resourceCloudflareTeamsProxyEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	id := d.Id()
 	accountID := d.Get("account_id").(string)
 
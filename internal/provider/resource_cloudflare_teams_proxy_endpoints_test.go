@@ -7,7 +7,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -57,7 +56,7 @@ resource "cloudflare_teams_proxy_endpoint" "%[1]s" {
 }
 
 func testAccCheckCloudflareTeamsProxyEndpointDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_teams_proxy_endpoint" {
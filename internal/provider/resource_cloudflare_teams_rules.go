@@ -29,7 +29,7 @@ func resourceCloudflareTeamsRule() *schema.Resource {
 const rulePrecedenceFactor int64 = 1000
 
 func resourceCloudflareTeamsRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	rule, err := client.TeamsRule(ctx, accountID, d.Id())
@@ -78,7 +78,7 @@ func resourceCloudflareTeamsRuleRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceCloudflareTeamsRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	accountID := d.Get("account_id").(string)
 	settings := inflateTeamsRuleSettings(d.Get("rule_settings"))
@@ -119,7 +119,7 @@ func resourceCloudflareTeamsRuleCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareTeamsRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	settings := inflateTeamsRuleSettings(d.Get("rule_settings"))
 
@@ -160,7 +160,7 @@ func resourceCloudflareTeamsRuleUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareTeamsRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	id := d.Id()
 	accountID := d.Get("account_id").(string)
 
@@ -178,11 +178,25 @@ func resourceCloudflareTeamsRuleImport(ctx context.Context, d *schema.ResourceDa
 	attributes := strings.SplitN(d.Id(), "/", 2)
 
 	if len(attributes) != 2 {
-		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/teamsRuleID\"", d.Id())
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/teamsRuleID\" or \"accountID/name:teamsRuleName\"", d.Id())
 	}
 
 	accountID, teamsRuleID := attributes[0], attributes[1]
 
+	if name := strings.TrimPrefix(teamsRuleID, "name:"); name != teamsRuleID {
+		client := meta.(*apiClient)
+		rules, err := client.TeamsRules(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing Teams Rules for account %q: %w", accountID, err)
+		}
+
+		id, err := findTeamsRuleIDByName(rules, name)
+		if err != nil {
+			return nil, err
+		}
+		teamsRuleID = id
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Teams Rule: id %s for account %s", teamsRuleID, accountID))
 
 	d.Set("account_id", accountID)
@@ -193,6 +207,25 @@ func resourceCloudflareTeamsRuleImport(ctx context.Context, d *schema.ResourceDa
 	return []*schema.ResourceData{d}, nil
 }
 
+// findTeamsRuleIDByName returns the ID of the only rule with the given name.
+func findTeamsRuleIDByName(rules []cloudflare.TeamsRule, name string) (string, error) {
+	var ids []string
+	for _, rule := range rules {
+		if rule.Name == name {
+			ids = append(ids, rule.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no Teams Rule named %q found", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("found %d Teams Rules named %q (%s), import using the rule ID instead", len(ids), name, strings.Join(ids, ", "))
+	}
+}
+
 func flattenTeamsRuleSettings(settings *cloudflare.TeamsRuleSettings) []interface{} {
 	return []interface{}{map[string]interface{}{
 		"block_page_enabled":                 settings.BlockPageEnabled,
@@ -48,10 +48,37 @@ func TestAccCloudflareTeamsRuleBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "rule_settings.0.insecure_disable_dnssec_validation", "false"),
 				),
 			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/name:%s", accountID, rnd),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func TestFindTeamsRuleIDByName(t *testing.T) {
+	rules := []cloudflare.TeamsRule{
+		{ID: "1", Name: "block malware"},
+		{ID: "2", Name: "allow office"},
+		{ID: "3", Name: "allow office"},
+	}
+
+	id, err := findTeamsRuleIDByName(rules, "block malware")
+	if err != nil || id != "1" {
+		t.Errorf("expected rule 1, got %q (%v)", id, err)
+	}
+
+	if _, err := findTeamsRuleIDByName(rules, "allow office"); err == nil {
+		t.Errorf("expected an error for an ambiguous name")
+	}
+
+	if _, err := findTeamsRuleIDByName(rules, "missing"); err == nil {
+		t.Errorf("expected an error for an unknown name")
+	}
+}
+
 func testAccCloudflareTeamsRuleConfigBasic(rnd, accountID string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_teams_rule" "%[1]s" {
@@ -72,7 +99,7 @@ resource "cloudflare_teams_rule" "%[1]s" {
 }
 
 func testAccCheckCloudflareTeamsRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_teams_rule" {
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type zoneCacheSetting struct {
+	Value string `json:"value"`
+}
+
+func resourceCloudflareTieredCache() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareTieredCacheSchema(),
+		CreateContext: resourceCloudflareTieredCacheCreate,
+		ReadContext:   resourceCloudflareTieredCacheRead,
+		UpdateContext: resourceCloudflareTieredCacheUpdate,
+		DeleteContext: resourceCloudflareTieredCacheDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTieredCacheImport,
+		},
+		Description: "Provides a resource to manage the Tiered Cache topology of a zone. Deleting the resource turns tiered caching off. " +
+			"It replaces the `tiered_caching` attribute of `cloudflare_argo`, which must not be set for the same zone.",
+	}
+}
+
+func resourceCloudflareTieredCacheCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+	return resourceCloudflareTieredCacheUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareTieredCacheRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	tieredCaching, err := client.ArgoTieredCaching(ctx, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading tiered caching for zone %q: %w", zoneID, err))
+	}
+
+	smart, err := getZoneCacheSetting(ctx, client, zoneID, "tiered_cache_smart_topology_enable")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Smart Tiered Cache for zone %q: %w", zoneID, err))
+	}
+
+	regional, err := getZoneCacheSetting(ctx, client, zoneID, "regional_tiered_cache")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Regional Tiered Cache for zone %q: %w", zoneID, err))
+	}
+
+	d.Set("cache_type", tieredCacheType(tieredCaching.Value, smart))
+	d.Set("regional", regional == "on")
+
+	return nil
+}
+
+// resourceCloudflareTieredCacheUpdate applies the topology across the Argo
+// tiered caching and Smart Tiered Cache endpoints. Smart topology only
+// applies while tiered caching is on, so tiered caching is enabled before
+// and disabled after it.
+func resourceCloudflareTieredCacheUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	cacheType := d.Get("cache_type").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting tiered cache topology for zone %s to %s", zoneID, cacheType))
+
+	if cacheType != "off" {
+		if _, err := client.UpdateArgoTieredCaching(ctx, zoneID, "on"); err != nil {
+			return diag.FromErr(fmt.Errorf("error enabling tiered caching for zone %q: %w", zoneID, err))
+		}
+	}
+
+	smart := "off"
+	if cacheType == "smart" {
+		smart = "on"
+	}
+	if err := setZoneCacheSetting(ctx, client, zoneID, "tiered_cache_smart_topology_enable", smart); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Smart Tiered Cache for zone %q: %w", zoneID, err))
+	}
+
+	if cacheType == "off" {
+		if _, err := client.UpdateArgoTieredCaching(ctx, zoneID, "off"); err != nil {
+			return diag.FromErr(fmt.Errorf("error disabling tiered caching for zone %q: %w", zoneID, err))
+		}
+	}
+
+	regional := "off"
+	if d.Get("regional").(bool) {
+		regional = "on"
+	}
+	if err := setZoneCacheSetting(ctx, client, zoneID, "regional_tiered_cache", regional); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Regional Tiered Cache for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareTieredCacheRead(ctx, d, meta)
+}
+
+func resourceCloudflareTieredCacheDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Disabling tiered caching for zone %s", zoneID))
+
+	if err := setZoneCacheSetting(ctx, client, zoneID, "regional_tiered_cache", "off"); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling Regional Tiered Cache for zone %q: %w", zoneID, err))
+	}
+
+	if err := setZoneCacheSetting(ctx, client, zoneID, "tiered_cache_smart_topology_enable", "off"); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling Smart Tiered Cache for zone %q: %w", zoneID, err))
+	}
+
+	if _, err := client.UpdateArgoTieredCaching(ctx, zoneID, "off"); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling tiered caching for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareTieredCacheImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareTieredCacheRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// tieredCacheType returns the topology from the values of the Argo tiered
+// caching and Smart Tiered Cache settings.
+func tieredCacheType(tieredCaching, smart string) string {
+	switch {
+	case tieredCaching != "on":
+		return "off"
+	case smart == "on":
+		return "smart"
+	default:
+		return "generic"
+	}
+}
+
+func getZoneCacheSetting(ctx context.Context, client *apiClient, zoneID, setting string) (string, error) {
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/zones/%s/cache/%s", zoneID, setting), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var s zoneCacheSetting
+	if err := json.Unmarshal(res, &s); err != nil {
+		return "", fmt.Errorf("error unmarshalling cache setting %q: %w", setting, err)
+	}
+
+	return s.Value, nil
+}
+
+func setZoneCacheSetting(ctx context.Context, client *apiClient, zoneID, setting, value string) error {
+	_, err := rawRequest(ctx, client, http.MethodPatch, fmt.Sprintf("/zones/%s/cache/%s", zoneID, setting), zoneCacheSetting{Value: value})
+	return err
+}
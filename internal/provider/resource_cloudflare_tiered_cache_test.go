@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareTieredCache_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_tiered_cache.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTieredCacheConfig(rnd, zoneID, "smart", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", zoneID),
+					resource.TestCheckResourceAttr(name, "cache_type", "smart"),
+					resource.TestCheckResourceAttr(name, "regional", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareTieredCacheConfig(rnd, zoneID, "generic", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "cache_type", "generic"),
+					resource.TestCheckResourceAttr(name, "regional", "false"),
+				),
+			},
+			{
+				Config: testAccCloudflareTieredCacheConfig(rnd, zoneID, "off", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "cache_type", "off"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareTieredCacheConfig(rnd, zoneID, cacheType string, regional bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_tiered_cache" "%[1]s" {
+  zone_id    = "%[2]s"
+  cache_type = "%[3]s"
+  regional   = %[4]t
+}
+`, rnd, zoneID, cacheType, regional)
+}
+
+func TestTieredCacheType(t *testing.T) {
+	assert.Equal(t, "off", tieredCacheType("off", "off"))
+	assert.Equal(t, "off", tieredCacheType("off", "on"))
+	assert.Equal(t, "generic", tieredCacheType("on", "off"))
+	assert.Equal(t, "smart", tieredCacheType("on", "on"))
+}
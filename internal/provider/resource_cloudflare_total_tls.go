@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type totalTLS struct {
+	Enabled              bool   `json:"enabled"`
+	CertificateAuthority string `json:"certificate_authority,omitempty"`
+	ValidityDays         int    `json:"validity_days,omitempty"`
+}
+
+func resourceCloudflareTotalTLS() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareTotalTLSSchema(),
+		CreateContext: resourceCloudflareTotalTLSCreate,
+		ReadContext:   resourceCloudflareTotalTLSRead,
+		UpdateContext: resourceCloudflareTotalTLSUpdate,
+		DeleteContext: resourceCloudflareTotalTLSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTotalTLSImport,
+		},
+		Description: "Provides a resource to manage Total TLS of a zone, which issues certificates for every proxied hostname of the zone. " +
+			"Destroying the resource disables Total TLS.",
+	}
+}
+
+func resourceCloudflareTotalTLSCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+
+	return resourceCloudflareTotalTLSUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareTotalTLSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	settings, err := totalTLSRequest(ctx, client, http.MethodGet, zoneID, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching Total TLS for zone %q: %w", zoneID, err))
+	}
+
+	d.Set("enabled", settings.Enabled)
+	d.Set("certificate_authority", settings.CertificateAuthority)
+	d.Set("validity_days", settings.ValidityDays)
+
+	return nil
+}
+
+func resourceCloudflareTotalTLSUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	settings := totalTLS{
+		Enabled:              d.Get("enabled").(bool),
+		CertificateAuthority: d.Get("certificate_authority").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Total TLS for zone %s: %+v", zoneID, settings))
+
+	if _, err := totalTLSRequest(ctx, client, http.MethodPost, zoneID, settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Total TLS for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareTotalTLSRead(ctx, d, meta)
+}
+
+func resourceCloudflareTotalTLSDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	if _, err := totalTLSRequest(ctx, client, http.MethodPost, zoneID, totalTLS{Enabled: false}); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling Total TLS for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareTotalTLSImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareTotalTLSRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func totalTLSRequest(ctx context.Context, client *apiClient, method, zoneID string, body interface{}) (totalTLS, error) {
+	var settings totalTLS
+
+	res, err := rawRequest(ctx, client, method, fmt.Sprintf("/zones/%s/acm/total_tls", zoneID), body)
+	if err != nil {
+		return settings, err
+	}
+
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return settings, fmt.Errorf("error unmarshalling Total TLS: %w", err)
+	}
+
+	return settings, nil
+}
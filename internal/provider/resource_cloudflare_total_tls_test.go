@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareTotalTLS_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_total_tls.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTotalTLSConfig(rnd, zoneID, true, "google"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", zoneID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "certificate_authority", "google"),
+					resource.TestCheckResourceAttrSet(name, "validity_days"),
+				),
+			},
+			{
+				Config: testAccCloudflareTotalTLSConfig(rnd, zoneID, true, "lets_encrypt"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "certificate_authority", "lets_encrypt"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareTotalTLSConfig(rnd, zoneID string, enabled bool, ca string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_total_tls" "%[1]s" {
+  zone_id               = "%[2]s"
+  enabled               = %[3]t
+  certificate_authority = "%[4]s"
+}
+`, rnd, zoneID, enabled, ca)
+}
@@ -27,7 +27,7 @@ func resourceCloudflareTunnelRoute() *schema.Resource {
 }
 
 func resourceCloudflareTunnelRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 	network := d.Get("network").(string)
 	virtualNetworkID := d.Get("virtual_network_id").(string)
@@ -71,7 +71,7 @@ func resourceCloudflareTunnelRouteRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareTunnelRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	virtualNetworkID := d.Get("virtual_network_id").(string)
 
 	resource := cloudflare.TunnelRoutesCreateParams{
@@ -101,7 +101,7 @@ func resourceCloudflareTunnelRouteCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareTunnelRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	resource := cloudflare.TunnelRoutesUpdateParams{
 		AccountID:        d.Get("account_id").(string),
@@ -124,7 +124,7 @@ func resourceCloudflareTunnelRouteUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareTunnelRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	network := d.Get("network").(string)
 
 	resource := cloudflare.TunnelRoutesDeleteParams{
@@ -92,7 +92,7 @@ func testAccCheckCloudflareTunnelRouteExists(name string, route *cloudflare.Tunn
 			return errors.New("No Tunnel route is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundTunnelRoute, err := client.ListTunnelRoutes(context.Background(), cloudflare.TunnelRoutesListParams{
 			AccountID:     rs.Primary.Attributes["account_id"],
 			IsDeleted:     cloudflare.BoolPtr(false),
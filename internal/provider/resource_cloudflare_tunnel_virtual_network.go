@@ -30,7 +30,7 @@ handle overlapping private IPs in your origins.`,
 }
 
 func resourceCloudflareTunnelVirtualNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	tunnelVirtualNetworks, err := client.ListTunnelVirtualNetworks(ctx, cloudflare.TunnelVirtualNetworksListParams{
@@ -62,7 +62,7 @@ func resourceCloudflareTunnelVirtualNetworkRead(ctx context.Context, d *schema.R
 }
 
 func resourceCloudflareTunnelVirtualNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	name := d.Get("name").(string)
 
 	resource := cloudflare.TunnelVirtualNetworkCreateParams{
@@ -86,7 +86,7 @@ func resourceCloudflareTunnelVirtualNetworkCreate(ctx context.Context, d *schema
 }
 
 func resourceCloudflareTunnelVirtualNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	resource := cloudflare.TunnelVirtualNetworkUpdateParams{
 		AccountID:        d.Get("account_id").(string),
@@ -108,7 +108,7 @@ func resourceCloudflareTunnelVirtualNetworkUpdate(ctx context.Context, d *schema
 }
 
 func resourceCloudflareTunnelVirtualNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	err := client.DeleteTunnelVirtualNetwork(ctx, cloudflare.TunnelVirtualNetworkDeleteParams{
 		AccountID: d.Get("account_id").(string),
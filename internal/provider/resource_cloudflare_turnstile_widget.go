@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	turnstileWidgetModes           = []string{"non-interactive", "invisible", "managed"}
+	turnstileWidgetRegions         = []string{"world"}
+	turnstileWidgetClearanceLevels = []string{"no_clearance", "jschallenge", "managed", "interactive"}
+)
+
+type turnstileWidget struct {
+	SiteKey        string   `json:"sitekey,omitempty"`
+	Secret         string   `json:"secret,omitempty"`
+	Name           string   `json:"name"`
+	Domains        []string `json:"domains"`
+	Mode           string   `json:"mode"`
+	Region         string   `json:"region"`
+	BotFightMode   bool     `json:"bot_fight_mode"`
+	Offlabel       bool     `json:"offlabel"`
+	EphemeralID    bool     `json:"ephemeral_id"`
+	ClearanceLevel string   `json:"clearance_level"`
+}
+
+func resourceCloudflareTurnstileWidget() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareTurnstileWidgetSchema(),
+		CreateContext: resourceCloudflareTurnstileWidgetCreate,
+		ReadContext:   resourceCloudflareTurnstileWidgetRead,
+		UpdateContext: resourceCloudflareTurnstileWidgetUpdate,
+		DeleteContext: resourceCloudflareTurnstileWidgetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTurnstileWidgetImport,
+		},
+		Description: "Provides a resource for managing Cloudflare Turnstile widgets. The resource ID is the widget's sitekey. " +
+			"The widget secret is rotated whenever `secret_rotation_trigger` changes.",
+	}
+}
+
+func resourceCloudflareTurnstileWidgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	widget := buildTurnstileWidget(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Turnstile widget from struct: %+v", widget))
+
+	w, err := turnstileWidgetRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/challenges/widgets", accountID), widget)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Turnstile widget %q: %w", widget.Name, err))
+	}
+
+	d.SetId(w.SiteKey)
+
+	return resourceCloudflareTurnstileWidgetRead(ctx, d, meta)
+}
+
+func resourceCloudflareTurnstileWidgetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	w, err := turnstileWidgetRequest(ctx, client, http.MethodGet, turnstileWidgetURI(accountID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Turnstile widget %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Turnstile widget %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", w.Name)
+	d.Set("mode", w.Mode)
+	d.Set("region", w.Region)
+	d.Set("bot_fight_mode", w.BotFightMode)
+	d.Set("offlabel", w.Offlabel)
+	d.Set("ephemeral_id", w.EphemeralID)
+	d.Set("clearance_level", w.ClearanceLevel)
+	d.Set("secret", w.Secret)
+
+	if err := d.Set("domains", flattenTurnstileWidgetDomains(w.Domains, d.Get("domains").(*schema.Set))); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting domains: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareTurnstileWidgetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	if d.HasChanges("name", "domains", "mode", "region", "bot_fight_mode", "offlabel", "ephemeral_id", "clearance_level") {
+		widget := buildTurnstileWidget(d)
+
+		tflog.Debug(ctx, fmt.Sprintf("Updating Turnstile widget %s from struct: %+v", d.Id(), widget))
+
+		_, err := turnstileWidgetRequest(ctx, client, http.MethodPut, turnstileWidgetURI(accountID, d.Id()), widget)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Turnstile widget %q: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("secret_rotation_trigger") {
+		tflog.Info(ctx, fmt.Sprintf("Rotating secret of Turnstile widget %s", d.Id()))
+
+		rotateSecret := struct {
+			InvalidateImmediately bool `json:"invalidate_immediately"`
+		}{
+			InvalidateImmediately: d.Get("invalidate_previous_secret").(bool),
+		}
+
+		_, err := turnstileWidgetRequest(ctx, client, http.MethodPost, turnstileWidgetURI(accountID, d.Id())+"/rotate_secret", rotateSecret)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error rotating secret of Turnstile widget %q: %w", d.Id(), err))
+		}
+	}
+
+	return resourceCloudflareTurnstileWidgetRead(ctx, d, meta)
+}
+
+func resourceCloudflareTurnstileWidgetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	accountID := d.Get("account_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, turnstileWidgetURI(accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Turnstile widget %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareTurnstileWidgetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/sitekey"`, d.Id())
+	}
+
+	accountID, siteKey := attributes[0], attributes[1]
+
+	d.SetId(siteKey)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareTurnstileWidgetRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildTurnstileWidget(d *schema.ResourceData) turnstileWidget {
+	return turnstileWidget{
+		Name:           d.Get("name").(string),
+		Domains:        expandInterfaceToStringList(d.Get("domains").(*schema.Set).List()),
+		Mode:           d.Get("mode").(string),
+		Region:         d.Get("region").(string),
+		BotFightMode:   d.Get("bot_fight_mode").(bool),
+		Offlabel:       d.Get("offlabel").(bool),
+		EphemeralID:    d.Get("ephemeral_id").(bool),
+		ClearanceLevel: d.Get("clearance_level").(string),
+	}
+}
+
+// turnstileWidgetDomainHash hashes domains case insensitively and without a
+// trailing dot as the API normalises domains which would otherwise produce a
+// diff for an equivalent value.
+func turnstileWidgetDomainHash(v interface{}) int {
+	return hashCodeString(normalizeTurnstileWidgetDomain(v.(string)))
+}
+
+func normalizeTurnstileWidgetDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(domain), ".")
+}
+
+// flattenTurnstileWidgetDomains keeps the configured spelling of a domain
+// when the API returns an equivalent, normalised, value.
+func flattenTurnstileWidgetDomains(domains []string, configured *schema.Set) []string {
+	known := make(map[string]string)
+	for _, v := range configured.List() {
+		known[normalizeTurnstileWidgetDomain(v.(string))] = v.(string)
+	}
+
+	flattened := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		if v, ok := known[normalizeTurnstileWidgetDomain(domain)]; ok {
+			domain = v
+		}
+		flattened = append(flattened, domain)
+	}
+
+	return flattened
+}
+
+func turnstileWidgetURI(accountID, siteKey string) string {
+	return fmt.Sprintf("/accounts/%s/challenges/widgets/%s", accountID, siteKey)
+}
+
+func turnstileWidgetRequest(ctx context.Context, client *apiClient, method, uri string, body interface{}) (turnstileWidget, error) {
+	var w turnstileWidget
+
+	res, err := rawRequest(ctx, client, method, uri, body)
+	if err != nil {
+		return w, err
+	}
+
+	if err := json.Unmarshal(res, &w); err != nil {
+		return w, fmt.Errorf("error unmarshalling Turnstile widget: %w", err)
+	}
+
+	return w, nil
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestFlattenTurnstileWidgetDomains(t *testing.T) {
+	configured := schema.NewSet(turnstileWidgetDomainHash, []interface{}{"Example.com", "www.example.com."})
+
+	got := flattenTurnstileWidgetDomains([]string{"example.com", "www.example.com", "api.example.com"}, configured)
+	want := []string{"Example.com", "www.example.com.", "api.example.com"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenTurnstileWidgetDomains() = %v, want %v", got, want)
+	}
+
+	if turnstileWidgetDomainHash("Example.com.") != turnstileWidgetDomainHash("example.com") {
+		t.Error("expected equivalent domains to hash to the same value")
+	}
+}
+
+func TestAccCloudflareTurnstileWidget_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_turnstile_widget.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTurnstileWidgetConfig(rnd, accountID, `"example.com", "example.net"`, "managed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "domains.#", "2"),
+					resource.TestCheckResourceAttr(name, "mode", "managed"),
+					resource.TestCheckResourceAttr(name, "clearance_level", "no_clearance"),
+					resource.TestCheckResourceAttrSet(name, "secret"),
+				),
+			},
+			{
+				// Reordering the domains must not produce a diff.
+				Config:   testAccCloudflareTurnstileWidgetConfig(rnd, accountID, `"example.net", "example.com"`, "managed"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCloudflareTurnstileWidgetConfig(rnd, accountID, `"example.com"`, "invisible"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "domains.#", "1"),
+					resource.TestCheckResourceAttr(name, "mode", "invisible"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTurnstileWidgetConfig(rnd, accountID, domains, mode string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_turnstile_widget" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  domains    = [%[3]s]
+  mode       = "%[4]s"
+}
+`, rnd, accountID, domains, mode)
+}
+
+func TestAccCloudflareTurnstileWidget_RotateSecret(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_turnstile_widget.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	var secret string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTurnstileWidgetRotateSecretConfig(rnd, accountID, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "secret_rotation_trigger", "first"),
+					testAccCheckCloudflareTurnstileWidgetSecret(name, &secret, false),
+				),
+			},
+			{
+				Config: testAccCloudflareTurnstileWidgetRotateSecretConfig(rnd, accountID, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "secret_rotation_trigger", "second"),
+					testAccCheckCloudflareTurnstileWidgetSecret(name, &secret, true),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckCloudflareTurnstileWidgetSecret records the widget secret and,
+// when changed is true, ensures it differs from the previously recorded one.
+func testAccCheckCloudflareTurnstileWidgetSecret(n string, secret *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		current := rs.Primary.Attributes["secret"]
+		if current == "" {
+			return fmt.Errorf("secret is not set")
+		}
+
+		if changed && current == *secret {
+			return fmt.Errorf("expected secret to be rotated")
+		}
+
+		*secret = current
+		return nil
+	}
+}
+
+func testAccCloudflareTurnstileWidgetRotateSecretConfig(rnd, accountID, trigger string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_turnstile_widget" "%[1]s" {
+  account_id                 = "%[2]s"
+  name                       = "%[1]s"
+  domains                    = ["example.com"]
+  mode                       = "managed"
+  secret_rotation_trigger    = "%[3]s"
+  invalidate_previous_secret = true
+}
+`, rnd, accountID, trigger)
+}
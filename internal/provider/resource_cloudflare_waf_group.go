@@ -26,7 +26,7 @@ func resourceCloudflareWAFGroup() *schema.Resource {
 }
 
 func resourceCloudflareWAFGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	groupID := d.Get("group_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -51,7 +51,7 @@ func resourceCloudflareWAFGroupRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCloudflareWAFGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	groupID := d.Get("group_id").(string)
 	zoneID := d.Get("zone_id").(string)
 	packageID := d.Get("package_id").(string)
@@ -97,7 +97,7 @@ func resourceCloudflareWAFGroupCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCloudflareWAFGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	groupID := d.Get("group_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -123,7 +123,7 @@ func resourceCloudflareWAFGroupDelete(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCloudflareWAFGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	groupID := d.Get("group_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -140,7 +140,7 @@ func resourceCloudflareWAFGroupUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCloudflareWAFGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -26,7 +26,7 @@ func resourceCloudflareWAFPackage() *schema.Resource {
 }
 
 func resourceCloudflareWAFPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	packageID := d.Get("package_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -50,7 +50,7 @@ func resourceCloudflareWAFPackageRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCloudflareWAFPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	packageID := d.Get("package_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -86,7 +86,7 @@ func resourceCloudflareWAFPackageCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareWAFPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	packageID := d.Get("package_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -117,7 +117,7 @@ func resourceCloudflareWAFPackageDelete(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareWAFPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	packageID := d.Get("package_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -138,7 +138,7 @@ func resourceCloudflareWAFPackageUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareWAFPackageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
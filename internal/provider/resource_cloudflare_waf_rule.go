@@ -26,7 +26,7 @@ func resourceCloudflareWAFRule() *schema.Resource {
 }
 
 func resourceCloudflareWAFRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	ruleID := d.Get("rule_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -52,7 +52,7 @@ func resourceCloudflareWAFRuleRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCloudflareWAFRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	ruleID := d.Get("rule_id").(string)
 	zoneID := d.Get("zone_id").(string)
 	packageID := d.Get("package_id").(string)
@@ -99,7 +99,7 @@ func resourceCloudflareWAFRuleCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceCloudflareWAFRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	ruleID := d.Get("rule_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -128,7 +128,7 @@ func resourceCloudflareWAFRuleDelete(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceCloudflareWAFRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	ruleID := d.Get("rule_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -145,7 +145,7 @@ func resourceCloudflareWAFRuleUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceCloudflareWAFRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -31,31 +33,43 @@ func resourceCloudflareWaitingRoom() *schema.Resource {
 	}
 }
 
-func buildWaitingRoom(d *schema.ResourceData) cloudflare.WaitingRoom {
-	return cloudflare.WaitingRoom{
-		Name:                    d.Get("name").(string),
-		Description:             d.Get("description").(string),
-		Suspended:               d.Get("suspended").(bool),
-		Host:                    d.Get("host").(string),
-		Path:                    d.Get("path").(string),
-		TotalActiveUsers:        d.Get("total_active_users").(int),
-		NewUsersPerMinute:       d.Get("new_users_per_minute").(int),
-		CustomPageHTML:          d.Get("custom_page_html").(string),
-		DefaultTemplateLanguage: d.Get("default_template_language").(string),
-		SessionDuration:         d.Get("session_duration").(int),
-		JsonResponseEnabled:     d.Get("json_response_enabled").(bool),
-		QueueAll:                d.Get("queue_all").(bool),
-		DisableSessionRenewal:   d.Get("disable_session_renewal").(bool),
+// waitingRoom extends cloudflare.WaitingRoom with the settings not yet
+// exposed by cloudflare-go.
+type waitingRoom struct {
+	cloudflare.WaitingRoom
+	QueueingMethod string `json:"queueing_method,omitempty"`
+	CookieSuffix   string `json:"cookie_suffix,omitempty"`
+}
+
+func buildWaitingRoom(d *schema.ResourceData) waitingRoom {
+	return waitingRoom{
+		WaitingRoom: cloudflare.WaitingRoom{
+			Name:                    d.Get("name").(string),
+			Description:             d.Get("description").(string),
+			Suspended:               d.Get("suspended").(bool),
+			Host:                    d.Get("host").(string),
+			Path:                    d.Get("path").(string),
+			TotalActiveUsers:        d.Get("total_active_users").(int),
+			NewUsersPerMinute:       d.Get("new_users_per_minute").(int),
+			CustomPageHTML:          d.Get("custom_page_html").(string),
+			DefaultTemplateLanguage: d.Get("default_template_language").(string),
+			SessionDuration:         d.Get("session_duration").(int),
+			JsonResponseEnabled:     d.Get("json_response_enabled").(bool),
+			QueueAll:                d.Get("queue_all").(bool),
+			DisableSessionRenewal:   d.Get("disable_session_renewal").(bool),
+		},
+		QueueingMethod: d.Get("queueing_method").(string),
+		CookieSuffix:   d.Get("cookie_suffix").(string),
 	}
 }
 
 func resourceCloudflareWaitingRoomCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 
 	newWaitingRoom := buildWaitingRoom(d)
 
-	waitingRoom, err := client.CreateWaitingRoom(ctx, zoneID, newWaitingRoom)
+	waitingRoom, err := waitingRoomRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/waiting_rooms", zoneID), newWaitingRoom)
 
 	if err != nil {
 		name := d.Get("name").(string)
@@ -68,13 +82,13 @@ func resourceCloudflareWaitingRoomCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareWaitingRoomRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	waitingRoomID := d.Id()
 	zoneID := d.Get("zone_id").(string)
 
-	waitingRoom, err := client.WaitingRoom(ctx, zoneID, waitingRoomID)
+	waitingRoom, err := waitingRoomRequest(ctx, client, http.MethodGet, waitingRoomURI(zoneID, waitingRoomID), nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		if isNotFoundError(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Removing waiting room from state because it's not found in API"))
 			d.SetId("")
 			return nil
@@ -96,17 +110,19 @@ func resourceCloudflareWaitingRoomRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("custom_page_html", waitingRoom.CustomPageHTML)
 	d.Set("default_template_language", waitingRoom.DefaultTemplateLanguage)
 	d.Set("json_response_enabled", waitingRoom.JsonResponseEnabled)
+	d.Set("queueing_method", waitingRoom.QueueingMethod)
+	d.Set("cookie_suffix", waitingRoom.CookieSuffix)
 	return nil
 }
 
 func resourceCloudflareWaitingRoomUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	waitingRoomID := d.Id()
 	zoneID := d.Get("zone_id").(string)
 
 	waitingRoom := buildWaitingRoom(d)
 
-	_, err := client.ChangeWaitingRoom(ctx, zoneID, waitingRoomID, waitingRoom)
+	_, err := waitingRoomRequest(ctx, client, http.MethodPatch, waitingRoomURI(zoneID, waitingRoomID), waitingRoom)
 
 	if err != nil {
 		name := d.Get("name").(string)
@@ -117,7 +133,7 @@ func resourceCloudflareWaitingRoomUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareWaitingRoomDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	waitingRoomID := d.Id()
 	zoneID := d.Get("zone_id").(string)
 
@@ -132,7 +148,7 @@ func resourceCloudflareWaitingRoomDelete(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareWaitingRoomImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	idAttr := strings.SplitN(d.Id(), "/", 2)
 	var zoneID string
 	var waitingRoomID string
@@ -154,3 +170,22 @@ func resourceCloudflareWaitingRoomImport(ctx context.Context, d *schema.Resource
 	resourceCloudflareWaitingRoomRead(ctx, d, meta)
 	return []*schema.ResourceData{d}, nil
 }
+
+func waitingRoomURI(zoneID, waitingRoomID string) string {
+	return fmt.Sprintf("/zones/%s/waiting_rooms/%s", zoneID, waitingRoomID)
+}
+
+func waitingRoomRequest(ctx context.Context, client *apiClient, method, uri string, body interface{}) (waitingRoom, error) {
+	var room waitingRoom
+
+	res, err := rawRequest(ctx, client, method, uri, body)
+	if err != nil {
+		return room, err
+	}
+
+	if err := json.Unmarshal(res, &room); err != nil {
+		return room, fmt.Errorf("error unmarshalling waiting room: %w", err)
+	}
+
+	return room, nil
+}
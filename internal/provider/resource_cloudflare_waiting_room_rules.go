@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type waitingRoomRule struct {
+	ID          string `json:"id,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Action      string `json:"action"`
+	Expression  string `json:"expression"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+}
+
+func resourceCloudflareWaitingRoomRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareWaitingRoomRulesUpdate,
+		ReadContext:   resourceCloudflareWaitingRoomRulesRead,
+		UpdateContext: resourceCloudflareWaitingRoomRulesUpdate,
+		DeleteContext: resourceCloudflareWaitingRoomRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWaitingRoomRulesImport,
+		},
+
+		Schema:      resourceCloudflareWaitingRoomRulesSchema(),
+		Description: "Provides a Cloudflare Waiting Room Rules resource. Rule expressions are validated at plan time against the fields supported by Waiting Room.",
+	}
+}
+
+func expandWaitingRoomRules(d *schema.ResourceData) []waitingRoomRule {
+	rules := []waitingRoomRule{}
+	for _, r := range d.Get("rules").([]interface{}) {
+		rule := r.(map[string]interface{})
+		rules = append(rules, waitingRoomRule{
+			Action:      rule["action"].(string),
+			Expression:  rule["expression"].(string),
+			Description: rule["description"].(string),
+			Enabled:     rule["enabled"].(bool),
+		})
+	}
+
+	return rules
+}
+
+func flattenWaitingRoomRules(rules []waitingRoomRule) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		flattened = append(flattened, map[string]interface{}{
+			"id":          rule.ID,
+			"version":     rule.Version,
+			"action":      rule.Action,
+			"expression":  rule.Expression,
+			"description": rule.Description,
+			"enabled":     rule.Enabled,
+		})
+	}
+
+	return flattened
+}
+
+func resourceCloudflareWaitingRoomRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	waitingRoomID := d.Get("waiting_room_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, waitingRoomRulesURI(zoneID, waitingRoomID), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Waiting room %s no longer exists", waitingRoomID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error getting waiting room rules for waiting room %q: %w", waitingRoomID, err))
+	}
+
+	var rules []waitingRoomRule
+	if err := json.Unmarshal(res, &rules); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling waiting room rules for waiting room %q: %w", waitingRoomID, err))
+	}
+
+	if err := d.Set("rules", flattenWaitingRoomRules(rules)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set rules: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWaitingRoomRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	waitingRoomID := d.Get("waiting_room_id").(string)
+	rules := expandWaitingRoomRules(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Replacing waiting room rules for waiting room %s: %+v", waitingRoomID, rules))
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, waitingRoomRulesURI(zoneID, waitingRoomID), rules); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating waiting room rules for waiting room %q: %w", waitingRoomID, err))
+	}
+
+	d.SetId(waitingRoomID)
+
+	return resourceCloudflareWaitingRoomRulesRead(ctx, d, meta)
+}
+
+func resourceCloudflareWaitingRoomRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	waitingRoomID := d.Get("waiting_room_id").(string)
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, waitingRoomRulesURI(zoneID, waitingRoomID), []waitingRoomRule{}); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting waiting room rules for waiting room %q: %w", waitingRoomID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWaitingRoomRulesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/waitingRoomID"`, d.Id())
+	}
+
+	zoneID, waitingRoomID := attributes[0], attributes[1]
+
+	d.SetId(waitingRoomID)
+	d.Set("zone_id", zoneID)
+	d.Set("waiting_room_id", waitingRoomID)
+
+	resourceCloudflareWaitingRoomRulesRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func waitingRoomRulesURI(zoneID, waitingRoomID string) string {
+	return fmt.Sprintf("/zones/%s/waiting_rooms/%s/rules", zoneID, waitingRoomID)
+}
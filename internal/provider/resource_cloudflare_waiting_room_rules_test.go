@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestValidateWaitingRoomRuleExpression(t *testing.T) {
+	testCases := map[string]struct {
+		expression string
+		errCount   int
+	}{
+		"ip source":                   {`ip.src in {192.0.2.0/24 2001:db8::/32}`, 0},
+		"ip list":                     {`ip.src in $office_ips`, 0},
+		"path and method":             {`http.request.uri.path eq "/api/v1.2/status" and http.request.method eq "GET"`, 0},
+		"header lookup":               {`any(http.request.headers["x-bypass"][*] eq "abc.def")`, 0},
+		"function call":               {`lower(http.host) eq "www.example.com"`, 0},
+		"geoip":                       {`ip.src.country in {"US" "CA"} or ip.geoip.asnum eq 13335`, 0},
+		"bot management":              {`cf.bot_management.score lt 30`, 1},
+		"unsupported field repeated":  {`cf.threat_score gt 10 or cf.threat_score lt 1`, 1},
+		"multiple unsupported fields": {`http.request.body.raw contains "x" and cf.client.bot`, 2},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, errs := validateWaitingRoomRuleExpression(tc.expression, "expression")
+			if len(errs) != tc.errCount {
+				t.Errorf("expected %d errors for %q, got %d: %v", tc.errCount, tc.expression, len(errs), errs)
+			}
+		})
+	}
+}
+
+func TestAccCloudflareWaitingRoomRules_Basic(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_waiting_room_rules.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWaitingRoomRules(rnd, zoneID, domain, `ip.src in {192.0.2.0/24}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "rules.#", "2"),
+					resource.TestCheckResourceAttr(name, "rules.0.action", "bypass_waiting_room"),
+					resource.TestCheckResourceAttr(name, "rules.0.expression", "ip.src in {192.0.2.0/24}"),
+					resource.TestCheckResourceAttr(name, "rules.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet(name, "rules.0.id"),
+					resource.TestCheckResourceAttr(name, "rules.1.enabled", "false"),
+				),
+			},
+			{
+				Config:      testAccCloudflareWaitingRoomRules(rnd, zoneID, domain, `cf.bot_management.score lt 30`),
+				ExpectError: regexp.MustCompile(`field "cf.bot_management.score" is not supported in waiting room rules`),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWaitingRoomRules(rnd, zoneID, domain, expression string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_waiting_room" "%[1]s" {
+  name                 = "waiting_room_%[1]s"
+  zone_id              = "%[2]s"
+  host                 = "www.%[3]s"
+  path                 = "/%[1]s"
+  new_users_per_minute = 400
+  total_active_users   = 405
+}
+
+resource "cloudflare_waiting_room_rules" "%[1]s" {
+  zone_id         = "%[2]s"
+  waiting_room_id = cloudflare_waiting_room.%[1]s.id
+
+  rules {
+    description = "bypass office"
+    action      = "bypass_waiting_room"
+    expression  = "%[4]s"
+  }
+
+  rules {
+    description = "bypass status page"
+    action      = "bypass_waiting_room"
+    expression  = "http.request.uri.path eq \"/status\""
+    enabled     = false
+  }
+}
+`, rnd, zoneID, domain, expression)
+}
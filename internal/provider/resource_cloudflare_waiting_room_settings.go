@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type waitingRoomSettings struct {
+	SearchEngineCrawlerBypass bool `json:"search_engine_crawler_bypass"`
+}
+
+func resourceCloudflareWaitingRoomSettings() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWaitingRoomSettingsSchema(),
+		CreateContext: resourceCloudflareWaitingRoomSettingsUpdate,
+		ReadContext:   resourceCloudflareWaitingRoomSettingsRead,
+		UpdateContext: resourceCloudflareWaitingRoomSettingsUpdate,
+		DeleteContext: resourceCloudflareWaitingRoomSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWaitingRoomSettingsImport,
+		},
+		Description: "Provides a resource to manage the zone-wide settings shared by all waiting rooms of a zone.",
+	}
+}
+
+func resourceCloudflareWaitingRoomSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, waitingRoomSettingsURI(zoneID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading waiting room settings for zone %q: %w", zoneID, err))
+	}
+
+	var settings waitingRoomSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling waiting room settings: %w", err))
+	}
+
+	d.Set("search_engine_crawler_bypass", settings.SearchEngineCrawlerBypass)
+
+	return nil
+}
+
+func resourceCloudflareWaitingRoomSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	settings := waitingRoomSettings{
+		SearchEngineCrawlerBypass: d.Get("search_engine_crawler_bypass").(bool),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating waiting room settings for zone %s: %+v", zoneID, settings))
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, waitingRoomSettingsURI(zoneID), settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating waiting room settings for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareWaitingRoomSettingsRead(ctx, d, meta)
+}
+
+func resourceCloudflareWaitingRoomSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Resetting waiting room settings for zone %s", zoneID))
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, waitingRoomSettingsURI(zoneID), waitingRoomSettings{}); err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting waiting room settings for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWaitingRoomSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareWaitingRoomSettingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func waitingRoomSettingsURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/waiting_rooms/settings", zoneID)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWaitingRoomSettings_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_waiting_room_settings.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWaitingRoomSettingsConfig(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "search_engine_crawler_bypass", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareWaitingRoomSettingsConfig(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "search_engine_crawler_bypass", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareWaitingRoomSettingsConfig(rnd, zoneID string, bypass bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_waiting_room_settings" "%[1]s" {
+  zone_id                      = "%[2]s"
+  search_engine_crawler_bypass = %[3]t
+}
+`, rnd, zoneID, bypass)
+}
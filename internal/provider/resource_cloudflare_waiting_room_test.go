@@ -6,7 +6,6 @@ import (
 	"os"
 	"testing"
 
-	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -39,6 +38,8 @@ func TestAccCloudflareWaitingRoom_Create(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "total_active_users", "405"),
 					resource.TestCheckResourceAttr(name, "session_duration", "10"),
 					resource.TestCheckResourceAttr(name, "json_response_enabled", "true"),
+					resource.TestCheckResourceAttr(name, "queueing_method", "random"),
+					resource.TestCheckResourceAttr(name, "cookie_suffix", "queue1"),
 				),
 			},
 		},
@@ -46,7 +47,7 @@ func TestAccCloudflareWaitingRoom_Create(t *testing.T) {
 }
 
 func testAccCheckCloudflareWaitingRoomDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_waiting_room" {
@@ -79,6 +80,8 @@ resource "cloudflare_waiting_room" "%[1]s" {
   suspended                 = true
   queue_all                 = false
   json_response_enabled     = true
+  queueing_method           = "random"
+  cookie_suffix             = "queue1"
 }
 `, resourceName, waitingRoomName, zoneID, domain, path)
 }
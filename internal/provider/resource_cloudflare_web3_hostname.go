@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type web3Hostname struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Target      string `json:"target,omitempty"`
+	Description string `json:"description"`
+	DNSLink     string `json:"dnslink"`
+	Status      string `json:"status,omitempty"`
+	CreatedOn   string `json:"created_on,omitempty"`
+	ModifiedOn  string `json:"modified_on,omitempty"`
+}
+
+func resourceCloudflareWeb3Hostname() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWeb3HostnameSchema(),
+		CreateContext: resourceCloudflareWeb3HostnameCreate,
+		ReadContext:   resourceCloudflareWeb3HostnameRead,
+		UpdateContext: resourceCloudflareWeb3HostnameUpdate,
+		DeleteContext: resourceCloudflareWeb3HostnameDelete,
+		CustomizeDiff: resourceCloudflareWeb3HostnameDNSLinkDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWeb3HostnameImport,
+		},
+		Description: "Provides a resource to manage a Web3 hostname pointing to an IPFS or Ethereum gateway.",
+	}
+}
+
+func resourceCloudflareWeb3HostnameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	hostname := web3Hostname{
+		Name:        d.Get("name").(string),
+		Target:      d.Get("target").(string),
+		Description: d.Get("description").(string),
+		DNSLink:     d.Get("dnslink").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Web3 hostname from struct: %+v", hostname))
+
+	res, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/web3/hostnames", zoneID), hostname)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Web3 hostname %q: %w", hostname.Name, err))
+	}
+
+	var created web3Hostname
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Web3 hostname %q: %w", hostname.Name, err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareWeb3HostnameRead(ctx, d, meta)
+}
+
+func resourceCloudflareWeb3HostnameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, web3HostnameURI(zoneID, d.Id()), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Web3 hostname %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error fetching Web3 hostname %q: %w", d.Id(), err))
+	}
+
+	var hostname web3Hostname
+	if err := json.Unmarshal(res, &hostname); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Web3 hostname %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", hostname.Name)
+	d.Set("target", hostname.Target)
+	d.Set("description", hostname.Description)
+	d.Set("dnslink", hostname.DNSLink)
+	d.Set("status", hostname.Status)
+	d.Set("created_on", hostname.CreatedOn)
+	d.Set("modified_on", hostname.ModifiedOn)
+
+	return nil
+}
+
+func resourceCloudflareWeb3HostnameUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	hostname := web3Hostname{
+		Description: d.Get("description").(string),
+		DNSLink:     d.Get("dnslink").(string),
+	}
+
+	_, err := rawRequest(ctx, client, http.MethodPatch, web3HostnameURI(zoneID, d.Id()), hostname)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Web3 hostname %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareWeb3HostnameRead(ctx, d, meta)
+}
+
+func resourceCloudflareWeb3HostnameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	_, err := rawRequest(ctx, client, http.MethodDelete, web3HostnameURI(zoneID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Web3 hostname %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWeb3HostnameImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "zoneID/hostnameID"`, d.Id())
+	}
+
+	zoneID, hostnameID := attributes[0], attributes[1]
+
+	d.SetId(hostnameID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareWeb3HostnameRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareWeb3HostnameDNSLinkDiff rejects a DNSLink on targets
+// that don't serve one, which the API only reports once the hostname is
+// being created.
+func resourceCloudflareWeb3HostnameDNSLinkDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("target") || !d.NewValueKnown("dnslink") {
+		return nil
+	}
+
+	if target := d.Get("target").(string); target != "ipfs" && d.Get("dnslink").(string) != "" {
+		return fmt.Errorf("dnslink is only supported with the ipfs target, not %q", target)
+	}
+
+	return nil
+}
+
+func web3HostnameURI(zoneID, hostnameID string) string {
+	return fmt.Sprintf("/zones/%s/web3/hostnames/%s", zoneID, hostnameID)
+}
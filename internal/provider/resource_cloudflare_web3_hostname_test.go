@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWeb3Hostname_IPFS(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_web3_hostname.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	hostname := fmt.Sprintf("%s.%s", rnd, domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWeb3HostnameConfig(rnd, zoneID, hostname, "ipfs", "initial", "/ipns/onboarding.ipfs.cloudflare.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", hostname),
+					resource.TestCheckResourceAttr(name, "target", "ipfs"),
+					resource.TestCheckResourceAttr(name, "description", "initial"),
+					resource.TestCheckResourceAttr(name, "dnslink", "/ipns/onboarding.ipfs.cloudflare.com"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+				),
+			},
+			{
+				Config: testAccCloudflareWeb3HostnameConfig(rnd, zoneID, hostname, "ipfs", "updated", "/ipfs/bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "description", "updated"),
+					resource.TestCheckResourceAttr(name, "dnslink", "/ipfs/bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareWeb3Hostname_DNSLinkRequiresIPFS(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareWeb3HostnameConfig(rnd, zoneID, fmt.Sprintf("%s.%s", rnd, domain), "ethereum", "", "/ipns/onboarding.ipfs.cloudflare.com"),
+				ExpectError: regexp.MustCompile(`dnslink is only supported with the ipfs target`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWeb3HostnameConfig(rnd, zoneID, hostname, target, description, dnslink string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_web3_hostname" "%[1]s" {
+  zone_id     = "%[2]s"
+  name        = "%[3]s"
+  target      = "%[4]s"
+  description = "%[5]s"
+  dnslink     = "%[6]s"
+}
+`, rnd, zoneID, hostname, target, description, dnslink)
+}
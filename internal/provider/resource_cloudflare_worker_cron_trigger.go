@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -20,13 +21,14 @@ func resourceCloudflareWorkerCronTrigger() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareWorkerCronTriggerImport,
 		},
+		Description: "Provides a Cloudflare Worker Cron Trigger resource. The full set of schedules for a Worker script is managed by this resource and any schedules added outside of Terraform will be removed on the next apply.",
 	}
 }
 
 // resourceCloudflareWorkerCronTriggerUpdate is used for creation and updates of
 // Worker Cron Triggers as the remote API endpoint is shared uses HTTP PUT.
 func resourceCloudflareWorkerCronTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	accountID := d.Get("account_id").(string)
 
 	scriptName := d.Get("script_name").(string)
@@ -42,7 +44,7 @@ func resourceCloudflareWorkerCronTriggerUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceCloudflareWorkerCronTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	scriptName := d.Get("script_name").(string)
 	accountID := d.Get("account_id").(string)
 
@@ -57,7 +59,16 @@ func resourceCloudflareWorkerCronTriggerRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(fmt.Errorf("failed to read Worker Cron Trigger: %w", err))
 	}
 
-	if err := d.Set("schedules", transformWorkerCronTriggerStructToSet(s)); err != nil {
+	// Schedules added outside of Terraform are surfaced as drift and will be
+	// removed on the next apply.
+	remoteSchedules := transformWorkerCronTriggerStructToSet(s)
+	if managed := d.Get("schedules").(*schema.Set); managed.Len() > 0 {
+		if unmanaged := remoteSchedules.Difference(managed); unmanaged.Len() > 0 {
+			tflog.Warn(ctx, fmt.Sprintf("Worker Cron Trigger for script %q has schedules not managed by Terraform: %v", scriptName, unmanaged.List()))
+		}
+	}
+
+	if err := d.Set("schedules", remoteSchedules); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set schedules attribute: %w", err))
 	}
 
@@ -65,7 +76,7 @@ func resourceCloudflareWorkerCronTriggerRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceCloudflareWorkerCronTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	scriptName := d.Get("script_name").(string)
 	accountID := d.Get("account_id").(string)
 
@@ -80,7 +91,16 @@ func resourceCloudflareWorkerCronTriggerDelete(ctx context.Context, d *schema.Re
 }
 
 func resourceCloudflareWorkerCronTriggerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	d.SetId(stringChecksum(d.Id()))
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 || attributes[0] == "" || attributes[1] == "" {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/scriptName": importing using only the script name is no longer supported`, d.Id())
+	}
+
+	accountID, scriptName := attributes[0], attributes[1]
+
+	d.SetId(stringChecksum(scriptName))
+	d.Set("account_id", accountID)
+	d.Set("script_name", scriptName)
 
 	resourceCloudflareWorkerCronTriggerRead(ctx, d, meta)
 
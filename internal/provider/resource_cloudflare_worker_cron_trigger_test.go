@@ -3,9 +3,11 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccCloudflareWorkerCronTriggerBasic(t *testing.T) {
@@ -26,6 +28,28 @@ func TestAccCloudflareWorkerCronTriggerBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "schedules.#", "2"),
 				),
 			},
+			{
+				Config: testAccCloudflareWorkerCronTriggerConfigUpdated(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "script_name", rnd),
+					resource.TestCheckResourceAttr(name, "schedules.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "schedules.*", "0 0 L * *"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/%s", accountID, rnd), nil
+				},
+			},
+			{
+				ResourceName:  name,
+				ImportState:   true,
+				ImportStateId: rnd,
+				ExpectError:   regexp.MustCompile(`should be in format "accountID/scriptName"`),
+			},
 		},
 	})
 }
@@ -47,3 +71,20 @@ resource "cloudflare_worker_cron_trigger" "%[1]s" {
 }
 `, rnd, accountID)
 }
+
+func testAccCloudflareWorkerCronTriggerConfigUpdated(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s" {
+	name = "%[1]s"
+	content = "addEventListener('fetch', event => {event.respondWith(new Response('test'))});"
+}
+
+resource "cloudflare_worker_cron_trigger" "%[1]s" {
+	account_id  = "%[2]s"
+	script_name = cloudflare_worker_script.%[1]s.name
+	schedules   = [
+		"0 0 L * *", # midnight on the last day of every month
+	]
+}
+`, rnd, accountID)
+}
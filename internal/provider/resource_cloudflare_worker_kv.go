@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -26,7 +27,7 @@ func resourceCloudflareWorkerKV() *schema.Resource {
 }
 
 func resourceCloudflareWorkersKVRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	namespaceID, key, err := parseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -43,16 +44,55 @@ func resourceCloudflareWorkersKVRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.Set("value", string(value))
+
+	storageKey, err := findWorkersKVStorageKey(ctx, client, namespaceID, key)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error reading workers kv metadata"))
+	}
+
+	if storageKey != nil {
+		if storageKey.Metadata != nil {
+			metadata, err := json.Marshal(storageKey.Metadata)
+			if err != nil {
+				return diag.FromErr(errors.Wrap(err, "error marshalling workers kv metadata"))
+			}
+			d.Set("metadata", string(metadata))
+		} else {
+			d.Set("metadata", "")
+		}
+		d.Set("expiration", storageKey.Expiration)
+	}
+
 	return nil
 }
 
 func resourceCloudflareWorkersKVUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	namespaceID := d.Get("namespace_id").(string)
 	key := d.Get("key").(string)
-	value := d.Get("value").(string)
 
-	_, err := client.WriteWorkersKV(ctx, namespaceID, key, []byte(value))
+	pair := &cloudflare.WorkersKVPair{
+		Key:   key,
+		Value: d.Get("value").(string),
+	}
+
+	if expiration, ok := d.GetOk("expiration_ttl"); ok {
+		pair.ExpirationTTL = expiration.(int)
+	} else if expiration, ok := d.GetOk("expiration"); ok {
+		pair.Expiration = expiration.(int)
+	}
+
+	if metadata, ok := d.GetOk("metadata"); ok {
+		var m interface{}
+		if err := json.Unmarshal([]byte(metadata.(string)), &m); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error unmarshalling workers kv metadata"))
+		}
+		pair.Metadata = m
+	}
+
+	// The bulk endpoint is used for single writes as it is the only one which
+	// accepts expiration and metadata alongside the value.
+	_, err := client.WriteWorkersKVBulk(ctx, namespaceID, cloudflare.WorkersKVBulkWriteRequest{pair})
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating workers kv"))
 	}
@@ -65,7 +105,7 @@ func resourceCloudflareWorkersKVUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceCloudflareWorkersKVDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	namespaceID, key, err := parseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -95,6 +135,30 @@ func resourceCloudflareWorkersKVImport(ctx context.Context, d *schema.ResourceDa
 	return []*schema.ResourceData{d}, nil
 }
 
+// findWorkersKVStorageKey looks up the listing entry for a single key which
+// is the only place the API exposes the expiration and metadata of a pair.
+func findWorkersKVStorageKey(ctx context.Context, client *apiClient, namespaceID, key string) (*cloudflare.StorageKey, error) {
+	opts := cloudflare.ListWorkersKVsOptions{Prefix: cloudflare.StringPtr(key)}
+
+	for {
+		resp, err := client.ListWorkersKVsWithOptions(ctx, namespaceID, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range resp.Result {
+			if k.Name == key {
+				return &k, nil
+			}
+		}
+
+		if resp.Cursor == "" {
+			return nil, nil
+		}
+		opts.Cursor = cloudflare.StringPtr(resp.Cursor)
+	}
+}
+
 func parseId(id string) (string, string, error) {
 	parts := strings.SplitN(id, "/", 2)
 	if len(parts) != 2 {
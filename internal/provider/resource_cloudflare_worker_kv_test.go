@@ -36,8 +36,35 @@ func TestAccCloudflareWorkersKV_Basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWorkersKV_WithMetadataAndExpiration(t *testing.T) {
+	t.Parallel()
+	var kvPair cloudflare.WorkersKVPair
+	name := generateRandomResourceName()
+	key := generateRandomResourceName()
+	value := generateRandomResourceName()
+	resourceName := "cloudflare_workers_kv." + name
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCloudflareWorkersKVDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareWorkersKVWithMetadataAndExpiration(name, key, value),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkersKVExists(key, &kvPair),
+					resource.TestCheckResourceAttr(resourceName, "value", value),
+					resource.TestCheckResourceAttr(resourceName, "metadata", `{"environment":"test"}`),
+					resource.TestCheckResourceAttr(resourceName, "expiration_ttl", "3600"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCloudflareWorkersKVDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_workers_kv" {
@@ -66,9 +93,20 @@ resource "cloudflare_workers_kv" "%[1]s" {
 }`, rName, key, value)
 }
 
+func testAccCheckCloudflareWorkersKVWithMetadataAndExpiration(rName string, key string, value string) string {
+	return testAccCheckCloudflareWorkersKVNamespace(rName) + fmt.Sprintf(`
+resource "cloudflare_workers_kv" "%[1]s" {
+	namespace_id   = cloudflare_workers_kv_namespace.%[1]s.id
+	key            = "%[2]s"
+	value          = "%[3]s"
+	metadata       = jsonencode({ environment = "test" })
+	expiration_ttl = 3600
+}`, rName, key, value)
+}
+
 func testAccCheckCloudflareWorkersKVExists(key string, kv *cloudflare.WorkersKVPair) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "cloudflare_workers_kv" {
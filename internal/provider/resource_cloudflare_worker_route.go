@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -20,6 +22,7 @@ func resourceCloudflareWorkerRoute() *schema.Resource {
 		ReadContext:   resourceCloudflareWorkerRouteRead,
 		UpdateContext: resourceCloudflareWorkerRouteUpdate,
 		DeleteContext: resourceCloudflareWorkerRouteDelete,
+		CustomizeDiff: resourceCloudflareWorkerRouteOverlapDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareWorkerRouteImport,
 		},
@@ -36,7 +39,7 @@ func getRouteFromResource(d *schema.ResourceData) cloudflare.WorkerRoute {
 }
 
 func resourceCloudflareWorkerRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	route := getRouteFromResource(d)
 	zoneID := d.Get("zone_id").(string)
 
@@ -55,11 +58,17 @@ func resourceCloudflareWorkerRouteCreate(ctx context.Context, d *schema.Resource
 
 	tflog.Info(ctx, fmt.Sprintf("Cloudflare Worker Route ID: %s", d.Id()))
 
+	if mode, ok := d.GetOk("request_limit_failure_mode"); ok {
+		if err := setWorkerRouteRequestLimitFailureMode(ctx, client, zoneID, route, mode.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
 func resourceCloudflareWorkerRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	routeID := d.Id()
 
@@ -89,11 +98,21 @@ func resourceCloudflareWorkerRouteRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("pattern", route.Pattern)
 	d.Set("script_name", route.Script)
 
+	// The failure mode is only returned by the routes endpoint, which isn't
+	// used by accounts still on filters, so it is only read when managed.
+	if d.Get("request_limit_failure_mode").(string) != "" {
+		mode, err := getWorkerRouteRequestLimitFailureMode(ctx, client, zoneID, routeID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("request_limit_failure_mode", mode)
+	}
+
 	return nil
 }
 
 func resourceCloudflareWorkerRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	route := getRouteFromResource(d)
 
@@ -104,11 +123,17 @@ func resourceCloudflareWorkerRouteUpdate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(errors.Wrap(err, "error updating worker route"))
 	}
 
+	if mode, ok := d.GetOk("request_limit_failure_mode"); ok {
+		if err := setWorkerRouteRequestLimitFailureMode(ctx, client, zoneID, route, mode.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
 func resourceCloudflareWorkerRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Get("zone_id").(string)
 	route := getRouteFromResource(d)
 
@@ -141,3 +166,135 @@ func resourceCloudflareWorkerRouteImport(ctx context.Context, d *schema.Resource
 
 	return []*schema.ResourceData{d}, nil
 }
+
+type workerRouteRequestLimit struct {
+	Pattern              string `json:"pattern"`
+	Script               string `json:"script,omitempty"`
+	RequestLimitFailOpen bool   `json:"request_limit_fail_open"`
+}
+
+func setWorkerRouteRequestLimitFailureMode(ctx context.Context, client *apiClient, zoneID string, route cloudflare.WorkerRoute, mode string) error {
+	params := workerRouteRequestLimit{
+		Pattern:              route.Pattern,
+		Script:               route.Script,
+		RequestLimitFailOpen: mode == "fail_open",
+	}
+
+	_, err := rawRequest(ctx, client, http.MethodPut, fmt.Sprintf("/zones/%s/workers/routes/%s", zoneID, route.ID), params)
+	if err != nil {
+		return errors.Wrap(err, "error updating worker route request limit failure mode")
+	}
+
+	return nil
+}
+
+func getWorkerRouteRequestLimitFailureMode(ctx context.Context, client *apiClient, zoneID, routeID string) (string, error) {
+	res, err := rawRequest(ctx, client, http.MethodGet, fmt.Sprintf("/zones/%s/workers/routes/%s", zoneID, routeID), nil)
+	if err != nil {
+		return "", errors.Wrap(err, "error reading worker route request limit failure mode")
+	}
+
+	var route workerRouteRequestLimit
+	if err := json.Unmarshal(res, &route); err != nil {
+		return "", errors.Wrap(err, "error unmarshalling worker route")
+	}
+
+	if route.RequestLimitFailOpen {
+		return "fail_open", nil
+	}
+	return "fail_closed", nil
+}
+
+// resourceCloudflareWorkerRouteOverlapDiff checks the pattern of a new or
+// changed route against the routes that already exist in the zone. Routes
+// created in the same apply aren't known to the API yet, and unchanged routes
+// aren't checked again, so overlaps between them are never detected.
+func resourceCloudflareWorkerRouteOverlapDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("zone_id") || !d.NewValueKnown("pattern") {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges("pattern", "allow_overlapping_patterns") {
+		return nil
+	}
+
+	client := meta.(*apiClient)
+	pattern := d.Get("pattern").(string)
+	allowOverlap := d.Get("allow_overlapping_patterns").(bool)
+
+	resp, err := client.ListWorkerRoutes(ctx, d.Get("zone_id").(string))
+	if err != nil {
+		return errors.Wrap(err, "error reading worker routes")
+	}
+
+	for _, r := range resp.Routes {
+		if r.ID == d.Id() {
+			continue
+		}
+
+		if strings.EqualFold(workerRoutePatternWithoutScheme(r.Pattern), workerRoutePatternWithoutScheme(pattern)) {
+			return fmt.Errorf("pattern %q is already used by worker route %s", pattern, r.ID)
+		}
+
+		if !allowOverlap && workerRoutePatternsOverlap(pattern, r.Pattern) {
+			return fmt.Errorf("pattern %q overlaps with pattern %q of worker route %s, set allow_overlapping_patterns to let the most specific route handle requests matching both", pattern, r.Pattern, r.ID)
+		}
+	}
+
+	return nil
+}
+
+// workerRoutePatternsOverlap reports whether a request URL can match both
+// patterns. Route patterns only allow a wildcard at the start of the
+// hostname and at the end of the path.
+func workerRoutePatternsOverlap(a, b string) bool {
+	hostA, pathA := splitWorkerRoutePattern(a)
+	hostB, pathB := splitWorkerRoutePattern(b)
+
+	return workerRouteHostsOverlap(hostA, hostB) && workerRoutePathsOverlap(pathA, pathB)
+}
+
+func workerRouteHostsOverlap(a, b string) bool {
+	wildA, wildB := strings.HasPrefix(a, "*"), strings.HasPrefix(b, "*")
+	a, b = strings.TrimPrefix(a, "*"), strings.TrimPrefix(b, "*")
+
+	switch {
+	case wildA && wildB:
+		return strings.HasSuffix(a, b) || strings.HasSuffix(b, a)
+	case wildA:
+		return strings.HasSuffix(b, a)
+	case wildB:
+		return strings.HasSuffix(a, b)
+	default:
+		return a == b
+	}
+}
+
+func workerRoutePathsOverlap(a, b string) bool {
+	wildA, wildB := strings.HasSuffix(a, "*"), strings.HasSuffix(b, "*")
+	a, b = strings.TrimSuffix(a, "*"), strings.TrimSuffix(b, "*")
+
+	switch {
+	case wildA && wildB:
+		return strings.HasPrefix(a, b) || strings.HasPrefix(b, a)
+	case wildA:
+		return strings.HasPrefix(b, a)
+	case wildB:
+		return strings.HasPrefix(a, b)
+	default:
+		return a == b
+	}
+}
+
+func splitWorkerRoutePattern(pattern string) (string, string) {
+	pattern = workerRoutePatternWithoutScheme(pattern)
+
+	if i := strings.Index(pattern, "/"); i >= 0 {
+		return strings.ToLower(pattern[:i]), pattern[i:]
+	}
+	return strings.ToLower(pattern), "/"
+}
+
+func workerRoutePatternWithoutScheme(pattern string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(pattern, "https://"), "http://")
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -142,6 +144,98 @@ resource "cloudflare_worker_route" "%[2]s" {
 }`, zoneID, routeRnd, pattern)
 }
 
+func TestAccCloudflareWorkerRoute_RequestLimitFailureMode(t *testing.T) {
+	var route cloudflare.WorkerRoute
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	routeRnd := generateRandomResourceName()
+	routeName := "cloudflare_worker_route." + routeRnd
+	pattern := fmt.Sprintf("%s/%s/*", zoneName, routeRnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWorkerRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareWorkerRouteConfigRequestLimitFailureMode(zoneID, routeRnd, pattern, "fail_closed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerRouteExists(routeName, &route),
+					resource.TestCheckResourceAttr(routeName, "request_limit_failure_mode", "fail_closed"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareWorkerRouteConfigRequestLimitFailureMode(zoneID, routeRnd, pattern, "fail_open"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerRouteExists(routeName, &route),
+					resource.TestCheckResourceAttr(routeName, "request_limit_failure_mode", "fail_open"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareWorkerRouteConfigRequestLimitFailureMode(zoneID, routeRnd, pattern, "fail_open") +
+					testAccCheckCloudflareWorkerRouteConfigNoOverlap(zoneID, routeRnd+"_overlap", fmt.Sprintf("%s/%s/api/*", zoneName, routeRnd)),
+				ExpectError: regexp.MustCompile(`overlaps with pattern`),
+			},
+		},
+	})
+}
+
+// TestAccCloudflareWorkerRoute_OverlapSameApply shows that overlapping routes
+// created in the same apply are not detected, as neither exists in the API
+// when the other is planned.
+func TestAccCloudflareWorkerRoute_OverlapSameApply(t *testing.T) {
+	var route cloudflare.WorkerRoute
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	routeRnd := generateRandomResourceName()
+	overlapRnd := routeRnd + "_overlap"
+	config := testAccCheckCloudflareWorkerRouteConfigNoOverlap(zoneID, routeRnd, fmt.Sprintf("%s/%s/*", zoneName, routeRnd)) +
+		testAccCheckCloudflareWorkerRouteConfigNoOverlap(zoneID, overlapRnd, fmt.Sprintf("%s/%s/api/*", zoneName, routeRnd))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWorkerRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerRouteExists("cloudflare_worker_route."+routeRnd, &route),
+					testAccCheckCloudflareWorkerRouteExists("cloudflare_worker_route."+overlapRnd, &route),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareWorkerRouteConfigRequestLimitFailureMode(zoneID, routeRnd, pattern, mode string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_route" "%[2]s" {
+  zone_id                    = "%[1]s"
+  pattern                    = "%[3]s"
+  request_limit_failure_mode = "%[4]s"
+}`, zoneID, routeRnd, pattern, mode)
+}
+
+func testAccCheckCloudflareWorkerRouteConfigNoOverlap(zoneID, routeRnd, pattern string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_route" "%[2]s" {
+  zone_id                    = "%[1]s"
+  pattern                    = "%[3]s"
+  allow_overlapping_patterns = false
+}`, zoneID, routeRnd, pattern)
+}
+
 func getRouteFromApi(zoneID, routeId string) (cloudflare.WorkerRoute, error) {
 	if zoneID == "" {
 		return cloudflare.WorkerRoute{}, fmt.Errorf("zoneID is required to get a route")
@@ -150,7 +244,7 @@ func getRouteFromApi(zoneID, routeId string) (cloudflare.WorkerRoute, error) {
 		return cloudflare.WorkerRoute{}, fmt.Errorf("routeId is required to get a route")
 	}
 
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 	resp, err := client.ListWorkerRoutes(context.Background(), zoneID)
 	if err != nil {
 		return cloudflare.WorkerRoute{}, err
@@ -215,3 +309,25 @@ func testAccCheckCloudflareWorkerRouteDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestWorkerRoutePatternsOverlap(t *testing.T) {
+	testCases := []struct {
+		a, b    string
+		overlap bool
+	}{
+		{"example.com/*", "example.com/api/*", true},
+		{"example.com/api/*", "example.com/static/*", false},
+		{"*.example.com/*", "api.example.com/v1", true},
+		{"*example.com/*", "example.com/", true},
+		{"*.example.com/*", "example.com/", false},
+		{"https://example.com/api", "example.com/api*", true},
+		{"example.com/api", "EXAMPLE.com/api", true},
+		{"example.com/api", "example.org/api", false},
+		{"*.a.example.com/*", "*.example.com/x*", true},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.overlap, workerRoutePatternsOverlap(tc.a, tc.b), "%s and %s", tc.a, tc.b)
+		assert.Equal(t, tc.overlap, workerRoutePatternsOverlap(tc.b, tc.a), "%s and %s", tc.b, tc.a)
+	}
+}
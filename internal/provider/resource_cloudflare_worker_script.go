@@ -1,10 +1,21 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -24,6 +35,8 @@ func resourceCloudflareWorkerScript() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareWorkerScriptImport,
 		},
+		CustomizeDiff: resourceCloudflareWorkerScriptAssetsDiff,
+		Description:   "Provides a Cloudflare worker script resource. In order for a script to be active, you'll also need to setup a `cloudflare_worker_route`.",
 	}
 }
 
@@ -34,7 +47,7 @@ type ScriptData struct {
 	Params cloudflare.WorkerRequestParams
 }
 
-func getScriptData(d *schema.ResourceData, client *cloudflare.API) (ScriptData, error) {
+func getScriptData(d *schema.ResourceData, client *apiClient) (ScriptData, error) {
 	scriptName := d.Get("name").(string)
 
 	params := cloudflare.WorkerRequestParams{
@@ -49,7 +62,7 @@ func getScriptData(d *schema.ResourceData, client *cloudflare.API) (ScriptData,
 
 type ScriptBindings map[string]cloudflare.WorkerBinding
 
-func getWorkerScriptBindings(ctx context.Context, scriptName string, client *cloudflare.API) (ScriptBindings, error) {
+func getWorkerScriptBindings(ctx context.Context, scriptName string, client *apiClient) (ScriptBindings, error) {
 	resp, err := client.ListWorkerBindings(ctx, &cloudflare.WorkerRequestParams{ScriptName: scriptName})
 	if err != nil {
 		return nil, fmt.Errorf("cannot list script bindings: %w", err)
@@ -95,8 +108,51 @@ func parseWorkerBindings(d *schema.ResourceData, bindings ScriptBindings) {
 	}
 }
 
+// uploadWorkerScript uploads the script with its bindings. Scripts without
+// static assets go through the library; scripts with assets first upload any
+// files missing from the account and then attach the completed upload to the
+// script metadata.
+func uploadWorkerScript(ctx context.Context, d *schema.ResourceData, client *apiClient, scriptData ScriptData, scriptBody string, bindings ScriptBindings) error {
+	ctx = withRequestTimeout(ctx, slowRequestTimeout)
+
+	assets := d.Get("assets").([]interface{})
+	if len(assets) == 0 || assets[0] == nil {
+		scriptParams := cloudflare.WorkerScriptParams{
+			Script:   scriptBody,
+			Bindings: bindings,
+		}
+
+		if _, err := client.UploadWorkerWithBindings(ctx, &scriptData.Params, &scriptParams); err != nil {
+			return err
+		}
+
+		return d.Set("assets_manifest_hash", "")
+	}
+
+	if client.AccountID == "" {
+		return fmt.Errorf("account_id must be set on the provider to upload worker script assets")
+	}
+
+	config := assets[0].(map[string]interface{})
+	manifest, err := buildWorkerAssetsManifest(config["directory"].(string))
+	if err != nil {
+		return err
+	}
+
+	completionToken, err := uploadWorkerAssets(ctx, client, scriptData.ID, config["directory"].(string), manifest)
+	if err != nil {
+		return err
+	}
+
+	if err := uploadWorkerScriptWithAssets(ctx, client, scriptData.ID, scriptBody, bindings, config, completionToken); err != nil {
+		return err
+	}
+
+	return d.Set("assets_manifest_hash", workerAssetsManifestChecksum(manifest))
+}
+
 func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	scriptData, err := getScriptData(d, client)
 	if err != nil {
@@ -120,13 +176,7 @@ func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.Resourc
 
 	parseWorkerBindings(d, bindings)
 
-	scriptParams := cloudflare.WorkerScriptParams{
-		Script:   scriptBody,
-		Bindings: bindings,
-	}
-
-	_, err = client.UploadWorkerWithBindings(ctx, &scriptData.Params, &scriptParams)
-	if err != nil {
+	if err := uploadWorkerScript(ctx, d, client, scriptData, scriptBody, bindings); err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating worker script"))
 	}
 
@@ -136,7 +186,7 @@ func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	scriptData, err := getScriptData(d, client)
 	if err != nil {
@@ -228,7 +278,7 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareWorkerScriptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	scriptData, err := getScriptData(d, client)
 	if err != nil {
@@ -246,13 +296,7 @@ func resourceCloudflareWorkerScriptUpdate(ctx context.Context, d *schema.Resourc
 
 	parseWorkerBindings(d, bindings)
 
-	scriptParams := cloudflare.WorkerScriptParams{
-		Script:   scriptBody,
-		Bindings: bindings,
-	}
-
-	_, err = client.UploadWorkerWithBindings(ctx, &scriptData.Params, &scriptParams)
-	if err != nil {
+	if err := uploadWorkerScript(ctx, d, client, scriptData, scriptBody, bindings); err != nil {
 		return diag.FromErr(errors.Wrap(err, "error updating worker script"))
 	}
 
@@ -260,7 +304,7 @@ func resourceCloudflareWorkerScriptUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceCloudflareWorkerScriptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	scriptData, err := getScriptData(d, client)
 	if err != nil {
@@ -291,3 +335,292 @@ func resourceCloudflareWorkerScriptImport(ctx context.Context, d *schema.Resourc
 
 	return []*schema.ResourceData{d}, nil
 }
+
+type workerAssetsManifestEntry struct {
+	Hash string `json:"hash"`
+	Size int64  `json:"size"`
+}
+
+type workerAssetsUploadSession struct {
+	JWT     string     `json:"jwt"`
+	Buckets [][]string `json:"buckets"`
+}
+
+// buildWorkerAssetsManifest walks directory and returns the asset manifest
+// keyed by the slash separated path of each file relative to directory.
+func buildWorkerAssetsManifest(directory string) (map[string]workerAssetsManifestEntry, error) {
+	manifest := make(map[string]workerAssetsManifestEntry)
+
+	err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(directory, path)
+		if err != nil {
+			return err
+		}
+
+		manifest["/"+filepath.ToSlash(rel)] = workerAssetsManifestEntry{
+			Hash: workerAssetHash(content, filepath.Ext(path)),
+			Size: info.Size(),
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot read assets directory %q: %w", directory, err)
+	}
+
+	if len(manifest) == 0 {
+		return nil, fmt.Errorf("assets directory %q does not contain any files", directory)
+	}
+
+	return manifest, nil
+}
+
+// workerAssetHash mirrors the hashing used by the Workers tooling: the first
+// 32 hex characters of the SHA-256 of the base64 encoded content followed by
+// the file extension (without the leading dot).
+func workerAssetHash(content []byte, ext string) string {
+	sum := sha256.Sum256([]byte(base64.StdEncoding.EncodeToString(content) + strings.TrimPrefix(ext, ".")))
+	return hex.EncodeToString(sum[:])[:32]
+}
+
+// workerAssetsManifestChecksum returns a stable checksum of the manifest so
+// changes to the files on disk show up in the plan.
+func workerAssetsManifestChecksum(manifest map[string]workerAssetsManifestEntry) string {
+	entries := make([]string, 0, len(manifest))
+	for path, entry := range manifest {
+		entries = append(entries, path+":"+entry.Hash)
+	}
+	sort.Strings(entries)
+
+	return stringListChecksum(entries)
+}
+
+func resourceCloudflareWorkerScriptAssetsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	directory, ok := d.GetOk("assets.0.directory")
+	if !ok || directory.(string) == "" {
+		if d.Get("assets_manifest_hash").(string) != "" {
+			return d.SetNew("assets_manifest_hash", "")
+		}
+		return nil
+	}
+
+	manifest, err := buildWorkerAssetsManifest(directory.(string))
+	if err != nil {
+		return err
+	}
+
+	if checksum := workerAssetsManifestChecksum(manifest); checksum != d.Get("assets_manifest_hash").(string) {
+		return d.SetNew("assets_manifest_hash", checksum)
+	}
+
+	return nil
+}
+
+// uploadWorkerAssets registers the manifest with an upload session and
+// uploads the files the API reports as missing. The returned token is
+// attached to the script upload to bind the assets to the script.
+func uploadWorkerAssets(ctx context.Context, client *apiClient, scriptName, directory string, manifest map[string]workerAssetsManifestEntry) (string, error) {
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/assets-upload-session", client.AccountID, scriptName)
+	res, err := rawRequest(ctx, client, http.MethodPost, uri, map[string]interface{}{"manifest": manifest})
+	if err != nil {
+		return "", fmt.Errorf("error creating assets upload session: %w", err)
+	}
+
+	var session workerAssetsUploadSession
+	if err := json.Unmarshal(res, &session); err != nil {
+		return "", fmt.Errorf("error unmarshalling assets upload session: %w", err)
+	}
+
+	// Nothing to upload, the session token completes the upload as-is.
+	if len(session.Buckets) == 0 {
+		return session.JWT, nil
+	}
+
+	paths := make(map[string]string, len(manifest))
+	for path, entry := range manifest {
+		paths[entry.Hash] = path
+	}
+
+	completionToken := session.JWT
+	uri = fmt.Sprintf("/accounts/%s/workers/assets/upload?base64=true", client.AccountID)
+
+	for _, bucket := range session.Buckets {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+
+		for _, hash := range bucket {
+			path, ok := paths[hash]
+			if !ok {
+				return "", fmt.Errorf("assets upload session requested unknown hash %q", hash)
+			}
+
+			content, err := ioutil.ReadFile(filepath.Join(directory, filepath.FromSlash(strings.TrimPrefix(path, "/"))))
+			if err != nil {
+				return "", fmt.Errorf("cannot read asset %q: %w", path, err)
+			}
+
+			header := make(textproto.MIMEHeader)
+			header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, hash, hash))
+			header.Set("Content-Type", workerAssetContentType(path))
+
+			part, err := writer.CreatePart(header)
+			if err != nil {
+				return "", err
+			}
+			if _, err := part.Write([]byte(base64.StdEncoding.EncodeToString(content))); err != nil {
+				return "", err
+			}
+		}
+
+		if err := writer.Close(); err != nil {
+			return "", err
+		}
+
+		res, err := rawMultipartRequest(ctx, client, http.MethodPost, uri, session.JWT, writer.FormDataContentType(), body.Bytes())
+		if err != nil {
+			return "", fmt.Errorf("error uploading assets: %w", err)
+		}
+
+		var result struct {
+			JWT string `json:"jwt"`
+		}
+		if len(res) > 0 {
+			if err := json.Unmarshal(res, &result); err != nil {
+				return "", fmt.Errorf("error unmarshalling assets upload response: %w", err)
+			}
+		}
+		if result.JWT != "" {
+			completionToken = result.JWT
+		}
+	}
+
+	return completionToken, nil
+}
+
+func workerAssetContentType(path string) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(path)); contentType != "" {
+		return contentType
+	}
+
+	return "application/octet-stream"
+}
+
+// uploadWorkerScriptWithAssets uploads the script with the asset
+// configuration attached to its metadata. The library
+// has no support for the assets metadata so the multipart body is built here,
+// including the bindings.
+func uploadWorkerScriptWithAssets(ctx context.Context, client *apiClient, scriptName, scriptBody string, bindings ScriptBindings, config map[string]interface{}, completionToken string) error {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	metadataBindings := make([]map[string]interface{}, 0, len(bindings)+1)
+	modules := make(map[string][]byte)
+
+	for name, binding := range bindings {
+		switch v := binding.(type) {
+		case cloudflare.WorkerKvNamespaceBinding:
+			metadataBindings = append(metadataBindings, map[string]interface{}{
+				"type":         "kv_namespace",
+				"name":         name,
+				"namespace_id": v.NamespaceID,
+			})
+		case cloudflare.WorkerPlainTextBinding:
+			metadataBindings = append(metadataBindings, map[string]interface{}{
+				"type": "plain_text",
+				"name": name,
+				"text": v.Text,
+			})
+		case cloudflare.WorkerSecretTextBinding:
+			metadataBindings = append(metadataBindings, map[string]interface{}{
+				"type": "secret_text",
+				"name": name,
+				"text": v.Text,
+			})
+		case cloudflare.WorkerWebAssemblyBinding:
+			module, err := ioutil.ReadAll(v.Module)
+			if err != nil {
+				return fmt.Errorf("cannot read contents of wasm bindings (%s): %w", name, err)
+			}
+			modules[name] = module
+			metadataBindings = append(metadataBindings, map[string]interface{}{
+				"type": "wasm_module",
+				"name": name,
+				"part": name,
+			})
+		}
+	}
+
+	if binding := config["binding"].(string); binding != "" {
+		metadataBindings = append(metadataBindings, map[string]interface{}{
+			"type": "assets",
+			"name": binding,
+		})
+	}
+
+	metadata, err := json.Marshal(map[string]interface{}{
+		"body_part": "script",
+		"bindings":  metadataBindings,
+		"assets": map[string]interface{}{
+			"jwt": completionToken,
+			"config": map[string]interface{}{
+				"html_handling":      config["html_handling"].(string),
+				"not_found_handling": config["not_found_handling"].(string),
+				"run_worker_first":   config["run_worker_first"].(bool),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := writeWorkerScriptPart(writer, "metadata", "", "application/json", metadata); err != nil {
+		return err
+	}
+	if err := writeWorkerScriptPart(writer, "script", "script", "application/javascript", []byte(scriptBody)); err != nil {
+		return err
+	}
+	for name, module := range modules {
+		if err := writeWorkerScriptPart(writer, name, name, "application/wasm", module); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s", client.AccountID, scriptName)
+	_, err = rawMultipartRequest(ctx, client, http.MethodPut, uri, "", writer.FormDataContentType(), body.Bytes())
+
+	return err
+}
+
+func writeWorkerScriptPart(writer *multipart.Writer, name, filename, contentType string, content []byte) error {
+	header := make(textproto.MIMEHeader)
+	if filename != "" {
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, name, filename))
+	} else {
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, name))
+	}
+	header.Set("Content-Type", contentType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = part.Write(content)
+	return err
+}
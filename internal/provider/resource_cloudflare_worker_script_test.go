@@ -3,6 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -60,6 +63,122 @@ func TestAccCloudflareWorkerScript_MultiScriptEnt(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWorkerScript_Assets(t *testing.T) {
+	t.Parallel()
+
+	var script cloudflare.WorkerScript
+	rnd := generateRandomResourceName()
+	name := "cloudflare_worker_script." + rnd
+	directory := t.TempDir()
+
+	if err := ioutil.WriteFile(filepath.Join(directory, "index.html"), []byte("<h1>"+rnd+"</h1>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWorkerScriptDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareWorkerScriptConfigAssets(rnd, directory, "none"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerScriptExists(name, &script, nil),
+					resource.TestCheckResourceAttr(name, "assets.0.binding", "ASSETS"),
+					resource.TestCheckResourceAttr(name, "assets.0.html_handling", "auto-trailing-slash"),
+					resource.TestCheckResourceAttr(name, "assets.0.not_found_handling", "none"),
+					resource.TestCheckResourceAttrSet(name, "assets_manifest_hash"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := ioutil.WriteFile(filepath.Join(directory, "404.html"), []byte("not found"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccCheckCloudflareWorkerScriptConfigAssets(rnd, directory, "404-page"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerScriptExists(name, &script, nil),
+					resource.TestCheckResourceAttr(name, "assets.0.not_found_handling", "404-page"),
+					resource.TestCheckResourceAttrSet(name, "assets_manifest_hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildWorkerAssetsManifest(t *testing.T) {
+	directory := t.TempDir()
+
+	if err := os.MkdirAll(filepath.Join(directory, "css"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(directory, "index.html"), []byte("<h1>hello</h1>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(directory, "css", "site.css"), []byte("body {}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := buildWorkerAssetsManifest(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(manifest) != 2 {
+		t.Fatalf("expected 2 manifest entries, got %d", len(manifest))
+	}
+
+	index, ok := manifest["/index.html"]
+	if !ok {
+		t.Fatal("expected /index.html in manifest")
+	}
+	if index.Size != 14 {
+		t.Errorf("expected /index.html size 14, got %d", index.Size)
+	}
+	if index.Hash != workerAssetHash([]byte("<h1>hello</h1>"), ".html") || len(index.Hash) != 32 {
+		t.Errorf("unexpected /index.html hash %q", index.Hash)
+	}
+
+	if _, ok := manifest["/css/site.css"]; !ok {
+		t.Error("expected /css/site.css in manifest")
+	}
+
+	checksum := workerAssetsManifestChecksum(manifest)
+	if err := ioutil.WriteFile(filepath.Join(directory, "index.html"), []byte("<h1>changed</h1>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err = buildWorkerAssetsManifest(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if workerAssetsManifestChecksum(manifest) == checksum {
+		t.Error("expected manifest checksum to change with file contents")
+	}
+
+	if _, err := buildWorkerAssetsManifest(t.TempDir()); err == nil {
+		t.Error("expected an error for an empty assets directory")
+	}
+}
+
+func testAccCheckCloudflareWorkerScriptConfigAssets(rnd, directory, notFoundHandling string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s" {
+  name    = "%[1]s"
+  content = "%[2]s"
+
+  assets {
+    directory          = "%[3]s"
+    binding            = "ASSETS"
+    not_found_handling = "%[4]s"
+  }
+}`, rnd, scriptContent1, filepath.ToSlash(directory), notFoundHandling)
+}
+
 func testAccCheckCloudflareWorkerScriptConfigMultiScriptInitial(rnd string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_worker_script" "%[1]s" {
@@ -127,7 +246,7 @@ func testAccCheckCloudflareWorkerScriptExists(n string, script *cloudflare.Worke
 			return fmt.Errorf("No Worker Script ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		params := getRequestParamsFromResource(rs)
 		r, err := client.DownloadWorker(context.Background(), &params)
 		if err != nil {
@@ -161,7 +280,7 @@ func testAccCheckCloudflareWorkerScriptDestroy(s *terraform.State) error {
 			continue
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		params := getRequestParamsFromResource(rs)
 		r, _ := client.DownloadWorker(context.Background(), &params)
 
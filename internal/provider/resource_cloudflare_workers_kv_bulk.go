@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareWorkersKVBulk() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWorkersKVBulkSchema(),
+		CreateContext: resourceCloudflareWorkersKVBulkUpdate,
+		ReadContext:   resourceCloudflareWorkersKVBulkRead,
+		UpdateContext: resourceCloudflareWorkersKVBulkUpdate,
+		DeleteContext: resourceCloudflareWorkersKVBulkDelete,
+		Description: "Provides a resource which manages a set of KV pairs in a Workers KV namespace using the bulk API. " +
+			"Only keys declared in `values` are managed; other keys in the namespace are left untouched.",
+	}
+}
+
+func resourceCloudflareWorkersKVBulkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	namespaceID := d.Id()
+	encoded := d.Get("base64").(bool)
+
+	values := make(map[string]interface{})
+	for key := range d.Get("values").(map[string]interface{}) {
+		value, err := client.ReadWorkersKV(ctx, namespaceID, key)
+		if err != nil {
+			if isNotFoundError(err) {
+				tflog.Info(ctx, fmt.Sprintf("Workers KV key %s no longer exists in namespace %s", key, namespaceID))
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("error reading workers kv key %q: %w", key, err))
+		}
+
+		if encoded {
+			values[key] = base64.StdEncoding.EncodeToString(value)
+		} else {
+			values[key] = string(value)
+		}
+	}
+
+	d.Set("namespace_id", namespaceID)
+
+	if err := d.Set("values", values); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting values: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWorkersKVBulkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	namespaceID := d.Get("namespace_id").(string)
+
+	o, n := d.GetChange("values")
+	oldValues, newValues := o.(map[string]interface{}), n.(map[string]interface{})
+	forceWrite := d.HasChanges("base64", "expiration_ttl")
+
+	removed := make([]string, 0)
+	for key := range oldValues {
+		if _, ok := newValues[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	pairs := make(cloudflare.WorkersKVBulkWriteRequest, 0, len(newValues))
+	for key, value := range newValues {
+		if old, ok := oldValues[key]; ok && old == value && !forceWrite && !d.IsNewResource() {
+			continue
+		}
+
+		pairs = append(pairs, &cloudflare.WorkersKVPair{
+			Key:           key,
+			Value:         value.(string),
+			Base64:        d.Get("base64").(bool),
+			ExpirationTTL: d.Get("expiration_ttl").(int),
+		})
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Writing %d and deleting %d keys in Workers KV namespace %s", len(pairs), len(removed), namespaceID))
+
+	for start := 0; start < len(pairs); start += workersKVBulkLimit {
+		end := start + workersKVBulkLimit
+		if end > len(pairs) {
+			end = len(pairs)
+		}
+
+		if _, err := client.WriteWorkersKVBulk(ctx, namespaceID, pairs[start:end]); err != nil {
+			return diag.FromErr(fmt.Errorf("error writing workers kv pairs: %w", err))
+		}
+	}
+
+	if err := deleteWorkersKVKeys(ctx, client, namespaceID, removed); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting workers kv pairs: %w", err))
+	}
+
+	d.SetId(namespaceID)
+
+	return resourceCloudflareWorkersKVBulkRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkersKVBulkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	keys := make([]string, 0)
+	for key := range d.Get("values").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting %d keys from Workers KV namespace %s", len(keys), d.Id()))
+
+	if err := deleteWorkersKVKeys(ctx, client, d.Id(), keys); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting workers kv pairs: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareWorkersKVBulk_Basic(t *testing.T) {
+	t.Parallel()
+	name := generateRandomResourceName()
+	resourceName := "cloudflare_workers_kv_bulk." + name
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCloudflareWorkersKVBulkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareWorkersKVBulk(name, `
+    first  = "one"
+    second = "two"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "values.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "values.first", "one"),
+					resource.TestCheckResourceAttr(resourceName, "values.second", "two"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareWorkersKVBulk(name, `
+    first = "uno"
+    third = "three"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "values.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "values.first", "uno"),
+					resource.TestCheckResourceAttr(resourceName, "values.third", "three"),
+					testAccCheckCloudflareWorkersKVKeyAbsent(resourceName, "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkersKVBulkDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_workers_kv_bulk" {
+			continue
+		}
+
+		for attr := range rs.Primary.Attributes {
+			if !strings.HasPrefix(attr, "values.") || attr == "values.%" {
+				continue
+			}
+
+			key := strings.TrimPrefix(attr, "values.")
+			if _, err := client.ReadWorkersKV(context.Background(), rs.Primary.ID, key); err == nil {
+				return fmt.Errorf("workers kv key %q still exists", key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudflareWorkersKVBulk(rName, values string) string {
+	return testAccCheckCloudflareWorkersKVNamespace(rName) + fmt.Sprintf(`
+resource "cloudflare_workers_kv_bulk" "%[1]s" {
+  namespace_id = cloudflare_workers_kv_namespace.%[1]s.id
+
+  values = {%[2]s
+  }
+}`, rName, values)
+}
+
+func testAccCheckCloudflareWorkersKVKeyAbsent(resourceName, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*apiClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if _, err := client.ReadWorkersKV(context.Background(), rs.Primary.Attributes["namespace_id"], key); err == nil {
+			return fmt.Errorf("workers kv key %q still exists", key)
+		}
+
+		return nil
+	}
+}
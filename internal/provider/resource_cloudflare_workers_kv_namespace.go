@@ -11,6 +11,10 @@ import (
 	"github.com/pkg/errors"
 )
 
+// workersKVBulkLimit is the maximum number of keys accepted by a single bulk
+// request and returned by a single page of the key listing.
+const workersKVBulkLimit = 10000
+
 func resourceCloudflareWorkersKVNamespace() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareWorkersKVNamespaceSchema(),
@@ -25,7 +29,7 @@ func resourceCloudflareWorkersKVNamespace() *schema.Resource {
 }
 
 func resourceCloudflareWorkersKVNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	req := &cloudflare.WorkersKVNamespaceRequest{
 		Title: d.Get("title").(string),
@@ -50,7 +54,7 @@ func resourceCloudflareWorkersKVNamespaceCreate(ctx context.Context, d *schema.R
 }
 
 func resourceCloudflareWorkersKVNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	namespaceID := d.Id()
 
 	resp, err := client.ListWorkersKVNamespaces(ctx)
@@ -75,7 +79,7 @@ func resourceCloudflareWorkersKVNamespaceRead(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudflareWorkersKVNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	namespace := &cloudflare.WorkersKVNamespaceRequest{
 		Title: d.Get("title").(string),
@@ -92,7 +96,15 @@ func resourceCloudflareWorkersKVNamespaceUpdate(ctx context.Context, d *schema.R
 }
 
 func resourceCloudflareWorkersKVNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
+
+	if d.Get("purge_on_destroy").(bool) {
+		tflog.Info(ctx, fmt.Sprintf("Purging all keys from Cloudflare Workers KV Namespace with id: %+v", d.Id()))
+
+		if err := purgeWorkersKVNamespace(ctx, client, d.Id()); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error purging workers kv namespace"))
+		}
+	}
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare Workers KV Namespace with id: %+v", d.Id()))
 
@@ -104,8 +116,51 @@ func resourceCloudflareWorkersKVNamespaceDelete(ctx context.Context, d *schema.R
 	return nil
 }
 
+// purgeWorkersKVNamespace removes every key from a namespace. All keys are
+// collected before deleting any of them as key listings are eventually
+// consistent and may still return keys which have already been removed.
+func purgeWorkersKVNamespace(ctx context.Context, client *apiClient, namespaceID string) error {
+	var keys []string
+	opts := cloudflare.ListWorkersKVsOptions{Limit: cloudflare.IntPtr(workersKVBulkLimit)}
+
+	for {
+		resp, err := client.ListWorkersKVsWithOptions(ctx, namespaceID, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, k := range resp.Result {
+			keys = append(keys, k.Name)
+		}
+
+		if resp.Cursor == "" {
+			break
+		}
+		opts.Cursor = cloudflare.StringPtr(resp.Cursor)
+	}
+
+	return deleteWorkersKVKeys(ctx, client, namespaceID, keys)
+}
+
+// deleteWorkersKVKeys deletes the given keys from a namespace in batches no
+// larger than the bulk delete endpoint accepts.
+func deleteWorkersKVKeys(ctx context.Context, client *apiClient, namespaceID string, keys []string) error {
+	for start := 0; start < len(keys); start += workersKVBulkLimit {
+		end := start + workersKVBulkLimit
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		if _, err := client.DeleteWorkersKVBulk(ctx, namespaceID, keys[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceCloudflareWorkersKVNamespaceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	namespaces, err := client.ListWorkersKVNamespaces(ctx)
 	var title string
@@ -121,6 +176,7 @@ func resourceCloudflareWorkersKVNamespaceImport(ctx context.Context, d *schema.R
 	}
 
 	d.Set("title", title)
+	d.Set("purge_on_destroy", false)
 	d.SetId(d.Id())
 
 	return []*schema.ResourceData{d}, nil
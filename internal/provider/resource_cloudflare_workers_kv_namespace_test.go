@@ -33,8 +33,30 @@ func TestAccCloudflareWorkersKVNamespace_Basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWorkersKVNamespace_PurgeOnDestroy(t *testing.T) {
+	t.Parallel()
+	var namespace cloudflare.WorkersKVNamespace
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_workers_kv_namespace." + rnd
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCloudflareWorkersKVNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareWorkersKVNamespacePurgeOnDestroy(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkersKVNamespaceExists(rnd, &namespace),
+					resource.TestCheckResourceAttr(resourceName, "purge_on_destroy", "true"),
+					testAccWriteUnmanagedWorkersKV(resourceName),
+				),
+			},
+		},
+	})
+}
+
 func testAccCloudflareWorkersKVNamespaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_workers_kv_namespace" {
@@ -64,9 +86,32 @@ resource "cloudflare_workers_kv_namespace" "%[1]s" {
 }`, rName)
 }
 
+func testAccCheckCloudflareWorkersKVNamespacePurgeOnDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_workers_kv_namespace" "%[1]s" {
+	title            = "%[1]s"
+	purge_on_destroy = true
+}`, rName)
+}
+
+// testAccWriteUnmanagedWorkersKV writes a key outside of Terraform so that
+// the namespace is non-empty when it is destroyed.
+func testAccWriteUnmanagedWorkersKV(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*apiClient)
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		_, err := client.WriteWorkersKV(context.Background(), rs.Primary.ID, generateRandomResourceName(), []byte("unmanaged"))
+		return err
+	}
+}
+
 func testAccCheckCloudflareWorkersKVNamespaceExists(title string, namespace *cloudflare.WorkersKVNamespace) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		resp, err := client.ListWorkersKVNamespaces(context.Background())
 		if err != nil {
 			return err
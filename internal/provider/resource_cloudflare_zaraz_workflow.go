@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZarazWorkflow() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZarazWorkflowSchema(),
+		CreateContext: resourceCloudflareZarazWorkflowCreate,
+		ReadContext:   resourceCloudflareZarazWorkflowRead,
+		UpdateContext: resourceCloudflareZarazWorkflowUpdate,
+		DeleteContext: resourceCloudflareZarazWorkflowDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZarazWorkflowImport,
+		},
+		Description: "Provides a resource to manage the Zaraz workflow of a zone. In the `preview` workflow " +
+			"configuration changes are saved as a draft and only published when `publish_trigger` changes, " +
+			"recording `publish_description` in the Zaraz history. Deleting the resource restores the " +
+			"`realtime` workflow.",
+	}
+}
+
+func resourceCloudflareZarazWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+	return resourceCloudflareZarazWorkflowUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareZarazWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, zarazWorkflowURI(zoneID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Zaraz workflow for zone %q: %w", zoneID, err))
+	}
+
+	var workflow string
+	if err := json.Unmarshal(res, &workflow); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Zaraz workflow: %w", err))
+	}
+
+	d.Set("workflow", workflow)
+
+	return nil
+}
+
+func resourceCloudflareZarazWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	workflow := d.Get("workflow").(string)
+
+	if d.HasChange("workflow") {
+		tflog.Debug(ctx, fmt.Sprintf("Setting Zaraz workflow for zone %s to %s", zoneID, workflow))
+
+		if _, err := rawRequest(ctx, client, http.MethodPut, zarazWorkflowURI(zoneID), workflow); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Zaraz workflow for zone %q: %w", zoneID, err))
+		}
+	}
+
+	if d.HasChange("publish_trigger") && d.Get("publish_trigger").(string) != "" {
+		if workflow != "preview" {
+			return diag.FromErr(fmt.Errorf(`publish_trigger is only applicable when workflow is "preview"`))
+		}
+
+		description := d.Get("publish_description").(string)
+		tflog.Info(ctx, fmt.Sprintf("Publishing Zaraz configuration for zone %s: %q", zoneID, description))
+
+		if _, err := rawRequest(ctx, client, http.MethodPost, fmt.Sprintf("/zones/%s/settings/zaraz/publish", zoneID), description); err != nil {
+			return diag.FromErr(fmt.Errorf("error publishing Zaraz configuration for zone %q: %w", zoneID, err))
+		}
+	}
+
+	return resourceCloudflareZarazWorkflowRead(ctx, d, meta)
+}
+
+func resourceCloudflareZarazWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Restoring realtime Zaraz workflow for zone %s", zoneID))
+
+	if _, err := rawRequest(ctx, client, http.MethodPut, zarazWorkflowURI(zoneID), "realtime"); err != nil {
+		return diag.FromErr(fmt.Errorf("error restoring Zaraz workflow for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZarazWorkflowImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareZarazWorkflowRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func zarazWorkflowURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/settings/zaraz/workflow", zoneID)
+}
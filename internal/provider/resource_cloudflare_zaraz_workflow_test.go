@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareZarazWorkflow_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zaraz_workflow.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZarazWorkflowConfig(rnd, zoneID, "preview", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "workflow", "preview"),
+				),
+			},
+			{
+				Config: testAccCloudflareZarazWorkflowConfig(rnd, zoneID, "preview", `
+  publish_trigger     = "v1"
+  publish_description = "publish v1"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "workflow", "preview"),
+					resource.TestCheckResourceAttr(name, "publish_trigger", "v1"),
+					resource.TestCheckResourceAttr(name, "publish_description", "publish v1"),
+				),
+			},
+			{
+				Config: testAccCloudflareZarazWorkflowConfig(rnd, zoneID, "realtime", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "workflow", "realtime"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareZarazWorkflow_PublishRequiresPreview(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZarazWorkflowConfig(rnd, zoneID, "realtime", `
+  publish_trigger = "v1"`),
+				ExpectError: regexp.MustCompile(`publish_trigger is only applicable when workflow is "preview"`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZarazWorkflowConfig(rnd, zoneID, workflow, extra string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zaraz_workflow" "%[1]s" {
+  zone_id  = "%[2]s"
+  workflow = "%[3]s"
+  %[4]s
+}
+`, rnd, zoneID, workflow, extra)
+}
@@ -30,6 +30,9 @@ const (
 
 type subscriptionData struct {
 	ID, Name, Description string
+	// Level orders the plans by their feature set so that downgrades can be
+	// detected regardless of whether a regular or partner plan is in use.
+	Level int
 }
 
 var ratePlans = map[string]subscriptionData{
@@ -37,41 +40,49 @@ var ratePlans = map[string]subscriptionData{
 		Name:        "CF_FREE",
 		ID:          planIDFree,
 		Description: "Free Website",
+		Level:       0,
 	},
 	planIDPro: {
 		Name:        "CF_PRO_20_20",
 		ID:          planIDPro,
 		Description: "Pro Website",
+		Level:       1,
 	},
 	planIDBusiness: {
 		Name:        "CF_BIZ",
 		ID:          planIDBusiness,
 		Description: "Business Website",
+		Level:       2,
 	},
 	planIDEnterprise: {
 		Name:        "CF_ENT",
 		ID:          planIDEnterprise,
 		Description: "Enterprise Website",
+		Level:       3,
 	},
 	planIDPartnerFree: {
 		Name:        "PARTNERS_FREE",
 		ID:          planIDPartnerFree,
 		Description: "Free Website",
+		Level:       0,
 	},
 	planIDPartnerPro: {
 		Name:        "PARTNERS_PRO",
 		ID:          planIDPartnerPro,
 		Description: "Pro Website",
+		Level:       1,
 	},
 	planIDPartnerBusiness: {
 		Name:        "PARTNERS_BIZ",
 		ID:          planIDPartnerBusiness,
 		Description: "Business Website",
+		Level:       2,
 	},
 	planIDPartnerEnterprise: {
 		Name:        "PARTNERS_ENT",
 		ID:          planIDPartnerEnterprise,
 		Description: "Enterprise Website",
+		Level:       3,
 	},
 }
 
@@ -85,11 +96,12 @@ func resourceCloudflareZone() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceCloudflareZonePlanDowngradeDiff,
 	}
 }
 
 func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	zoneName := d.Get("zone").(string)
 	jumpstart := d.Get("jump_start").(bool)
@@ -135,7 +147,7 @@ func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Id()
 
 	zone, err := client.ZoneDetails(ctx, zoneID)
@@ -176,7 +188,7 @@ func resourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Id()
 	zone, _ := client.ZoneDetails(ctx, zoneID)
 
@@ -224,7 +236,7 @@ func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceCloudflareZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 	zoneID := d.Id()
 
 	log.Printf("[INFO] Deleting Cloudflare Zone: id %s", zoneID)
@@ -249,9 +261,39 @@ func flattenMeta(d *schema.ResourceData, meta cloudflare.ZoneMeta) map[string]in
 	return cfg
 }
 
+// resourceCloudflareZonePlanDowngradeDiff fails the plan when `prevent_downgrade`
+// is enabled and the zone would be moved to a plan with fewer features.
+func resourceCloudflareZonePlanDowngradeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("prevent_downgrade").(bool) || d.Id() == "" || !d.HasChange("plan") {
+		return nil
+	}
+
+	oldPlan, newPlan := d.GetChange("plan")
+	if zonePlanIsDowngrade(oldPlan.(string), newPlan.(string)) {
+		return fmt.Errorf("changing plan from %q to %q is a downgrade and `prevent_downgrade` is enabled", oldPlan, newPlan)
+	}
+
+	return nil
+}
+
+// zonePlanIsDowngrade reports whether moving from oldPlan to newPlan drops the
+// zone to a lower plan level. Unknown plans are never considered a downgrade.
+func zonePlanIsDowngrade(oldPlan, newPlan string) bool {
+	from, ok := ratePlans[oldPlan]
+	if !ok {
+		return false
+	}
+	to, ok := ratePlans[newPlan]
+	if !ok {
+		return false
+	}
+
+	return to.Level < from.Level
+}
+
 // setRatePlan handles the internals of creating or updating a zone
 // subscription rate plan.
-func setRatePlan(ctx context.Context, client *cloudflare.API, zoneID, planID string, isNewPlan bool, d *schema.ResourceData) error {
+func setRatePlan(ctx context.Context, client *apiClient, zoneID, planID string, isNewPlan bool, d *schema.ResourceData) error {
 	if isNewPlan {
 		// A free rate plan is the default so no need to explicitly make another
 		// HTTP call to set it.
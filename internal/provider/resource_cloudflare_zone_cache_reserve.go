@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type zoneCacheReserve struct {
+	Value      string `json:"value"`
+	ModifiedOn string `json:"modified_on,omitempty"`
+}
+
+func resourceCloudflareZoneCacheReserve() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZoneCacheReserveSchema(),
+		CreateContext: resourceCloudflareZoneCacheReserveCreate,
+		ReadContext:   resourceCloudflareZoneCacheReserveRead,
+		UpdateContext: resourceCloudflareZoneCacheReserveUpdate,
+		DeleteContext: resourceCloudflareZoneCacheReserveDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneCacheReserveImport,
+		},
+		Description: "Provides a resource to manage Cache Reserve for a zone. Deleting the resource disables Cache Reserve.",
+	}
+}
+
+func resourceCloudflareZoneCacheReserveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+	return resourceCloudflareZoneCacheReserveUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareZoneCacheReserveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := rawRequest(ctx, client, http.MethodGet, zoneCacheReserveURI(zoneID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Cache Reserve for zone %q: %w", zoneID, err))
+	}
+
+	var setting zoneCacheReserve
+	if err := json.Unmarshal(res, &setting); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Cache Reserve setting: %w", err))
+	}
+
+	d.Set("enabled", setting.Value == "on")
+	d.Set("modified_on", setting.ModifiedOn)
+
+	return nil
+}
+
+func resourceCloudflareZoneCacheReserveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	setting := zoneCacheReserve{Value: "off"}
+	if d.Get("enabled").(bool) {
+		setting.Value = "on"
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cache Reserve for zone %s: %+v", zoneID, setting))
+
+	if _, err := rawRequest(ctx, client, http.MethodPatch, zoneCacheReserveURI(zoneID), setting); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Cache Reserve for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareZoneCacheReserveRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneCacheReserveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Disabling Cache Reserve for zone %s", zoneID))
+
+	if _, err := rawRequest(ctx, client, http.MethodPatch, zoneCacheReserveURI(zoneID), zoneCacheReserve{Value: "off"}); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling Cache Reserve for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneCacheReserveImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareZoneCacheReserveRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func zoneCacheReserveURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/cache/cache_reserve", zoneID)
+}
@@ -18,11 +18,14 @@ func resourceCloudflareZoneCacheVariants() *schema.Resource {
 		ReadContext:   resourceCloudflareZoneCacheVariantsRead,
 		UpdateContext: resourceCloudflareZoneCacheVariantsUpdate,
 		DeleteContext: resourceCloudflareZoneCacheVariantsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneCacheVariantsImport,
+		},
 	}
 }
 
 func resourceCloudflareZoneCacheVariantsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	tflog.Info(ctx, fmt.Sprintf("Reading Zone Cache Variants in zone %q", d.Id()))
 
@@ -88,7 +91,7 @@ func resourceCloudflareZoneCacheVariantsRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceCloudflareZoneCacheVariantsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	zoneID := d.Get("zone_id").(string)
 	d.SetId(zoneID)
@@ -106,7 +109,7 @@ func resourceCloudflareZoneCacheVariantsUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceCloudflareZoneCacheVariantsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Zone Cache Variants for zone ID: %q", d.Id()))
 
@@ -168,3 +171,11 @@ func cacheVariantsValuesFromResource(d *schema.ResourceData) cloudflare.ZoneCach
 
 	return variantsValue
 }
+
+func resourceCloudflareZoneCacheVariantsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareZoneCacheVariantsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
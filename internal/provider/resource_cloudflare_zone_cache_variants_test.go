@@ -61,6 +61,11 @@ func TestAccCloudflareZoneCacheVariants_OneExt(t *testing.T) {
 					resource.TestCheckNoResourceAttr(name, "webp.#"),
 				),
 			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -19,6 +21,20 @@ const (
 	DNSSECStatusDisabled = "disabled"
 )
 
+// zoneDNSSEC extends cloudflare.ZoneDNSSEC with the multi-signer and
+// presigned settings which cloudflare-go doesn't expose.
+type zoneDNSSEC struct {
+	cloudflare.ZoneDNSSEC
+	MultiSigner bool `json:"dnssec_multi_signer"`
+	Presigned   bool `json:"dnssec_presigned"`
+}
+
+type zoneDNSSECUpdate struct {
+	Status      string `json:"status,omitempty"`
+	MultiSigner bool   `json:"dnssec_multi_signer"`
+	Presigned   bool   `json:"dnssec_presigned"`
+}
+
 func resourceCloudflareZoneDNSSEC() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareZoneDNSSECSchema(),
@@ -29,11 +45,12 @@ func resourceCloudflareZoneDNSSEC() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Description: "Provides a Cloudflare resource to enable DNSSEC on a zone and expose the DS record to configure at the registrar. Deleting the resource disables DNSSEC.",
 	}
 }
 
 func resourceCloudflareZoneDNSSECCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	zoneID := d.Get("zone_id").(string)
 
@@ -43,12 +60,14 @@ func resourceCloudflareZoneDNSSECCreate(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error finding Zone DNSSEC %q: %w", zoneID, err))
 	}
+
+	update := buildZoneDNSSECUpdate(d)
 	if currentDNSSEC.Status != DNSSECStatusActive && currentDNSSEC.Status != DNSSECStatusPending {
-		_, err := client.UpdateZoneDNSSEC(ctx, zoneID, cloudflare.ZoneDNSSECUpdateOptions{Status: DNSSECStatusActive})
+		update.Status = DNSSECStatusActive
+	}
 
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("error creating zone DNSSEC %q: %w", zoneID, err))
-		}
+	if _, err := rawRequest(ctx, client, http.MethodPatch, zoneDNSSECURI(zoneID), update); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating zone DNSSEC %q: %w", zoneID, err))
 	}
 
 	d.SetId(zoneID)
@@ -57,7 +76,7 @@ func resourceCloudflareZoneDNSSECCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	zoneID := d.Get("zone_id").(string)
 
@@ -67,7 +86,7 @@ func resourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceDat
 		zoneID = d.Id()
 	}
 
-	dnssec, err := client.ZoneDNSSECSetting(ctx, zoneID)
+	dnssec, err := fetchZoneDNSSEC(ctx, client, zoneID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error finding Zone DNSSEC %q: %w", zoneID, err))
 	}
@@ -88,17 +107,29 @@ func resourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("key_tag", dnssec.KeyTag)
 	d.Set("public_key", dnssec.PublicKey)
 	d.Set("modified_on", dnssec.ModifiedOn.Format(time.RFC1123Z))
+	d.Set("dnssec_multi_signer", dnssec.MultiSigner)
+	d.Set("dnssec_presigned", dnssec.Presigned)
 
 	return nil
 }
 
-// Just returning remote state since changing the zone ID would force a new resource.
 func resourceCloudflareZoneDNSSECUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return resourceCloudflareZoneRead(ctx, d, meta)
+	client := meta.(*apiClient)
+
+	zoneID := d.Get("zone_id").(string)
+	update := buildZoneDNSSECUpdate(d)
+
+	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare Zone DNSSEC %s: %+v", zoneID, update))
+
+	if _, err := rawRequest(ctx, client, http.MethodPatch, zoneDNSSECURI(zoneID), update); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating zone DNSSEC %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareZoneDNSSECRead(ctx, d, meta)
 }
 
 func resourceCloudflareZoneDNSSECDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	zoneID := d.Get("zone_id").(string)
 
@@ -117,3 +148,29 @@ func resourceCloudflareZoneDNSSECDelete(ctx context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+func buildZoneDNSSECUpdate(d *schema.ResourceData) zoneDNSSECUpdate {
+	return zoneDNSSECUpdate{
+		MultiSigner: d.Get("dnssec_multi_signer").(bool),
+		Presigned:   d.Get("dnssec_presigned").(bool),
+	}
+}
+
+func fetchZoneDNSSEC(ctx context.Context, client *apiClient, zoneID string) (zoneDNSSEC, error) {
+	var dnssec zoneDNSSEC
+
+	res, err := rawRequest(ctx, client, http.MethodGet, zoneDNSSECURI(zoneID), nil)
+	if err != nil {
+		return dnssec, err
+	}
+
+	if err := json.Unmarshal(res, &dnssec); err != nil {
+		return dnssec, fmt.Errorf("error unmarshalling zone DNSSEC: %w", err)
+	}
+
+	return dnssec, nil
+}
+
+func zoneDNSSECURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/dnssec", zoneID)
+}
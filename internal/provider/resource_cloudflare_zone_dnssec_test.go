@@ -39,3 +39,38 @@ func TestAccCloudflareZoneDNSSECFull(t *testing.T) {
 		},
 	})
 }
+
+func TestAccCloudflareZoneDNSSEC_MultiSigner(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_dnssec.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneDNSSECMultiSignerConfig(zoneID, rnd, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "dnssec_multi_signer", "true"),
+					resource.TestCheckResourceAttr(name, "dnssec_presigned", "false"),
+					resource.TestCheckResourceAttrSet(name, "ds"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneDNSSECMultiSignerConfig(zoneID, rnd, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "dnssec_multi_signer", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneDNSSECMultiSignerConfig(zoneID, name string, multiSigner bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_dnssec" "%[1]s" {
+  zone_id             = "%[2]s"
+  dnssec_multi_signer = %[3]t
+}`, name, zoneID, multiSigner)
+}
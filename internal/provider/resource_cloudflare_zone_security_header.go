@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZoneSecurityHeader() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZoneSecurityHeaderSchema(),
+		CreateContext: resourceCloudflareZoneSecurityHeaderCreate,
+		ReadContext:   resourceCloudflareZoneSecurityHeaderRead,
+		UpdateContext: resourceCloudflareZoneSecurityHeaderUpdate,
+		DeleteContext: resourceCloudflareZoneSecurityHeaderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneSecurityHeaderImport,
+		},
+		CustomizeDiff: resourceCloudflareZoneSecurityHeaderDiff,
+		Description: "Provides a resource to manage the HTTP Strict Transport Security (HSTS) and `nosniff` headers of a zone. " +
+			"It manages the same setting as `security_header` in `cloudflare_zone_settings_override`, which must not be set for the same zone. " +
+			"Destroying the resource disables the headers.",
+	}
+}
+
+func resourceCloudflareZoneSecurityHeaderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+
+	return resourceCloudflareZoneSecurityHeaderUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareZoneSecurityHeaderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	setting, err := client.ZoneSingleSetting(ctx, zoneID, "security_header")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching security header for zone %q: %w", zoneID, err))
+	}
+
+	value, ok := setting.Value.(map[string]interface{})
+	if !ok {
+		return diag.FromErr(fmt.Errorf("unexpected security header value for zone %q: %v", zoneID, setting.Value))
+	}
+	hsts, ok := value["strict_transport_security"].(map[string]interface{})
+	if !ok {
+		return diag.FromErr(fmt.Errorf("unexpected security header value for zone %q: %v", zoneID, setting.Value))
+	}
+
+	d.Set("enabled", hsts["enabled"])
+	d.Set("include_subdomains", hsts["include_subdomains"])
+	d.Set("preload", hsts["preload"])
+	d.Set("nosniff", hsts["nosniff"])
+	if maxAge, ok := hsts["max_age"].(float64); ok {
+		d.Set("max_age", int(maxAge))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneSecurityHeaderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	hsts := map[string]interface{}{
+		"enabled":            d.Get("enabled").(bool),
+		"max_age":            d.Get("max_age").(int),
+		"include_subdomains": d.Get("include_subdomains").(bool),
+		"preload":            d.Get("preload").(bool),
+		"nosniff":            d.Get("nosniff").(bool),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating security header for zone %s: %+v", zoneID, hsts))
+
+	if err := updateZoneSecurityHeader(ctx, client, zoneID, hsts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareZoneSecurityHeaderRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneSecurityHeaderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	hsts := map[string]interface{}{
+		"enabled":            false,
+		"max_age":            0,
+		"include_subdomains": false,
+		"preload":            false,
+		"nosniff":            false,
+	}
+
+	if err := updateZoneSecurityHeader(ctx, client, zoneID, hsts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneSecurityHeaderImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareZoneSecurityHeaderRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareZoneSecurityHeaderDiff rejects preload configurations
+// that browser preload lists would not accept.
+func resourceCloudflareZoneSecurityHeaderDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("preload").(bool) {
+		return nil
+	}
+
+	if !d.Get("enabled").(bool) {
+		return fmt.Errorf("preload requires enabled to be true")
+	}
+	if !d.Get("include_subdomains").(bool) {
+		return fmt.Errorf("preload requires include_subdomains to be true")
+	}
+	if maxAge := d.Get("max_age").(int); maxAge < zoneSecurityHeaderPreloadMinMaxAge {
+		return fmt.Errorf("preload requires max_age to be at least %d seconds (one year), got %d", zoneSecurityHeaderPreloadMinMaxAge, maxAge)
+	}
+
+	return nil
+}
+
+func updateZoneSecurityHeader(ctx context.Context, client *apiClient, zoneID string, hsts map[string]interface{}) error {
+	setting := cloudflare.ZoneSetting{
+		Value: map[string]interface{}{
+			"strict_transport_security": hsts,
+		},
+	}
+
+	_, err := client.UpdateZoneSingleSetting(ctx, zoneID, "security_header", setting)
+	if err != nil {
+		return fmt.Errorf("error updating security header for zone %q: %w", zoneID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareZoneSecurityHeader_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_security_header.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSecurityHeaderConfig(rnd, zoneID, 86400, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", zoneID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "max_age", "86400"),
+					resource.TestCheckResourceAttr(name, "include_subdomains", "true"),
+					resource.TestCheckResourceAttr(name, "preload", "false"),
+					resource.TestCheckResourceAttr(name, "nosniff", "true"),
+				),
+			},
+			{
+				Config:      testAccCloudflareZoneSecurityHeaderConfig(rnd, zoneID, 86400, true),
+				ExpectError: regexp.MustCompile(`preload requires max_age to be at least 31536000 seconds`),
+			},
+			{
+				Config: testAccCloudflareZoneSecurityHeaderConfig(rnd, zoneID, 31536000, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "max_age", "31536000"),
+					resource.TestCheckResourceAttr(name, "preload", "true"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneSecurityHeaderConfig(rnd, zoneID string, maxAge int, preload bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_security_header" "%[1]s" {
+  zone_id            = "%[2]s"
+  max_age            = %[3]d
+  include_subdomains = true
+  preload            = %[4]t
+  nosniff            = true
+}
+`, rnd, zoneID, maxAge, preload)
+}
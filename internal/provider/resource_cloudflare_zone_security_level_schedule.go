@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZoneSecurityLevelSchedule() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZoneSecurityLevelScheduleSchema(),
+		CreateContext: resourceCloudflareZoneSecurityLevelScheduleCreate,
+		ReadContext:   resourceCloudflareZoneSecurityLevelScheduleRead,
+		UpdateContext: resourceCloudflareZoneSecurityLevelScheduleUpdate,
+		DeleteContext: resourceCloudflareZoneSecurityLevelScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneSecurityLevelScheduleImport,
+		},
+		CustomizeDiff: resourceCloudflareZoneSecurityLevelScheduleDiff,
+		Description: "Provides a Cloudflare resource to temporarily raise the security level of a zone, " +
+			"for example to enable under attack mode during an incident. Outside of the schedule the " +
+			"security level is reverted on the next apply, and it is always reverted when the resource is destroyed.",
+	}
+}
+
+func resourceCloudflareZoneSecurityLevelScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	previous, err := client.ZoneSingleSetting(ctx, zoneID, "security_level")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching security level for zone %q: %w", zoneID, err))
+	}
+	d.Set("previous_security_level", previous.Value)
+
+	active, err := zoneSecurityLevelScheduleActive(d.Get("until").(string), d.Get("window").([]interface{}), time.Now())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("active", active)
+
+	if err := applyZoneSecurityLevelSchedule(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareZoneSecurityLevelScheduleRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneSecurityLevelScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+
+	current, err := client.ZoneSingleSetting(ctx, zoneID, "security_level")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching security level for zone %q: %w", zoneID, err))
+	}
+
+	d.Set("current_security_level", current.Value)
+
+	return nil
+}
+
+func resourceCloudflareZoneSecurityLevelScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+
+	if err := applyZoneSecurityLevelSchedule(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareZoneSecurityLevelScheduleRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneSecurityLevelScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient)
+	zoneID := d.Get("zone_id").(string)
+	level := zoneSecurityLevelScheduleRevertLevel(d)
+
+	if level == "" {
+		tflog.Warn(ctx, fmt.Sprintf("No security level to revert zone %s to, leaving it unchanged", zoneID))
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reverting security level for zone %s to %s", zoneID, level))
+
+	if err := setZoneSecurityLevel(ctx, client, zoneID, level); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneSecurityLevelScheduleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*apiClient)
+	zoneID := d.Id()
+
+	// The level before the schedule was applied isn't known when importing
+	// so the current level is used.
+	current, err := client.ZoneSingleSetting(ctx, zoneID, "security_level")
+	if err != nil {
+		return nil, fmt.Errorf("error fetching security level for zone %q: %w", zoneID, err)
+	}
+
+	d.Set("zone_id", zoneID)
+	d.Set("previous_security_level", current.Value)
+
+	resourceCloudflareZoneSecurityLevelScheduleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareZoneSecurityLevelScheduleDiff plans a change whenever
+// the security level of the zone doesn't match the schedule, such as after
+// `until` has passed, so the level is changed on the next apply.
+func resourceCloudflareZoneSecurityLevelScheduleDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	active, err := zoneSecurityLevelScheduleActive(d.Get("until").(string), d.Get("window").([]interface{}), time.Now())
+	if err != nil {
+		return err
+	}
+
+	level := d.Get("revert_security_level").(string)
+	if level == "" {
+		level = d.Get("previous_security_level").(string)
+	}
+	if active {
+		level = d.Get("security_level").(string)
+	}
+
+	if active != d.Get("active").(bool) {
+		if err := d.SetNew("active", active); err != nil {
+			return err
+		}
+	}
+
+	if level != "" && level != d.Get("current_security_level").(string) {
+		return d.SetNew("current_security_level", level)
+	}
+
+	return nil
+}
+
+// applyZoneSecurityLevelSchedule sets the security level of the zone
+// according to `active`. On update this is the value planned by
+// resourceCloudflareZoneSecurityLevelScheduleDiff rather than one recomputed
+// at apply time, so that a window boundary passing between plan and apply
+// doesn't change the level to something other than what was planned. Such a
+// boundary is picked up by the next plan instead.
+func applyZoneSecurityLevelSchedule(ctx context.Context, client *apiClient, d *schema.ResourceData) error {
+	zoneID := d.Get("zone_id").(string)
+	active := d.Get("active").(bool)
+
+	level := zoneSecurityLevelScheduleRevertLevel(d)
+	if active {
+		level = d.Get("security_level").(string)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting security level for zone %s to %s (schedule active: %t)", zoneID, level, active))
+
+	return setZoneSecurityLevel(ctx, client, zoneID, level)
+}
+
+func setZoneSecurityLevel(ctx context.Context, client *apiClient, zoneID, level string) error {
+	_, err := client.UpdateZoneSingleSetting(ctx, zoneID, "security_level", cloudflare.ZoneSetting{Value: level})
+	if err != nil {
+		return fmt.Errorf("error setting security level for zone %q: %w", zoneID, err)
+	}
+
+	return nil
+}
+
+func zoneSecurityLevelScheduleRevertLevel(d *schema.ResourceData) string {
+	if level := d.Get("revert_security_level").(string); level != "" {
+		return level
+	}
+
+	return d.Get("previous_security_level").(string)
+}
+
+// zoneSecurityLevelScheduleActive reports whether the schedule applies at
+// now. A schedule without `until` or windows is always active.
+func zoneSecurityLevelScheduleActive(until string, windows []interface{}, now time.Time) (bool, error) {
+	now = now.UTC()
+
+	if until != "" {
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return false, fmt.Errorf("error parsing until %q: %w", until, err)
+		}
+		if !now.Before(t) {
+			return false, nil
+		}
+	}
+
+	if len(windows) == 0 {
+		return true, nil
+	}
+
+	day := strings.ToLower(now.Weekday().String()[:3])
+	clock := now.Format("15:04")
+
+	for _, w := range windows {
+		window := w.(map[string]interface{})
+		start, end := window["start"].(string), window["end"].(string)
+
+		var days []string
+		if set, ok := window["days"].(*schema.Set); ok {
+			days = expandInterfaceToStringList(set.List())
+		}
+
+		if start <= end {
+			if (len(days) == 0 || contains(days, day)) && clock >= start && clock < end {
+				return true, nil
+			}
+			continue
+		}
+
+		// Windows spanning midnight start on one of the days and end on the
+		// following day.
+		yesterday := strings.ToLower(now.AddDate(0, 0, -1).Weekday().String()[:3])
+		if clock >= start && (len(days) == 0 || contains(days, day)) {
+			return true, nil
+		}
+		if clock < end && (len(days) == 0 || contains(days, yesterday)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestZoneSecurityLevelScheduleActive(t *testing.T) {
+	// A Wednesday.
+	now := time.Date(2022, 6, 15, 23, 30, 0, 0, time.UTC)
+	days := func(d string) *schema.Set {
+		return schema.NewSet(schema.HashString, []interface{}{d})
+	}
+
+	cases := []struct {
+		name    string
+		until   string
+		windows []interface{}
+		active  bool
+	}{
+		{"no schedule", "", nil, true},
+		{"before until", "2022-06-16T00:00:00Z", nil, true},
+		{"after until", "2022-06-15T23:00:00Z", nil, false},
+		{"in window", "", []interface{}{map[string]interface{}{"start": "23:00", "end": "23:59"}}, true},
+		{"outside window", "", []interface{}{map[string]interface{}{"start": "09:00", "end": "17:00"}}, false},
+		{"window on other day", "", []interface{}{map[string]interface{}{"days": days("thu"), "start": "23:00", "end": "23:59"}}, false},
+		{"window spanning midnight", "", []interface{}{map[string]interface{}{"days": days("wed"), "start": "22:00", "end": "02:00"}}, true},
+		{"window spanning midnight from previous day", "", []interface{}{map[string]interface{}{"days": days("tue"), "start": "22:00", "end": "02:00"}}, false},
+		{"window after until", "2022-06-15T23:00:00Z", []interface{}{map[string]interface{}{"start": "23:00", "end": "23:59"}}, false},
+	}
+
+	for _, c := range cases {
+		active, err := zoneSecurityLevelScheduleActive(c.until, c.windows, now)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+		if active != c.active {
+			t.Errorf("%s: expected active %t, got %t", c.name, c.active, active)
+		}
+	}
+
+	// Just after midnight on Thursday is covered by a Wednesday window.
+	active, _ := zoneSecurityLevelScheduleActive("", []interface{}{map[string]interface{}{"days": days("wed"), "start": "22:00", "end": "02:00"}}, now.Add(time.Hour))
+	if !active {
+		t.Errorf("expected window spanning midnight to be active on the following day")
+	}
+}
+
+func TestAccCloudflareZoneSecurityLevelSchedule_Basic(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_security_level_schedule.%s", rnd)
+	until := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	expired := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSecurityLevelScheduleConfig(rnd, zoneID, until),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "active", "true"),
+					resource.TestCheckResourceAttr(name, "current_security_level", "under_attack"),
+					resource.TestCheckResourceAttrSet(name, "previous_security_level"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneSecurityLevelScheduleConfig(rnd, zoneID, expired),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "active", "false"),
+					resource.TestCheckResourceAttr(name, "current_security_level", "medium"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneSecurityLevelScheduleConfig(rnd, zoneID, until string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_security_level_schedule" "%[1]s" {
+  zone_id               = "%[2]s"
+  security_level        = "under_attack"
+  revert_security_level = "medium"
+  until                 = "%[3]s"
+}
+`, rnd, zoneID, until)
+}
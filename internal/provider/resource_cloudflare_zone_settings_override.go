@@ -36,7 +36,7 @@ var fetchAsSingleSetting = []string{
 }
 
 func resourceCloudflareZoneSettingsOverrideCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	zoneID := d.Get("zone_id").(string)
 	d.SetId(zoneID)
@@ -78,7 +78,7 @@ func resourceCloudflareZoneSettingsOverrideCreate(ctx context.Context, d *schema
 	return resourceCloudflareZoneSettingsOverrideUpdate(ctx, d, meta)
 }
 
-func updateZoneSettingsResponseWithSingleZoneSettings(ctx context.Context, zoneSettings *cloudflare.ZoneSettingResponse, zoneId string, client *cloudflare.API) error {
+func updateZoneSettingsResponseWithSingleZoneSettings(ctx context.Context, zoneSettings *cloudflare.ZoneSettingResponse, zoneId string, client *apiClient) error {
 	for _, settingName := range fetchAsSingleSetting {
 		singleSetting, err := client.ZoneSingleSetting(ctx, zoneId, settingName)
 		if err != nil {
@@ -89,7 +89,7 @@ func updateZoneSettingsResponseWithSingleZoneSettings(ctx context.Context, zoneS
 	return nil
 }
 
-func updateZoneSettingsResponseWithUniversalSSLSettings(ctx context.Context, zoneSettings *cloudflare.ZoneSettingResponse, zoneId string, client *cloudflare.API) error {
+func updateZoneSettingsResponseWithUniversalSSLSettings(ctx context.Context, zoneSettings *cloudflare.ZoneSettingResponse, zoneId string, client *apiClient) error {
 	ussl, err := client.UniversalSSLSettingDetails(ctx, zoneId)
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("Error reading initial Universal SSL settings for zone %q", zoneId))
@@ -107,7 +107,7 @@ func updateZoneSettingsResponseWithUniversalSSLSettings(ctx context.Context, zon
 }
 
 func resourceCloudflareZoneSettingsOverrideRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	zone, err := client.ZoneDetails(ctx, d.Id())
 	if err != nil {
@@ -218,7 +218,7 @@ func flattenReadOnlyZoneSettings(ctx context.Context, settings []cloudflare.Zone
 	return ids
 }
 
-func updateSingleZoneSettings(ctx context.Context, zoneSettings []cloudflare.ZoneSetting, client *cloudflare.API, zoneID string) ([]cloudflare.ZoneSetting, error) {
+func updateSingleZoneSettings(ctx context.Context, zoneSettings []cloudflare.ZoneSetting, client *apiClient, zoneID string) ([]cloudflare.ZoneSetting, error) {
 	var indexesToCut []int
 	for i, setting := range zoneSettings {
 		if contains(fetchAsSingleSetting, setting.ID) {
@@ -236,7 +236,7 @@ func updateSingleZoneSettings(ctx context.Context, zoneSettings []cloudflare.Zon
 	return zoneSettings, nil
 }
 
-func updateUniversalSSLSetting(ctx context.Context, zoneSettings []cloudflare.ZoneSetting, client *cloudflare.API, zoneID string) ([]cloudflare.ZoneSetting, error) {
+func updateUniversalSSLSetting(ctx context.Context, zoneSettings []cloudflare.ZoneSetting, client *apiClient, zoneID string) ([]cloudflare.ZoneSetting, error) {
 	indexToCut := -1
 	for i, setting := range zoneSettings {
 		// Skipping USSL Update if value is empty, especially when we are reverting to the initial state and we did not had the information
@@ -259,7 +259,7 @@ func updateUniversalSSLSetting(ctx context.Context, zoneSettings []cloudflare.Zo
 }
 
 func resourceCloudflareZoneSettingsOverrideUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	if cfg, ok := d.GetOkExists("settings"); ok && cfg != nil && len(cfg.([]interface{})) > 0 {
 		readOnlySettings := expandInterfaceToStringList(d.Get("readonly_settings"))
@@ -364,7 +364,7 @@ func expandZoneSetting(d *schema.ResourceData, keyFormatString, k string, settin
 }
 
 func resourceCloudflareZoneSettingsOverrideDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
+	client := meta.(*apiClient)
 
 	if cfg, ok := d.GetOkExists("settings"); ok && cfg != nil && len(cfg.([]interface{})) > 0 {
 		readOnlySettings := expandInterfaceToStringList(d.Get("readonly_settings"))
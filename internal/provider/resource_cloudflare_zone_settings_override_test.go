@@ -8,7 +8,6 @@ import (
 
 	"reflect"
 
-	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -92,7 +91,7 @@ func testAccCheckCloudflareZoneSettings(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Zone ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 		foundZone, err := client.ZoneSettings(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
@@ -122,7 +121,7 @@ func testAccCheckCloudflareZoneSettings(n string) resource.TestCheckFunc {
 
 func testAccGetInitialZoneSettings(t *testing.T, zoneID string, settings map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 
 		foundZone, err := client.ZoneSettings(context.Background(), zoneID)
 		if err != nil {
@@ -150,7 +149,7 @@ func testAccGetInitialZoneSettings(t *testing.T, zoneID string, settings map[str
 
 func testAccCheckInitialZoneSettings(zoneID string, initialSettings map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*apiClient)
 
 		foundZone, err := client.ZoneSettings(context.Background(), zoneID)
 		if err != nil {
@@ -8,6 +8,28 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func TestZonePlanIsDowngrade(t *testing.T) {
+	testCases := []struct {
+		from, to string
+		want     bool
+	}{
+		{planIDFree, planIDPro, false},
+		{planIDPro, planIDPro, false},
+		{planIDEnterprise, planIDBusiness, true},
+		{planIDBusiness, planIDFree, true},
+		{planIDPartnerEnterprise, planIDEnterprise, false},
+		{planIDPartnerEnterprise, planIDPro, true},
+		{planIDEnterprise, planIDPartnerFree, true},
+		{"", planIDFree, false},
+	}
+
+	for _, tc := range testCases {
+		if got := zonePlanIsDowngrade(tc.from, tc.to); got != tc.want {
+			t.Errorf("zonePlanIsDowngrade(%q, %q) = %t, want %t", tc.from, tc.to, got, tc.want)
+		}
+	}
+}
+
 func TestAccCloudflareZone_Basic(t *testing.T) {
 	rnd := generateRandomResourceName()
 	name := "cloudflare_zone." + rnd
@@ -10,6 +10,18 @@ import (
 	"github.com/pkg/errors"
 )
 
+var (
+	accessSaasAppAuthTypes            = []string{"saml", "oidc"}
+	accessSaasAppNameIDFormats        = []string{"id", "email"}
+	accessSaasAppAttributeNameFormats = []string{
+		"urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified",
+		"urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
+		"urn:oasis:names:tc:SAML:2.0:attrname-format:uri",
+	}
+	accessSaasAppGrantTypes = []string{"authorization_code", "authorization_code_with_pkce", "refresh_tokens", "hybrid", "implicit"}
+	accessSaasAppScopes     = []string{"openid", "groups", "email", "profile"}
+)
+
 func resourceCloudflareAccessApplicationSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"account_id": {
@@ -38,15 +50,16 @@ func resourceCloudflareAccessApplicationSchema() map[string]*schema.Schema {
 		},
 		"domain": {
 			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The complete URL of the asset you wish to put Cloudflare Access in front of. Can include subdomains or paths. Or both.",
+			Optional:    true,
+			Computed:    true,
+			Description: "The complete URL of the asset you wish to put Cloudflare Access in front of. Can include subdomains or paths. Or both. Required for all application types except `saas`.",
 		},
 		"type": {
 			Type:         schema.TypeString,
 			Optional:     true,
 			Default:      "self_hosted",
-			ValidateFunc: validation.StringInSlice([]string{"self_hosted", "ssh", "vnc", "file"}, false),
-			Description:  fmt.Sprintf("The application type. %s", renderAvailableDocumentationValuesStringSlice([]string{"self_hosted", "ssh", "vnc", "file"})),
+			ValidateFunc: validation.StringInSlice([]string{"self_hosted", "ssh", "vnc", "file", "saas"}, false),
+			Description:  fmt.Sprintf("The application type. %s", renderAvailableDocumentationValuesStringSlice([]string{"self_hosted", "ssh", "vnc", "file", "saas"})),
 		},
 		"session_duration": {
 			Type:     schema.TypeString,
@@ -186,6 +199,202 @@ func resourceCloudflareAccessApplicationSchema() map[string]*schema.Schema {
 			Default:     false,
 			Description: "Option to return a 401 status code in service authentication rules on failed requests.",
 		},
+		"saas_app": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "SaaS configuration for the Access Application. Only applicable to applications of type `saas`.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"auth_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "saml",
+						ValidateFunc: validation.StringInSlice(accessSaasAppAuthTypes, false),
+						Description:  fmt.Sprintf("The authentication protocol used by the SaaS application. %s", renderAvailableDocumentationValuesStringSlice(accessSaasAppAuthTypes)),
+					},
+					"sp_entity_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "A globally unique name for an identity or service provider. Only applicable to `saml` applications.",
+					},
+					"consumer_service_url": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The service provider's endpoint that is responsible for receiving and parsing a SAML assertion. Only applicable to `saml` applications.",
+					},
+					"name_id_format": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice(accessSaasAppNameIDFormats, false),
+						Description:  fmt.Sprintf("The format of the name identifier sent to the SaaS application. Only applicable to `saml` applications. %s", renderAvailableDocumentationValuesStringSlice(accessSaasAppNameIDFormats)),
+					},
+					"custom_attribute": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Custom attribute statements mapped from IdP claims into the SAML assertion. Only applicable to `saml` applications.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "The name of the attribute as provided to the SaaS application.",
+								},
+								"name_format": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(accessSaasAppAttributeNameFormats, false),
+									Description:  fmt.Sprintf("The format of the attribute name. %s", renderAvailableDocumentationValuesStringSlice(accessSaasAppAttributeNameFormats)),
+								},
+								"friendly_name": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "A friendly name for the attribute as provided to the SaaS application.",
+								},
+								"required": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "True if the attribute must be always present.",
+								},
+								"source": {
+									Type:     schema.TypeList,
+									Required: true,
+									MaxItems: 1,
+									Elem:     accessSaasAppSourceResource(),
+								},
+							},
+						},
+					},
+					"idp_entity_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The unique identifier for the SaaS application.",
+					},
+					"public_key": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The public certificate that will be used to verify identities.",
+					},
+					"sso_endpoint": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The endpoint where the SaaS application will send login requests.",
+					},
+					"redirect_uris": {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The permitted URL's for Cloudflare to return Authorization codes and Access/ID tokens. Only applicable to `oidc` applications.",
+					},
+					"grant_types": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(accessSaasAppGrantTypes, false),
+						},
+						Description: fmt.Sprintf("The OIDC flows supported by this application. Only applicable to `oidc` applications. %s", renderAvailableDocumentationValuesStringSlice(accessSaasAppGrantTypes)),
+					},
+					"scopes": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(accessSaasAppScopes, false),
+						},
+						Description: fmt.Sprintf("Define the user information shared with the application. Only applicable to `oidc` applications. %s", renderAvailableDocumentationValuesStringSlice(accessSaasAppScopes)),
+					},
+					"app_launcher_url": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The URL where this application's tile will redirect users. Only applicable to `oidc` applications.",
+					},
+					"group_filter_regex": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "A regex to filter Cloudflare groups returned in the ID token and userinfo endpoint. Only applicable to `oidc` applications.",
+					},
+					"custom_claim": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Custom claims mapped from IdP claims into the ID token. Only applicable to `oidc` applications.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "The name of the claim.",
+								},
+								"scope": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(accessSaasAppScopes, false),
+									Description:  fmt.Sprintf("The scope of the claim. %s", renderAvailableDocumentationValuesStringSlice(accessSaasAppScopes)),
+								},
+								"required": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "True if the attribute must be always present.",
+								},
+								"source": {
+									Type:     schema.TypeList,
+									Required: true,
+									MaxItems: 1,
+									Elem:     accessSaasAppSourceResource(),
+								},
+							},
+						},
+					},
+					"refresh_token_options": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "Refresh token grant options. Only applicable to `oidc` applications with the `refresh_tokens` grant type.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"lifetime": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "How long a refresh token will be valid for after creation. Valid units are `m`, `h` and `d`. Must be longer than 1m.",
+								},
+							},
+						},
+					},
+					"client_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The application client ID. Only applicable to `oidc` applications.",
+					},
+					"client_secret": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Sensitive:   true,
+						Description: "The application client secret, only returned when the application is created. Only applicable to `oidc` applications.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func accessSaasAppSourceResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the IdP claim.",
+			},
+			"name_by_idp": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A mapping from IdP ID to claim name, used when the claim name differs between identity providers.",
+			},
+		},
 	}
 }
 
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessGroupsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description:  "The account identifier to list Access groups and policies for. Conflicts with `zone_id`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"account_id", "zone_id"},
+		},
+		"zone_id": {
+			Description:  "The zone identifier to list Access groups and policies for. Conflicts with `account_id`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"account_id", "zone_id"},
+		},
+		"groups": {
+			Description: "The Access groups with the applications, policies and groups referencing them.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The identifier of the group.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the group.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"application_ids": {
+						Description: "The applications with a policy referencing the group.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"policy_ids": {
+						Description: "The policies referencing the group.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"group_ids": {
+						Description: "The other groups referencing the group.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"in_use": {
+						Description: "Whether any policy or other group references the group.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"policies": {
+			Description: "The Access policies of all applications.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The identifier of the policy.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the policy.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"application_id": {
+						Description: "The application the policy belongs to.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"decision": {
+						Description: "The decision of the policy.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"group_ids": {
+						Description: "The groups referenced by the include, exclude and require rules of the policy.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
@@ -82,6 +82,17 @@ func resourceCloudflareAccessPolicySchema() map[string]*schema.Schema {
 			Optional: true,
 			Elem:     AccessPolicyApprovalGroupElement,
 		},
+		"validate_external_evaluation_keys": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to check that the `keys_url` of `external_evaluation` conditions serves a JSON Web Key Set before the policy is saved.",
+		},
+		"application_aud": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Audience tag of the application the policy is associated with. Only set when the policy has `external_evaluation` conditions, for the external service to validate the requests it receives.",
+		},
 	}
 }
 
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccountAuditTokenSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"fail_on_invalid": {
+			Description: "Whether to return an error when the API token used by the provider is not active or has expired.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"token_id": {
+			Description: "The identifier of the API token used by the provider.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"status": {
+			Description: "The status of the API token. Available values: `active`, `disabled`, `expired`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"not_before": {
+			Description: "The time (RFC3339) before which the API token is not valid. Empty when not set.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"expires_on": {
+			Description: "The time (RFC3339) at which the API token expires. Empty when the token does not expire.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"valid": {
+			Description: "Whether the API token is active and within its validity period.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+}
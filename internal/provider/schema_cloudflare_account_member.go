@@ -1,6 +1,13 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var accountMemberPolicyAccess = []string{"allow", "deny"}
 
 func resourceCloudflareAccountMemberSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -11,10 +18,43 @@ func resourceCloudflareAccountMemberSchema() map[string]*schema.Schema {
 		},
 
 		"role_ids": {
-			Type:        schema.TypeSet,
-			Required:    true,
-			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: "List of account role IDs that you want to assign to a member.",
+			Type:         schema.TypeSet,
+			Optional:     true,
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			ExactlyOneOf: []string{"role_ids", "policies"},
+			Description:  "List of account role IDs that you want to assign to a member.",
+		},
+
+		"policies": {
+			Type:         schema.TypeSet,
+			Optional:     true,
+			ExactlyOneOf: []string{"role_ids", "policies"},
+			Description:  "Access policies that grant the member permission groups on resource groups, instead of account roles.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"access": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "allow",
+						ValidateFunc: validation.StringInSlice(accountMemberPolicyAccess, false),
+						Description:  fmt.Sprintf("Whether the policy allows or denies the permissions. %s", renderAvailableDocumentationValuesStringSlice(accountMemberPolicyAccess)),
+					},
+					"permission_group_ids": {
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The permission groups granted or denied by the policy.",
+					},
+					"resource_group_ids": {
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The resource groups the policy applies to. A resource group scoped to all zones of the account also covers zones added later, without updating the member.",
+					},
+				},
+			},
 		},
 	}
 }
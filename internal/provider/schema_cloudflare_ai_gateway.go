@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareAIGatewaySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description:  "The name of the gateway, used in the gateway endpoint URL.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+		"cache_ttl": {
+			Description:  "Number of seconds responses are cached for. `0` disables caching.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"cache_invalidate_on_update": {
+			Description: "Whether cached responses are invalidated when the gateway is updated.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"collect_logs": {
+			Description: "Whether requests passing through the gateway are logged.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"rate_limiting_limit": {
+			Description:  "Number of requests allowed per `rate_limiting_interval`. `0` disables rate limiting.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"rate_limiting_interval": {
+			Description:  "Number of seconds in each rate limiting window.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"rate_limiting_technique": {
+			Description:  fmt.Sprintf("How the rate limiting window is calculated. %s", renderAvailableDocumentationValuesStringSlice(aiGatewayRateLimitingTechniques)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "fixed",
+			ValidateFunc: validateEnum(aiGatewayRateLimitingTechniques),
+		},
+		"log_management": {
+			Description:  "Maximum number of logs stored for the gateway. Unlimited when not set.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(10000, 10000000),
+		},
+		"log_management_strategy": {
+			Description:  fmt.Sprintf("What happens once `log_management` is reached. %s", renderAvailableDocumentationValuesStringSlice(aiGatewayLogManagementStrategies)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"log_management"},
+			ValidateFunc: validateEnum(aiGatewayLogManagementStrategies),
+		},
+		"created_at": {
+			Description: "When the gateway was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"modified_at": {
+			Description: "When the gateway was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func dataSourceCloudflareAIGatewaySchema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{}
+
+	for k, v := range resourceCloudflareAIGatewaySchema() {
+		s[k] = &schema.Schema{
+			Description: v.Description,
+			Type:        v.Type,
+			Computed:    true,
+		}
+	}
+
+	s["account_id"].Required, s["account_id"].Computed = true, false
+	s["name"].Required, s["name"].Computed = true, false
+
+	return s
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var apiShieldOperationMethods = []string{"GET", "POST", "HEAD", "OPTIONS", "PUT", "DELETE", "CONNECT", "PATCH", "TRACE"}
+
+func resourceCloudflareAPIShieldOperationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"method": {
+			Description:  fmt.Sprintf("The HTTP method used to access the endpoint. %s", renderAvailableDocumentationValuesStringSlice(apiShieldOperationMethods)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(apiShieldOperationMethods, false),
+		},
+		"host": {
+			Description: "RFC3986-compliant host.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"endpoint": {
+			Description: "The endpoint which can contain path parameter templates in curly braces, each will be replaced from left to right with `{varN}`, starting with `{var1}`. This will then be [Cloudflare-normalized](https://developers.cloudflare.com/rules/normalization/how-it-works/).",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"last_updated": {
+			Description: "When the operation was last updated.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var apiShieldMitigationActions = []string{"log", "block", "none"}
+
+func resourceCloudflareAPIShieldOperationSchemaValidationSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"operation_id": {
+			Description: "Operation ID these settings should apply to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"mitigation_action": {
+			Description:  fmt.Sprintf("The mitigation action to apply to requests for this operation which fail schema validation. Omit to use the zone default. %s", renderAvailableDocumentationValuesStringSlice(apiShieldMitigationActions)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(apiShieldMitigationActions, false),
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareAPIShieldOperationsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"openapi_path": {
+			Description: "Path to an OpenAPI v3 document in JSON or YAML format. Every operation in the document is registered, and operations previously registered by this resource that are no longer in the document are removed.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"host": {
+			Description: "Host to register the operations against. Overrides the hosts of the document `servers`, whose base paths still apply. Required when the document has no absolute server URLs.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"operations": {
+			Description: "The operations registered from the document.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"operation_id": {
+						Description: "The identifier of the operation.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"method": {
+						Description: "The HTTP method used to access the endpoint.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"host": {
+						Description: "RFC3986-compliant host.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"endpoint": {
+						Description: "The endpoint, with path parameter templates replaced by `{varN}`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
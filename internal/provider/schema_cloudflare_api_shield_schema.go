@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var apiShieldSchemaKinds = []string{"openapi_v3"}
+
+func resourceCloudflareAPIShieldSchemaSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "Name of the schema.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"kind": {
+			Description:  fmt.Sprintf("Kind of schema. %s", renderAvailableDocumentationValuesStringSlice(apiShieldSchemaKinds)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "openapi_v3",
+			ValidateFunc: validation.StringInSlice(apiShieldSchemaKinds, false),
+		},
+		"source": {
+			Description: "Schema file contents. Changing the source uploads a new schema.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"validation_enabled": {
+			Description: "Whether requests are validated against the schema.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"created_at": {
+			Description: "When the schema was uploaded.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
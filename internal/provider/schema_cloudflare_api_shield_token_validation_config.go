@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	apiShieldTokenTypes     = []string{"JWT"}
+	apiShieldTokenKeyTypes  = []string{"RSA", "EC"}
+	apiShieldTokenKeyAlgs   = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384"}
+	apiShieldTokenKeyCurves = []string{"P-256", "P-384"}
+)
+
+func resourceCloudflareAPIShieldTokenValidationConfigSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"title": {
+			Description:  "Human readable title of the configuration.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 50),
+		},
+		"description": {
+			Description:  "Human readable description of the configuration.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 500),
+		},
+		"token_type": {
+			Description:  fmt.Sprintf("Type of token to validate. %s", renderAvailableDocumentationValuesStringSlice(apiShieldTokenTypes)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "JWT",
+			ValidateFunc: validation.StringInSlice(apiShieldTokenTypes, false),
+		},
+		"token_sources": {
+			Description: "Ruleset expressions that locate the token in a request, e.g. `http.request.headers[\"authorization\"][0]`. The first source containing a token is used.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			MaxItems:    4,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"credentials": {
+			Description: "Public keys used to verify token signatures.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Description: "A JSON Web Key.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"kid": {
+									Description: "Key ID.",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+								"kty": {
+									Description:  fmt.Sprintf("Key type. %s", renderAvailableDocumentationValuesStringSlice(apiShieldTokenKeyTypes)),
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(apiShieldTokenKeyTypes, false),
+								},
+								"alg": {
+									Description:  fmt.Sprintf("Algorithm the key is used with. %s", renderAvailableDocumentationValuesStringSlice(apiShieldTokenKeyAlgs)),
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(apiShieldTokenKeyAlgs, false),
+								},
+								"n": {
+									Description: "RSA modulus. Required for `RSA` keys.",
+									Type:        schema.TypeString,
+									Optional:    true,
+								},
+								"e": {
+									Description: "RSA exponent. Required for `RSA` keys.",
+									Type:        schema.TypeString,
+									Optional:    true,
+								},
+								"crv": {
+									Description:  fmt.Sprintf("Curve of the key. Required for `EC` keys. %s", renderAvailableDocumentationValuesStringSlice(apiShieldTokenKeyCurves)),
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(apiShieldTokenKeyCurves, false),
+								},
+								"x": {
+									Description: "X coordinate of the public key. Required for `EC` keys.",
+									Type:        schema.TypeString,
+									Optional:    true,
+								},
+								"y": {
+									Description: "Y coordinate of the public key. Required for `EC` keys.",
+									Type:        schema.TypeString,
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"created_at": {
+			Description: "When the configuration was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_updated": {
+			Description: "When the configuration was last updated.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -19,6 +19,7 @@ func resourceCloudflareArgoSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
 			Optional:     true,
 			Description:  fmt.Sprintf("Whether tiered caching is enabled. %s", renderAvailableDocumentationValuesStringSlice([]string{"on", "off"})),
+			Deprecated:   "Use the `cloudflare_tiered_cache` resource instead.",
 		},
 		"smart_routing": {
 			Type:         schema.TypeString,
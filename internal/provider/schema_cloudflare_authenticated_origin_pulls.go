@@ -11,16 +11,25 @@ func resourceCloudflareAuthenticatedOriginPullsSchema() map[string]*schema.Schem
 			ForceNew:    true,
 		},
 		"hostname": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Description: "Specify a hostname to enable Per-Hostname Authenticated Origin Pulls on, using the provided certificate.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
 		},
 		"authenticated_origin_pulls_certificate": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Description: "The ID of an uploaded Authenticated Origin Pulls certificate. If no hostname is provided, this certificate will be used zone wide as Per-Zone Authenticated Origin Pulls.",
+			Type:        schema.TypeString,
+			Optional:    true,
 		},
 		"enabled": {
-			Type:     schema.TypeBool,
-			Required: true,
+			Description: "Whether or not to enable Authenticated Origin Pulls on the given zone or hostname.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"status": {
+			Description: "Deployment status of Per-Hostname Authenticated Origin Pulls on the hostname.",
+			Type:        schema.TypeString,
+			Computed:    true,
 		},
 	}
 }
@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var authenticatedOriginPullsCertificateTypes = []string{"per-zone", "per-hostname"}
+
 func resourceCloudflareAuthenticatedOriginPullsCertificateSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"zone_id": {
@@ -14,49 +18,58 @@ func resourceCloudflareAuthenticatedOriginPullsCertificateSchema() map[string]*s
 			ForceNew:    true,
 		},
 		"certificate": {
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Description: "The public client certificate.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
 		},
 		"private_key": {
-			Type:      schema.TypeString,
-			Required:  true,
-			Sensitive: true,
-			ForceNew:  true,
+			Description: "The private key of the client certificate.",
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			ForceNew:    true,
 		},
 		"issuer": {
-			Type:     schema.TypeString,
-			Computed: true,
-			ForceNew: true,
+			Description: "The certificate authority that issued the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			ForceNew:    true,
 		},
 		"signature": {
-			Type:     schema.TypeString,
-			Computed: true,
-			ForceNew: true,
+			Description: "The type of hash used for the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			ForceNew:    true,
 		},
 		"serial_number": {
-			Type:     schema.TypeString,
-			Computed: true,
-			ForceNew: true,
+			Description: "The serial number of the certificate. Only available for Per-Hostname certificates.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			ForceNew:    true,
 		},
 		"expires_on": {
-			Type:     schema.TypeString,
-			Computed: true,
-			ForceNew: true,
+			Description: "When the certificate expires.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			ForceNew:    true,
 		},
 		"status": {
-			Type:     schema.TypeString,
-			Computed: true,
-			ForceNew: true,
+			Description: "Deployment status of the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			ForceNew:    true,
 		},
 		"uploaded_on": {
-			Type:     schema.TypeString,
-			Computed: true,
-			ForceNew: true,
+			Description: "When the certificate was uploaded.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			ForceNew:    true,
 		},
 		"type": {
+			Description:  fmt.Sprintf("The form of Authenticated Origin Pulls to upload the certificate to. %s", renderAvailableDocumentationValuesStringSlice(authenticatedOriginPullsCertificateTypes)),
 			Type:         schema.TypeString,
-			ValidateFunc: validation.StringInSlice([]string{"per-zone", "per-hostname"}, false),
+			ValidateFunc: validation.StringInSlice(authenticatedOriginPullsCertificateTypes, false),
 			Required:     true,
 			ForceNew:     true,
 		},
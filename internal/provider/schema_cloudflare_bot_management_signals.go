@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareBotManagementSignalsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"names": {
+			Description: "Names of the JA4 signals to return. Unknown names fail at plan time. Defaults to all signals.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ja4_field": {
+			Description: "The rules language field holding the JA4 fingerprint of the request.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"detection_ids_field": {
+			Description: "The rules language field holding the IDs of the bot detections that matched the request.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"ja4_signals": {
+			Description: "The JA4 signals, sorted by name.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "Name of the signal.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"kind": {
+						Description: "Whether the signal is a `ratio`, a `rank` or a `quantile`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"description": {
+						Description: "What the signal measures.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
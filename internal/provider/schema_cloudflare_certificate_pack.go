@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -14,32 +16,37 @@ func resourceCloudflareCertificatePackSchema() map[string]*schema.Schema {
 			ForceNew:    true,
 		},
 		"type": {
+			Description:  fmt.Sprintf("Certificate pack configuration type. %s", renderAvailableDocumentationValuesStringSlice([]string{"custom", "dedicated_custom", "advanced"})),
 			Type:         schema.TypeString,
 			Required:     true,
 			ForceNew:     true,
 			ValidateFunc: validation.StringInSlice([]string{"custom", "dedicated_custom", "advanced"}, false),
 		},
 		"hosts": {
-			Type:     schema.TypeSet,
-			Required: true,
-			ForceNew: true,
+			Description: "List of hostnames to provision the certificate pack for. The zone name must be included as a host. Changing the hosts replaces the certificate pack.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			ForceNew:    true,
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
 		},
 		"validation_method": {
+			Description:  fmt.Sprintf("Which validation method to use in order to prove domain ownership. Required for advanced certificate packs. %s", renderAvailableDocumentationValuesStringSlice([]string{"txt", "http", "email"})),
 			Type:         schema.TypeString,
 			Optional:     true,
 			ForceNew:     true,
 			ValidateFunc: validation.StringInSlice([]string{"txt", "http", "email"}, false),
 		},
 		"validity_days": {
+			Description:  "How long the certificate is valid for, in days. Required for advanced certificate packs. Certificates issued by `lets_encrypt` are only valid for 90 days. Available values: `14`, `30`, `90`, `365`.",
 			Type:         schema.TypeInt,
 			Optional:     true,
 			ForceNew:     true,
 			ValidateFunc: validation.IntInSlice([]int{14, 30, 90, 365}),
 		},
 		"certificate_authority": {
+			Description:  fmt.Sprintf("Which certificate authority issues the certificate pack. Required for advanced certificate packs. %s", renderAvailableDocumentationValuesStringSlice([]string{"digicert", "lets_encrypt"})),
 			Type:         schema.TypeString,
 			Optional:     true,
 			Computed:     true,
@@ -48,27 +55,36 @@ func resourceCloudflareCertificatePackSchema() map[string]*schema.Schema {
 			Default:      nil,
 		},
 		"validation_records": {
-			Type:     schema.TypeList,
-			Computed: true,
-			Optional: true,
-			Elem:     sslValidationRecordsSchema(),
+			Description: "Records to prove ownership of the hosts to the certificate authority, for instance with DNS records.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Optional:    true,
+			Elem:        sslValidationRecordsSchema(),
 		},
 		"validation_errors": {
-			Type:     schema.TypeList,
-			Computed: true,
-			Optional: true,
-			Elem:     sslValidationErrorsSchema(),
+			Description: "Errors encountered while validating the ownership of the hosts.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Optional:    true,
+			Elem:        sslValidationErrorsSchema(),
 		},
 		"cloudflare_branding": {
-			Type:     schema.TypeBool,
-			Optional: true,
-			ForceNew: true,
+			Description: "Whether or not to add Cloudflare branding, `sni.cloudflaressl.com`, as the Common Name of an advanced certificate.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
 		},
 		"wait_for_active_status": {
-			Type:     schema.TypeBool,
-			ForceNew: true,
-			Optional: true,
-			Default:  false,
+			Description: "Whether or not to wait for the certificates of the pack to be active during creation. Otherwise creating an advanced certificate pack waits for its validation records.",
+			Type:        schema.TypeBool,
+			ForceNew:    true,
+			Optional:    true,
+			Default:     false,
+		},
+		"status": {
+			Description: "Status of the certificate pack.",
+			Type:        schema.TypeString,
+			Computed:    true,
 		},
 	}
 }
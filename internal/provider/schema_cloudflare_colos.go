@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareColosSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": {
+			Description: "Only return data centers in this region, for example `Europe` or `North America`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"country": {
+			Description: "Only return data centers in this country, as an ISO 3166-1 alpha-2 code.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"iata_codes": {
+			Description: "The IATA codes of the matching data centers, sorted.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"colos": {
+			Description: "The matching data centers, sorted by IATA code.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"iata": {
+						Description: "The IATA code of the airport closest to the data center.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"city": {
+						Description: "The city of the data center.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"country": {
+						Description: "The ISO 3166-1 alpha-2 code of the country of the data center.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"region": {
+						Description: "The region of the data center.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"latitude": {
+						Description: "The latitude of the data center.",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"longitude": {
+						Description: "The longitude of the data center.",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
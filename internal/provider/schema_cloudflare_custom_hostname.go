@@ -1,10 +1,16 @@
 package provider
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// customHostnameSSLWaitStatuses are the SSL statuses that `wait_for` can wait
+// for, in the order they are reached.
+var customHostnameSSLWaitStatuses = []string{"pending_validation", "active"}
+
 func resourceCloudflareCustomHostnameSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"zone_id": {
@@ -117,6 +123,31 @@ func resourceCloudflareCustomHostnameSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"custom_metadata": {
+			Description: "Custom metadata associated with the hostname, available to Cloudflare logic configured for the zone.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"wait_for": {
+			Description: "Wait for the certificate to reach a status before completing the apply. Waiting is bound by the create and update timeouts.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ssl_status": {
+						Description:  fmt.Sprintf("The status to wait for. Waiting for `pending_validation` also completes on any later status. %s", renderAvailableDocumentationValuesStringSlice(customHostnameSSLWaitStatuses)),
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "active",
+						ValidateFunc: validation.StringInSlice(customHostnameSSLWaitStatuses, false),
+					},
+				},
+			},
+		},
 		"status": {
 			Type:     schema.TypeString,
 			Computed: true,
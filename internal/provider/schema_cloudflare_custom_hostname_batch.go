@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareCustomHostnameBatchSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"hostnames": {
+			Description: "The custom hostnames to provision. Hostnames which failed to provision are removed from state and retried on the next apply.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+		"custom_origin_server": {
+			Description: "The custom origin server used by every hostname in the batch.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"custom_origin_sni": {
+			Description: "The SNI sent to the custom origin server by every hostname in the batch.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"ssl": {
+			Description: "SSL configuration shared by every hostname in the batch.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"method": {
+						Description:  "Domain control validation method.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"http", "txt", "email"}, false),
+					},
+					"type": {
+						Description:  "Level of validation to be used for the certificates.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "dv",
+						ValidateFunc: validation.StringInSlice([]string{"dv"}, false),
+					},
+					"certificate_authority": {
+						Description:  "The certificate authority that will issue the certificates.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"lets_encrypt", "digicert"}, false),
+					},
+					"wildcard": {
+						Description: "Whether the certificates cover a wildcard of each hostname.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+					},
+					"settings": {
+						Description: "SSL/TLS settings for the certificates.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"http2": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+								},
+								"tls13": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+								},
+								"min_tls_version": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice([]string{"1.0", "1.1", "1.2", "1.3"}, false),
+								},
+								"ciphers": {
+									Type:     schema.TypeSet,
+									Optional: true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"early_hints": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"concurrency": {
+			Description:  "Maximum number of concurrent API requests used to reconcile the batch.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 50),
+		},
+		"custom_hostnames": {
+			Description: "The provisioned custom hostnames.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hostname": {
+						Description: "The custom hostname.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"id": {
+						Description: "The custom hostname identifier.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"status": {
+						Description: "Status of the custom hostname.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"ssl_status": {
+						Description: "Status of the custom hostname's certificate.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"ownership_verification": {
+						Description: "DNS record used to verify ownership of the custom hostname.",
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -11,12 +11,28 @@ func resourceCloudflareCustomHostnameFallbackOriginSchema() map[string]*schema.S
 			Required:    true,
 		},
 		"origin": {
-			Type:     schema.TypeString,
-			Required: true,
+			Description: "Hostname you intend to fallback requests to. Origin must be a proxied A/AAAA/CNAME DNS record within Cloudflare.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"wait_for_active": {
+			Description: "Whether to wait for the fallback origin to be active before the apply completes. Otherwise the apply completes once it is being deployed.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
 		},
 		"status": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Description: "Status of the fallback origin's activation.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"errors": {
+			Description: "Errors encountered while deploying the fallback origin.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
 		},
 	}
 }
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	customHostnameStatuses    = []string{"active", "pending", "active_redeploying", "moved", "pending_deletion", "deleted", "pending_blocked", "pending_migration", "pending_provisioned", "test_pending", "test_active", "test_active_apex", "test_blocked", "test_failed", "provisioned", "blocked"}
+	customHostnameSSLStatuses = []string{"initializing", "pending_validation", "deleted", "pending_issuance", "pending_deployment", "pending_deletion", "pending_expiration", "expired", "active", "initializing_timed_out", "validation_timed_out", "issuance_timed_out", "deployment_timed_out", "deletion_timed_out", "pending_cleanup", "staging_deployment", "staging_active", "deactivating", "inactive", "backup_issued", "holding_deployment"}
+)
+
+func dataSourceCloudflareCustomHostnamesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to list custom hostnames for.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"hostname": {
+			Description: "Only include custom hostnames containing this value.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"status": {
+			Description:  fmt.Sprintf("Only include custom hostnames with this status. %s", renderAvailableDocumentationValuesStringSlice(customHostnameStatuses)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(customHostnameStatuses, false),
+		},
+		"ssl_status": {
+			Description:  fmt.Sprintf("Only include custom hostnames whose certificate has this status. %s", renderAvailableDocumentationValuesStringSlice(customHostnameSSLStatuses)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(customHostnameSSLStatuses, false),
+		},
+		"custom_hostnames": {
+			Description: "The custom hostnames matching the filters.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The custom hostname identifier.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"hostname": {
+						Description: "The custom hostname.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"status": {
+						Description: "Status of the custom hostname.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"ssl_status": {
+						Description: "Status of the custom hostname's certificate.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"ssl_method": {
+						Description: "Domain control validation method of the certificate.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"custom_origin_server": {
+						Description: "The custom origin server used by the custom hostname.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"created_at": {
+						Description: "When the custom hostname was created.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"verification_errors": {
+						Description: "Errors encountered while verifying ownership of the custom hostname.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"ssl_validation_errors": {
+						Description: "Errors encountered while validating the custom hostname's certificate.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"ownership_verification": {
+						Description: "DNS record used to verify ownership of the custom hostname.",
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
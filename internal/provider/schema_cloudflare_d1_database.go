@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var d1DatabaseLocationHints = []string{"wnam", "enam", "weur", "eeur", "apac", "oc"}
+
+func resourceCloudflareD1DatabaseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "Name of the database.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"primary_location_hint": {
+			Description:  fmt.Sprintf("The region the database is created in. %s", renderAvailableDocumentationValuesStringSlice(d1DatabaseLocationHints)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(d1DatabaseLocationHints, false),
+		},
+		"import_sql_path": {
+			Description: "Path to a SQL dump to import into the database once it is created, such as an export of another database. Changes after creation are ignored.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"export_on_destroy": {
+			Description: "Export the database to an R2 bucket before it is destroyed. The database is not destroyed if the export fails.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"bucket_name": {
+						Description: "Name of the R2 bucket in the same account to store the export in.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"key_prefix": {
+						Description: "Prefix of the object key. The key is the prefix followed by `<name>-<timestamp>.sql`.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"version": {
+			Description: "The storage backend version of the database.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"num_tables": {
+			Description: "The number of tables in the database.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"file_size": {
+			Description: "The size of the database in bytes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"created_at": {
+			Description: "When the database was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareDevicePostureIntegrationsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to list device posture integrations for.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: "Only include integrations with this name.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"type": {
+			Description: "Only include integrations of this type.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"integrations": {
+			Description: "The device posture integrations of the account. Their credentials are not included.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The identifier of the integration.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the integration.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"type": {
+						Description: "The type of the integration.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"interval": {
+						Description: "How often the integration polls the third-party service.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
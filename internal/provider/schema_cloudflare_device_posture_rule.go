@@ -26,12 +26,18 @@ func resourceCloudflareDevicePostureRuleSchema() map[string]*schema.Schema {
 			Optional: true,
 		},
 		"schedule": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validateDevicePostureRuleDuration,
+			DiffSuppressFunc: suppressEquivalentDevicePostureRuleDurations,
+			Description:      "Tells the client when to run the device posture check. Must be in the format `1h` or `30m`. Valid units are `h` and `m`.",
 		},
 		"expiration": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validateDevicePostureRuleDuration,
+			DiffSuppressFunc: suppressEquivalentDevicePostureRuleDurations,
+			Description:      "Expire posture results after the specified amount of time. Must be in the format `1h` or `30m`. Valid units are `h` and `m`.",
 		},
 		"match": {
 			Type:     schema.TypeList,
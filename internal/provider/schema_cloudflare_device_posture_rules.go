@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareDevicePostureRulesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to list device posture rules for.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: "Only include rules with this name.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"type": {
+			Description: "Only include rules of this type.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"rules": {
+			Description: "The device posture rules of the account.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The identifier of the rule.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the rule.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"type": {
+						Description: "The type of the rule.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"description": {
+						Description: "The description of the rule.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"schedule": {
+						Description: "When the client runs the device posture check.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"expiration": {
+						Description: "When posture results expire.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
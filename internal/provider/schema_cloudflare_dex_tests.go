@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareDEXTestsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"since": {
+			Description:  "RFC3339 timestamp of the start of the reporting window. Defaults to 24 hours before `until`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"until": {
+			Description:  "RFC3339 timestamp of the end of the reporting window. Defaults to the current time.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"tests": {
+			Description: "Results summary of each DEX test in the account.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The DEX test identifier.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the DEX test.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"kind": {
+						Description: "The kind of DEX test, either `http` or `traceroute`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"host": {
+						Description: "The host or URL being tested.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"enabled": {
+						Description: "Whether the DEX test is enabled.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"interval": {
+						Description: "How often the DEX test runs.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"availability_pct": {
+						Description: "Average availability of the tested host during the reporting window, between `0` and `100`.",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"latency_p50_ms": {
+						Description: "Median latency in milliseconds. Resource fetch time for `http` tests, round trip time for `traceroute` tests.",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"latency_p90_ms": {
+						Description: "90th percentile latency in milliseconds.",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"latency_p95_ms": {
+						Description: "95th percentile latency in milliseconds.",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"latency_p99_ms": {
+						Description: "99th percentile latency in milliseconds.",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareDNSFirewallAnalyticsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"cluster_id": {
+			Description: "The DNS Firewall cluster identifier to retrieve analytics for.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"since": {
+			Description:  "RFC3339 timestamp of the start of the reporting window. Defaults to 24 hours before `until`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"until": {
+			Description:  "RFC3339 timestamp of the end of the reporting window. Defaults to the current time.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"query_count": {
+			Description: "Total number of queries received by the cluster.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"uncached_count": {
+			Description: "Number of queries which were not answered from cache.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"stale_count": {
+			Description: "Number of queries answered with stale records.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"cache_hit_ratio": {
+			Description: "Share of queries answered from cache, between `0` and `1`.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"response_time_avg": {
+			Description: "Average response time in milliseconds.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"response_time_median": {
+			Description: "Median response time in milliseconds.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"response_time_90th": {
+			Description: "90th percentile response time in milliseconds.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"response_time_99th": {
+			Description: "99th percentile response time in milliseconds.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dnsRecordsBatchTypes = []string{"A", "AAAA", "CNAME", "TXT", "MX", "NS", "PTR", "SPF"}
+
+func resourceCloudflareDNSRecordsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"record": {
+			Description: "The DNS records to manage. Records are identified by their type, name and value.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The fully qualified name of the record, e.g. `www.example.com`.",
+						Type:        schema.TypeString,
+						Required:    true,
+						StateFunc: func(i interface{}) string {
+							return strings.ToLower(i.(string))
+						},
+					},
+					"type": {
+						Description:  fmt.Sprintf("The type of the record. %s", renderAvailableDocumentationValuesStringSlice(dnsRecordsBatchTypes)),
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(dnsRecordsBatchTypes, false),
+					},
+					"value": {
+						Description: "The value of the record.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"ttl": {
+						Description: "The TTL of the record. `1` means automatic.",
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Default:     1,
+					},
+					"proxied": {
+						Description: "Whether the record is proxied by Cloudflare. Only applies to `A`, `AAAA` and `CNAME` records.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"priority": {
+						Description: "The priority of the record. Only applies to `MX` records.",
+						Type:        schema.TypeInt,
+						Optional:    true,
+					},
+					"comment": {
+						Description: "Comments or notes about the record.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"tags": {
+						Description: "Custom tags for the record, in `name:value` format.",
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"delete_unmanaged": {
+			Description: "Whether records in the zone which are not part of `record` are deleted. When disabled only records previously created or adopted by this resource are removed.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"batch_size": {
+			Description:  "Maximum number of record changes sent in a single batch request. Each batch is applied atomically.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      200,
+			ValidateFunc: validation.IntBetween(1, 3500),
+		},
+		"record_ids": {
+			Description: "The identifiers of the managed records, keyed by `TYPE/name/value`.",
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareDNSRecordsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"filter": {
+			Description: "Filters applied by the API when listing records. All filters must match.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Description: "Type of the records.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"name": {
+						Description:   "Exact fully qualified name of the records.",
+						Type:          schema.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"filter.0.name_contains", "filter.0.name_starts_with"},
+					},
+					"name_contains": {
+						Description:   "Substring the record names must contain.",
+						Type:          schema.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"filter.0.name", "filter.0.name_starts_with"},
+					},
+					"name_starts_with": {
+						Description:   "Prefix the record names must start with.",
+						Type:          schema.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"filter.0.name", "filter.0.name_contains"},
+					},
+					"content": {
+						Description: "Exact content of the records.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"tag": {
+						Description: "Tag the records must have, either `name` or `name:value`.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"proxied": {
+						Description: "Whether the records are proxied. Records are returned regardless of their proxied status when unset.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"records": {
+			Description: "The DNS records matching the filter.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The record identifier.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The fully qualified name of the record.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"type": {
+						Description: "The type of the record.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"value": {
+						Description: "The value of the record.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"ttl": {
+						Description: "The TTL of the record. `1` means automatic.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"proxied": {
+						Description: "Whether the record is proxied by Cloudflare.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"priority": {
+						Description: "The priority of the record.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"comment": {
+						Description: "Comment attached to the record.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"tags": {
+						Description: "Tags attached to the record.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
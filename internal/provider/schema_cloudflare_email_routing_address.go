@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareEmailRoutingAddressSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"email": {
+			Description: "The destination email address. A verification email is sent to the address when it is created.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"verified": {
+			Description: "When the address was verified. Empty until the verification email has been confirmed.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created": {
+			Description: "When the address was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"modified": {
+			Description: "When the address was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
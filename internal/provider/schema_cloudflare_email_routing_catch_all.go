@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareEmailRoutingCatchAllSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the catch-all rule.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"enabled": {
+			Description: "Whether the catch-all rule is enabled.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"action": emailRoutingRuleActionSchema(),
+		"tag": {
+			Description: "The Email Routing identifier of the catch-all rule.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
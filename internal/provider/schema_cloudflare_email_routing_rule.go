@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var emailRoutingRuleActionTypes = []string{"forward", "worker", "drop"}
+
+func resourceCloudflareEmailRoutingRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the rule.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"enabled": {
+			Description: "Whether the rule is enabled.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"priority": {
+			Description:  "The priority of the rule. Rules with a lower value are evaluated first.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"matcher": {
+			Description: "Matching patterns to forward to the actions. All matchers must match.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Description:  fmt.Sprintf("Type of matcher. %s", renderAvailableDocumentationValuesStringSlice([]string{"literal"})),
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "literal",
+						ValidateFunc: validation.StringInSlice([]string{"literal"}, false),
+					},
+					"field": {
+						Description:  fmt.Sprintf("Field to match on. %s", renderAvailableDocumentationValuesStringSlice([]string{"to"})),
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "to",
+						ValidateFunc: validation.StringInSlice([]string{"to"}, false),
+					},
+					"value": {
+						Description: "Value to match, e.g. `sales@example.com`.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+				},
+			},
+		},
+		"action": emailRoutingRuleActionSchema(),
+		"tag": {
+			Description: "The Email Routing identifier of the rule.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func emailRoutingRuleActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Actions taken on matching emails.",
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Description:  fmt.Sprintf("Type of action. %s", renderAvailableDocumentationValuesStringSlice(emailRoutingRuleActionTypes)),
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(emailRoutingRuleActionTypes, false),
+				},
+				"value": {
+					Description: "Destination addresses for `forward` actions or the Worker script name for `worker` actions.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareEmailRoutingSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"enabled": {
+			Description: "Whether Email Routing is enabled for the zone.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"skip_wizard": {
+			Description: "Whether to skip the onboarding wizard in the dashboard.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the zone.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"status": {
+			Description: "The status of Email Routing for the zone, e.g. `ready` or `misconfigured`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"tag": {
+			Description: "The Email Routing identifier of the zone.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created": {
+			Description: "When Email Routing was first enabled for the zone.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"modified": {
+			Description: "When the Email Routing settings were last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
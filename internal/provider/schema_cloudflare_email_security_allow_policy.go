@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareEmailSecurityAllowPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"pattern": {
+			Description: "The sender or recipient pattern the policy applies to.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"pattern_type": {
+			Description:  fmt.Sprintf("The type of the pattern. %s", renderAvailableDocumentationValuesStringSlice(emailSecurityPatternTypes)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(emailSecurityPatternTypes, false),
+		},
+		"is_regex": {
+			Description: "Whether the pattern is a regular expression.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"is_acceptable_sender": {
+			Description: "Whether messages from the sender are not flagged as spam, spoof or bulk. Malicious messages are still blocked.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"is_exempt_recipient": {
+			Description: "Whether messages to the recipient bypass all detections.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"is_trusted_sender": {
+			Description: "Whether messages from the sender bypass all detections.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"verify_sender": {
+			Description: "Whether the sender must pass SPF, DKIM or DMARC for the policy to apply.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"comments": {
+			Description: "Comments about the allow policy.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"created_at": {
+			Description: "When the allow policy was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_modified": {
+			Description: "When the allow policy was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
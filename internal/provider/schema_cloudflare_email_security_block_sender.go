@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var emailSecurityPatternTypes = []string{"EMAIL", "DOMAIN", "IP", "UNKNOWN"}
+
+func resourceCloudflareEmailSecurityBlockSenderSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"pattern": {
+			Description: "The sender pattern to block.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"pattern_type": {
+			Description:  fmt.Sprintf("The type of the pattern. %s", renderAvailableDocumentationValuesStringSlice(emailSecurityPatternTypes)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(emailSecurityPatternTypes, false),
+		},
+		"is_regex": {
+			Description: "Whether the pattern is a regular expression.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"comments": {
+			Description: "Comments about the block sender.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"created_at": {
+			Description: "When the block sender was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_modified": {
+			Description: "When the block sender was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
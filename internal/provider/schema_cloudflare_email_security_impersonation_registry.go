@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareEmailSecurityImpersonationRegistrySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The display name of the person.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"email": {
+			Description: "The email address of the person.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"is_email_regex": {
+			Description: "Whether the email address is a regular expression.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"created_at": {
+			Description: "When the impersonation registry entry was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_modified": {
+			Description: "When the impersonation registry entry was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareEmailSecurityTrustedDomainSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"pattern": {
+			Description: "The domain pattern to trust.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"is_regex": {
+			Description: "Whether the pattern is a regular expression.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"is_recent": {
+			Description: "Whether to exempt the domain from recently registered domain detections.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"is_similarity": {
+			Description: "Whether to exempt the domain from lookalike domain detections.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"comments": {
+			Description: "Comments about the trusted domain.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"created_at": {
+			Description: "When the trusted domain was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_modified": {
+			Description: "When the trusted domain was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareFirewallEventsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"since": {
+			Description:  "RFC3339 timestamp of the start of the sampling window. Defaults to 1 hour before `until`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"until": {
+			Description:  "RFC3339 timestamp of the end of the sampling window. Defaults to the current time.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"rule_id": {
+			Description: "Only return events triggered by this rule.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"action": {
+			Description: "Only return events with this action, for example `block` or `managed_challenge`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"source": {
+			Description: "Only return events from this security product, for example `firewallManaged` or `firewallCustom`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"limit": {
+			Description:  "Maximum number of events to return, most recent first.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      100,
+			ValidateFunc: validation.IntBetween(1, 10000),
+		},
+		"events": {
+			Description: "A sample of the security events in the window, most recent first.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ray_id": {
+						Description: "The Ray ID of the request.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"datetime": {
+						Description: "RFC3339 timestamp of the event.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"rule_id": {
+						Description: "The identifier of the rule that triggered the event.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"action": {
+						Description: "The action taken on the request.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"source": {
+						Description: "The security product that triggered the event.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"client_ip": {
+						Description: "The IP address of the client.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"host": {
+						Description: "The host the request was sent to.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"uri": {
+						Description: "The path and query string of the request.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
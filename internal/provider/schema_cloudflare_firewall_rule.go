@@ -54,7 +54,7 @@ func resourceCloudflareFirewallRuleSchema() map[string]*schema.Schema {
 			Type: schema.TypeSet,
 			Elem: &schema.Schema{
 				Type:         schema.TypeString,
-				ValidateFunc: validation.StringInSlice([]string{"zoneLockdown", "uaBlock", "bic", "hot", "securityLevel", "rateLimit", "waf"}, false),
+				ValidateFunc: validateEnum([]string{"zoneLockdown", "uaBlock", "bic", "hot", "securityLevel", "rateLimit", "waf"}),
 			},
 			Optional:    true,
 			Description: fmt.Sprintf("List of products to bypass for a request when the bypass action is used. %s", renderAvailableDocumentationValuesStringSlice([]string{"zoneLockdown", "uaBlock", "bic", "hot", "securityLevel", "rateLimit", "waf"})),
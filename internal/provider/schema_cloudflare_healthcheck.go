@@ -169,6 +169,16 @@ func resourceCloudflareHealthcheckSchema() map[string]*schema.Schema {
 			},
 			Deprecated: "Use `cloudflare_notification_policy` instead.",
 		},
+		"status": {
+			Description: "The current status of the origin server according to the health check, e.g. `healthy` or `unhealthy`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"failure_reason": {
+			Description: "The reason the health check is failing, if any.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 		"created_on": {
 			Description: "Creation time.",
 			Type:        schema.TypeString,
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var hostnameTLSSettings = []string{"min_tls_version", "ciphers", "http2"}
+
+func resourceCloudflareHostnameTLSSettingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"hostname": {
+			Description: "The hostname to apply the setting to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"setting": {
+			Description:  fmt.Sprintf("The TLS setting to manage. %s", renderAvailableDocumentationValuesStringSlice(hostnameTLSSettings)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateEnum(hostnameTLSSettings),
+		},
+		"value": {
+			Description: "The value of the `min_tls_version` (`1.0`, `1.1`, `1.2` or `1.3`) or `http2` (`on` or `off`) setting.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"ciphers": {
+			Description: "The cipher suites allowed for the hostname, in BoringSSL format. Used with the `ciphers` setting.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"status": {
+			Description: "Deployment status of the setting.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
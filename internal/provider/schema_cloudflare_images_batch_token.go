@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareImagesBatchTokenSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"rotation_trigger": {
+			Description: "Arbitrary value which issues a new token whenever it changes, for example a timestamp or a `time_rotating` resource.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"token": {
+			Description: "The token used to authenticate requests to the Images batch API.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"expires_on": {
+			Description: "When the token expires, in RFC 3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareImagesDeliveryURLSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"account_hash": {
+			Description: "The account hash used in Images delivery URLs.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"base_url": {
+			Description: "The base URL images are delivered from, to be suffixed with `/<image_id>/<variant_name>`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
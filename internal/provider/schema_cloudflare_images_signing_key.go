@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareImagesSigningKeySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description:  "The name of the signing key. Changing the name rotates the key.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+		},
+		"rotation_trigger": {
+			Description: "Arbitrary value which rotates the value of the key whenever it changes, for example a timestamp or a `time_rotating` resource.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"value": {
+			Description: "The secret used to sign Images delivery URLs.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareImagesVariantSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description:  "The name of the variant, used in delivery URLs.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 99),
+		},
+		"fit": {
+			Description:  fmt.Sprintf("How the image is resized to fit the given width and height. %s", renderAvailableDocumentationValuesStringSlice(imagesVariantFitValues)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateEnum(imagesVariantFitValues),
+		},
+		"width": {
+			Description:  "Maximum width of the image in pixels.",
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 10000),
+		},
+		"height": {
+			Description:  "Maximum height of the image in pixels.",
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 10000),
+		},
+		"metadata": {
+			Description:  fmt.Sprintf("Which EXIF metadata to preserve when resizing the image. %s", renderAvailableDocumentationValuesStringSlice(imagesVariantMetadataValues)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "none",
+			ValidateFunc: validateEnum(imagesVariantMetadataValues),
+		},
+		"never_require_signed_urls": {
+			Description: "Whether the variant can be accessed without a signed URL, even when the image requires signed URLs.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareIPAccessRulesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ip": {
+			Description:  "The IP address to resolve the effective IP Access rule for.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"zone_id": {
+			Description:  "The zone identifier to resolve rules for. Rules inherited from the account and user are included.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			AtLeastOneOf: []string{"zone_id", "account_id"},
+		},
+		"account_id": {
+			Description:  "The account identifier to resolve rules for.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			AtLeastOneOf: []string{"zone_id", "account_id"},
+		},
+		"asn": {
+			Description: "The ASN the IP address belongs to, e.g. `AS13335`. When set, ASN rules are taken into account.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"country": {
+			Description: "The ISO 3166-1 alpha-2 country code the IP address is located in. When set, country rules are taken into account.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"mode": {
+			Description: "The action of the effective rule. Empty when no rule matches.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"rule_id": {
+			Description: "The identifier of the effective rule.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"scope": {
+			Description: "The scope the effective rule is defined at. One of `zone`, `account` or `user`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"matching_rules": {
+			Description: "All rules matching the IP address, ordered by precedence.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The rule identifier.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"mode": {
+						Description: "The action applied by the rule.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"scope": {
+						Description: "The scope the rule is defined at.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"target": {
+						Description: "The request property targeted by the rule.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"value": {
+						Description: "The value of the targeted request property.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"notes": {
+						Description: "The notes of the rule.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
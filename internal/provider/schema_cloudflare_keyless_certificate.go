@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var keylessCertificateBundleMethods = []string{"ubiquitous", "optimal", "force"}
+
+func resourceCloudflareKeylessCertificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the Keyless SSL configuration.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"certificate": {
+			Description:      "The PEM encoded certificate, whose private key is held by the key server.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppressKeylessCertificateImportedDiff,
+		},
+		"bundle_method": {
+			Description:      fmt.Sprintf("How the certificate chain is bundled. %s", renderAvailableDocumentationValuesStringSlice(keylessCertificateBundleMethods)),
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "ubiquitous",
+			ForceNew:         true,
+			ValidateFunc:     validateEnum(keylessCertificateBundleMethods),
+			DiffSuppressFunc: suppressKeylessCertificateImportedDiff,
+		},
+		"host": {
+			Description: "The hostname or IP address of the key server.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"port": {
+			Description:  "The port the key server listens on.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      24008,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"enabled": {
+			Description: "Whether the Keyless SSL configuration is used.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"tunnel": {
+			Description: "Reach the key server through a Cloudflare Tunnel instead of the public internet.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"private_ip": {
+						Description:  "The private IP address of the key server.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPAddress,
+					},
+					"vnet_id": {
+						Description: "The identifier of the virtual network the key server is in.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+				},
+			},
+		},
+		"status": {
+			Description: "The health status of the key server as seen by Cloudflare.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+// suppressKeylessCertificateImportedDiff keeps imported configurations from
+// being replaced as the API never returns the certificate or bundle method.
+func suppressKeylessCertificateImportedDiff(k, old, new string, d *schema.ResourceData) bool {
+	return old == "" && d.Id() != ""
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareLeakedCredentialCheckRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"username": {
+			Description: "The ruleset expression locating the username in a request, e.g. `lookup_json_string(http.request.body.raw, \"user\")`.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"password": {
+			Description: "The ruleset expression locating the password in a request, e.g. `lookup_json_string(http.request.body.raw, \"secret\")`.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+	}
+}
@@ -1,12 +1,15 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var listKinds = []string{"ip", "redirect", "hostname", "asn"}
+
 func resourceCloudflareListSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"account_id": {
@@ -27,15 +30,23 @@ func resourceCloudflareListSchema() map[string]*schema.Schema {
 			Optional:    true,
 		},
 		"kind": {
-			Description:  "The type of items the list will contain.",
+			Description:  fmt.Sprintf("The type of items the list will contain. %s", renderAvailableDocumentationValuesStringSlice(listKinds)),
 			Type:         schema.TypeString,
-			ValidateFunc: validation.StringInSlice([]string{"ip", "redirect"}, false),
+			ValidateFunc: validation.StringInSlice(listKinds, false),
 			Required:     true,
 		},
 		"item": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem:     listItemElem,
+			Description:   "The items of the list. Items added outside of Terraform are detected as drift and removed unless `ignore_items` is set.",
+			Type:          schema.TypeList,
+			Optional:      true,
+			Elem:          listItemElem,
+			ConflictsWith: []string{"ignore_items"},
+		},
+		"ignore_items": {
+			Description:   "Whether to leave the items of the list unmanaged by this resource. Must be set when the items are managed through `cloudflare_list_item` resources.",
+			Type:          schema.TypeBool,
+			Optional:      true,
+			ConflictsWith: []string{"item"},
 		},
 	}
 }
@@ -56,45 +67,17 @@ var listItemElem = &schema.Resource{
 					"redirect": {
 						Type:     schema.TypeList,
 						Optional: true,
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"source_url": {
-									Description: "The source url of the redirect.",
-									Type:        schema.TypeString,
-									Required:    true,
-								},
-								"target_url": {
-									Description: "The target url of the redirect.",
-									Type:        schema.TypeString,
-									Required:    true,
-								},
-								"include_subdomains": {
-									Description: "Whether the redirect also matches subdomains of the source url.",
-									Type:        schema.TypeBool,
-									Optional:    true,
-								},
-								"subpath_matching": {
-									Description: "Whether the redirect also matches subpaths of the source url.",
-									Type:        schema.TypeBool,
-									Optional:    true,
-								},
-								"status_code": {
-									Description: "The status code to be used when redirecting a request.",
-									Type:        schema.TypeInt,
-									Optional:    true,
-								},
-								"preserve_query_string": {
-									Description: "Whether the redirect target url should keep the query string of the request's url.",
-									Type:        schema.TypeBool,
-									Optional:    true,
-								},
-								"preserve_path_suffix": {
-									Description: "Whether to preserve the path suffix when doing subpath matching.",
-									Type:        schema.TypeBool,
-									Optional:    true,
-								},
-							},
-						},
+						Elem:     listItemRedirectResource(false),
+					},
+					"hostname": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem:     listItemHostnameResource(false),
+					},
+					"asn": {
+						Type:     schema.TypeInt,
+						Optional: true,
 					},
 				},
 			},
@@ -106,3 +89,67 @@ var listItemElem = &schema.Resource{
 		},
 	},
 }
+
+// listItemRedirectResource returns the schema of a redirect list item. Item
+// level resources can't be updated in place so they set forceNew.
+func listItemRedirectResource(forceNew bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"source_url": {
+				Description: "The source url of the redirect.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    forceNew,
+			},
+			"target_url": {
+				Description: "The target url of the redirect.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    forceNew,
+			},
+			"include_subdomains": {
+				Description: "Whether the redirect also matches subdomains of the source url.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    forceNew,
+			},
+			"subpath_matching": {
+				Description: "Whether the redirect also matches subpaths of the source url.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    forceNew,
+			},
+			"status_code": {
+				Description: "The status code to be used when redirecting a request.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    forceNew,
+			},
+			"preserve_query_string": {
+				Description: "Whether the redirect target url should keep the query string of the request's url.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    forceNew,
+			},
+			"preserve_path_suffix": {
+				Description: "Whether to preserve the path suffix when doing subpath matching.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    forceNew,
+			},
+		},
+	}
+}
+
+func listItemHostnameResource(forceNew bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"url_hostname": {
+				Description: "The FQDN to match on. Wildcard sub-domain matching is allowed, e.g. `*.example.com`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    forceNew,
+			},
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var listItemValueKeys = []string{"ip", "redirect", "hostname", "asn"}
+
+func resourceCloudflareListItemSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"list_id": {
+			Description: "The list identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"ip": {
+			Description:  "IP address or CIDR to include in a list of kind `ip`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: listItemValueKeys,
+		},
+		"redirect": {
+			Description:  "Redirect to include in a list of kind `redirect`.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			Elem:         listItemRedirectResource(true),
+			ExactlyOneOf: listItemValueKeys,
+		},
+		"hostname": {
+			Description:  "Hostname to include in a list of kind `hostname`.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			Elem:         listItemHostnameResource(true),
+			ExactlyOneOf: listItemValueKeys,
+		},
+		"asn": {
+			Description:  "Autonomous system number to include in a list of kind `asn`.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: listItemValueKeys,
+		},
+		"comment": {
+			Description: "An optional comment for the item.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+	}
+}
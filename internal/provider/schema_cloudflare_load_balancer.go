@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -39,7 +41,7 @@ func resourceCloudflareLoadBalancerSchema() map[string]*schema.Schema {
 			Type:         schema.TypeString,
 			Optional:     true,
 			Default:      "none",
-			ValidateFunc: validation.StringInSlice([]string{"", "none", "cookie", "ip_cookie"}, false),
+			ValidateFunc: validation.StringInSlice([]string{"", "none", "cookie", "ip_cookie", "header"}, false),
 		},
 
 		"proxied": {
@@ -83,13 +85,85 @@ func resourceCloudflareLoadBalancerSchema() map[string]*schema.Schema {
 		},
 
 		"session_affinity_attributes": {
-			Type:     schema.TypeMap,
-			Optional: true,
+			Description: "Session affinity settings. Supported keys are `samesite`, `secure`, `drain_duration`, `zero_downtime_failover` (`none`, `temporary` or `sticky`), and for `header` session affinity, `headers` (a comma-separated list of header names) and `require_all_headers` (`true` or `false`).",
+			Type:        schema.TypeMap,
+			Optional:    true,
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
 		},
 
+		"adaptive_routing": {
+			Description: "Controls features that modify the routing of requests to pools and origins in response to dynamic conditions.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"failover_across_pools": {
+						Description: "Whether requests that fail against an origin with zero-downtime failover are retried on an origin in another pool.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+
+		"location_strategy": {
+			Description: "Controls how the location of the client is determined for proximity and geo steering.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"prefer_ecs": {
+						Description:  fmt.Sprintf("Whether the EDNS Client Subnet is preferred over `mode`. %s", renderAvailableDocumentationValuesStringSlice([]string{"always", "never", "proximity", "geo"})),
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "proximity",
+						ValidateFunc: validation.StringInSlice([]string{"always", "never", "proximity", "geo"}, false),
+					},
+					"mode": {
+						Description:  fmt.Sprintf("The location used when EDNS Client Subnet is not preferred or not available. %s", renderAvailableDocumentationValuesStringSlice([]string{"pop", "resolver_ip"})),
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "pop",
+						ValidateFunc: validation.StringInSlice([]string{"pop", "resolver_ip"}, false),
+					},
+				},
+			},
+		},
+
+		"random_steering": {
+			Description: "Configures pool weights for the `random` steering policy.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"pool_weights": {
+						Description: "A map of pool IDs to their weight, between 0 and 1. Pools without a weight use `default_weight`.",
+						Type:        schema.TypeMap,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeFloat,
+						},
+					},
+					"default_weight": {
+						Description:  "The weight of the pools without an entry in `pool_weights`.",
+						Type:         schema.TypeFloat,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.FloatBetween(0, 1),
+					},
+				},
+			},
+		},
+
 		"rules": {
 			Type:     schema.TypeList,
 			Optional: true,
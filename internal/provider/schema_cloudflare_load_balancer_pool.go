@@ -124,6 +124,11 @@ var originsElem = &schema.Resource{
 			Default:  true,
 		},
 
+		"virtual_network_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
 		"header": {
 			Type:     schema.TypeSet,
 			Optional: true,
@@ -185,7 +190,7 @@ var originSteeringElem = &schema.Resource{
 			Type:         schema.TypeString,
 			Default:      "random",
 			Optional:     true,
-			ValidateFunc: validation.StringInSlice([]string{"", "hash", "random"}, false),
+			ValidateFunc: validation.StringInSlice([]string{"", "hash", "random", "least_outstanding_requests", "least_connections"}, false),
 		},
 	},
 }
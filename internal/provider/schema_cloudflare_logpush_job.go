@@ -60,10 +60,52 @@ func resourceCloudflareLogpushJobSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: `Ownership challenge token to prove destination ownership, required when destination is Amazon S3, Google Cloud Storage, Microsoft Azure or Sumo Logic. See [Developer documentation](https://developers.cloudflare.com/logs/logpush/logpush-configuration-api/understanding-logpush-api/#usage).`,
 		},
-		"filter": {
-			Type:        schema.TypeString,
+		"validate_destination": {
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Use filters to select the events to include and/or remove from your logs. For more information, refer to [Filters](https://developers.cloudflare.com/logs/reference/logpush-api-configuration/filters/).",
+			Default:     false,
+			Description: "Whether to validate the `destination_conf`, `ownership_challenge` and `logpull_options` against the API at plan time, reporting the reason an invalid destination is rejected instead of failing on apply.",
+		},
+		"filter": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"filter_builder"},
+			Description:   "Use filters to select the events to include and/or remove from your logs. For more information, refer to [Filters](https://developers.cloudflare.com/logs/reference/logpush-api-configuration/filters/).",
+		},
+		"filter_builder": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"filter"},
+			Description:   "Structured alternative to `filter` which is compiled to the filter JSON. Field names are validated against the fields available for the `dataset`.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"match": logpushJobFilterMatchSchema(),
+					"condition": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Conditions which are combined according to `match`.",
+						Elem:        logpushJobFilterConditionSchema(),
+					},
+					"group": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Nested groups of conditions which are combined with the top level conditions according to `match`.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"match": logpushJobFilterMatchSchema(),
+								"condition": {
+									Type:        schema.TypeList,
+									Required:    true,
+									MinItems:    1,
+									Description: "Conditions which are combined according to the group's `match`.",
+									Elem:        logpushJobFilterConditionSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		"frequency": {
 			Type:         schema.TypeString,
@@ -74,3 +116,44 @@ func resourceCloudflareLogpushJobSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+func logpushJobFilterMatchSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "all",
+		ValidateFunc: validation.StringInSlice([]string{"all", "any"}, false),
+		Description:  fmt.Sprintf("Whether all (`and`) or any (`or`) of the conditions must match. %s", renderAvailableDocumentationValuesStringSlice([]string{"all", "any"})),
+	}
+}
+
+func logpushJobFilterConditionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The dataset field to compare.",
+			},
+			"operator": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEnum(logpushJobFilterOperators),
+				Description:  fmt.Sprintf("The comparison operator. %s", renderAvailableDocumentationValuesStringSlice(logpushJobFilterOperators)),
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The value to compare against. Numeric and boolean values are sent as their JSON types. Required unless `operator` is `in` or `!in`.",
+			},
+			"values": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The values to compare against. Required when `operator` is `in` or `!in`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var magicNetworkMonitoringRuleDurations = []string{"1m", "5m", "10m", "15m", "20m", "30m", "45m", "60m"}
+
+func resourceCloudflareMagicNetworkMonitoringRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the rule. Must be unique within the account.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"prefixes": {
+			Description: "The IP prefixes that are monitored by this rule.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+		"automatic_advertisement": {
+			Description: "Whether the prefixes are automatically advertised through Magic Transit when an attack is detected. When enabled, every prefix must be contained in an IP prefix onboarded to the account.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"bandwidth_threshold": {
+			Description:  "The number of bits per second that triggers the rule. Conflicts with `packet_threshold`.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			ExactlyOneOf: []string{"bandwidth_threshold", "packet_threshold"},
+		},
+		"packet_threshold": {
+			Description:  "The number of packets per second that triggers the rule. Conflicts with `bandwidth_threshold`.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			ExactlyOneOf: []string{"bandwidth_threshold", "packet_threshold"},
+		},
+		"duration": {
+			Description:  fmt.Sprintf("The amount of time the threshold must be exceeded for the rule to trigger. %s", renderAvailableDocumentationValuesStringSlice(magicNetworkMonitoringRuleDurations)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "1m",
+			ValidateFunc: validation.StringInSlice(magicNetworkMonitoringRuleDurations, false),
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareMagicTransitConnectorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"connector_id": {
+			Description:  "The identifier of the connector. Conflicts with `serial_number`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"connector_id", "serial_number"},
+		},
+		"serial_number": {
+			Description:  "The serial number of the connector device, used to find a newly shipped connector. Conflicts with `connector_id`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"connector_id", "serial_number"},
+		},
+		"activated": {
+			Description: "Whether the connector is activated and allowed to establish tunnels.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"interrupt_window_duration_hours": {
+			Description:  "Length in hours of the window in which the connector may be updated and restarted.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(1, 24),
+		},
+		"interrupt_window_hour_of_day": {
+			Description:  "Hour of the day, in `timezone`, at which the interrupt window starts.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 23),
+		},
+		"timezone": {
+			Description: "IANA timezone used for the interrupt window, e.g. `Europe/London`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"notes": {
+			Description: "Free form notes about the connector.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"last_heartbeat": {
+			Description: "When the connector last reported to Cloudflare.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_seen_version": {
+			Description: "The software version last reported by the connector.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -8,9 +8,10 @@ func resourceCloudflareManagedHeadersSchema() map[string]*schema.Schema {
 			Description: "The zone identifier to target for the resource.",
 			Type:        schema.TypeString,
 			Required:    true,
+			ForceNew:    true,
 		},
 		"managed_request_headers": {
-			Description: "The list of managed request headers",
+			Description: "The list of managed request headers. Headers removed from the list are disabled.",
 			Type:        schema.TypeSet,
 			Optional:    true,
 			Elem: &schema.Resource{
@@ -29,7 +30,7 @@ func resourceCloudflareManagedHeadersSchema() map[string]*schema.Schema {
 			},
 		},
 		"managed_response_headers": {
-			Description: "The list of managed response headers",
+			Description: "The list of managed response headers. Headers removed from the list are disabled.",
 			Type:        schema.TypeSet,
 			Optional:    true,
 			Elem: &schema.Resource{
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareMTLSCertificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "Optional unique name for the certificate.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"certificates": {
+			Description: "The PEM encoded certificate, or CA bundle when `ca` is `true`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"private_key": {
+			Description: "The private key of the certificate. Not needed for CA bundles.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+		},
+		"ca": {
+			Description: "Whether the certificate is a CA bundle used to validate client certificates.",
+			Type:        schema.TypeBool,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"issuer": {
+			Description: "The certificate authority that issued the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"serial_number": {
+			Description: "The serial number of the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"signature": {
+			Description: "The algorithm used to sign the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"uploaded_on": {
+			Description: "When the certificate was uploaded.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"expires_on": {
+			Description: "When the certificate expires.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"associations": {
+			Description: "The services the certificate is used by.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"service": {
+						Description: "The service using the certificate.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"status": {
+						Description: "The status of the association.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareMTLSCertificateAssociationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"mtls_certificate_id": {
+			Description: "The identifier of the CA bundle uploaded with `cloudflare_mtls_certificate`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"hostnames": {
+			Description: "The hostnames of the zone whose client certificates are validated against the CA bundle.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
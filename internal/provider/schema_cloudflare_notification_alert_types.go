@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareNotificationAlertTypesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"product": {
+			Description: "Only return alert types of the product, e.g. `Origin Monitoring`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"alert_types": {
+			Description: "The alert types available to notification policies.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Description: "The alert type, used as `alert_type` of a notification policy.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"product": {
+						Description: "The product the alert type belongs to.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"display_name": {
+						Description: "The name of the alert type.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"description": {
+						Description: "Description of the alert type.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"filters": {
+						Description: "The filters accepted by the alert type.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"key": {
+									Description: "The filter key, as used in the `filters` block of a notification policy.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"optional": {
+									Description: "Whether the filter may be omitted.",
+									Type:        schema.TypeBool,
+									Computed:    true,
+								},
+								"comparison_operator": {
+									Description: "How the filter values are compared.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"range": {
+									Description: "The number of values the filter accepts, e.g. `1-n`.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"available_values": {
+									Description: "The values the filter accepts. Empty when any value is accepted.",
+									Type:        schema.TypeList,
+									Computed:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"supported": {
+									Description: "Whether the filter can be set in the `filters` block of `cloudflare_notification_policy`.",
+									Type:        schema.TypeBool,
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareObservatoryScheduleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"url": {
+			Description: "The page to test, without the scheme. For example `example.com/pricing`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"region": {
+			Description:  fmt.Sprintf("The region tests are run from. %s", renderAvailableDocumentationValuesStringSlice(observatoryRegions)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateEnum(observatoryRegions),
+		},
+		"frequency": {
+			Description:  fmt.Sprintf("How often the test is run. %s", renderAvailableDocumentationValuesStringSlice(observatoryFrequencies)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateEnum(observatoryFrequencies),
+		},
+		"last_test_date": {
+			Description: "When the most recent test of the page from the region completed.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"desktop_performance_score": {
+			Description: "Lighthouse performance score (0-100) for desktop from the most recent test.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"mobile_performance_score": {
+			Description: "Lighthouse performance score (0-100) for mobile from the most recent test.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+}
@@ -34,6 +34,12 @@ func resourceCloudflareOriginCACertificateSchema() map[string]*schema.Schema {
 			ForceNew:     true,
 			ValidateFunc: validation.StringInSlice([]string{"origin-rsa", "origin-ecc", "keyless-certificate"}, false),
 		},
+		"min_days_remaining": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Number of days before expiry at which the certificate is re-issued on the next apply.",
+		},
 		"requested_validity": {
 			Type:         schema.TypeInt,
 			Optional:     true,
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var pageShieldPolicyActions = []string{"allow", "log"}
+
+func resourceCloudflarePageShieldPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"action": {
+			Description:  fmt.Sprintf("The action to take when a resource violates the policy. `allow` enforces the policy while `log` only reports violations. %s", renderAvailableDocumentationValuesStringSlice(pageShieldPolicyActions)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(pageShieldPolicyActions, false),
+		},
+		"description": {
+			Description: "A description of the policy.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"enabled": {
+			Description: "Whether the policy is enabled.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"expression": {
+			Description: "The ruleset expression selecting the pages the policy applies to, e.g. `ends_with(http.request.uri.path, \"/checkout\")`.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"value": {
+			Description: "The Content Security Policy directives of the policy, e.g. `script-src 'self' https://cdn.example.com`.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+	}
+}
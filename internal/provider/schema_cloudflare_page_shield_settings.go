@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflarePageShieldSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"enabled": {
+			Description: "Whether Page Shield is enabled for the zone.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"use_cloudflare_reporting_endpoint": {
+			Description: "Whether CSP reports are sent to a Cloudflare-owned endpoint instead of a path on the zone.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"use_connection_url_path": {
+			Description: "Whether the full path of connection URLs is stored instead of only the host.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"updated_at": {
+			Description: "When the settings were last updated.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
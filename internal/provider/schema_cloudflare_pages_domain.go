@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflarePagesDomainSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"project_name": {
+			Description: "Name of the Pages Project.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"domain": {
+			Description: "Custom domain.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"wait_for_active_status": {
+			Description: "Whether or not to wait for the domain to become active before completing creation. The duration of the wait is controlled by the `create` timeout.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+		},
+		"status": {
+			Description: "Status of the custom domain.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"validation_status": {
+			Description: "Status of the certificate validation for the custom domain.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"verification_status": {
+			Description: "Status of the ownership verification for the custom domain.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflarePagesProjectSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "Name of the project.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"production_branch": {
+			Description: "The name of the branch that is used for the production environment.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"subdomain": {
+			Description: "The Cloudflare subdomain associated with the project.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"domains": {
+			Description: "A list of associated custom domains for the project.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"created_on": {
+			Description: "When the project was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"build_config": {
+			Description: "Configuration for the project build process.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"build_command": {
+						Description: "Command used to build project.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"destination_dir": {
+						Description: "Output directory of the build.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"root_dir": {
+						Description: "Directory to run the command.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"web_analytics_tag": {
+						Description: "The classifying tag for analytics.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"web_analytics_token": {
+						Description: "The auth token for analytics.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+		},
+		"source": {
+			Description: "Configuration for the project source.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Description:  fmt.Sprintf("Project host type. %s", renderAvailableDocumentationValuesStringSlice(pagesProjectSourceTypes)),
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(pagesProjectSourceTypes, false),
+					},
+					"config": {
+						Description: "Configuration for the source of the Cloudflare Pages project.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"owner": {
+									Description: "Project owner username.",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+								"repo_name": {
+									Description: "Project repository name.",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+								"production_branch": {
+									Description: "Project production branch name.",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+								"pr_comments_enabled": {
+									Description: "Enable Pages to comment on Pull Requests.",
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     true,
+								},
+								"deployments_enabled": {
+									Description: "Toggle deployments on this repo.",
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     true,
+								},
+								"production_deployment_enabled": {
+									Description: "Whether commits to the production branch are deployed.",
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     true,
+								},
+								"preview_deployment_setting": {
+									Description:  fmt.Sprintf("Which branches get preview deployments. `custom` uses `preview_branch_includes` and `preview_branch_excludes`. %s", renderAvailableDocumentationValuesStringSlice(pagesProjectPreviewDeploymentSettings)),
+									Type:         schema.TypeString,
+									Optional:     true,
+									Default:      "all",
+									ValidateFunc: validation.StringInSlice(pagesProjectPreviewDeploymentSettings, false),
+								},
+								"preview_branch_includes": {
+									Description: "Branches to include for automatic preview deployments.",
+									Type:        schema.TypeList,
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"preview_branch_excludes": {
+									Description: "Branches to exclude from automatic preview deployments.",
+									Type:        schema.TypeList,
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"deployment_configs": {
+			Description: "Configuration for deployments in a project.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"preview": {
+						Description: "Configuration for preview deploys.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Computed:    true,
+						MaxItems:    1,
+						Elem:        pagesProjectDeploymentConfigEnvironmentSchema(),
+					},
+					"production": {
+						Description: "Configuration for production deploys.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Computed:    true,
+						MaxItems:    1,
+						Elem:        pagesProjectDeploymentConfigEnvironmentSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func pagesProjectDeploymentConfigEnvironmentSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"environment_variables": {
+				Description: "Environment variables for the build and runtime of the deployment.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"secrets": {
+				Description: "Encrypted environment variables for the build and runtime of the deployment. Values are write only and are not read back from the API.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"kv_namespaces": {
+				Description: "KV namespaces used for Pages Functions, keyed by binding name with the namespace ID as the value.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"durable_object_namespaces": {
+				Description: "Durable Object namespaces used for Pages Functions, keyed by binding name with the namespace ID as the value.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"d1_databases": {
+				Description: "D1 databases used for Pages Functions, keyed by binding name with the database ID as the value.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"r2_buckets": {
+				Description: "R2 buckets used for Pages Functions, keyed by binding name with the bucket name as the value.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"compatibility_date": {
+				Description: "Compatibility date used for Pages Functions.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"compatibility_flags": {
+				Description: "Compatibility flags used for Pages Functions.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
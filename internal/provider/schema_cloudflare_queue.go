@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareQueueSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the queue.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"created_on": {
+			Description: "When the queue was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"modified_on": {
+			Description: "When the queue was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
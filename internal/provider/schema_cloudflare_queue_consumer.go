@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareQueueConsumerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"queue_id": {
+			Description: "The ID of the queue to consume messages from.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"script_name": {
+			Description: "The name of the Worker script consuming the queue.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"dead_letter_queue_id": {
+			Description: "The ID of the queue messages are sent to once `max_retries` is exhausted. Must be a different queue in the same account.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"batch_size": {
+			Description:  "Maximum number of messages delivered to the consumer in a single batch.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		"max_retries": {
+			Description:  "Maximum number of times a message is retried before it is sent to the dead letter queue or dropped.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      3,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+		"max_wait_time_ms": {
+			Description:  "Maximum number of milliseconds to wait for a batch to fill before it is delivered.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      5000,
+			ValidateFunc: validation.IntBetween(0, 60000),
+		},
+		"max_concurrency": {
+			Description:  "Maximum number of concurrent consumer invocations. Leave unset to scale automatically.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 250),
+		},
+		"retry_delay": {
+			Description:  "Number of seconds to delay a message before it is retried.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 43200),
+		},
+		"created_on": {
+			Description: "When the consumer was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareR2BucketCORSSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"bucket": {
+			Description: "Name of the R2 bucket.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"rule": {
+			Description: "CORS rules applied to the bucket.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "Identifier for the rule.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"allowed_methods": {
+						Description: fmt.Sprintf("HTTP methods allowed in cross-origin requests. %s", renderAvailableDocumentationValuesStringSlice(r2BucketCORSMethods)),
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validateEnum(r2BucketCORSMethods),
+						},
+					},
+					"allowed_origins": {
+						Description: "Origins allowed to make cross-origin requests.",
+						Type:        schema.TypeList,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"allowed_headers": {
+						Description: "Request headers allowed in cross-origin requests.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"expose_headers": {
+						Description: "Response headers exposed to the cross-origin client.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"max_age_seconds": {
+						Description: "How long, in seconds, browsers may cache the response to a preflight request.",
+						Type:        schema.TypeInt,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
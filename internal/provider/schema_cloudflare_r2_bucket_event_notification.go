@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareR2BucketEventNotificationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"bucket": {
+			Description: "Name of the R2 bucket.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"queue_id": {
+			Description: "The ID of the Queue that event notifications are delivered to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"queue_name": {
+			Description: "The name of the Queue that event notifications are delivered to.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"rule": {
+			Description: "Rules describing which object events are sent to the Queue.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"actions": {
+						Description: fmt.Sprintf("Object actions which trigger a notification. %s", renderAvailableDocumentationValuesStringSlice(r2BucketEventNotificationActions)),
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validateEnum(r2BucketEventNotificationActions),
+						},
+					},
+					"prefix": {
+						Description: "Only send notifications for objects whose key begins with this prefix.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"suffix": {
+						Description: "Only send notifications for objects whose key ends with this suffix.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"description": {
+						Description: "A description of the rule.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
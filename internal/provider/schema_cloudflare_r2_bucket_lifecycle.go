@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareR2BucketLifecycleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"bucket": {
+			Description: "Name of the R2 bucket.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"rule": {
+			Description: "Lifecycle rules applied to the bucket.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "Unique identifier for the rule.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"enabled": {
+						Description: "Whether the rule is active.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+					"prefix": {
+						Description: "Only apply the rule to objects whose key begins with this prefix. Applies to every object when empty.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"expiration": {
+						Description: "Delete objects once the condition is met.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: r2BucketLifecycleConditionSchema(),
+						},
+					},
+					"abort_multipart_uploads_after_days": {
+						Description:  "Abort incomplete multipart uploads this many days after they were started.",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"transition": {
+						Description: "Move objects to another storage class once the condition is met.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        r2BucketLifecycleTransitionSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// r2BucketLifecycleConditionSchema returns the condition shared by lifecycle
+// actions. Exactly one of `max_age_days` or `date` must be set.
+func r2BucketLifecycleConditionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"max_age_days": {
+			Description:  "Apply the action once an object is this many days old. Conflicts with `date`.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"date": {
+			Description:  "Apply the action to objects created before this RFC3339 timestamp. Conflicts with `max_age_days`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+	}
+}
+
+func r2BucketLifecycleTransitionSchema() *schema.Resource {
+	s := r2BucketLifecycleConditionSchema()
+	s["storage_class"] = &schema.Schema{
+		Description:  fmt.Sprintf("Storage class to move objects to. %s", renderAvailableDocumentationValuesStringSlice(r2BucketStorageClasses)),
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "InfrequentAccess",
+		ValidateFunc: validateEnum(r2BucketStorageClasses),
+	}
+
+	return &schema.Resource{Schema: s}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareR2BucketMetricsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to retrieve R2 metrics for.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"bucket_name": {
+			Description: "Only return metrics for this bucket.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"since": {
+			Description:  "RFC3339 timestamp of the start of the window. Defaults to the start of the current month, matching the billing period.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"until": {
+			Description:  "RFC3339 timestamp of the end of the window. Defaults to the current time.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"buckets": {
+			Description: "The metrics of each bucket with storage or operations in the window, ordered by name.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the bucket.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"object_count": {
+						Description: "The peak number of objects stored in the bucket.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"storage_bytes": {
+						Description: "The peak size of the objects and their metadata stored in the bucket, in bytes.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"class_a_operations": {
+						Description: "The number of Class A operations, such as writes and lists, on the bucket.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"class_b_operations": {
+						Description: "The number of Class B operations, such as reads, on the bucket.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"storage_bytes": {
+			Description: "The sum of `storage_bytes` across all buckets.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"class_a_operations": {
+			Description: "The sum of `class_a_operations` across all buckets.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"class_b_operations": {
+			Description: "The sum of `class_b_operations` across all buckets.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareR2BucketsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to list R2 buckets for.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name_contains": {
+			Description: "Only include buckets whose name contains this value.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"buckets": {
+			Description: "The R2 buckets of the account.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the bucket.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"creation_date": {
+						Description: "When the bucket was created.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"location": {
+						Description: "The location hint of the bucket.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"storage_class": {
+						Description: "The default storage class of objects in the bucket.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
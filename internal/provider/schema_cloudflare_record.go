@@ -4,7 +4,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCloudflareRecordSchema() map[string]*schema.Schema {
@@ -34,7 +33,7 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 			Type:         schema.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR"}, false),
+			ValidateFunc: validateEnum([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "SVCB", "HTTPS"}),
 		},
 
 		"value": {
@@ -64,16 +63,18 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 						Optional: true,
 					},
 					"flags": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: suppressRecordDataDiff,
 					},
 					"service": {
 						Type:     schema.TypeString,
 						Optional: true,
 					},
 					"certificate": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: suppressRecordDataDiff,
 					},
 					"type": {
 						Type:     schema.TypeInt,
@@ -114,8 +115,9 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 						Optional: true,
 					},
 					"target": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: suppressRecordDataDiff,
 					},
 
 					// LOC record properties
@@ -174,8 +176,9 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 						Optional: true,
 					},
 					"public_key": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: suppressRecordDataDiff,
 					},
 
 					// DS record properties
@@ -184,8 +187,9 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 						Optional: true,
 					},
 					"digest": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: suppressRecordDataDiff,
 					},
 
 					// NAPTR record properties
@@ -208,8 +212,9 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 
 					// SSHFP record properties
 					"fingerprint": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: suppressRecordDataDiff,
 					},
 
 					// URI record properties
@@ -268,6 +273,19 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 			Type:     schema.TypeBool,
 			Computed: true,
 		},
+		"comment": {
+			Description: "Comments or notes about the DNS record. This field has no effect on DNS responses.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"tags": {
+			Description: "Custom tags for the DNS record, in `name:value` format. This field has no effect on DNS responses.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
 		"allow_overwrite": {
 			Type:     schema.TypeBool,
 			Optional: true,
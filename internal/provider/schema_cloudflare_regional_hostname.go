@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareRegionalHostnameSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"hostname": {
+			Description: "The hostname to pin to a region. Wildcards are supported for one level, such as `*.example.com`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"region_key": {
+			Description: "The key of the region TLS termination and processing of the hostname is restricted to, such as `eu` or `us`. Available keys can be looked up with the `cloudflare_regional_hostname_regions` data source.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"created_on": {
+			Description: "When the regional hostname was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func dataSourceCloudflareRegionalHostnameRegionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"regions": {
+			Description: "The regions available to regional hostnames.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Description: "The key of the region, used as `region_key` of a regional hostname.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"label": {
+						Description: "The human-readable name of the region.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -2,12 +2,89 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var (
+	rulesetEdgeTTLModes    = []string{"respect_origin", "bypass_by_default", "override_origin"}
+	rulesetBrowserTTLModes = []string{"respect_origin", "bypass_by_default", "override_origin", "bypass"}
+
+	rulesetConfigPolishValues        = []string{"off", "lossless", "lossy"}
+	rulesetConfigSecurityLevelValues = []string{"off", "essentially_off", "low", "medium", "high", "under_attack"}
+	rulesetConfigSSLValues           = []string{"off", "flexible", "full", "strict", "origin_pull"}
+
+	rulesetRedirectStatusCodes = []int{301, 302, 303, 307, 308}
+
+	rulesetOverrideSensitivityLevels = []string{"default", "medium", "low", "eoff"}
+
+	rulesetRateLimitPeriods            = []int{10, 60, 120, 300, 600, 3600}
+	rulesetRateLimitMitigationTimeouts = []int{0, 10, 60, 120, 300, 600, 3600, 86400}
+	rulesetRateLimitCharacteristics    = []string{
+		"cf.colo.id",
+		"ip.src",
+		"ip.src.asnum",
+		"ip.src.country",
+		"cf.unique_visitor_id",
+		"cf.bot_management.ja3_hash",
+		"cf.bot_management.ja4",
+		"http.host",
+		"http.request.uri.path",
+		"http.request.body.raw",
+	}
+
+	// rulesetRateLimitFieldLookupCharacteristic matches the characteristics
+	// that count on the value of a named header, cookie, query string
+	// argument, form field or JSON body field.
+	rulesetRateLimitFieldLookupCharacteristic = regexp.MustCompile(`^(http\.request\.(headers|cookies|uri\.args|body\.form)\["[^"]+"\]|lookup_json_string\(http\.request\.body\.raw, "[^"]+"\))$`)
+)
+
+// validateRulesetRateLimitCharacteristic ensures a rate limiting
+// characteristic is either a known field or a supported field lookup.
+func validateRulesetRateLimitCharacteristic(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	for _, c := range rulesetRateLimitCharacteristics {
+		if v == c {
+			return
+		}
+	}
+
+	if !rulesetRateLimitFieldLookupCharacteristic.MatchString(v) {
+		errs = append(errs, fmt.Errorf(`%q must be one of %s or a lookup such as http.request.headers["x-api-key"], got %q`, key, strings.Join(rulesetRateLimitCharacteristics, ", "), v))
+	}
+
+	return
+}
+
+// rulesetActionParameterProductValues are the products skip rules can
+// target, spelled as the API expects them. cloudflare-go lowercases some of
+// them (`zonelockdown`, `uablock` and `ratelimit`), which the API doesn't
+// accept.
+func rulesetActionParameterProductValues() []string {
+	return []string{"bic", "hot", "rateLimit", "securityLevel", "uaBlock", "waf", "zoneLockdown"}
+}
+
+// rulesetPhaseValues extends the phases known to cloudflare-go with those
+// the library does not model yet.
+func rulesetPhaseValues() []string {
+	phases := append(cloudflare.RulesetPhaseValues(), "http_config_settings", "http_request_dynamic_redirect")
+	sort.Strings(phases)
+	return phases
+}
+
+// rulesetRuleActionValues extends the actions known to cloudflare-go with
+// those the library does not model yet.
+func rulesetRuleActionValues() []string {
+	actions := append(cloudflare.RulesetRuleActionValues(), "set_config")
+	sort.Strings(actions)
+	return actions
+}
+
 func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"account_id": {
@@ -36,14 +113,14 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 		"kind": {
 			Type:         schema.TypeString,
 			Required:     true,
-			ValidateFunc: validation.StringInSlice(cloudflare.RulesetKindValues(), false),
+			ValidateFunc: validateEnum(cloudflare.RulesetKindValues()),
 			Description:  fmt.Sprintf("Type of Ruleset to create. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetKindValues())),
 		},
 		"phase": {
 			Type:         schema.TypeString,
 			Required:     true,
-			ValidateFunc: validation.StringInSlice(cloudflare.RulesetPhaseValues(), false),
-			Description:  fmt.Sprintf("Point in the request/response lifecycle where the ruleset will be created. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetPhaseValues())),
+			ValidateFunc: validateEnum(rulesetPhaseValues()),
+			Description:  fmt.Sprintf("Point in the request/response lifecycle where the ruleset will be created. %s", renderAvailableDocumentationValuesStringSlice(rulesetPhaseValues())),
 		},
 		"shareable_entitlement_name": {
 			Type:        schema.TypeString,
@@ -68,8 +145,9 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 					},
 					"ref": {
 						Type:        schema.TypeString,
+						Optional:    true,
 						Computed:    true,
-						Description: "Rule reference.",
+						Description: "Rule reference used to track the rule across updates, for example in analytics. Must be unique within the ruleset. When not set, the reference of the previously applied rule with the same expression and description is kept so that updates do not reset it.",
 					},
 					"enabled": {
 						Type:        schema.TypeBool,
@@ -79,8 +157,8 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 					"action": {
 						Type:         schema.TypeString,
 						Optional:     true,
-						ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-						Description:  fmt.Sprintf("Action to perform in the ruleset rule. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetRuleActionValues())),
+						ValidateFunc: validateEnum(rulesetRuleActionValues()),
+						Description:  fmt.Sprintf("Action to perform in the ruleset rule. %s", renderAvailableDocumentationValuesStringSlice(rulesetRuleActionValues())),
 					},
 					"expression": {
 						Description: "Criteria for an HTTP request to trigger the ruleset rule action. Uses the Firewall Rules expression language based on Wireshark display filters. Refer to the [Firewall Rules language](https://developers.cloudflare.com/firewall/cf-firewall-language) documentation for all available fields, operators, and functions",
@@ -107,17 +185,19 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 								"products": {
 									Type:        schema.TypeSet,
 									Optional:    true,
-									Description: fmt.Sprintf("Products to target with the actions. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetActionParameterProductValues())),
+									Description: fmt.Sprintf("Products to target with the actions. %s", renderAvailableDocumentationValuesStringSlice(rulesetActionParameterProductValues())),
 									Elem: &schema.Schema{
-										Type: schema.TypeString,
+										Type:         schema.TypeString,
+										ValidateFunc: validateEnum(rulesetActionParameterProductValues()),
 									},
 								},
 								"phases": {
 									Type:        schema.TypeSet,
 									Optional:    true,
-									Description: fmt.Sprintf("Point in the request/response lifecycle where the ruleset will be created. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetPhaseValues())),
+									Description: fmt.Sprintf("Point in the request/response lifecycle where the ruleset will be created. %s", renderAvailableDocumentationValuesStringSlice(rulesetPhaseValues())),
 									Elem: &schema.Schema{
-										Type: schema.TypeString,
+										Type:         schema.TypeString,
+										ValidateFunc: validateEnum(rulesetPhaseValues()),
 									},
 								},
 								"uri": {
@@ -196,9 +276,10 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 												Optional:    true,
 											},
 											"operation": {
-												Type:        schema.TypeString,
-												Optional:    true,
-												Description: fmt.Sprintf("Action to perform on the HTTP request header. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetRuleActionParametersHTTPHeaderOperationValues())),
+												Type:         schema.TypeString,
+												Optional:     true,
+												ValidateFunc: validateEnum(cloudflare.RulesetRuleActionParametersHTTPHeaderOperationValues()),
+												Description:  fmt.Sprintf("Action to perform on the HTTP request header. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetRuleActionParametersHTTPHeaderOperationValues())),
 											},
 										},
 									},
@@ -257,8 +338,14 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 											"action": {
 												Type:         schema.TypeString,
 												Optional:     true,
-												ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-												Description:  fmt.Sprintf("Action to perform in the rule-level override. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetRuleActionValues())),
+												ValidateFunc: validateEnum(rulesetRuleActionValues()),
+												Description:  fmt.Sprintf("Action to perform in the rule-level override. %s", renderAvailableDocumentationValuesStringSlice(rulesetRuleActionValues())),
+											},
+											"sensitivity_level": {
+												Type:         schema.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringInSlice(rulesetOverrideSensitivityLevels, false),
+												Description:  fmt.Sprintf("Sensitivity level to apply to all rules in the managed ruleset. %s", renderAvailableDocumentationValuesStringSlice(rulesetOverrideSensitivityLevels)),
 											},
 											"categories": {
 												Type:        schema.TypeList,
@@ -274,8 +361,8 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 														"action": {
 															Type:         schema.TypeString,
 															Optional:     true,
-															ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-															Description:  fmt.Sprintf("Action to perform in the tag-level override. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetRuleActionValues())),
+															ValidateFunc: validateEnum(rulesetRuleActionValues()),
+															Description:  fmt.Sprintf("Action to perform in the tag-level override. %s", renderAvailableDocumentationValuesStringSlice(rulesetRuleActionValues())),
 														},
 														"enabled": {
 															Type:        schema.TypeBool,
@@ -307,8 +394,8 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 														"action": {
 															Type:         schema.TypeString,
 															Optional:     true,
-															ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-															Description:  fmt.Sprintf("Action to perform in the rule-level override. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetRuleActionValues())),
+															ValidateFunc: validateEnum(rulesetRuleActionValues()),
+															Description:  fmt.Sprintf("Action to perform in the rule-level override. %s", renderAvailableDocumentationValuesStringSlice(rulesetRuleActionValues())),
 														},
 														"enabled": {
 															Type:        schema.TypeBool,
@@ -324,14 +411,16 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 															Description:  fmt.Sprintf("Defines if the current rule-level override enables or disables the rule. %s", renderAvailableDocumentationValuesStringSlice([]string{"enabled", "disabled"})),
 														},
 														"score_threshold": {
-															Type:        schema.TypeInt,
-															Optional:    true,
-															Description: "Anomaly score threshold to apply in the ruleset rule override. Only applicable to modsecurity-based rulesets.",
+															Type:         schema.TypeInt,
+															Optional:     true,
+															ValidateFunc: validation.IntAtLeast(1),
+															Description:  "Anomaly score threshold to apply in the ruleset rule override. Only applicable to modsecurity-based rulesets such as the Cloudflare OWASP Core Ruleset.",
 														},
 														"sensitivity_level": {
-															Type:        schema.TypeString,
-															Optional:    true,
-															Description: "Sensitivity level for a ruleset rule override.",
+															Type:         schema.TypeString,
+															Optional:     true,
+															ValidateFunc: validation.StringInSlice(rulesetOverrideSensitivityLevels, false),
+															Description:  fmt.Sprintf("Sensitivity level for a ruleset rule override. %s", renderAvailableDocumentationValuesStringSlice(rulesetOverrideSensitivityLevels)),
 														},
 													},
 												},
@@ -440,14 +529,16 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											"mode": {
-												Type:        schema.TypeString,
-												Required:    true,
-												Description: "Mode of the edge TTL",
+												Type:         schema.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringInSlice(rulesetEdgeTTLModes, false),
+												Description:  fmt.Sprintf("Mode of the edge TTL. %s", renderAvailableDocumentationValuesStringSlice(rulesetEdgeTTLModes)),
 											},
 											"default": {
-												Type:        schema.TypeInt,
-												Required:    true,
-												Description: "Default edge TTL",
+												Type:         schema.TypeInt,
+												Optional:     true,
+												ValidateFunc: validation.IntAtLeast(1),
+												Description:  "Default edge TTL in seconds. Required when `mode` is `override_origin`.",
 											},
 											"status_code_ttl": {
 												Type:        schema.TypeList,
@@ -463,6 +554,7 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 														"status_code_range": {
 															Type:        schema.TypeList,
 															Optional:    true,
+															MaxItems:    1,
 															Description: "Status code range for which the edge TTL is applied. Conflicts with \"status_code\".",
 															Elem: &schema.Resource{
 																Schema: map[string]*schema.Schema{
@@ -498,9 +590,10 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											"mode": {
-												Type:        schema.TypeString,
-												Required:    true,
-												Description: "Mode of the browser TTL",
+												Type:         schema.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringInSlice(rulesetBrowserTTLModes, false),
+												Description:  fmt.Sprintf("Mode of the browser TTL. %s", renderAvailableDocumentationValuesStringSlice(rulesetBrowserTTLModes)),
 											},
 											"default": {
 												Type:        schema.TypeInt,
@@ -569,7 +662,7 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 																	"include": {
 																		Type:        schema.TypeList,
 																		Optional:    true,
-																		Description: "List of query string parameters to include in the custom key. Conflicts with \"exclude\".",
+																		Description: "List of query string parameters to include in the custom key. Use `[\"*\"]` to include all parameters. Conflicts with \"exclude\".",
 																		Elem: &schema.Schema{
 																			Type: schema.TypeString,
 																		},
@@ -577,7 +670,7 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 																	"exclude": {
 																		Type:        schema.TypeList,
 																		Optional:    true,
-																		Description: "List of query string parameters to exclude from the custom key. Conflicts with \"include\".",
+																		Description: "List of query string parameters to exclude from the custom key. Use `[\"*\"]` to exclude all parameters. Conflicts with \"include\".",
 																		Elem: &schema.Schema{
 																			Type: schema.TypeString,
 																		},
@@ -693,6 +786,150 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 									Optional:    true,
 									Description: "Pass-through error page for origin",
 								},
+								"automatic_https_rewrites": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Turn on or off Automatic HTTPS Rewrites",
+								},
+								"autominify": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "Indicate which file extensions to minify automatically",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"html": {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Description: "HTML minification",
+											},
+											"css": {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Description: "CSS minification",
+											},
+											"js": {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Description: "JS minification",
+											},
+										},
+									},
+								},
+								"bic": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Inspect the visitor's browser for headers commonly associated with spammers and certain bots",
+								},
+								"disable_apps": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Turn off all active Cloudflare Apps",
+								},
+								"disable_railgun": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Turn off Railgun",
+								},
+								"disable_zaraz": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Turn off Zaraz",
+								},
+								"email_obfuscation": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Turn on or off Email Obfuscation",
+								},
+								"hotlink_protection": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Turn on or off Hotlink Protection",
+								},
+								"mirage": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Turn on or off Mirage",
+								},
+								"opportunistic_encryption": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Turn on or off Opportunistic Encryption",
+								},
+								"polish": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(rulesetConfigPolishValues, false),
+									Description:  fmt.Sprintf("Apply options from the Polish feature of the Cloudflare Speed app. %s", renderAvailableDocumentationValuesStringSlice(rulesetConfigPolishValues)),
+								},
+								"rocket_loader": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Turn on or off Rocket Loader",
+								},
+								"security_level": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(rulesetConfigSecurityLevelValues, false),
+									Description:  fmt.Sprintf("Control options for the Security Level feature from the Security app. %s", renderAvailableDocumentationValuesStringSlice(rulesetConfigSecurityLevelValues)),
+								},
+								"server_side_excludes": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Turn on or off Server Side Excludes",
+								},
+								"ssl": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(rulesetConfigSSLValues, false),
+									Description:  fmt.Sprintf("Control options for the SSL feature of the Edge Certificates tab in the Cloudflare SSL/TLS app. %s", renderAvailableDocumentationValuesStringSlice(rulesetConfigSSLValues)),
+								},
+								"sxg": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Turn on or off Signed Exchanges (SXG)",
+								},
+								"from_value": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "Use a value to lookup information for the action.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"status_code": {
+												Type:         schema.TypeInt,
+												Optional:     true,
+												ValidateFunc: validation.IntInSlice(rulesetRedirectStatusCodes),
+												Description:  fmt.Sprintf("Status code for redirect. %s", renderAvailableDocumentationValuesIntSlice(rulesetRedirectStatusCodes)),
+											},
+											"target_url": {
+												Type:        schema.TypeList,
+												Optional:    true,
+												MaxItems:    1,
+												Description: "Target URL for redirect.",
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"value": {
+															Type:        schema.TypeString,
+															Optional:    true,
+															Description: "Static value to provide as the HTTP request header value. Conflicts with `expression`.",
+														},
+														"expression": {
+															Type:        schema.TypeString,
+															Optional:    true,
+															Description: "Use a value dynamically determined by the Firewall Rules expression language based on Wireshark display filters. Refer to the [Firewall Rules language](https://developers.cloudflare.com/firewall/cf-firewall-language) documentation for all available fields, operators, and functions. Conflicts with `value`.",
+														},
+													},
+												},
+											},
+											"preserve_query_string": {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Description: "Preserve query string for redirect URL.",
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -704,27 +941,34 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"characteristics": {
-									Type:        schema.TypeSet,
-									Optional:    true,
-									Description: "List of parameters that define how Cloudflare tracks the request rate for this rule.",
+									Type:     schema.TypeSet,
+									Optional: true,
+									Description: fmt.Sprintf(
+										"List of parameters that define how Cloudflare tracks the request rate for this rule. %s Header, cookie, query string argument, form field and JSON body values are supported using lookups such as `http.request.headers[\"x-api-key\"]`, `http.request.cookies[\"session\"]`, `http.request.uri.args[\"page\"]`, `http.request.body.form[\"user\"]` and `lookup_json_string(http.request.body.raw, \"user\")`.",
+										renderAvailableDocumentationValuesStringSlice(rulesetRateLimitCharacteristics),
+									),
 									Elem: &schema.Schema{
-										Type: schema.TypeString,
+										Type:         schema.TypeString,
+										ValidateFunc: validateRulesetRateLimitCharacteristic,
 									},
 								},
 								"period": {
-									Type:        schema.TypeInt,
-									Optional:    true,
-									Description: "The period of time to consider (in seconds) when evaluating the request rate.",
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntInSlice(rulesetRateLimitPeriods),
+									Description:  fmt.Sprintf("The period of time to consider (in seconds) when evaluating the request rate. %s", renderAvailableDocumentationValuesIntSlice(rulesetRateLimitPeriods)),
 								},
 								"requests_per_period": {
-									Type:        schema.TypeInt,
-									Optional:    true,
-									Description: "The number of requests over the period of time that will trigger the Rate Limiting rule.",
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+									Description:  "The number of requests over the period of time that will trigger the Rate Limiting rule.",
 								},
 								"mitigation_timeout": {
-									Type:        schema.TypeInt,
-									Optional:    true,
-									Description: "Once the request rate is reached, the Rate Limiting rule blocks further requests for the period of time defined in this field.",
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntInSlice(rulesetRateLimitMitigationTimeouts),
+									Description:  fmt.Sprintf("Once the request rate is reached, the Rate Limiting rule blocks further requests for the period of time defined in this field. A value of `0` throttles requests above the rate instead of blocking all requests. %s", renderAvailableDocumentationValuesIntSlice(rulesetRateLimitMitigationTimeouts)),
 								},
 								"counting_expression": {
 									Type:        schema.TypeString,
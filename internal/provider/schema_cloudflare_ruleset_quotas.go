@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareRulesetQuotasSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description:  "The account identifier to target for the resource.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"account_id", "zone_id"},
+		},
+		"zone_id": {
+			Description:  "The zone identifier to target for the resource.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"account_id", "zone_id"},
+		},
+		"phases": {
+			Description: "Phases to report the quota of. Defaults to every phase with a known quota.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(rulesetPhaseValues(), false),
+			},
+		},
+		"allowed_overrides": {
+			Description: "Number of rules allowed per phase, overriding the default plan quota. Useful for Enterprise contracts with custom limits.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+		},
+		"plan": {
+			Description: "The plan the quotas are based on. Account level rulesets are only available on Enterprise plans.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"quotas": {
+			Description: "Rule usage of each phase.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"phase": {
+						Description: "The ruleset phase.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"used": {
+						Description: "Number of rules in the phase entrypoint ruleset.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"allowed": {
+						Description: "Number of rules allowed in the phase. `0` when the quota is not known.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"remaining": {
+						Description: "Number of rules which can still be added to the phase. Negative when the quota is exceeded.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareSecondaryDNSIncomingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the zone.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"peers": {
+			Description: "IDs of the `cloudflare_secondary_dns_peer` resources the zone is transferred from.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"auto_refresh_seconds": {
+			Description:  "How often, in seconds, the primary nameservers are checked for a new SOA serial when no NOTIFY is received.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      86400,
+			ValidateFunc: validation.IntAtLeast(300),
+		},
+		"soa_serial": {
+			Description: "The SOA serial of the most recently transferred version of the zone.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"checked_time": {
+			Description: "When the primary nameservers were last checked for a new SOA serial.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"modified_time": {
+			Description: "When the zone was last transferred.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
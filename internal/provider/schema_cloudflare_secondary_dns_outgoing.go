@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareSecondaryDNSOutgoingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the zone.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"peers": {
+			Description: "IDs of the `cloudflare_secondary_dns_peer` resources the zone is transferred to.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"enabled": {
+			Description: "Whether outgoing zone transfers are enabled. When enabled, NOTIFYs are sent to the peers on changes to the zone.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"force_notify_triggers": {
+			Description: "Arbitrary map of values that, when changed, sends a NOTIFY to the peers so they transfer the zone immediately instead of waiting for the SOA refresh. Reference the records managed alongside the zone to notify after every change.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"soa_serial": {
+			Description: "The SOA serial of the most recent version of the zone.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"last_transferred_time": {
+			Description: "When the zone was last transferred to a peer.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
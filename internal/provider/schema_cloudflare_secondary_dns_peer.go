@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareSecondaryDNSPeerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the peer.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"ip": {
+			Description:  "The IP address of the peer nameserver. Required for peers used for incoming zone transfers.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"port": {
+			Description:  "The DNS port of the peer nameserver.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      53,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"ixfr_enable": {
+			Description: "Whether IXFR is used instead of AXFR for incoming zone transfers from this peer.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"tsig_id": {
+			Description: "The ID of the `cloudflare_secondary_dns_tsig` used to authenticate transfers with this peer.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var secondaryDNSTSIGAlgorithms = []string{"hmac-sha512.", "hmac-sha256.", "hmac-sha1.", "hmac-md5.sig-alg.reg.int."}
+
+func resourceCloudflareSecondaryDNSTSIGSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the TSIG key, as configured on the primary nameserver.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"secret": {
+			Description: "The shared secret of the TSIG key.",
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+		},
+		"algo": {
+			Description:  fmt.Sprintf("The algorithm of the TSIG key. %s", renderAvailableDocumentationValuesStringSlice(secondaryDNSTSIGAlgorithms)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(secondaryDNSTSIGAlgorithms, false),
+		},
+	}
+}
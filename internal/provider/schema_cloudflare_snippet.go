@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareSnippetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description:  "The name of the snippet. Only lowercase letters, numbers and underscores are allowed.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9_]+$`), "must only contain lowercase letters, numbers and underscores"),
+		},
+		"main_module": {
+			Description: "The name of the file that exports the snippet handler.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"files": {
+			Description: "The JavaScript modules that make up the snippet.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The file name of the module.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"content": {
+						Description: "The content of the module.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+				},
+			},
+		},
+		"created_on": {
+			Description: "When the snippet was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"modified_on": {
+			Description: "When the snippet was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
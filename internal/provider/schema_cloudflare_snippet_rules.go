@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareSnippetRulesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"rules": {
+			Description: "The rules of the zone, evaluated in order. Every matching rule runs its snippet.",
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"expression": {
+						Description: "The expression requests must match for the snippet to run.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"snippet_name": {
+						Description: "The name of the snippet to run.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"enabled": {
+						Description: "Whether the rule is enabled.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+					"description": {
+						Description: "Brief summary of the rule and its intended use.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareStreamSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"url": {
+			Description: "Publicly accessible URL of the video which is copied into Stream.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "A name for the video, stored in the video metadata.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"allowed_origins": {
+			Description: "Origins allowed to display the video. Use `*` for wildcard subdomains. An empty list allows every origin.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"require_signed_urls": {
+			Description: "Whether the video can only be accessed using a signed URL.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"thumbnail_timestamp_pct": {
+			Description:  "The position in the video, as a value between 0 and 1, used for the default thumbnail.",
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.FloatBetween(0, 1),
+		},
+		"status": {
+			Description: "The processing state of the video.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"ready_to_stream": {
+			Description: "Whether the video is ready to be viewed.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"duration": {
+			Description: "The duration of the video in seconds.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"preview": {
+			Description: "The video's preview page URL.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"thumbnail": {
+			Description: "The video's thumbnail image URL.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareStreamAudioTrackSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"video_id": {
+			Description: "The identifier of the Stream video to add the audio track to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"url": {
+			Description: "The URL of the audio file to copy to Stream.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"label": {
+			Description: "The label of the audio track shown in the player, for example the language it is in.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"default": {
+			Description: "Whether the audio track is played by default. Setting a track as the default removes the flag from the other tracks of the video.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"status": {
+			Description: "The processing status of the audio track.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareStreamCaptionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"video_id": {
+			Description: "The identifier of the Stream video to add the captions to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"language": {
+			Description: "The BCP 47 language tag of the captions, for example `en` or `pt-BR`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"content": {
+			Description:  "The captions, in WebVTT format.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"label": {
+			Description: "The label of the captions shown in the player, derived from the language.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
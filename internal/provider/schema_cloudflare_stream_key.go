@@ -0,0 +1,31 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceCloudflareStreamKeySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"pem": {
+			Description: "The base64 encoded RSA private key in PEM format used to sign Stream URLs. Only available when the key is created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"jwk": {
+			Description: "The base64 encoded private key in JWK format used to sign Stream URLs. Only available when the key is created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"created": {
+			Description: "When the signing key was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
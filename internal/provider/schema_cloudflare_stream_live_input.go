@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareStreamLiveInputSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "A name for the live input, stored in the live input metadata.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"recording_mode": {
+			Description:  fmt.Sprintf("Whether broadcasts to the live input are recorded. %s", renderAvailableDocumentationValuesStringSlice(streamLiveInputRecordingModes)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "off",
+			ValidateFunc: validateEnum(streamLiveInputRecordingModes),
+		},
+		"recording_timeout_seconds": {
+			Description:  "How long, in seconds, to wait after a broadcast disconnects before the recording is finalised. `0` uses the Stream default.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"recording_require_signed_urls": {
+			Description: "Whether recordings can only be accessed using a signed URL.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"recording_allowed_origins": {
+			Description: "Origins allowed to display recordings of the live input.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"delete_recording_after_days": {
+			Description:  "Number of days after which recordings are deleted. Recordings are kept indefinitely when unset.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(30),
+		},
+		"rtmps_url": {
+			Description: "The RTMPS URL to broadcast to.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"rtmps_stream_key": {
+			Description: "The stream key used together with `rtmps_url`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"srt_url": {
+			Description: "The SRT URL to broadcast to.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"srt_stream_id": {
+			Description: "The stream ID used together with `srt_url`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"srt_passphrase": {
+			Description: "The passphrase used together with `srt_url`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"webrtc_url": {
+			Description: "The WebRTC (WHIP) URL to broadcast to.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+	}
+}
@@ -45,6 +45,11 @@ func resourceCloudflareTeamsAccountSchema() map[string]*schema.Schema {
 			Type:     schema.TypeBool,
 			Optional: true,
 		},
+		"extended_email_matching_enabled": {
+			Description: "Whether identity expressions in Gateway policies match email addresses regardless of plus-addressing and dots, so that `user+tag@example.com` matches `user@example.com`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
 		"logging": {
 			Type:     schema.TypeList,
 			MaxItems: 1,
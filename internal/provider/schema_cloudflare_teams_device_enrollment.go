@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareTeamsDeviceEnrollmentSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"allowed_idps": {
+			Description: "The identity providers users may authenticate with to enroll a device. All configured identity providers are allowed when not set.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"auto_redirect_to_identity": {
+			Description: "Whether users are redirected straight to the identity provider when only one is allowed.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"session_duration": {
+			Description: "How long a device enrollment session is valid for, e.g. `24h`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "24h",
+		},
+		"application_id": {
+			Description: "The identifier of the Access application backing device enrollment. Use it as the `application_id` of `cloudflare_access_policy` resources to define who may enroll devices.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"aud": {
+			Description: "Application Audience (AUD) Tag of the device enrollment application.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"domain": {
+			Description: "The domain users visit to enroll a device.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCloudflareTeamsRuleSchema() map[string]*schema.Schema {
@@ -31,7 +30,7 @@ func resourceCloudflareTeamsRuleSchema() map[string]*schema.Schema {
 		},
 		"action": {
 			Type:         schema.TypeString,
-			ValidateFunc: validation.StringInSlice(cloudflare.TeamsRulesActionValues(), false),
+			ValidateFunc: validateEnum(cloudflare.TeamsRulesActionValues()),
 			Required:     true,
 		},
 		"filters": {
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var tieredCacheTypes = []string{"off", "generic", "smart"}
+
+func resourceCloudflareTieredCacheSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"cache_type": {
+			Description:  fmt.Sprintf("The tiered cache topology. `generic` uses all Cloudflare data centers as upper tiers and `smart` picks the upper tiers closest to the origin. %s", renderAvailableDocumentationValuesStringSlice(tieredCacheTypes)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(tieredCacheTypes, false),
+		},
+		"regional": {
+			Description: "Whether Regional Tiered Cache adds a regional tier between the lower tiers and the upper tiers.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
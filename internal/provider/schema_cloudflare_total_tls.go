@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var totalTLSCertificateAuthorities = []string{"google", "lets_encrypt"}
+
+func resourceCloudflareTotalTLSSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"enabled": {
+			Description: "Whether a certificate is issued automatically for every proxied hostname of the zone.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"certificate_authority": {
+			Description:  fmt.Sprintf("The certificate authority issuing the certificates. Left to the Cloudflare default when not set. %s", renderAvailableDocumentationValuesStringSlice(totalTLSCertificateAuthorities)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateEnum(totalTLSCertificateAuthorities),
+		},
+		"validity_days": {
+			Description: "How long the issued certificates are valid for, in days.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+}
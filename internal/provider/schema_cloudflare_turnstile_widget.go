@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareTurnstileWidgetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description:  "Human readable widget name.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 254),
+		},
+		"domains": {
+			Description: "Domains where the widget is deployed. Domains are compared case insensitively and their order is not significant.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Set:         turnstileWidgetDomainHash,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"mode": {
+			Description:  fmt.Sprintf("Widget mode. %s", renderAvailableDocumentationValuesStringSlice(turnstileWidgetModes)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateEnum(turnstileWidgetModes),
+		},
+		"region": {
+			Description:  fmt.Sprintf("Region where the widget's challenges are served from. %s", renderAvailableDocumentationValuesStringSlice(turnstileWidgetRegions)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "world",
+			ValidateFunc: validateEnum(turnstileWidgetRegions),
+		},
+		"bot_fight_mode": {
+			Description: "Whether to issue computationally expensive challenges in response to malicious bots. Enterprise only.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"offlabel": {
+			Description: "Whether to hide the Cloudflare branding on the widget. Enterprise only.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"ephemeral_id": {
+			Description: "Whether to return an ephemeral visitor ID with Siteverify responses which can be used to link activity across sessions. Enterprise only.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"clearance_level": {
+			Description:  fmt.Sprintf("The level of Cloudflare challenge clearance granted when a visitor solves the widget, allowing them to pass WAF challenges on the same zone (pre-clearance). %s", renderAvailableDocumentationValuesStringSlice(turnstileWidgetClearanceLevels)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "no_clearance",
+			ValidateFunc: validateEnum(turnstileWidgetClearanceLevels),
+		},
+		"secret": {
+			Description: "Secret key used to validate responses with the Siteverify API.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"secret_rotation_trigger": {
+			Description: "Arbitrary value which rotates the widget secret whenever it changes, for example a timestamp or a `time_rotating` resource.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"invalidate_previous_secret": {
+			Description: "Whether the previous secret is invalidated immediately when the secret is rotated. Otherwise the previous secret remains valid for two hours.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
@@ -127,5 +127,19 @@ func resourceCloudflareWaitingRoomSchema() map[string]*schema.Schema {
 			Type:        schema.TypeBool,
 			Optional:    true,
 		},
+
+		"queueing_method": {
+			Description:  fmt.Sprintf("The queueing method used by the waiting room. %s", renderAvailableDocumentationValuesStringSlice(waitingRoomQueueingMethod)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "fifo",
+			ValidateFunc: validation.StringInSlice(waitingRoomQueueingMethod, false),
+		},
+
+		"cookie_suffix": {
+			Description: "Appends a suffix to the `__cfwaitingroom` cookie name, allowing multiple waiting rooms on overlapping routes to keep separate sessions.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
 	}
 }
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var waitingRoomRuleActions = []string{"bypass_waiting_room"}
+
+// waitingRoomRuleExpressionFields are the fields which may be used in
+// Waiting Room rule expressions. Expressions referencing any other field are
+// rejected by the edge at request time, which leaves the rule inactive.
+var waitingRoomRuleExpressionFields = []string{
+	"http.cookie",
+	"http.host",
+	"http.referer",
+	"http.request.full_uri",
+	"http.request.method",
+	"http.request.uri",
+	"http.request.uri.path",
+	"http.request.uri.path.extension",
+	"http.request.uri.query",
+	"http.request.uri.args",
+	"http.request.uri.args.names",
+	"http.request.uri.args.values",
+	"http.request.headers",
+	"http.request.headers.names",
+	"http.request.headers.values",
+	"http.request.cookies",
+	"http.request.accepted_languages",
+	"http.request.version",
+	"http.user_agent",
+	"http.x_forwarded_for",
+	"ip.src",
+	"ip.src.asnum",
+	"ip.src.continent",
+	"ip.src.country",
+	"ip.src.subdivision_1_iso_code",
+	"ip.src.subdivision_2_iso_code",
+	"ip.src.is_in_european_union",
+	"ip.src.city",
+	"ip.src.postal_code",
+	"ip.src.region",
+	"ip.src.region_code",
+	"ip.src.lat",
+	"ip.src.lon",
+	"ip.src.metro_code",
+	"ip.src.timezone.name",
+	"ip.geoip.asnum",
+	"ip.geoip.continent",
+	"ip.geoip.country",
+	"ip.geoip.subdivision_1_iso_code",
+	"ip.geoip.subdivision_2_iso_code",
+	"ip.geoip.is_in_european_union",
+}
+
+var (
+	waitingRoomRuleStringLiteral = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+	waitingRoomRuleField         = regexp.MustCompile(`[a-z][a-z0-9_]*(?:\.[a-z0-9_]+)+`)
+)
+
+func resourceCloudflareWaitingRoomRulesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"waiting_room_id": {
+			Description: "The Waiting Room ID the rules should apply to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"rules": {
+			Description: "List of rules to apply to the waiting room, evaluated in order.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "Unique rule identifier.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"version": {
+						Description: "Version of the waiting room rule.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"action": {
+						Description:  fmt.Sprintf("Action to perform in the rule. %s", renderAvailableDocumentationValuesStringSlice(waitingRoomRuleActions)),
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(waitingRoomRuleActions, false),
+					},
+					"expression": {
+						Description:  fmt.Sprintf("Criteria for an HTTP request to trigger the action. Only the following fields may be used: %s.", strings.Join(waitingRoomRuleExpressionFieldsDocumentation(), ", ")),
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateWaitingRoomRuleExpression,
+					},
+					"description": {
+						Description: "Brief summary of the waiting room rule and its intended use.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"enabled": {
+						Description: "Whether the rule is enabled.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateWaitingRoomRuleExpression rejects expressions which reference
+// fields that are not available to Waiting Room rules. String literals are
+// ignored so that hostnames and paths are not mistaken for fields.
+func validateWaitingRoomRuleExpression(v interface{}, k string) (warnings []string, errs []error) {
+	expression := waitingRoomRuleStringLiteral.ReplaceAllString(v.(string), `""`)
+
+	allowed := make(map[string]bool, len(waitingRoomRuleExpressionFields))
+	for _, f := range waitingRoomRuleExpressionFields {
+		allowed[f] = true
+	}
+
+	reported := make(map[string]bool)
+	for _, loc := range waitingRoomRuleField.FindAllStringIndex(expression, -1) {
+		field := expression[loc[0]:loc[1]]
+
+		// Identifiers preceded by a letter, digit or `$` are part of a larger
+		// token, such as a list reference or a number, and are not fields.
+		if loc[0] > 0 && strings.ContainsAny(expression[loc[0]-1:loc[0]], "$_abcdefghijklmnopqrstuvwxyz0123456789") {
+			continue
+		}
+
+		if !allowed[field] && !reported[field] {
+			reported[field] = true
+			errs = append(errs, fmt.Errorf("%s: field %q is not supported in waiting room rules", k, field))
+		}
+	}
+
+	return warnings, errs
+}
+
+func waitingRoomRuleExpressionFieldsDocumentation() []string {
+	fields := make([]string, 0, len(waitingRoomRuleExpressionFields))
+	for _, f := range waitingRoomRuleExpressionFields {
+		fields = append(fields, fmt.Sprintf("`%s`", f))
+	}
+	sort.Strings(fields)
+
+	return fields
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var web3HostnameTargets = []string{"ethereum", "ipfs", "ipfs_universal_path"}
+
+func resourceCloudflareWeb3HostnameSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The hostname that will point to the target gateway.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"target": {
+			Description:  fmt.Sprintf("The gateway the hostname points to. %s", renderAvailableDocumentationValuesStringSlice(web3HostnameTargets)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(web3HostnameTargets, false),
+		},
+		"description": {
+			Description: "An optional description of the hostname.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"dnslink": {
+			Description:  "The DNSLink value served by the hostname, such as `/ipns/onboarding.ipfs.cloudflare.com`. Only valid for the `ipfs` target.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/(ipfs|ipns)/.+`), "must start with /ipfs/ or /ipns/"),
+		},
+		"status": {
+			Description: "The status of the hostname's activation.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_on": {
+			Description: "When the hostname was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"modified_on": {
+			Description: "When the hostname was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -8,17 +8,22 @@ func resourceCloudflareWorkerCronTriggerSchema() map[string]*schema.Schema {
 			Description: "The account identifier to target for the resource.",
 			Type:        schema.TypeString,
 			Required:    true,
+			ForceNew:    true,
 		},
 		"script_name": {
-			Type:     schema.TypeString,
-			Required: true,
+			Description: "Worker script to target for the schedules.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
 		},
 		"schedules": {
-			Type:     schema.TypeSet,
-			Required: true,
-			MinItems: 1,
+			Description: "Cron expressions to execute the Worker script on. Expressions use the five field format (`minute hour day-of-month month day-of-week`).",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validateCronExpression,
 			},
 		},
 	}
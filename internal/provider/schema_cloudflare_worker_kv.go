@@ -1,21 +1,48 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 func resourceCloudflareWorkerKVSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"key": {
-			Type:     schema.TypeString,
-			Required: true,
+			Description: "Name of the KV pair.",
+			Type:        schema.TypeString,
+			Required:    true,
 		},
 		"namespace_id": {
-			Type:     schema.TypeString,
-			ForceNew: true,
-			Required: true,
+			Description: "The ID of the Workers KV namespace in which you want to create the KV pair.",
+			Type:        schema.TypeString,
+			ForceNew:    true,
+			Required:    true,
 		},
 		"value": {
-			Type:     schema.TypeString,
-			Required: true,
+			Description: "Value of the KV pair.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"metadata": {
+			Description:      "Arbitrary JSON object to associate with the KV pair. Must not exceed 1024 bytes when serialised.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.All(validation.StringIsJSON, validation.StringLenBetween(0, 1024)),
+			DiffSuppressFunc: suppressEquivalentJSONDiffs,
+		},
+		"expiration": {
+			Description:   "The time, measured in number of seconds since the UNIX epoch, at which the KV pair should expire. Conflicts with `expiration_ttl`.",
+			Type:          schema.TypeInt,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"expiration_ttl"},
+		},
+		"expiration_ttl": {
+			Description:   "The number of seconds from now after which the KV pair should expire. Must be at least 60. Conflicts with `expiration`.",
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ValidateFunc:  validation.IntAtLeast(60),
+			ConflictsWith: []string{"expiration"},
 		},
 	}
 }
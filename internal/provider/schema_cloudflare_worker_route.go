@@ -1,6 +1,13 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var workerRouteRequestLimitFailureModes = []string{"fail_open", "fail_closed"}
 
 func resourceCloudflareWorkerRouteSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -20,5 +27,19 @@ func resourceCloudflareWorkerRouteSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+
+		"request_limit_failure_mode": {
+			Description:  fmt.Sprintf("What happens to requests matching the route once the Workers request limit of the account is reached: `fail_open` sends them to the origin, `fail_closed` returns an error. %s", renderAvailableDocumentationValuesStringSlice(workerRouteRequestLimitFailureModes)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(workerRouteRequestLimitFailureModes, false),
+		},
+
+		"allow_overlapping_patterns": {
+			Description: "Whether the pattern may overlap with the pattern of another route in the zone. Requests matching several routes are handled by the most specific one. When `false`, the pattern is checked when the route is created or its pattern changes, and overlaps with routes that already exist in the zone fail at plan time. Routes created in the same apply are not compared with each other, so overlaps between them are not detected. Identical patterns are always rejected.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
 	}
 }
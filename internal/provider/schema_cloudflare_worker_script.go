@@ -1,6 +1,16 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	workerScriptAssetsHTMLHandlings     = []string{"auto-trailing-slash", "force-trailing-slash", "drop-trailing-slash", "none"}
+	workerScriptAssetsNotFoundHandlings = []string{"none", "404-page", "single-page-application"}
+)
 
 var kvNamespaceBindingResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
@@ -86,5 +96,50 @@ func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 			Optional: true,
 			Elem:     webAssemblyBindingResource,
 		},
+		"assets": {
+			Description: "Static assets served alongside the script. Requires `account_id` to be set on the provider.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"directory": {
+						Description: "Path to the local directory containing the assets to upload.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"binding": {
+						Description: "Name of the binding exposing the assets to the script, e.g. `ASSETS`.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"html_handling": {
+						Description:  fmt.Sprintf("How trailing slashes and `.html` extensions are handled for HTML assets. %s", renderAvailableDocumentationValuesStringSlice(workerScriptAssetsHTMLHandlings)),
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "auto-trailing-slash",
+						ValidateFunc: validation.StringInSlice(workerScriptAssetsHTMLHandlings, false),
+					},
+					"not_found_handling": {
+						Description:  fmt.Sprintf("How requests not matching an asset are handled. %s", renderAvailableDocumentationValuesStringSlice(workerScriptAssetsNotFoundHandlings)),
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "none",
+						ValidateFunc: validation.StringInSlice(workerScriptAssetsNotFoundHandlings, false),
+					},
+					"run_worker_first": {
+						Description: "Whether the script is invoked before assets are served, instead of only for requests not matching an asset.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+		"assets_manifest_hash": {
+			Description: "Checksum of the asset manifest, used to detect changes to the files in `assets.directory`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 	}
 }
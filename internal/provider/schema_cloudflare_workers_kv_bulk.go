@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareWorkersKVBulkSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"namespace_id": {
+			Description: "The ID of the Workers KV namespace in which the KV pairs are written.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"values": {
+			Description: "Map of keys to the values stored in the namespace. Keys removed from the map are deleted from the namespace.",
+			Type:        schema.TypeMap,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"base64": {
+			Description: "Whether the values are base64 encoded binary data which should be decoded before being stored.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"expiration_ttl": {
+			Description:  "The number of seconds from when they are written after which the KV pairs should expire. Must be at least 60.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(60),
+		},
+	}
+}
@@ -5,8 +5,15 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 func resourceCloudflareWorkersKVNamespaceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"title": {
-			Type:     schema.TypeString,
-			Required: true,
+			Description: "Title value of the Worker KV Namespace.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"purge_on_destroy": {
+			Description: "Whether to delete every key in the namespace before the namespace itself is destroyed.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
 		},
 	}
 }
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var zarazWorkflows = []string{"realtime", "preview"}
+
+func resourceCloudflareZarazWorkflowSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"workflow": {
+			Description:  fmt.Sprintf("Zaraz workflow. With `realtime` every configuration change is published immediately. With `preview` changes are saved as a draft until they are published. %s", renderAvailableDocumentationValuesStringSlice(zarazWorkflows)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(zarazWorkflows, false),
+		},
+		"publish_trigger": {
+			Description: "Arbitrary value which publishes the draft Zaraz configuration whenever it changes. Only applicable when `workflow` is `preview`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"publish_description": {
+			Description: "Description recorded in the Zaraz configuration history when the draft configuration is published.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+	}
+}
@@ -43,6 +43,11 @@ func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 				planIDPartnerEnterprise,
 			}, false),
 		},
+		"prevent_downgrade": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 		"meta": {
 			Type:     schema.TypeMap,
 			Computed: true,
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZoneCacheReserveSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"enabled": {
+			Description: "Whether Cache Reserve is enabled for the zone.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"modified_on": {
+			Description: "When the setting was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
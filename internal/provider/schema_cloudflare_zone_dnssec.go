@@ -11,49 +11,72 @@ func resourceCloudflareZoneDNSSECSchema() map[string]*schema.Schema {
 			ForceNew:    true,
 		},
 		"status": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Description: "The status of the zone DNSSEC.",
+			Type:        schema.TypeString,
+			Computed:    true,
 		},
 		"flags": {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Description: "The flags of the DNSKEY record.",
+			Type:        schema.TypeInt,
+			Computed:    true,
 		},
 		"algorithm": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Description: "The algorithm of the DS record.",
+			Type:        schema.TypeString,
+			Computed:    true,
 		},
 		"key_type": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Description: "The key type of the DNSKEY record.",
+			Type:        schema.TypeString,
+			Computed:    true,
 		},
 		"digest_type": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Description: "The digest type of the DS record.",
+			Type:        schema.TypeString,
+			Computed:    true,
 		},
 		"digest_algorithm": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Description: "The digest algorithm of the DS record.",
+			Type:        schema.TypeString,
+			Computed:    true,
 		},
 		"digest": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Description: "The digest of the DS record.",
+			Type:        schema.TypeString,
+			Computed:    true,
 		},
 		"ds": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Description: "The full DS record to configure at the registrar.",
+			Type:        schema.TypeString,
+			Computed:    true,
 		},
 		"key_tag": {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Description: "The key tag of the DS record.",
+			Type:        schema.TypeInt,
+			Computed:    true,
 		},
 		"public_key": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Description: "The public key of the DNSKEY record.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"dnssec_multi_signer": {
+			Description: "Whether multi-signer DNSSEC is enabled, allowing other providers to serve the zone with their own keys.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"dnssec_presigned": {
+			Description: "Whether the zone is transferred with its DNSSEC records already signed by the primary, for secondary zones.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
 		},
 		"modified_on": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Computed: true,
+			Description: "When the zone DNSSEC was last modified.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
 		},
 	}
 }
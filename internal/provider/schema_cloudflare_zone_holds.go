@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareZoneHoldsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"held": {
+			Description: "Only return zones which currently have a hold enabled.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"zones": {
+			Description: "Hold status of the zones in the account.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"zone_id": {
+						Description: "The zone identifier.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"zone_name": {
+						Description: "The zone name.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"hold": {
+						Description: "Whether the zone hold is enabled.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"include_subdomains": {
+						Description: "Whether the hold also applies to subdomains of the zone.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"hold_after": {
+						Description: "RFC3339 timestamp after which a temporarily disabled hold is re-enabled.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// zoneSecurityHeaderPreloadMinMaxAge is the minimum max-age, one year, that
+// browser HSTS preload lists accept.
+const zoneSecurityHeaderPreloadMinMaxAge = 31536000
+
+func resourceCloudflareZoneSecurityHeaderSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"enabled": {
+			Description: "Whether the `Strict-Transport-Security` header is sent.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"max_age": {
+			Description:  "How long, in seconds, browsers should only connect to the zone over HTTPS.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"include_subdomains": {
+			Description: "Whether the policy also applies to subdomains of the zone.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"preload": {
+			Description: fmt.Sprintf("Whether the zone may be included in browser HSTS preload lists. Requires `enabled`, `include_subdomains` and a `max_age` of at least %d seconds.", zoneSecurityHeaderPreloadMinMaxAge),
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"nosniff": {
+			Description: "Whether the `X-Content-Type-Options: nosniff` header is sent.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var zoneSecurityLevels = []string{"off", "essentially_off", "low", "medium", "high", "under_attack"}
+
+var zoneSecurityLevelScheduleDays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+var zoneSecurityLevelScheduleTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+func resourceCloudflareZoneSecurityLevelScheduleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"security_level": {
+			Description:  fmt.Sprintf("The security level applied while the schedule is active. %s", renderAvailableDocumentationValuesStringSlice(zoneSecurityLevels)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(zoneSecurityLevels, false),
+		},
+		"revert_security_level": {
+			Description:  fmt.Sprintf("The security level applied while the schedule is inactive and when the resource is destroyed. Defaults to `previous_security_level`. %s", renderAvailableDocumentationValuesStringSlice(zoneSecurityLevels)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(zoneSecurityLevels, false),
+		},
+		"until": {
+			Description:  "RFC3339 timestamp after which the schedule is no longer active. The security level is reverted on the next apply after this time.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"window": {
+			Description: "Recurring UTC windows during which the schedule is active. When combined with `until` both must match.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"days": {
+						Description: fmt.Sprintf("Days of the week the window applies to. Defaults to every day. %s", renderAvailableDocumentationValuesStringSlice(zoneSecurityLevelScheduleDays)),
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(zoneSecurityLevelScheduleDays, false),
+						},
+					},
+					"start": {
+						Description:  "Start of the window in `HH:MM` format, UTC.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringMatch(zoneSecurityLevelScheduleTimeRegexp, "must be in HH:MM format"),
+					},
+					"end": {
+						Description:  "End of the window in `HH:MM` format, UTC. Windows ending before they start span midnight.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringMatch(zoneSecurityLevelScheduleTimeRegexp, "must be in HH:MM format"),
+					},
+				},
+			},
+		},
+		"active": {
+			Description: "Whether the schedule was active when last applied or refreshed.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"previous_security_level": {
+			Description: "The security level of the zone before the resource was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"current_security_level": {
+			Description: "The security level currently applied to the zone.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -2,15 +2,22 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"hash/crc32"
+	"io"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -251,3 +258,253 @@ func renderAvailableDocumentationValuesStringSlice(s []string) string {
 	}
 	return output
 }
+
+// renderAvailableDocumentationValuesIntSlice takes a slice of ints and
+// formats it for documentation output use.
+//
+// Example: [1, 2, 3] -> `1`, `2`, `3`.
+func renderAvailableDocumentationValuesIntSlice(s []int) string {
+	values := make([]string, len(s))
+	for i, c := range s {
+		values[i] = strconv.Itoa(c)
+	}
+	return renderAvailableDocumentationValuesStringSlice(values)
+}
+
+// suppressEquivalentJSONDiffs suppresses differences between two JSON
+// documents which only differ in formatting or key order.
+func suppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+// rawResponseWithResultInfo is the API envelope including the result_info
+// which cloudflare.API.Raw discards.
+type rawResponseWithResultInfo struct {
+	cloudflare.Response
+	Result     json.RawMessage       `json:"result"`
+	ResultInfo cloudflare.ResultInfo `json:"result_info"`
+}
+
+// rawRequest performs a JSON API request like cloudflare.API.Raw, but bound
+// to ctx so that it is cancelled with the operation that made it.
+func rawRequest(ctx context.Context, client *apiClient, method, uri string, payload interface{}) (json.RawMessage, error) {
+	response, err := sendRawJSONRequest(ctx, client, method, uri, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Result, nil
+}
+
+// rawRequestWithResultInfo performs an API request like rawRequest but also
+// returns the result_info of the response. This is needed to follow the
+// cursors of endpoints which cloudflare-go does not paginate itself.
+func rawRequestWithResultInfo(ctx context.Context, client *apiClient, method, uri string) (json.RawMessage, cloudflare.ResultInfo, error) {
+	response, err := sendRawJSONRequest(ctx, client, method, uri, nil)
+	if err != nil {
+		return nil, cloudflare.ResultInfo{}, err
+	}
+
+	return response.Result, response.ResultInfo, nil
+}
+
+// sendRawJSONRequest sends payload, unless it is nil, as the JSON body of an
+// API request.
+func sendRawJSONRequest(ctx context.Context, client *apiClient, method, uri string, payload interface{}) (rawResponseWithResultInfo, error) {
+	var body io.Reader
+	if payload != nil {
+		b, ok := payload.([]byte)
+		if !ok {
+			var err error
+			if b, err = json.Marshal(payload); err != nil {
+				return rawResponseWithResultInfo{}, fmt.Errorf("error marshalling request body: %w", err)
+			}
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, client.BaseURL+uri, body)
+	if err != nil {
+		return rawResponseWithResultInfo{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return sendRawRequest(client, req, "")
+}
+
+// rawMultipartRequest performs an API request with a multipart/form-data
+// body, which cloudflare.API.Raw can't send. When token is set it is used as
+// the bearer token instead of the provider credentials, as required by
+// endpoints authenticated with short-lived upload tokens.
+func rawMultipartRequest(ctx context.Context, client *apiClient, method, uri, token, contentType string, body []byte) (json.RawMessage, error) {
+	req, err := http.NewRequestWithContext(withRequestTimeout(ctx, slowRequestTimeout), method, client.BaseURL+uri, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	response, err := sendRawRequest(client, req, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Result, nil
+}
+
+// sendRawRequest authenticates and sends req, returning the decoded API
+// envelope. Unsuccessful responses are returned as errors.
+func sendRawRequest(client *apiClient, req *http.Request, token string) (rawResponseWithResultInfo, error) {
+	setRawRequestHeaders(client, req, token)
+
+	var response rawResponseWithResultInfo
+
+	res, err := client.httpClient.Do(req)
+	if err != nil {
+		return response, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return response, err
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		if res.StatusCode >= http.StatusBadRequest {
+			return response, &rawRequestError{statusCode: res.StatusCode, rayID: res.Header.Get("cf-ray")}
+		}
+		return response, fmt.Errorf("error unmarshalling response (HTTP status %d): %w", res.StatusCode, err)
+	}
+
+	if res.StatusCode >= http.StatusBadRequest || !response.Success {
+		return response, &rawRequestError{
+			statusCode: res.StatusCode,
+			errors:     response.Errors,
+			rayID:      res.Header.Get("cf-ray"),
+		}
+	}
+
+	return response, nil
+}
+
+// rawRequestError is returned by the raw request helpers for unsuccessful
+// responses. Like the cloudflare-go errors, it carries the API error codes
+// and the Ray ID of the request.
+type rawRequestError struct {
+	statusCode int
+	errors     []cloudflare.ResponseInfo
+	rayID      string
+}
+
+func (e *rawRequestError) Error() string {
+	var messages []string
+	for _, e := range e.errors {
+		messages = append(messages, fmt.Sprintf("%s (%d)", e.Message, e.Code))
+	}
+	if len(messages) == 0 {
+		return fmt.Sprintf("HTTP status %d", e.statusCode)
+	}
+	return fmt.Sprintf("HTTP status %d: %s", e.statusCode, strings.Join(messages, ", "))
+}
+
+func (e *rawRequestError) ErrorCodes() []int {
+	var codes []int
+	for _, e := range e.errors {
+		codes = append(codes, e.Code)
+	}
+	return codes
+}
+
+func (e *rawRequestError) RayID() string {
+	return e.rayID
+}
+
+// isNotFoundError reports whether err is a 404 response, returned either by
+// cloudflare-go or by the raw request helpers.
+func isNotFoundError(err error) bool {
+	var notFoundError *cloudflare.NotFoundError
+	if errors.As(err, &notFoundError) {
+		return true
+	}
+
+	var requestError *rawRequestError
+	return errors.As(err, &requestError) && requestError.statusCode == http.StatusNotFound
+}
+
+// setRawRequestHeaders sets the authentication and user agent headers the
+// way cloudflare-go does. When token is set it is used as the bearer token
+// instead of the provider credentials.
+func setRawRequestHeaders(client *apiClient, req *http.Request, token string) {
+	switch {
+	case token != "":
+		req.Header.Set("Authorization", "Bearer "+token)
+	case client.APIToken != "":
+		req.Header.Set("Authorization", "Bearer "+client.APIToken)
+	default:
+		req.Header.Set("X-Auth-Key", client.APIKey)
+		req.Header.Set("X-Auth-Email", client.APIEmail)
+	}
+	req.Header.Set("User-Agent", client.UserAgent)
+}
+
+// graphQLRequest runs query against the GraphQL Analytics API and decodes
+// its data into result. The GraphQL API does not use the regular API
+// envelope, so errors are read from the GraphQL response instead.
+func graphQLRequest(ctx context.Context, client *apiClient, query string, variables map[string]interface{}, result interface{}) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.BaseURL+"/graphql", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	setRawRequestHeaders(client, req, "")
+
+	res, err := client.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return fmt.Errorf("error unmarshalling GraphQL response (HTTP status %d): %w", res.StatusCode, err)
+	}
+
+	if len(response.Errors) > 0 {
+		var messages []string
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		return fmt.Errorf("GraphQL error: %s", strings.Join(messages, ", "))
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("GraphQL request failed with HTTP status %d", res.StatusCode)
+	}
+
+	return json.Unmarshal(response.Data, result)
+}
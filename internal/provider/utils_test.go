@@ -0,0 +1,22 @@
+package provider
+
+import "testing"
+
+func TestSuppressEquivalentJSONDiffs(t *testing.T) {
+	cases := []struct {
+		old, new string
+		want     bool
+	}{
+		{`{"a":1,"b":2}`, `{"b":2,"a":1}`, true},
+		{`{"a":1}`, "{\n  \"a\": 1\n}", true},
+		{`{"a":1}`, `{"a":2}`, false},
+		{`{"a":1}`, ``, false},
+		{`not json`, `not json`, false},
+	}
+
+	for _, c := range cases {
+		if got := suppressEquivalentJSONDiffs("metadata", c.old, c.new, nil); got != c.want {
+			t.Errorf("suppressEquivalentJSONDiffs(%q, %q) = %t, want %t", c.old, c.new, got, c.want)
+		}
+	}
+}
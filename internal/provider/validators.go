@@ -4,7 +4,11 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var allowedHTTPMethods = []string{"GET", "POST", "PUT", "DELETE", "PATCH", "HEAD", "_ALL_"}
@@ -15,13 +19,13 @@ func validateRecordType(t string, proxied bool) error {
 	switch t {
 	case "A", "AAAA", "CNAME":
 		return nil
-	case "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR":
+	case "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "SVCB", "HTTPS":
 		if ![]bool{proxied}[0] {
 			return nil
 		}
 	default:
 		return fmt.Errorf(
-			`Invalid type %q. Valid types are "A", "AAAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI" or "PTR.`, t)
+			`Invalid type %q. Valid types are "A", "AAAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "SVCB" or "HTTPS".`, t)
 	}
 
 	return fmt.Errorf("type %q cannot be proxied", t)
@@ -74,3 +78,195 @@ func validateURL(v interface{}, k string) (s []string, errors []error) {
 	}
 	return
 }
+
+// cronField describes the accepted values of a single field within a cron
+// expression.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+var cronDayOfMonthSpecialRegexp = regexp.MustCompile(`^(L|LW|\d{1,2}W)$`)
+var cronDayOfWeekSpecialRegexp = regexp.MustCompile(`^([0-7]|[A-Za-z]{3})(L|#[1-5])$`)
+
+// validateCronExpression ensures that the value is a five field cron
+// expression as accepted by Worker Cron Triggers.
+func validateCronExpression(v interface{}, k string) (warnings []string, errors []error) {
+	fields := strings.Fields(v.(string))
+	if len(fields) != len(cronFields) {
+		errors = append(errors, fmt.Errorf("%q: cron expression %q must contain exactly %d fields, got %d", k, v.(string), len(cronFields), len(fields)))
+		return
+	}
+
+	for i, field := range fields {
+		if err := cronFields[i].validate(field); err != nil {
+			errors = append(errors, fmt.Errorf("%q: invalid %s field %q in cron expression %q: %w", k, cronFields[i].name, field, v.(string), err))
+		}
+	}
+
+	return
+}
+
+func (f cronField) validate(value string) error {
+	for _, part := range strings.Split(value, ",") {
+		if f.name == "day of month" && cronDayOfMonthSpecialRegexp.MatchString(part) {
+			if strings.HasSuffix(part, "W") && part != "LW" {
+				if _, err := f.parseValue(strings.TrimSuffix(part, "W")); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if f.name == "day of week" && cronDayOfWeekSpecialRegexp.MatchString(part) {
+			if _, err := f.parseValue(cronDayOfWeekSpecialRegexp.FindStringSubmatch(part)[1]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		base := part
+		if idx := strings.Index(part, "/"); idx != -1 {
+			base = part[:idx]
+			step, err := strconv.Atoi(part[idx+1:])
+			if err != nil || step < 1 {
+				return fmt.Errorf("step %q must be a positive integer", part[idx+1:])
+			}
+		}
+
+		if base == "*" {
+			continue
+		}
+
+		bounds := strings.SplitN(base, "-", 2)
+		start, err := f.parseValue(bounds[0])
+		if err != nil {
+			return err
+		}
+
+		if len(bounds) == 2 {
+			end, err := f.parseValue(bounds[1])
+			if err != nil {
+				return err
+			}
+
+			if start > end {
+				return fmt.Errorf("range %q must not be descending", base)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) parseValue(value string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("value %q is not a number", value)
+	}
+
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %d must be between %d and %d", n, f.min, f.max)
+	}
+
+	return n, nil
+}
+
+// validateEnum returns a ValidateFunc which only accepts one of the provided
+// values. Where possible, the values should be sourced from the `*Values()`
+// helpers in cloudflare-go so that accepted values track the API client. When
+// a value isn't accepted, the closest match is suggested to help catch typos
+// (such as `l4override` instead of `l4_override`) at plan time.
+func validateEnum(values []string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (warnings []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		for _, allowed := range values {
+			if value == allowed {
+				return
+			}
+		}
+
+		err := fmt.Errorf("expected %s to be one of %q, got %q", k, values, value)
+		if suggestion := closestEnumValue(value, values); suggestion != "" {
+			err = fmt.Errorf("%s. Did you mean %q?", err, suggestion)
+		}
+		errors = append(errors, err)
+
+		return
+	}
+}
+
+// closestEnumValue returns the accepted value which most closely resembles
+// the provided value or an empty string if none are similar enough.
+func closestEnumValue(value string, values []string) string {
+	normalise := func(s string) string {
+		return strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(s))
+	}
+
+	closest, closestDistance := "", 3
+	for _, allowed := range values {
+		if normalise(allowed) == normalise(value) {
+			return allowed
+		}
+
+		if distance := levenshteinDistance(strings.ToLower(value), strings.ToLower(allowed)); distance < closestDistance {
+			closest, closestDistance = allowed, distance
+		}
+	}
+
+	return closest
+}
+
+// levenshteinDistance returns the number of single character edits required
+// to change a into b.
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = previous[j] + 1
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+			if previous[j-1]+cost < current[j] {
+				current[j] = previous[j-1] + cost
+			}
+		}
+
+		previous = current
+	}
+
+	return previous[len(b)]
+}
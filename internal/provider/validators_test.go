@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"strings"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -17,6 +18,8 @@ func TestValidateRecordType(t *testing.T) {
 		"MX":    cloudflare.BoolPtr(false),
 		"NS":    cloudflare.BoolPtr(false),
 		"SPF":   cloudflare.BoolPtr(false),
+		"SVCB":  cloudflare.BoolPtr(false),
+		"HTTPS": cloudflare.BoolPtr(false),
 	}
 	for k, v := range validTypes {
 		err := validateRecordType(k, *v)
@@ -35,6 +38,7 @@ func TestValidateRecordType(t *testing.T) {
 		"TXT":   cloudflare.BoolPtr(true),
 		"SRV":   cloudflare.BoolPtr(true),
 		"SPF":   cloudflare.BoolPtr(true),
+		"HTTPS": cloudflare.BoolPtr(true),
 	}
 	for k, v := range invalidTypes {
 		if err := validateRecordType(k, *v); err == nil {
@@ -67,3 +71,74 @@ func TestValidateRecordName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateCronExpression(t *testing.T) {
+	validExpressions := []string{
+		"*/5 * * * *",
+		"10 7 * * mon-fri",
+		"0 0 1 JAN,JUL *",
+		"0 12 L * *",
+		"0 12 15W * *",
+		"30 9 * * 2#1",
+		"59 23 * * 6L",
+		"0-30/10 1-5 * * *",
+		"0 0 * * 0",
+		"0 0 * * 0-6",
+		"0 0 * * 7",
+		"0 0 * * SUN-SAT",
+		"0 0 * * 0L",
+	}
+	for _, v := range validExpressions {
+		if _, errs := validateCronExpression(v, "schedules"); len(errs) > 0 {
+			t.Fatalf("%q should be a valid cron expression: %v", v, errs)
+		}
+	}
+
+	invalidExpressions := []string{
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"30-10 * * * *",
+		"* * * foo *",
+		"* * * * 2#6",
+	}
+	for _, v := range invalidExpressions {
+		if _, errs := validateCronExpression(v, "schedules"); len(errs) == 0 {
+			t.Fatalf("%q should be an invalid cron expression", v)
+		}
+	}
+}
+
+func TestValidateEnum(t *testing.T) {
+	validate := validateEnum([]string{"l4_override", "ddos_dynamic", "log"})
+
+	if _, errs := validate("l4_override", "action"); len(errs) > 0 {
+		t.Fatalf("expected l4_override to be valid: %v", errs)
+	}
+
+	suggestions := map[string]string{
+		"l4override":   `Did you mean "l4_override"?`,
+		"ddos-dynamic": `Did you mean "ddos_dynamic"?`,
+		"lgo":          `Did you mean "log"?`,
+	}
+	for value, suggestion := range suggestions {
+		_, errs := validate(value, "action")
+		if len(errs) != 1 {
+			t.Fatalf("expected %q to be invalid", value)
+		}
+
+		if !strings.HasSuffix(errs[0].Error(), suggestion) {
+			t.Fatalf("expected error for %q to suggest %s, got %q", value, suggestion, errs[0])
+		}
+	}
+
+	_, errs := validate("something_else", "action")
+	if len(errs) != 1 || strings.Contains(errs[0].Error(), "Did you mean") {
+		t.Fatalf("expected no suggestion for unrelated value, got %v", errs)
+	}
+}